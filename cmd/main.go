@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/application"
+	"os"
 )
 
 func main() {
@@ -12,6 +13,8 @@ func main() {
 	// - config
 	cfg := &application.ConfigServerChi{
 		ServerAddress:          ":8080",
+		GRPCAddress:            ":9090",
+		RedisAddress:           os.Getenv("REDIS_ADDR"),
 	}
 	app := application.NewServerChi(cfg)
 	// - run
@@ -62,6 +62,24 @@ CONSTRAINT `fk_privinces_countries1`
     ENGINE = InnoDB;
 
 
+-- -----------------------------------------------------
+-- Table `frescos`.`tax_rules`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `frescos`.`tax_rules` (
+`id` INT AUTO_INCREMENT NOT NULL,
+`country_id` INT NOT NULL,
+`name` VARCHAR(64) NOT NULL,
+`rate` DECIMAL(5,2) NOT NULL,
+PRIMARY KEY (`id`),
+INDEX `fk_tax_rules_countries1_idx` (`country_id` ASC) VISIBLE,
+CONSTRAINT `fk_tax_rules_countries1`
+ FOREIGN KEY (`country_id`)
+     REFERENCES `frescos`.`countries` (`id`)
+     ON DELETE NO ACTION
+     ON UPDATE NO ACTION)
+    ENGINE = InnoDB;
+
+
 -- -----------------------------------------------------
 -- Table `frescos`.`localities`
 -- -----------------------------------------------------
@@ -156,10 +174,11 @@ DROP TABLE IF EXISTS `frescos`.`product_type`;
 
 CREATE TABLE IF NOT EXISTS `frescos`.`product_type`
 (
-    `id`          INT          NOT NULL,
-    `description` VARCHAR(255) NOT NULL,
-    `name`        VARCHAR(64)  NOT NULL,
-    PRIMARY KEY (`id`)
+    `id`          INT AUTO_INCREMENT NOT NULL,
+    `description` VARCHAR(255)       NOT NULL,
+    `name`        VARCHAR(64)        NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `name_UNIQUE` (`name` ASC) VISIBLE
 )
     ENGINE = InnoDB
     DEFAULT CHARACTER SET = utf8mb4;
@@ -273,10 +292,12 @@ CREATE TABLE IF NOT EXISTS `frescos`.`product_batches`
     `minimum_temperature` DECIMAL(19, 2) NOT NULL,
     `section_id`          INT            NOT NULL,
     `product_id`          INT            UNSIGNED NOT NULL,
+    `status`              VARCHAR(16)    NOT NULL DEFAULT 'received',
     PRIMARY KEY (`id`),
     UNIQUE INDEX `uq_batch_number` (`batch_number` ASC) VISIBLE,
     INDEX `fk_product_batches_sections_idx` (`section_id` ASC) VISIBLE,
     INDEX `fk_product_batches_products_idx` (`product_id` ASC) VISIBLE,
+    INDEX `idx_product_batches_status_due_date` (`status` ASC, `due_date` ASC) VISIBLE,
     CONSTRAINT `fk_product_batches_products`
         FOREIGN KEY (`product_id`)
             REFERENCES `frescos`.`products` (`id`)
@@ -290,6 +311,38 @@ CREATE TABLE IF NOT EXISTS `frescos`.`product_batches`
     DEFAULT CHARACTER SET = utf8mb4;
 
 
+-- -----------------------------------------------------
+-- Table `frescos`.`product_batch_status_changes`
+-- Changes of the status of the product batches along with their reason, in the order they were made
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`product_batch_status_changes`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`product_batch_status_changes`
+(
+    `id`               INT UNSIGNED AUTO_INCREMENT NOT NULL,
+    `product_batch_id` INT UNSIGNED NOT NULL,
+    `from_status`      VARCHAR(16)  NOT NULL,
+    `to_status`        VARCHAR(16)  NOT NULL,
+    `reason`           VARCHAR(255) NOT NULL,
+    `reason_code`      VARCHAR(32)  NULL DEFAULT NULL,
+    `employee_id`      INT          NULL DEFAULT NULL,
+    `changed_at`       DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`),
+    INDEX `idx_product_batch_status_changes_batch_changed` (`product_batch_id` ASC, `changed_at` ASC) VISIBLE,
+    INDEX `fk_product_batch_status_changes_employees_idx` (`employee_id` ASC) VISIBLE,
+    CONSTRAINT `fk_product_batch_status_changes_product_batches`
+        FOREIGN KEY (`product_batch_id`)
+            REFERENCES `frescos`.`product_batches` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION,
+    CONSTRAINT `fk_product_batch_status_changes_employees`
+        FOREIGN KEY (`employee_id`)
+            REFERENCES `frescos`.`employees` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
 -- -----------------------------------------------------
 -- Table `frescos`.`inbound_orders`
 -- -----------------------------------------------------
@@ -324,6 +377,238 @@ CREATE TABLE IF NOT EXISTS `frescos`.`inbound_orders`
     DEFAULT CHARACTER SET = utf8mb4;
 
 
+-- -----------------------------------------------------
+-- Table `frescos`.`stock_movements`
+-- Append-only ledger of the stock of the product batches, the current quantity of a batch is the sum of its movements
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`stock_movements`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`stock_movements`
+(
+    `id`               INT UNSIGNED AUTO_INCREMENT NOT NULL,
+    `product_batch_id` INT UNSIGNED NOT NULL,
+    `section_id`       INT          NOT NULL,
+    `quantity`         INT          NOT NULL,
+    `reason`           VARCHAR(32)  NOT NULL,
+    `reason_code`      VARCHAR(32)  NULL DEFAULT NULL,
+    `employee_id`      INT          NULL DEFAULT NULL,
+    `actor`            VARCHAR(255) NOT NULL DEFAULT '',
+    `created_at`       DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`),
+    INDEX `idx_stock_movements_batch_created` (`product_batch_id` ASC, `created_at` ASC) VISIBLE,
+    INDEX `fk_stock_movements_sections_idx` (`section_id` ASC) VISIBLE,
+    INDEX `fk_stock_movements_employees_idx` (`employee_id` ASC) VISIBLE,
+    CONSTRAINT `fk_stock_movements_product_batches`
+        FOREIGN KEY (`product_batch_id`)
+            REFERENCES `frescos`.`product_batches` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION,
+    CONSTRAINT `fk_stock_movements_sections`
+        FOREIGN KEY (`section_id`)
+            REFERENCES `frescos`.`sections` (`id`),
+    CONSTRAINT `fk_stock_movements_employees`
+        FOREIGN KEY (`employee_id`)
+            REFERENCES `frescos`.`employees` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
+-- -----------------------------------------------------
+-- Table `frescos`.`transfer_orders`
+-- Product batches moved from a section of a warehouse to a section of another one, drafted, dispatched, in transit
+-- and received in that order
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`transfer_orders`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`transfer_orders`
+(
+    `id`                       INT AUTO_INCREMENT NOT NULL,
+    `source_warehouse_id`      INT         NOT NULL,
+    `source_section_id`        INT         NOT NULL,
+    `destination_warehouse_id` INT         NOT NULL,
+    `destination_section_id`   INT         NOT NULL,
+    `carrier_id`               INT         NULL DEFAULT NULL,
+    `status`                   VARCHAR(16) NOT NULL DEFAULT 'draft',
+    `created_at`               DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `dispatched_at`            DATETIME(6) NULL DEFAULT NULL,
+    `in_transit_at`            DATETIME(6) NULL DEFAULT NULL,
+    `received_at`              DATETIME(6) NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `fk_transfer_orders_source_warehouses_idx` (`source_warehouse_id` ASC) VISIBLE,
+    INDEX `fk_transfer_orders_source_sections_idx` (`source_section_id` ASC) VISIBLE,
+    INDEX `fk_transfer_orders_destination_warehouses_idx` (`destination_warehouse_id` ASC) VISIBLE,
+    INDEX `fk_transfer_orders_destination_sections_idx` (`destination_section_id` ASC) VISIBLE,
+    INDEX `fk_transfer_orders_carriers_idx` (`carrier_id` ASC) VISIBLE,
+    CONSTRAINT `fk_transfer_orders_source_warehouses`
+        FOREIGN KEY (`source_warehouse_id`)
+            REFERENCES `frescos`.`warehouses` (`id`),
+    CONSTRAINT `fk_transfer_orders_source_sections`
+        FOREIGN KEY (`source_section_id`)
+            REFERENCES `frescos`.`sections` (`id`),
+    CONSTRAINT `fk_transfer_orders_destination_warehouses`
+        FOREIGN KEY (`destination_warehouse_id`)
+            REFERENCES `frescos`.`warehouses` (`id`),
+    CONSTRAINT `fk_transfer_orders_destination_sections`
+        FOREIGN KEY (`destination_section_id`)
+            REFERENCES `frescos`.`sections` (`id`),
+    CONSTRAINT `fk_transfer_orders_carriers`
+        FOREIGN KEY (`carrier_id`)
+            REFERENCES `frescos`.`carriers` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
+-- -----------------------------------------------------
+-- Table `frescos`.`transfer_order_lines`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`transfer_order_lines`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`transfer_order_lines`
+(
+    `id`                INT AUTO_INCREMENT NOT NULL,
+    `transfer_order_id` INT          NOT NULL,
+    `product_batch_id`  INT UNSIGNED NOT NULL,
+    `quantity`          INT          NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uq_transfer_order_lines_batch` (`transfer_order_id` ASC, `product_batch_id` ASC) VISIBLE,
+    INDEX `fk_transfer_order_lines_product_batches_idx` (`product_batch_id` ASC) VISIBLE,
+    CONSTRAINT `fk_transfer_order_lines_transfer_orders`
+        FOREIGN KEY (`transfer_order_id`)
+            REFERENCES `frescos`.`transfer_orders` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION,
+    CONSTRAINT `fk_transfer_order_lines_product_batches`
+        FOREIGN KEY (`product_batch_id`)
+            REFERENCES `frescos`.`product_batches` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
+-- -----------------------------------------------------
+-- Table `frescos`.`count_sessions`
+-- Physical counts of the product batches of a section, approving a session posts its variances to the stock ledger
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`count_sessions`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`count_sessions`
+(
+    `id`          INT AUTO_INCREMENT NOT NULL,
+    `section_id`  INT         NOT NULL,
+    `status`      VARCHAR(16) NOT NULL DEFAULT 'open',
+    `created_at`  DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `approved_at` DATETIME(6) NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_count_sessions_section_status` (`section_id` ASC, `status` ASC) VISIBLE,
+    CONSTRAINT `fk_count_sessions_sections`
+        FOREIGN KEY (`section_id`)
+            REFERENCES `frescos`.`sections` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
+-- -----------------------------------------------------
+-- Table `frescos`.`count_lines`
+-- The quantity a product batch was expected to hold when its session was opened and the one counted by an employee
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`count_lines`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`count_lines`
+(
+    `id`                INT AUTO_INCREMENT NOT NULL,
+    `count_session_id`  INT          NOT NULL,
+    `product_batch_id`  INT UNSIGNED NOT NULL,
+    `expected_quantity` INT          NOT NULL,
+    `counted_quantity`  INT          NULL DEFAULT NULL,
+    `variance`          INT          NULL DEFAULT NULL,
+    `employee_id`       INT          NULL DEFAULT NULL,
+    `counted_at`        DATETIME(6)  NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uq_count_lines_batch` (`count_session_id` ASC, `product_batch_id` ASC) VISIBLE,
+    INDEX `fk_count_lines_product_batches_idx` (`product_batch_id` ASC) VISIBLE,
+    INDEX `fk_count_lines_employees_idx` (`employee_id` ASC) VISIBLE,
+    CONSTRAINT `fk_count_lines_count_sessions`
+        FOREIGN KEY (`count_session_id`)
+            REFERENCES `frescos`.`count_sessions` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION,
+    CONSTRAINT `fk_count_lines_product_batches`
+        FOREIGN KEY (`product_batch_id`)
+            REFERENCES `frescos`.`product_batches` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION,
+    CONSTRAINT `fk_count_lines_employees`
+        FOREIGN KEY (`employee_id`)
+            REFERENCES `frescos`.`employees` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
+-- -----------------------------------------------------
+-- Table `frescos`.`recalls`
+-- Recall campaigns of every batch of a product or of a set of batches, the batches of an open recall cannot be picked
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`recalls`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`recalls`
+(
+    `id`         INT AUTO_INCREMENT NOT NULL,
+    `product_id` INT UNSIGNED NULL DEFAULT NULL,
+    `severity`   VARCHAR(16)  NOT NULL,
+    `reason`     VARCHAR(255) NOT NULL,
+    `status`     VARCHAR(16)  NOT NULL DEFAULT 'open',
+    `created_at` DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `closed_at`  DATETIME(6)  NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `fk_recalls_products_idx` (`product_id` ASC) VISIBLE,
+    INDEX `idx_recalls_status` (`status` ASC) VISIBLE,
+    CONSTRAINT `fk_recalls_products`
+        FOREIGN KEY (`product_id`)
+            REFERENCES `frescos`.`products` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
+-- -----------------------------------------------------
+-- Table `frescos`.`recall_batches`
+-- The batches of a recall, recalled, quarantined and disposed in that order
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`recall_batches`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`recall_batches`
+(
+    `id`                   INT AUTO_INCREMENT NOT NULL,
+    `recall_id`            INT          NOT NULL,
+    `product_batch_id`     INT UNSIGNED NOT NULL,
+    `status`               VARCHAR(16)  NOT NULL DEFAULT 'recalled',
+    `recalled_quantity`    INT          NOT NULL,
+    `quarantined_quantity` INT          NULL DEFAULT NULL,
+    `quarantined_at`       DATETIME(6)  NULL DEFAULT NULL,
+    `disposed_quantity`    INT          NULL DEFAULT NULL,
+    `disposed_at`          DATETIME(6)  NULL DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uq_recall_batches_batch` (`recall_id` ASC, `product_batch_id` ASC) VISIBLE,
+    INDEX `fk_recall_batches_product_batches_idx` (`product_batch_id` ASC) VISIBLE,
+    CONSTRAINT `fk_recall_batches_recalls`
+        FOREIGN KEY (`recall_id`)
+            REFERENCES `frescos`.`recalls` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION,
+    CONSTRAINT `fk_recall_batches_product_batches`
+        FOREIGN KEY (`product_batch_id`)
+            REFERENCES `frescos`.`product_batches` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
 -- -----------------------------------------------------
 -- Table `frescos`.`product_records`
 -- -----------------------------------------------------
@@ -332,12 +617,13 @@ DROP TABLE IF EXISTS `frescos`.`product_records`;
 CREATE TABLE IF NOT EXISTS `frescos`.`product_records`
 (
     `id`             INT  AUTO_INCREMENT NOT NULL,
-    `last_update`    DATETIME(6)    NULL DEFAULT NULL,
+    `last_update`    DATETIME(6)    NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
     `purchase_price` DECIMAL(19, 2) NULL DEFAULT NULL,
     `sale_price`     DECIMAL(19, 2) NULL DEFAULT NULL,
     `product_id`     INT            UNSIGNED NOT NULL,
     PRIMARY KEY (`id`),
     INDEX `fk_product_records_products_idx` (`product_id` ASC) VISIBLE,
+    INDEX `product_records_history_idx` (`product_id` ASC, `last_update` ASC) VISIBLE,
     CONSTRAINT `fk_product_records_products`
         FOREIGN KEY (`product_id`)
             REFERENCES `frescos`.`products` (`id`)
@@ -430,6 +716,181 @@ CREATE TABLE IF NOT EXISTS `frescos`.`order_details`
     DEFAULT CHARACTER SET = utf8mb4;
 
 
+-- -----------------------------------------------------
+-- Table `frescos`.`order_detail_picks`
+-- Stock of a product batch picked to fulfill a line of a purchase order, along with its pick in the stock ledger
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`order_detail_picks`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`order_detail_picks`
+(
+    `id`                INT AUTO_INCREMENT NOT NULL,
+    `order_detail_id`   INT          NOT NULL,
+    `product_batch_id`  INT UNSIGNED NOT NULL,
+    `quantity`          INT          NOT NULL,
+    `employee_id`       INT          NULL DEFAULT NULL,
+    `stock_movement_id` INT UNSIGNED NOT NULL,
+    `picked_at`         DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`),
+    INDEX `fk_order_detail_picks_order_details_idx` (`order_detail_id` ASC) VISIBLE,
+    INDEX `idx_order_detail_picks_batch_picked` (`product_batch_id` ASC, `picked_at` ASC) VISIBLE,
+    INDEX `fk_order_detail_picks_employees_idx` (`employee_id` ASC) VISIBLE,
+    UNIQUE INDEX `uq_order_detail_picks_stock_movement` (`stock_movement_id` ASC) VISIBLE,
+    CONSTRAINT `fk_order_detail_picks_order_details`
+        FOREIGN KEY (`order_detail_id`)
+            REFERENCES `frescos`.`order_details` (`id`)
+            ON DELETE CASCADE,
+    CONSTRAINT `fk_order_detail_picks_product_batches`
+        FOREIGN KEY (`product_batch_id`)
+            REFERENCES `frescos`.`product_batches` (`id`),
+    CONSTRAINT `fk_order_detail_picks_employees`
+        FOREIGN KEY (`employee_id`)
+            REFERENCES `frescos`.`employees` (`id`),
+    CONSTRAINT `fk_order_detail_picks_stock_movements`
+        FOREIGN KEY (`stock_movement_id`)
+            REFERENCES `frescos`.`stock_movements` (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+
+-- -----------------------------------------------------
+-- Table `frescos`.`audit_logs`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`audit_logs`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`audit_logs`
+(
+    `id`          BIGINT AUTO_INCREMENT NOT NULL,
+    `actor`       VARCHAR(128) NOT NULL,
+    `request_id`  VARCHAR(128) NOT NULL,
+    `action`      VARCHAR(16)  NOT NULL,
+    `entity_type` VARCHAR(64)  NOT NULL,
+    `entity_id`   VARCHAR(64)  NOT NULL,
+    `before`      JSON         NULL DEFAULT NULL,
+    `after`       JSON         NULL DEFAULT NULL,
+    `diff`        JSON         NULL DEFAULT NULL,
+    `created_at`  DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`),
+    INDEX `idx_audit_logs_entity` (`entity_type` ASC, `entity_id` ASC) VISIBLE,
+    INDEX `idx_audit_logs_actor` (`actor` ASC) VISIBLE,
+    INDEX `idx_audit_logs_request` (`request_id` ASC) VISIBLE,
+    INDEX `idx_audit_logs_created_at` (`created_at` ASC) VISIBLE
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+-- The audit trail is append only
+DROP TRIGGER IF EXISTS `frescos`.`audit_logs_no_update`;
+CREATE TRIGGER `frescos`.`audit_logs_no_update`
+    BEFORE UPDATE
+    ON `frescos`.`audit_logs`
+    FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append only';
+
+DROP TRIGGER IF EXISTS `frescos`.`audit_logs_no_delete`;
+CREATE TRIGGER `frescos`.`audit_logs_no_delete`
+    BEFORE DELETE
+    ON `frescos`.`audit_logs`
+    FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append only';
+
+-- -----------------------------------------------------
+-- Table `frescos`.`idempotency_keys`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`idempotency_keys`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`idempotency_keys`
+(
+    `id`              INT AUTO_INCREMENT NOT NULL,
+    `idempotency_key` VARCHAR(255) NOT NULL,
+    `method`          VARCHAR(8)   NOT NULL,
+    `path`            VARCHAR(255) NOT NULL,
+    `request_hash`    CHAR(64)     NOT NULL,
+    `status_code`     INT          NOT NULL DEFAULT 0,
+    `content_type`    VARCHAR(128) NOT NULL DEFAULT '',
+    `response`        MEDIUMBLOB   NULL DEFAULT NULL,
+    `created_at`      DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uq_idempotency_key` (`idempotency_key` ASC) VISIBLE
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+-- -----------------------------------------------------
+-- Table `frescos`.`webhook_subscriptions`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`webhook_subscriptions`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`webhook_subscriptions`
+(
+    `id`          INT AUTO_INCREMENT NOT NULL,
+    `url`         VARCHAR(2048) NOT NULL,
+    `secret`      VARCHAR(255)  NOT NULL,
+    `event_types` JSON          NOT NULL,
+    `active`      TINYINT(1)    NOT NULL DEFAULT 1,
+    `created_at`  DATETIME(6)   NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`)
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+-- -----------------------------------------------------
+-- Table `frescos`.`webhook_deliveries`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`webhook_deliveries`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`webhook_deliveries`
+(
+    `id`              INT AUTO_INCREMENT NOT NULL,
+    `subscription_id` INT          NOT NULL,
+    `event_id`        VARCHAR(255) NOT NULL,
+    `event_type`      VARCHAR(64)  NOT NULL,
+    `payload`         JSON         NOT NULL,
+    `status`          VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    `attempts`        INT          NOT NULL DEFAULT 0,
+    `last_error`      VARCHAR(255) NOT NULL DEFAULT '',
+    `response_status` INT          NOT NULL DEFAULT 0,
+    `next_attempt_at` DATETIME(6)  NULL DEFAULT NULL,
+    `delivered_at`    DATETIME(6)  NULL DEFAULT NULL,
+    `created_at`      DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `uq_webhook_delivery_event` (`subscription_id` ASC, `event_id` ASC) VISIBLE,
+    INDEX `idx_webhook_delivery_due` (`status` ASC, `next_attempt_at` ASC) VISIBLE,
+    CONSTRAINT `fk_webhook_deliveries_subscriptions`
+        FOREIGN KEY (`subscription_id`)
+            REFERENCES `frescos`.`webhook_subscriptions` (`id`)
+            ON DELETE CASCADE
+            ON UPDATE NO ACTION
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
+-- -----------------------------------------------------
+-- Table `frescos`.`outbox`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `frescos`.`outbox`;
+
+CREATE TABLE IF NOT EXISTS `frescos`.`outbox`
+(
+    `id`              BIGINT AUTO_INCREMENT NOT NULL,
+    `aggregate_type`  VARCHAR(64)  NOT NULL,
+    `aggregate_id`    INT          NOT NULL,
+    `event_type`      VARCHAR(64)  NOT NULL,
+    `payload`         JSON         NOT NULL,
+    `status`          VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    `attempts`        INT          NOT NULL DEFAULT 0,
+    `last_error`      VARCHAR(255) NOT NULL DEFAULT '',
+    `next_attempt_at` DATETIME(6)  NULL DEFAULT NULL,
+    `dispatched_at`   DATETIME(6)  NULL DEFAULT NULL,
+    `created_at`      DATETIME(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (`id`),
+    INDEX `idx_outbox_pending` (`status` ASC, `id` ASC) VISIBLE,
+    INDEX `idx_outbox_aggregate` (`aggregate_type` ASC, `aggregate_id` ASC, `status` ASC, `id` ASC) VISIBLE
+)
+    ENGINE = InnoDB
+    DEFAULT CHARACTER SET = utf8mb4;
+
 SET SQL_MODE = @OLD_SQL_MODE;
 SET FOREIGN_KEY_CHECKS = @OLD_FOREIGN_KEY_CHECKS;
 SET UNIQUE_CHECKS = @OLD_UNIQUE_CHECKS;
@@ -10,6 +10,12 @@ INSERT INTO `frescos`.`countries` (`id`, `country`) VALUES
 (2, 'Colombia'),
 (3, 'México');
 
+-- Insert statements for tax rules, charged on the subtotal of the purchase orders shipped from each country
+INSERT INTO `frescos`.`tax_rules` (`id`, `country_id`, `name`, `rate`) VALUES
+(1, 1, 'IVA', 21.00),
+(2, 2, 'IVA', 19.00),
+(3, 3, 'IVA', 16.00);
+
 -- Insert statements for provinces
 INSERT INTO `frescos`.`provinces` (`id`, `province`, `country_id`) VALUES
 (1, 'Buenos Aires', 1),
@@ -287,24 +293,24 @@ VALUES
     ('10', 38, 3.90, 55, 14, 1.90, 1, 2);
 
 
-INSERT INTO `product_batches` (batch_number, current_quantity, current_temperature,due_date,initial_quantity,manufacturing_date,manufacturing_hour,minimum_temperature,section_id,product_id)
+INSERT INTO `product_batches` (batch_number, current_quantity, current_temperature,due_date,initial_quantity,manufacturing_date,manufacturing_hour,minimum_temperature,section_id,product_id,status)
 VALUES
-    ( 1, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,1,1),
-    ( 2, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,1,1),
-    ( 3, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,1,1),
-    ( 4, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,2,1),
-    ( 5, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,2,1),
-    ( 6, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,3,1),
-    ( 7, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,4,1),
-    ( 8, 200,20,"2022-04-04",1000
-    ,"2020-04-04",10,5,5,1);
+    ( 1, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,1,1,"available"),
+    ( 2, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,1,1,"available"),
+    ( 3, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,1,1,"available"),
+    ( 4, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,2,1,"available"),
+    ( 5, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,2,1,"available"),
+    ( 6, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,3,1,"available"),
+    ( 7, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,4,1,"available"),
+    ( 8, 200,20,"2030-04-04",1000
+    ,"2020-04-04",10,5,5,1,"available");
 
 insert into inbound_orders (id, order_date, order_number, employee_id, warehouse_id, product_batch_id) values (1, '2012-07-16', '263-93-6778', 6, 10, 6);
 insert into inbound_orders (id, order_date, order_number, employee_id, warehouse_id, product_batch_id) values (2, '2003-11-29', '613-86-9402', 10, 6, 8);
@@ -315,4 +321,12 @@ insert into inbound_orders (id, order_date, order_number, employee_id, warehouse
 insert into inbound_orders (id, order_date, order_number, employee_id, warehouse_id, product_batch_id) values (7, '2016-09-17', '430-41-2235', 7, 10, 2);
 insert into inbound_orders (id, order_date, order_number, employee_id, warehouse_id, product_batch_id) values (8, '2009-01-19', '188-43-1616', 9, 6, 8);
 insert into inbound_orders (id, order_date, order_number, employee_id, warehouse_id, product_batch_id) values (9, '2008-06-10', '752-18-3288', 3, 2, 1);
-insert into inbound_orders (id, order_date, order_number, employee_id, warehouse_id, product_batch_id) values (10, '2006-10-09', '284-71-5152', 10, 2, 3);
\ No newline at end of file
+insert into inbound_orders (id, order_date, order_number, employee_id, warehouse_id, product_batch_id) values (10, '2006-10-09', '284-71-5152', 10, 2, 3);
+
+-- Insert statements for the stock ledger, the receipt of every batch holding stock, by the employee of its first
+-- inbound order if any
+INSERT INTO stock_movements (product_batch_id, section_id, quantity, reason, employee_id, actor)
+SELECT pb.id, pb.section_id, pb.current_quantity, 'receipt', io.employee_id, 'seed'
+FROM product_batches pb
+LEFT JOIN inbound_orders io ON io.id = (SELECT MIN(id) FROM inbound_orders WHERE product_batch_id = pb.id)
+WHERE pb.current_quantity > 0;
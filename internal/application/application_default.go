@@ -1,13 +1,21 @@
 package application
 
 import (
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
-	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/application/route"
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/expiry"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/graph"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/outbox"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository/cached"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository/database"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/rpc"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service/default"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/webhook"
+	"github.com/redis/go-redis/v9"
 	"log"
+	"net"
 	"net/http"
 )
 
@@ -15,10 +23,19 @@ import (
 type ConfigServerChi struct {
 	// ServerAddress is the address where the server will be listening
 	ServerAddress string
+	// GRPCAddress is the address where the gRPC server will be listening
+	GRPCAddress string
+	// RedisAddress is the address of the Redis-compatible server that caches the reference data, it is cached in
+	// memory when empty
+	RedisAddress string
 }
 type ServerChi struct {
 	// serverAddress is the address where the server will be listening
 	serverAddress string
+	// grpcAddress is the address where the gRPC server will be listening
+	grpcAddress string
+	// redisAddress is the address of the Redis-compatible server of the cache
+	redisAddress string
 }
 
 // NewServerChi is a function that returns a new instance of ServerChi
@@ -26,15 +43,22 @@ func NewServerChi(cfg *ConfigServerChi) *ServerChi {
 	// default values
 	defaultConfig := &ConfigServerChi{
 		ServerAddress: ":8080",
+		GRPCAddress:   ":9090",
 	}
 	if cfg != nil {
 		if cfg.ServerAddress != "" {
 			defaultConfig.ServerAddress = cfg.ServerAddress
 		}
+		if cfg.GRPCAddress != "" {
+			defaultConfig.GRPCAddress = cfg.GRPCAddress
+		}
+		defaultConfig.RedisAddress = cfg.RedisAddress
 	}
 
 	return &ServerChi{
 		serverAddress: defaultConfig.ServerAddress,
+		grpcAddress:   defaultConfig.GRPCAddress,
+		redisAddress:  defaultConfig.RedisAddress,
 	}
 }
 
@@ -48,6 +72,12 @@ func (a *ServerChi) Run() (err error) {
 		return
 	}
 
+	// - cache of the reference data, shared by the instances through Redis when it is configured
+	var referenceCache cache.Cache = cache.NewLRU(cache.DefaultCapacity)
+	if a.redisAddress != "" {
+		referenceCache = cache.NewRedis(redis.NewClient(&redis.Options{Addr: a.redisAddress}), "frescos:")
+	}
+
 	// - repositories
 
 	productRecordRepository := database.NewProductRecordRepository(db)
@@ -60,61 +90,131 @@ func (a *ServerChi) Run() (err error) {
 	buyerRepository := database.NewBuyerRepository(db)
 	sectionRepository := database.NewSectionRepository(db)
 	inboundOrderRepository := database.NewInboundOrderRepository(db)
-	localityRepository := database.NewLocalityRepository(db)
+	localityRepository := cached.NewLocalityRepository(database.NewLocalityRepository(db), referenceCache, cached.DefaultTTL, cached.DefaultReportTTL)
+	productTypeRepository := cached.NewProductTypeRepository(database.NewProductTypeRepository(db), referenceCache, cached.DefaultTTL)
+	countryRepository := cached.NewCountryRepository(database.NewCountryRepository(db), referenceCache, cached.DefaultTTL)
+	provinceRepository := cached.NewProvinceRepository(database.NewProvinceRepository(db), referenceCache, cached.DefaultTTL)
 	purchaseOrderRepository := database.NewPurchaseOrderRepository(db)
+	orderStatusRepository := cached.NewOrderStatusRepository(database.NewOrderStatusRepository(db), referenceCache, cached.DefaultTTL)
+	orderDetailRepository := database.NewOrderDetailRepository(db)
+	taxRuleRepository := database.NewTaxRuleRepository(db)
+	stockMovementRepository := database.NewStockMovementRepository(db)
+	transferOrderRepository := database.NewTransferOrderRepository(db)
+	countSessionRepository := database.NewCountSessionRepository(db)
+	recallRepository := database.NewRecallRepository(db)
+	auditRepository := database.NewAuditRepository(db)
+	idempotencyRepository := database.NewIdempotencyRepository(db)
+	webhookSubscriptionRepository := database.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepository := database.NewWebhookDeliveryRepository(db)
+	outboxRepository := database.NewOutboxRepository(db)
+	transactor := cached.NewTransactor(database.NewTransactor(db), referenceCache)
 
 	// - services
 
-	productRecordService := _default.NewProductRecordDefault(productRecordRepository)
+	// changes of the product batches and sections are published for the gRPC inventory stream and the webhooks,
+	// the events of the orders come from the outbox
+	broker := events.NewBroker()
+
+	productRecordService := _default.NewProductRecordDefault(productRecordRepository, productRepository)
 	productService := _default.NewProductDefault(productRepository)
 	warehouseService := _default.NewWarehouseDefault(warehouseRepository)
 	carrierService := _default.NewCarrierDefault(carrierRepository)
-	productBatchService := _default.NewProductBatchDefault(productBatchRepository)
+	productBatchService := events.NewProductBatchService(_default.NewProductBatchDefault(productBatchRepository), broker)
 	buyerService := _default.NewBuyerDefault(buyerRepository)
 	sellerService := _default.NewSellerService(sellerRepository)
-	sectionService := _default.NewSectionService(sectionRepository)
+	sectionService := events.NewSectionService(_default.NewSectionService(sectionRepository), broker)
 	employeeService := _default.NewEmployeeService(employeeRepository)
 	purchaseOrderService := _default.NewPurchaseOrderDefault(purchaseOrderRepository)
+	orderStatusService := _default.NewOrderStatusDefault(orderStatusRepository)
+	orderDetailService := _default.NewOrderDetailDefault(orderDetailRepository, purchaseOrderRepository, productBatchRepository, sectionRepository, productRecordRepository)
+	invoiceService := _default.NewInvoiceDefault(purchaseOrderRepository, orderDetailRepository, buyerRepository, warehouseRepository, localityRepository, provinceRepository, countryRepository, taxRuleRepository)
+	stockService := _default.NewStockDefault(stockMovementRepository)
+	transferOrderService := _default.NewTransferOrderDefault(transferOrderRepository, sectionRepository, productBatchRepository)
+	countSessionService := _default.NewCountSessionDefault(countSessionRepository, sectionRepository, employeeRepository)
+	recallService := _default.NewRecallDefault(recallRepository)
 	inboundOrderService := _default.NewInboundOrderService(inboundOrderRepository)
 	localityService := _default.NewLocalityService(localityRepository)
+	productTypeService := _default.NewProductTypeDefault(productTypeRepository)
+	countryService := _default.NewCountryDefault(countryRepository, provinceRepository, localityRepository, taxRuleRepository)
+	provinceService := _default.NewProvinceDefault(provinceRepository, localityRepository)
+	auditService := _default.NewAuditDefault(auditRepository)
+	idempotencyService := _default.NewIdempotencyDefault(idempotencyRepository, _default.DefaultIdempotencyTTL)
+	importService := _default.NewImportDefault(transactor)
+	webhookService := _default.NewWebhookDefault(webhookSubscriptionRepository, webhookDeliveryRepository, nil, _default.DefaultWebhookRetryPolicy)
 
 	// - handlers
-	productHandler := handler.NewProductDefault(productService)
-	productBatchHandler := handler.NewProductBatchDefault(productBatchService)
-	productRecordHandler := handler.NewProductRecordHandler(productRecordService)
-	buyerHandler := handler.NewBuyerHandler(buyerService)
-	warehouseHandler := handler.NewWarehouseDefault(warehouseService)
-	carrierHandler := handler.NewCarrierDefault(carrierService)
-	sellerHandler := handler.NewSellerHandler(sellerService)
-	employeeHandler := handler.NewEmployeeHandler(employeeService)
-	sectionHandler := handler.NewSectionDefault(sectionService)
-	purchaseOrderHandler := handler.NewPurchaseOrderDefault(purchaseOrderService)
-	inboundOrderHandler := handler.NewInboundOrderHandler(inboundOrderService)
-	localityHandler := handler.NewLocalityHandler(localityService)
+	handlers := Handlers{
+		Product:       handler.NewProductDefault(productService),
+		ProductBatch:  handler.NewProductBatchDefault(productBatchService),
+		ProductRecord: handler.NewProductRecordHandler(productRecordService),
+		ProductType:   handler.NewProductTypeHandler(productTypeService),
+		Buyer:         handler.NewBuyerHandler(buyerService),
+		Warehouse:     handler.NewWarehouseDefault(warehouseService),
+		Carrier:       handler.NewCarrierDefault(carrierService),
+		Seller:        handler.NewSellerHandler(sellerService),
+		Employee:      handler.NewEmployeeHandler(employeeService),
+		Section:       handler.NewSectionDefault(sectionService),
+		PurchaseOrder: handler.NewPurchaseOrderDefault(purchaseOrderService),
+		OrderStatus:   handler.NewOrderStatusHandler(orderStatusService),
+		OrderDetail:   handler.NewOrderDetailHandler(orderDetailService),
+		Invoice:       handler.NewInvoiceHandler(invoiceService),
+		Stock:         handler.NewStockHandler(stockService),
+		TransferOrder: handler.NewTransferOrderHandler(transferOrderService),
+		CountSession:  handler.NewCountSessionHandler(countSessionService),
+		Recall:        handler.NewRecallHandler(recallService),
+		InboundOrder:  handler.NewInboundOrderHandler(inboundOrderService),
+		Locality:      handler.NewLocalityHandler(localityService),
+		Country:       handler.NewCountryHandler(countryService),
+		Province:      handler.NewProvinceHandler(provinceService),
+		Audit:         handler.NewAuditHandler(auditService),
+		Import:        handler.NewImportHandler(importService),
+		GraphQL: handler.NewGraphQLHandler(graph.NewSchema(graph.Services{
+			Warehouse:     warehouseService,
+			Section:       sectionService,
+			ProductBatch:  productBatchService,
+			Product:       productService,
+			Seller:        sellerService,
+			ProductRecord: productRecordService,
+			PurchaseOrder: purchaseOrderService,
+			OrderDetail:   orderDetailService,
+		})),
+		Webhook: handler.NewWebhookHandler(webhookService),
+	}
 
 	// router
-	rt := chi.NewRouter()
-
-	// - middlewares
-	rt.Use(middleware.Logger)
-	rt.Use(middleware.Recoverer)
-
-	// - endpoints
-
-	route.DefaultRoutes(rt)
-	route.BuyerRoutes(rt, buyerHandler)
-	route.WarehouseRoutes(rt, warehouseHandler)
-	route.CarrierRoutes(rt, carrierHandler)
-	route.SellerRoutes(rt, sellerHandler)
-	route.EmployeeRoutes(rt, employeeHandler)
-	route.SectionRoutes(rt, sectionHandler)
-	route.ProductRoutes(rt, productHandler)
-	route.ProductRecordRoutes(rt, productRecordHandler)
-	route.ProductBatchRoutes(rt, productBatchHandler)
-	route.PurchaseOrderRoutes(rt, purchaseOrderHandler)
-	route.InboundOrderRoutes(rt, inboundOrderHandler)
-	route.LocalityRoutes(rt, localityHandler)
-
-	err = http.ListenAndServe(a.serverAddress, rt)
+	rt := NewRouter(handlers, idempotencyService)
+
+	// gRPC server, on its own port
+	listener, err := net.Listen("tcp", a.grpcAddress)
+	if err != nil {
+		return
+	}
+	grpcServer := rpc.NewServer(rpc.Services{
+		PurchaseOrder: purchaseOrderService,
+		InboundOrder:  inboundOrderService,
+		ProductBatch:  productBatchService,
+	}, broker)
+	defer grpcServer.Stop()
+
+	// the outbox events and the webhooks are dispatched in the background until the servers stop
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go outbox.NewRelay(outboxRepository, outbox.DefaultInterval,
+		outbox.NewBusPublisher(broker),
+		outbox.NewLogPublisher(nil),
+		outbox.NewWebhookPublisher(webhookService),
+	).Run(ctx)
+	go webhook.NewWorker(webhookService, productBatchService, broker, webhook.DefaultInterval, webhook.DefaultExpiryWindow).Run(ctx)
+	go expiry.NewSweeper(productBatchService, expiry.DefaultInterval).Run(ctx)
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- grpcServer.Serve(listener)
+	}()
+	go func() {
+		errs <- http.ListenAndServe(a.serverAddress, rt)
+	}()
+
+	err = <-errs
 	return
 }
@@ -0,0 +1,13 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// AuditRoutes sets up the read-only routes of the audit trail.
+func AuditRoutes(router chi.Router, handler *handler.AuditHandler) {
+	router.Route("/api/v1/audit", func(r chi.Router) {
+		r.Get("/", handler.GetAuditLogs)
+	})
+}
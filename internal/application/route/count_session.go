@@ -0,0 +1,19 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// CountSessionRoutes sets up the routes of the physical counts of the sections and their variance reports
+func CountSessionRoutes(router chi.Router, handler *handler.CountSessionHandler) {
+	router.Route("/api/v1/countSessions", func(r chi.Router) {
+		r.Get("/", handler.GetCountSessions)
+		r.Get("/reportWarehouses", handler.GetCountSessionReportWarehouses)
+		r.Get("/reportEmployees", handler.GetCountSessionReportEmployees)
+		r.Get("/{id}", handler.GetCountSession)
+		r.Post("/", handler.PostCountSession)
+		r.Post("/{id}/counts", handler.PostCount)
+		r.Post("/{id}/approve", handler.PostApprove)
+	})
+}
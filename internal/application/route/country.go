@@ -0,0 +1,26 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// CountryRoutes sets up the routes of the countries, along with the routes that browse their provinces and the
+// localities of those provinces and the routes that manage their tax rules
+func CountryRoutes(router chi.Router, handler *handler.CountryHandler) {
+	router.Route("/api/v1/countries", func(r chi.Router) {
+		r.Get("/", handler.GetCountries)
+		r.Get("/{id}", handler.GetCountry)
+		r.Post("/", handler.PostCountry)
+		r.Put("/{id}", handler.PutCountry)
+		r.Patch("/{id}", handler.PatchCountry)
+		r.Delete("/{id}", handler.DeleteCountry)
+		r.Get("/{id}/provinces", handler.GetCountryProvinces)
+		r.Get("/{id}/provinces/{provinceId}/localities", handler.GetCountryProvinceLocalities)
+		r.Get("/{id}/taxRules", handler.GetCountryTaxRules)
+		r.Get("/{id}/taxRules/{taxRuleId}", handler.GetCountryTaxRule)
+		r.Post("/{id}/taxRules", handler.PostCountryTaxRule)
+		r.Put("/{id}/taxRules/{taxRuleId}", handler.PutCountryTaxRule)
+		r.Delete("/{id}/taxRules/{taxRuleId}", handler.DeleteCountryTaxRule)
+	})
+}
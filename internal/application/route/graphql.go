@@ -0,0 +1,11 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// GraphQLRoutes sets up the route of the GraphQL queries.
+func GraphQLRoutes(router chi.Router, handler *handler.GraphQLHandler) {
+	router.Post("/graphql", handler.PostGraphQL)
+}
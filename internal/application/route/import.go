@@ -0,0 +1,13 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// ImportRoutes sets up the routes of the bulk import of resources.
+func ImportRoutes(router chi.Router, handler *handler.ImportHandler) {
+	router.Route("/api/v1/imports", func(r chi.Router) {
+		r.Post("/{resource}", handler.PostImport)
+	})
+}
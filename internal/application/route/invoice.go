@@ -0,0 +1,12 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// InvoiceRoutes sets up the routes of the priced purchase orders and their invoices
+func InvoiceRoutes(router chi.Router, handler *handler.InvoiceHandler) {
+	router.Get("/api/v1/purchaseOrders/{id}", handler.GetPricedPurchaseOrder)
+	router.Get("/api/v1/purchaseOrders/{id}/invoice", handler.GetInvoice)
+}
@@ -5,16 +5,16 @@ import (
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
 )
 
-// EmployeeRoutes sets up the routes for employee related operations.
+// LocalityRoutes sets up the routes for locality related operations.
 func LocalityRoutes(router chi.Router, handler *handler.LocalityHandler) {
 
 	router.Route("/api/v1/localities", func(r chi.Router) {
 		r.Get("/reportSellers", handler.GetLocality)
 		r.Post("/", handler.PostLocality)
 		r.Get("/reportCarriers", handler.GetCarrier)
-
-		//r.Post("/", handler.CreateEmployee)
-		//r.Patch("/{id}", handler.PatchEmployee)
-		//r.Delete("/{id}", handler.DeleteEmployee)
+		r.Get("/search", handler.SearchLocalities)
+		r.Get("/{id}", handler.GetLocalityById)
+		r.Patch("/{id}", handler.PatchLocality)
+		r.Delete("/{id}", handler.DeleteLocality)
 	})
 }
@@ -0,0 +1,324 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/openapi"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+)
+
+// OpenAPIInfo describes the API in the OpenAPI document
+var OpenAPIInfo = openapi.Info{
+	Title:       "W17-G1 Bootcamp API",
+	Description: "Inventory, warehousing and order management API of the Meli Frescos bootcamp",
+	Version:     "1.0.0",
+}
+
+// OpenAPIRoutes sets up the routes that serve the OpenAPI document of the router and the Swagger UI that renders it
+func OpenAPIRoutes(router chi.Router, spec *openapi.Spec) {
+	router.Get("/openapi.json", openapi.Handler(spec))
+	router.Get("/docs", openapi.UIHandler)
+}
+
+// query parameters shared by several operations
+var (
+	idQuery = openapi.Parameter{
+		Name:        "id",
+		In:          "query",
+		Description: "restricts the report to a single resource",
+		Schema:      &openapi.Schema{Type: "integer"},
+	}
+	formatQuery = openapi.Parameter{
+		Name:        "format",
+		In:          "query",
+		Description: "exports the report as a file instead of JSON, it takes precedence over the Accept header",
+		Schema:      &openapi.Schema{Type: "string", Enum: []any{response.FormatCSV, response.FormatXLSX}},
+	}
+	documentFormatQuery = openapi.Parameter{
+		Name:        "format",
+		In:          "query",
+		Description: "renders the document as a printable page instead of JSON, it takes precedence over the Accept header",
+		Schema:      &openapi.Schema{Type: "string", Enum: []any{"json", response.FormatHTML}},
+	}
+	idempotencyKeyHeader = openapi.Parameter{
+		Name:        "Idempotency-Key",
+		In:          "header",
+		Description: "replays the stored response of a previous request with the same key instead of creating it again",
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+)
+
+// report status codes, a report is also served as CSV or XLSX
+var reportErrors = []int{http.StatusBadRequest, http.StatusNotFound}
+
+// Operations documents every route of the API, keyed by method and chi pattern. A route missing from it makes the
+// OpenAPI document fail to generate.
+var Operations = map[string]openapi.Operation{
+	// - audit
+	"GET /api/v1/audit/": {
+		Summary:  "Search the audit trail",
+		Tag:      "audit",
+		Response: []models.AuditLog{},
+		Parameters: []openapi.Parameter{
+			{Name: "entity", In: "query", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "id", In: "query", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "actor", In: "query", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "action", In: "query", Schema: &openapi.Schema{Type: "string", Enum: []any{models.AuditActionCreate, models.AuditActionUpdate, models.AuditActionDelete}}},
+			{Name: "request_id", In: "query", Schema: &openapi.Schema{Type: "string"}},
+			{Name: "from", In: "query", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+			{Name: "to", In: "query", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+		},
+		Errors: []int{http.StatusBadRequest, http.StatusInternalServerError},
+	},
+
+	// - buyers
+	"GET /api/v1/buyers/":                     {Summary: "List buyers", Tag: "buyers", Response: []models.Buyer{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/buyers/{id}":                 {Summary: "Get a buyer", Tag: "buyers", Response: models.Buyer{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/buyers/":                    {Summary: "Create a buyer", Tag: "buyers", Request: request.BuyerRequest{}, Response: models.Buyer{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/buyers/{id}":               {Summary: "Update a buyer", Tag: "buyers", Patch: models.Buyer{}, Response: models.Buyer{}, Errors: patchErrors},
+	"DELETE /api/v1/buyers/{id}":              {Summary: "Delete a buyer", Tag: "buyers", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/buyers/reportPurchaseOrders": {Summary: "Count the purchase orders of buyers", Tag: "buyers", Response: []models.BuyerReport{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+
+	// - carriers
+	"GET /api/v1/carriers/":        {Summary: "List carriers", Tag: "carriers", Response: []models.Carrier{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/carriers/{id}":    {Summary: "Get a carrier", Tag: "carriers", Response: models.Carrier{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/carriers/":       {Summary: "Create a carrier", Tag: "carriers", Request: request.CarrierRequest{}, Response: models.Carrier{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/carriers/{id}":    {Summary: "Replace a carrier", Tag: "carriers", Request: request.CarrierRequest{}, Response: models.Carrier{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+	"PATCH /api/v1/carriers/{id}":  {Summary: "Update a carrier", Tag: "carriers", Patch: models.Carrier{}, Response: models.Carrier{}, Errors: patchErrors},
+	"DELETE /api/v1/carriers/{id}": {Summary: "Delete a carrier", Tag: "carriers", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - count sessions
+	"GET /api/v1/countSessions/":                 {Summary: "List count sessions with their lines", Tag: "countSessions", Response: []models.CountSession{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/countSessions/reportWarehouses": {Summary: "Sum the variances of the approved count sessions of warehouses", Tag: "countSessions", Response: []models.WarehouseCountVariance{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"GET /api/v1/countSessions/reportEmployees":  {Summary: "Sum the variances of the product batches counted by employees", Tag: "countSessions", Response: []models.EmployeeCountVariance{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"GET /api/v1/countSessions/{id}":             {Summary: "Get a count session with its lines", Tag: "countSessions", Response: models.CountSession{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/countSessions/":                {Summary: "Open a count session that snapshots the product batches of a section", Tag: "countSessions", Request: request.CountSessionRequest{}, Response: models.CountSession{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+	"POST /api/v1/countSessions/{id}/counts":     {Summary: "Record the quantity of a product batch counted by an employee", Tag: "countSessions", Request: request.CountRequest{}, Response: models.CountLine{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"POST /api/v1/countSessions/{id}/approve":    {Summary: "Approve a count session and post its variances as stock adjustments", Tag: "countSessions", Response: models.CountSession{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+
+	// - countries
+	"GET /api/v1/countries/":                                       {Summary: "List countries", Tag: "countries", Response: []models.Country{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/countries/{id}":                                   {Summary: "Get a country", Tag: "countries", Response: models.Country{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/countries/":                                      {Summary: "Create a country", Tag: "countries", Request: request.CountryRequest{}, Response: models.Country{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/countries/{id}":                                   {Summary: "Replace a country", Tag: "countries", Request: request.CountryRequest{}, Response: models.Country{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/countries/{id}":                                 {Summary: "Update a country", Tag: "countries", Patch: models.Country{}, Response: models.Country{}, Errors: patchErrors},
+	"DELETE /api/v1/countries/{id}":                                {Summary: "Delete a country without provinces nor tax rules", Tag: "countries", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+	"GET /api/v1/countries/{id}/provinces":                         {Summary: "List the provinces of a country", Tag: "countries", Response: []models.Province{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/countries/{id}/provinces/{provinceId}/localities": {Summary: "List the localities of a province of a country", Tag: "countries", Response: []models.Locality{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/countries/{id}/taxRules":                          {Summary: "List the tax rules of a country", Tag: "countries", Response: []models.TaxRule{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/countries/{id}/taxRules/{taxRuleId}":              {Summary: "Get a tax rule of a country", Tag: "countries", Response: models.TaxRule{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/countries/{id}/taxRules":                         {Summary: "Add a tax rule to a country", Tag: "countries", Request: request.TaxRuleRequest{}, Response: models.TaxRule{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/countries/{id}/taxRules/{taxRuleId}":              {Summary: "Replace a tax rule of a country", Tag: "countries", Request: request.TaxRuleRequest{}, Response: models.TaxRule{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity}},
+	"DELETE /api/v1/countries/{id}/taxRules/{taxRuleId}":           {Summary: "Delete a tax rule of a country", Tag: "countries", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - employees
+	"GET /api/v1/employees/":                    {Summary: "List employees", Tag: "employees", Response: []models.Employee{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/employees/{id}":                {Summary: "Get an employee", Tag: "employees", Response: models.Employee{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/employees/reportInboundOrders": {Summary: "Count the inbound orders of employees", Tag: "employees", Response: []models.EmployeeInboundOrdersReport{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"POST /api/v1/employees/":                   {Summary: "Create an employee", Tag: "employees", Request: request.EmployeeRequest{}, Response: models.Employee{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/employees/{id}":              {Summary: "Update an employee", Tag: "employees", Patch: models.Employee{}, Response: models.Employee{}, Errors: patchErrors},
+	"DELETE /api/v1/employees/{id}":             {Summary: "Delete an employee", Tag: "employees", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - imports
+	"POST /api/v1/imports/{resource}": {
+		Summary:           "Import products, sellers, localities or product batches in bulk",
+		Tag:               "imports",
+		Request:           "",
+		RequestMediaTypes: []string{"text/csv", "application/x-ndjson", "application/jsonl"},
+		Response:          models.ImportReport{},
+		Status:            http.StatusCreated,
+		Parameters: []openapi.Parameter{{
+			Name:   "mode",
+			In:     "query",
+			Schema: &openapi.Schema{Type: "string", Enum: []any{models.ImportModeAtomic, models.ImportModeBestEffort}},
+		}},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity, http.StatusInternalServerError},
+	},
+
+	// - inbound orders
+	"GET /api/v1/inbound-orders/":        {Summary: "List inbound orders", Tag: "inbound-orders", Response: []models.InboundOrder{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/inbound-orders/{id}":    {Summary: "Get an inbound order", Tag: "inbound-orders", Response: models.InboundOrder{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/inbound-orders/":       {Summary: "Create an inbound order", Tag: "inbound-orders", Request: request.InboundOrder{}, Response: models.InboundOrder{}, Status: http.StatusCreated, Parameters: []openapi.Parameter{idempotencyKeyHeader}, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/inbound-orders/{id}":    {Summary: "Replace an inbound order", Tag: "inbound-orders", Request: request.InboundOrder{}, Response: models.InboundOrder{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+	"PATCH /api/v1/inbound-orders/{id}":  {Summary: "Update an inbound order", Tag: "inbound-orders", Patch: models.InboundOrder{}, Response: models.InboundOrder{}, Errors: patchErrors},
+	"DELETE /api/v1/inbound-orders/{id}": {Summary: "Delete an inbound order", Tag: "inbound-orders", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - localities
+	"GET /api/v1/localities/reportSellers":  {Summary: "Count the sellers of localities", Tag: "localities", Response: []models.LocalitySellerCount{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"POST /api/v1/localities/":              {Summary: "Create a locality", Tag: "localities", Request: request.LocalityRequest{}, Response: models.LocalityDoc{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest}},
+	"GET /api/v1/localities/reportCarriers": {Summary: "Count the carriers of localities", Tag: "localities", Response: []models.LocalityCarrierCount{}, Parameters: []openapi.Parameter{idQuery}, Errors: reportErrors},
+	"GET /api/v1/localities/search": {
+		Summary:    "Search localities by name, ignoring case and accents",
+		Tag:        "localities",
+		Response:   []models.LocalityDoc{},
+		Parameters: []openapi.Parameter{{Name: "name", In: "query", Required: true, Schema: &openapi.Schema{Type: "string"}}},
+		Errors:     []int{http.StatusBadRequest},
+	},
+	"GET /api/v1/localities/{id}":    {Summary: "Get a locality", Tag: "localities", Response: models.Locality{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"PATCH /api/v1/localities/{id}":  {Summary: "Update a locality", Tag: "localities", Patch: models.Locality{}, Response: models.Locality{}, Errors: patchErrors},
+	"DELETE /api/v1/localities/{id}": {Summary: "Delete a locality no seller, carrier or warehouse uses", Tag: "localities", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+
+	// - order statuses
+	"GET /api/v1/orderStatuses/":     {Summary: "List the statuses of the purchase orders", Tag: "orderStatuses", Response: []models.OrderStatus{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/orderStatuses/{id}": {Summary: "Get a status of the purchase orders", Tag: "orderStatuses", Response: models.OrderStatus{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - products
+	"GET /api/v1/products/":              {Summary: "List products", Tag: "products", Response: []models.Product{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/products/reportRecords": {Summary: "Count the records of products", Tag: "products", Response: []models.ProductReport{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"GET /api/v1/products/{id}":          {Summary: "Get a product", Tag: "products", Response: models.Product{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/products/":             {Summary: "Create a product", Tag: "products", Request: request.ProductRequest{}, Response: models.Product{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/products/{id}":        {Summary: "Update a product", Tag: "products", Patch: models.Product{}, Response: models.Product{}, Errors: patchErrors},
+	"DELETE /api/v1/products/{id}":       {Summary: "Delete a product", Tag: "products", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - product batches
+	"POST /api/v1/productBatches/":                   {Summary: "Create a product batch", Tag: "productBatches", Request: request.ProductBatchRequest{}, Response: models.ProductBatch{}, Status: http.StatusCreated, Parameters: []openapi.Parameter{idempotencyKeyHeader}, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"GET /api/v1/productBatches/{batchNumber}/trace": {Summary: "Trace a product batch from its seller to the buyers it was shipped to", Tag: "productBatches", Response: models.BatchTrace{}, Errors: []int{http.StatusNotFound}},
+	"POST /api/v1/productBatches/{id}/status":        {Summary: "Move a product batch to another status for a reason", Tag: "productBatches", Request: request.ProductBatchStatusRequest{}, Response: models.ProductBatch{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"GET /api/v1/productBatches/{id}/statusChanges":  {Summary: "List the status changes of a product batch, oldest first", Tag: "productBatches", Response: []models.ProductBatchStatusChange{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - product records
+	"GET /api/v1/productRecords/":              {Summary: "List product records", Tag: "productRecords", Response: []models.ProductRecord{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/productRecords/{id}":          {Summary: "Get a product record", Tag: "productRecords", Response: models.ProductRecord{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/productRecords/":             {Summary: "Record new prices of a product", Tag: "productRecords", Request: request.ProductRecordRequest{}, Response: models.ProductRecord{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"GET /api/v1/products/{id}/prices/current": {Summary: "Get the price of a product in effect now", Tag: "productRecords", Response: models.ProductPrice{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/products/{id}/prices/at": {
+		Summary: "Get the price of a product in effect at a date", Tag: "productRecords", Response: models.ProductPrice{},
+		Parameters: []openapi.Parameter{{Name: "date", In: "query", Required: true, Schema: &openapi.Schema{Type: "string", Format: "date-time"}}},
+		Errors:     []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	"GET /api/v1/products/{id}/prices/trend": {
+		Summary: "Summarize the prices of a product within a date range", Tag: "productRecords", Response: models.ProductPriceTrend{},
+		Parameters: []openapi.Parameter{
+			{Name: "from", In: "query", Required: true, Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+			{Name: "to", In: "query", Required: true, Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+		},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+
+	// - product types
+	"GET /api/v1/productTypes/":            {Summary: "List product types", Tag: "productTypes", Response: []models.ProductType{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/productTypes/reportUsage": {Summary: "Count the products and sections of product types", Tag: "productTypes", Response: []models.ProductTypeUsage{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"GET /api/v1/productTypes/{id}":        {Summary: "Get a product type", Tag: "productTypes", Response: models.ProductType{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/productTypes/":           {Summary: "Create a product type", Tag: "productTypes", Request: request.ProductTypeRequest{}, Response: models.ProductType{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/productTypes/{id}":        {Summary: "Replace a product type", Tag: "productTypes", Request: request.ProductTypeRequest{}, Response: models.ProductType{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/productTypes/{id}":      {Summary: "Update a product type", Tag: "productTypes", Patch: models.ProductType{}, Response: models.ProductType{}, Errors: patchErrors},
+	"DELETE /api/v1/productTypes/{id}":     {Summary: "Delete a product type no product or section uses", Tag: "productTypes", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+
+	// - provinces
+	"GET /api/v1/provinces/":        {Summary: "List provinces", Tag: "provinces", Response: []models.Province{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/provinces/{id}":    {Summary: "Get a province", Tag: "provinces", Response: models.Province{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/provinces/":       {Summary: "Create a province", Tag: "provinces", Request: request.ProvinceRequest{}, Response: models.Province{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/provinces/{id}":    {Summary: "Replace a province", Tag: "provinces", Request: request.ProvinceRequest{}, Response: models.Province{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/provinces/{id}":  {Summary: "Update a province", Tag: "provinces", Patch: models.Province{}, Response: models.Province{}, Errors: patchErrors},
+	"DELETE /api/v1/provinces/{id}": {Summary: "Delete a province without localities", Tag: "provinces", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+
+	// - purchase orders
+	"GET /api/v1/purchaseOrders/{id}":                           {Summary: "Get a purchase order with its line amounts, taxes and totals", Tag: "purchaseOrders", Response: models.PricedPurchaseOrder{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/purchaseOrders/{id}/invoice":                   {Summary: "Get the invoice of a purchase order as JSON or HTML", Tag: "purchaseOrders", Response: models.Invoice{}, Parameters: []openapi.Parameter{documentFormatQuery}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/purchaseOrders/":                              {Summary: "Create a purchase order", Tag: "purchaseOrders", Request: request.PurchaseOrderRequest{}, Response: models.PurchaseOrder{}, Status: http.StatusCreated, Parameters: []openapi.Parameter{idempotencyKeyHeader}, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"GET /api/v1/purchaseOrders/{id}/details/":                  {Summary: "List the lines of a purchase order with its totals", Tag: "purchaseOrders", Response: models.PurchaseOrderLines{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/purchaseOrders/{id}/details/{detailId}":        {Summary: "Get a line of a purchase order", Tag: "purchaseOrders", Response: models.OrderDetail{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/purchaseOrders/{id}/details/":                 {Summary: "Add a line to a purchase order not shipped yet", Tag: "purchaseOrders", Request: request.PurchaseOrderLineRequest{}, Response: models.OrderDetail{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/purchaseOrders/{id}/details/{detailId}":        {Summary: "Replace a line of a purchase order not shipped yet", Tag: "purchaseOrders", Request: request.PurchaseOrderLineRequest{}, Response: models.OrderDetail{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"DELETE /api/v1/purchaseOrders/{id}/details/{detailId}":     {Summary: "Remove a line of a purchase order not shipped nor picked yet", Tag: "purchaseOrders", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+	"GET /api/v1/purchaseOrders/{id}/details/{detailId}/picks":  {Summary: "List the product batches picked for a line of a purchase order", Tag: "purchaseOrders", Response: []models.OrderDetailPick{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/purchaseOrders/{id}/details/{detailId}/picks": {Summary: "Pick stock of a product batch for a line of a purchase order not shipped yet", Tag: "purchaseOrders", Request: request.PurchaseOrderPickRequest{}, Response: models.OrderDetailPick{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+
+	// - recalls
+	"GET /api/v1/recalls/":                 {Summary: "List recalls with their batches", Tag: "recalls", Response: []models.Recall{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/recalls/report":           {Summary: "Sum up the batches, stock and purchase orders of recalls", Tag: "recalls", Response: []models.RecallReport{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"GET /api/v1/recalls/{id}":             {Summary: "Get a recall with its batches", Tag: "recalls", Response: models.Recall{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/recalls/{id}/stock":       {Summary: "Get the stock of the batches of a recall per warehouse", Tag: "recalls", Response: []models.RecallStock{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/recalls/{id}/orders":      {Summary: "List the purchase orders the batches of a recall were picked for, per buyer", Tag: "recalls", Response: []models.RecallOrders{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/recalls/":                {Summary: "Open a recall of a product or of batch numbers, which blocks their batches from being picked", Tag: "recalls", Request: request.RecallRequest{}, Response: models.Recall{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"POST /api/v1/recalls/{id}/quarantine": {Summary: "Quarantine a recalled batch", Tag: "recalls", Request: request.RecallBatchRequest{}, Response: models.RecallBatch{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"POST /api/v1/recalls/{id}/dispose":    {Summary: "Dispose of the stock of a quarantined batch", Tag: "recalls", Request: request.RecallDisposalRequest{}, Response: models.RecallBatch{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"POST /api/v1/recalls/{id}/close":      {Summary: "Close a recall whose batches were all disposed", Tag: "recalls", Response: models.Recall{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+
+	// - stock
+	"GET /api/v1/stock/": {
+		Summary: "Get the stock on hand, reserved and available of the products per warehouse", Tag: "stock", Response: []models.StockBalance{},
+		Parameters: []openapi.Parameter{
+			{Name: "product_id", In: "query", Schema: &openapi.Schema{Type: "integer"}},
+			{Name: "warehouse_id", In: "query", Schema: &openapi.Schema{Type: "integer"}},
+		},
+		Errors: []int{http.StatusBadRequest, http.StatusInternalServerError},
+	},
+	"GET /api/v1/stock/movements": {
+		Summary: "List the stock ledger of a product batch", Tag: "stock", Response: []models.StockMovement{},
+		Parameters: []openapi.Parameter{{Name: "product_batch_id", In: "query", Required: true, Schema: &openapi.Schema{Type: "integer"}}},
+		Errors:     []int{http.StatusBadRequest, http.StatusInternalServerError},
+	},
+	"POST /api/v1/stock/adjustments": {Summary: "Adjust the stock of a product batch with a reason code and the responsible employee", Tag: "stock", Request: request.StockAdjustmentRequest{}, Response: models.StockMovement{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"GET /api/v1/stock/reportWaste": {
+		Summary: "Sum the stock removed because it expired, was damaged, left its temperature range or was recalled per product and warehouse", Tag: "stock", Response: []models.WasteReport{},
+		Parameters: []openapi.Parameter{
+			{Name: "product_id", In: "query", Schema: &openapi.Schema{Type: "integer"}},
+			{Name: "seller_id", In: "query", Schema: &openapi.Schema{Type: "integer"}},
+			{Name: "warehouse_id", In: "query", Schema: &openapi.Schema{Type: "integer"}},
+			formatQuery,
+		},
+		Errors: reportErrors,
+	},
+	"POST /api/v1/stock/movements": {Summary: "Post an adjustment or a disposal to the stock ledger", Tag: "stock", Request: request.StockMovementRequest{}, Response: models.StockMovement{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+
+	// - sections
+	"GET /api/v1/sections/":               {Summary: "List sections", Tag: "sections", Response: []models.Section{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/sections/reportProducts": {Summary: "Count the product batches of sections that may still be sold", Tag: "sections", Response: []models.SectionReport{}, Parameters: []openapi.Parameter{idQuery, formatQuery}, Errors: reportErrors},
+	"GET /api/v1/sections/{id}":           {Summary: "Get a section", Tag: "sections", Response: models.Section{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/sections/":              {Summary: "Create a section", Tag: "sections", Request: request.SectionRequest{}, Response: models.Section{}, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/sections/{id}":         {Summary: "Update a section", Tag: "sections", Patch: models.Section{}, Response: models.Section{}, Errors: patchErrors},
+	"DELETE /api/v1/sections/{id}":        {Summary: "Delete a section", Tag: "sections", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - sellers
+	"GET /api/v1/sellers/":        {Summary: "List sellers", Tag: "sellers", Response: []models.Seller{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/sellers/{id}":    {Summary: "Get a seller", Tag: "sellers", Response: models.Seller{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/sellers/":       {Summary: "Create a seller", Tag: "sellers", Request: request.SellerRequest{}, Response: models.Seller{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/sellers/{id}":    {Summary: "Replace a seller", Tag: "sellers", Request: request.SellerRequest{}, Response: models.Seller{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+	"PATCH /api/v1/sellers/{id}":  {Summary: "Update a seller", Tag: "sellers", Patch: models.Seller{}, Response: models.Seller{}, Errors: patchErrors},
+	"DELETE /api/v1/sellers/{id}": {Summary: "Delete a seller", Tag: "sellers", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - transfer orders
+	"GET /api/v1/transferOrders/":               {Summary: "List transfer orders with their lines", Tag: "transferOrders", Response: []models.TransferOrder{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/transferOrders/{id}":           {Summary: "Get a transfer order with its lines", Tag: "transferOrders", Response: models.TransferOrder{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/transferOrders/":              {Summary: "Draft a transfer order for product batches of a section", Tag: "transferOrders", Request: request.TransferOrderRequest{}, Response: models.TransferOrder{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"POST /api/v1/transferOrders/{id}/dispatch": {Summary: "Take the product batches of a draft transfer order out of the source section", Tag: "transferOrders", Response: models.TransferOrder{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+	"POST /api/v1/transferOrders/{id}/transit":  {Summary: "Hand a dispatched transfer order to its carrier", Tag: "transferOrders", Request: request.TransferOrderTransitRequest{}, Response: models.TransferOrder{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"POST /api/v1/transferOrders/{id}/receive":  {Summary: "Store the product batches of a transfer order in transit in the destination section", Tag: "transferOrders", Response: models.TransferOrder{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict}},
+
+	// - warehouses
+	"GET /api/v1/warehouses/":        {Summary: "List warehouses", Tag: "warehouses", Response: []models.Warehouse{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/warehouses/{id}":    {Summary: "Get a warehouse", Tag: "warehouses", Response: models.Warehouse{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/warehouses/":       {Summary: "Create a warehouse", Tag: "warehouses", Request: request.WarehouseRequest{}, Response: models.Warehouse{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/warehouses/{id}":  {Summary: "Update a warehouse", Tag: "warehouses", Patch: models.Warehouse{}, Response: models.Warehouse{}, Errors: patchErrors},
+	"DELETE /api/v1/warehouses/{id}": {Summary: "Delete a warehouse", Tag: "warehouses", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - webhooks
+	"GET /api/v1/webhooks/subscriptions":                 {Summary: "List webhook subscriptions", Tag: "webhooks", Response: []models.WebhookSubscription{}, Errors: []int{http.StatusInternalServerError}},
+	"GET /api/v1/webhooks/subscriptions/{id}":            {Summary: "Get a webhook subscription", Tag: "webhooks", Response: models.WebhookSubscription{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"POST /api/v1/webhooks/subscriptions":                {Summary: "Subscribe an endpoint to webhook events", Tag: "webhooks", Request: request.WebhookSubscriptionRequest{}, Response: models.WebhookSubscription{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest, http.StatusUnprocessableEntity}},
+	"PUT /api/v1/webhooks/subscriptions/{id}":            {Summary: "Replace a webhook subscription", Tag: "webhooks", Request: request.WebhookSubscriptionRequest{}, Response: models.WebhookSubscription{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity}},
+	"PATCH /api/v1/webhooks/subscriptions/{id}":          {Summary: "Update a webhook subscription", Tag: "webhooks", Patch: models.WebhookSubscription{}, Response: models.WebhookSubscription{}, Errors: patchErrors},
+	"DELETE /api/v1/webhooks/subscriptions/{id}":         {Summary: "Delete a webhook subscription and its deliveries", Tag: "webhooks", Status: http.StatusNoContent, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/webhooks/subscriptions/{id}/deliveries": {Summary: "List the deliveries of a webhook subscription", Tag: "webhooks", Response: []models.WebhookDelivery{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+	"GET /api/v1/webhooks/deadLetters":                   {Summary: "List the webhook deliveries that failed every attempt", Tag: "webhooks", Response: []models.WebhookDelivery{}, Errors: []int{http.StatusInternalServerError}},
+	"POST /api/v1/webhooks/deliveries/{id}/redeliver":    {Summary: "Attempt a webhook delivery again", Tag: "webhooks", Response: models.WebhookDelivery{}, Errors: []int{http.StatusBadRequest, http.StatusNotFound}},
+
+	// - graphql
+	"POST /graphql": {Summary: "Query warehouses, orders and their relations with GraphQL", Tag: "graphql", Request: request.GraphQLRequest{}, ResponseMediaType: openapi.MediaTypeJSON, Errors: []int{http.StatusBadRequest}},
+
+	// - documentation
+	"GET /openapi.json": {Summary: "Get the OpenAPI document of the API", Tag: "docs", ResponseMediaType: openapi.MediaTypeJSON},
+	"GET /docs":         {Summary: "Browse the API with Swagger UI", Tag: "docs", ResponseMediaType: "text/html"},
+}
+
+// status codes of the error responses of a PATCH operation
+var patchErrors = []int{
+	http.StatusBadRequest,
+	http.StatusNotFound,
+	http.StatusConflict,
+	http.StatusUnsupportedMediaType,
+	http.StatusUnprocessableEntity,
+}
@@ -0,0 +1,19 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// OrderDetailRoutes sets up the routes of the lines, nested under their purchase order
+func OrderDetailRoutes(router chi.Router, handler *handler.OrderDetailHandler) {
+	router.Route("/api/v1/purchaseOrders/{id}/details", func(r chi.Router) {
+		r.Get("/", handler.GetOrderDetails)
+		r.Get("/{detailId}", handler.GetOrderDetail)
+		r.Post("/", handler.PostOrderDetail)
+		r.Put("/{detailId}", handler.PutOrderDetail)
+		r.Delete("/{detailId}", handler.DeleteOrderDetail)
+		r.Get("/{detailId}/picks", handler.GetOrderDetailPicks)
+		r.Post("/{detailId}/picks", handler.PostOrderDetailPick)
+	})
+}
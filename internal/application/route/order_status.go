@@ -0,0 +1,14 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// OrderStatusRoutes sets up the routes of the statuses of the purchase orders, which are read only
+func OrderStatusRoutes(router chi.Router, handler *handler.OrderStatusHandler) {
+	router.Route("/api/v1/orderStatuses", func(r chi.Router) {
+		r.Get("/", handler.GetOrderStatuses)
+		r.Get("/{id}", handler.GetOrderStatus)
+	})
+}
@@ -9,5 +9,8 @@ func ProductBatchRoutes(rt chi.Router, handler *handler.ProductBatchDefault) {
 	rt.Route("/api/v1/productBatches", func(rt chi.Router) {
 		// - GET /products
 		rt.Post("/", handler.PostProductBatch)
+		rt.Get("/{batchNumber}/trace", handler.GetProductBatchTrace)
+		rt.Post("/{id}/status", handler.PostProductBatchStatus)
+		rt.Get("/{id}/statusChanges", handler.GetProductBatchStatusChanges)
 	})
 }
@@ -6,12 +6,16 @@ import (
 )
 
 func ProductRecordRoutes(rt chi.Router, handler *handler.ProductRecordHandler) {
+	// the records are a price history, they are only appended
 	rt.Route("/api/v1/productRecords", func(rt chi.Router) {
 		// - GET /products
 		rt.Get("/", handler.GetProductRecords)
 		rt.Post("/", handler.PostProductRecord)
 		rt.Get("/{id}", handler.GetProductRecord)
-		rt.Patch("/{id}", handler.PatchProductRecord)
-		rt.Delete("/{id}", handler.DeleteProductRecord)
+	})
+	rt.Route("/api/v1/products/{id}/prices", func(rt chi.Router) {
+		rt.Get("/current", handler.GetProductCurrentPrice)
+		rt.Get("/at", handler.GetProductPriceAt)
+		rt.Get("/trend", handler.GetProductPriceTrend)
 	})
 }
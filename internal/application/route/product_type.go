@@ -0,0 +1,19 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// ProductTypeRoutes sets up the routes of the product types
+func ProductTypeRoutes(router chi.Router, handler *handler.ProductTypeHandler) {
+	router.Route("/api/v1/productTypes", func(r chi.Router) {
+		r.Get("/", handler.GetProductTypes)
+		r.Get("/reportUsage", handler.GetProductTypeReportUsage)
+		r.Get("/{id}", handler.GetProductType)
+		r.Post("/", handler.PostProductType)
+		r.Put("/{id}", handler.PutProductType)
+		r.Patch("/{id}", handler.PatchProductType)
+		r.Delete("/{id}", handler.DeleteProductType)
+	})
+}
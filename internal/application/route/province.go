@@ -0,0 +1,18 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// ProvinceRoutes sets up the routes of the provinces
+func ProvinceRoutes(router chi.Router, handler *handler.ProvinceHandler) {
+	router.Route("/api/v1/provinces", func(r chi.Router) {
+		r.Get("/", handler.GetProvinces)
+		r.Get("/{id}", handler.GetProvince)
+		r.Post("/", handler.PostProvince)
+		r.Put("/{id}", handler.PutProvince)
+		r.Patch("/{id}", handler.PatchProvince)
+		r.Delete("/{id}", handler.DeleteProvince)
+	})
+}
@@ -0,0 +1,21 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// RecallRoutes sets up the routes of the recall campaigns, from the recalled batches to their disposal
+func RecallRoutes(router chi.Router, handler *handler.RecallHandler) {
+	router.Route("/api/v1/recalls", func(r chi.Router) {
+		r.Get("/", handler.GetRecalls)
+		r.Get("/report", handler.GetRecallReport)
+		r.Get("/{id}", handler.GetRecall)
+		r.Get("/{id}/stock", handler.GetRecallStock)
+		r.Get("/{id}/orders", handler.GetRecallOrders)
+		r.Post("/", handler.PostRecall)
+		r.Post("/{id}/quarantine", handler.PostQuarantine)
+		r.Post("/{id}/dispose", handler.PostDisposal)
+		r.Post("/{id}/close", handler.PostClose)
+	})
+}
@@ -0,0 +1,17 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// StockRoutes sets up the routes of the stock balances and the ledger they are derived from
+func StockRoutes(router chi.Router, handler *handler.StockHandler) {
+	router.Route("/api/v1/stock", func(r chi.Router) {
+		r.Get("/", handler.GetStock)
+		r.Get("/movements", handler.GetStockMovements)
+		r.Post("/movements", handler.PostStockMovement)
+		r.Post("/adjustments", handler.PostStockAdjustment)
+		r.Get("/reportWaste", handler.GetStockReportWaste)
+	})
+}
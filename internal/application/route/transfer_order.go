@@ -0,0 +1,18 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// TransferOrderRoutes sets up the routes of the transfer orders and the steps that move them between warehouses
+func TransferOrderRoutes(router chi.Router, handler *handler.TransferOrderHandler) {
+	router.Route("/api/v1/transferOrders", func(r chi.Router) {
+		r.Get("/", handler.GetTransferOrders)
+		r.Get("/{id}", handler.GetTransferOrder)
+		r.Post("/", handler.PostTransferOrder)
+		r.Post("/{id}/dispatch", handler.PostDispatch)
+		r.Post("/{id}/transit", handler.PostTransit)
+		r.Post("/{id}/receive", handler.PostReceive)
+	})
+}
@@ -0,0 +1,21 @@
+package route
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+)
+
+// WebhookRoutes sets up the routes for the webhook subscriptions and their deliveries.
+func WebhookRoutes(router chi.Router, handler *handler.WebhookHandler) {
+	router.Route("/api/v1/webhooks", func(r chi.Router) {
+		r.Get("/subscriptions", handler.GetWebhookSubscriptions)
+		r.Get("/subscriptions/{id}", handler.GetWebhookSubscription)
+		r.Post("/subscriptions", handler.PostWebhookSubscription)
+		r.Put("/subscriptions/{id}", handler.PutWebhookSubscription)
+		r.Patch("/subscriptions/{id}", handler.PatchWebhookSubscription)
+		r.Delete("/subscriptions/{id}", handler.DeleteWebhookSubscription)
+		r.Get("/subscriptions/{id}/deliveries", handler.GetWebhookDeliveries)
+		r.Get("/deadLetters", handler.GetDeadLetters)
+		r.Post("/deliveries/{id}/redeliver", handler.PostRedeliver)
+	})
+}
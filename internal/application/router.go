@@ -0,0 +1,91 @@
+package application
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/application/route"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+	mw "github.com/miloalej-dev/W17-G1-Bootcamp/internal/middleware"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/openapi"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+)
+
+// Handlers groups the handlers of every resource served by the API
+type Handlers struct {
+	Product       *handler.ProductDefault
+	ProductBatch  *handler.ProductBatchDefault
+	ProductRecord *handler.ProductRecordHandler
+	ProductType   *handler.ProductTypeHandler
+	Buyer         *handler.BuyerHandler
+	Warehouse     *handler.WarehouseDefault
+	Carrier       *handler.CarrierDefault
+	Country       *handler.CountryHandler
+	Province      *handler.ProvinceHandler
+	Seller        *handler.SellerHandler
+	Employee      *handler.EmployeeHandler
+	Section       *handler.SectionHandler
+	PurchaseOrder *handler.PurchaseOrderHandler
+	OrderStatus   *handler.OrderStatusHandler
+	OrderDetail   *handler.OrderDetailHandler
+	Invoice       *handler.InvoiceHandler
+	InboundOrder  *handler.InboundOrderHandler
+	Stock         *handler.StockHandler
+	TransferOrder *handler.TransferOrderHandler
+	CountSession  *handler.CountSessionHandler
+	Recall        *handler.RecallHandler
+	Locality      *handler.LocalityHandler
+	Audit         *handler.AuditHandler
+	Import        *handler.ImportHandler
+	GraphQL       *handler.GraphQLHandler
+	Webhook       *handler.WebhookHandler
+}
+
+// NewRouter returns the router of the API with its middlewares and every route mounted
+func NewRouter(h Handlers, idempotencyService service.IdempotencyService) chi.Router {
+	rt := chi.NewRouter()
+	spec := openapi.NewSpec(route.OpenAPIInfo, rt, route.Operations)
+
+	// - middlewares
+	rt.Use(middleware.RequestID)
+	rt.Use(middleware.Logger)
+	rt.Use(middleware.Recoverer)
+	rt.Use(mw.Audit)
+	rt.Use(mw.Validate(spec))
+
+	// - endpoints
+
+	route.DefaultRoutes(rt)
+	route.BuyerRoutes(rt, h.Buyer)
+	route.WarehouseRoutes(rt, h.Warehouse)
+	route.CarrierRoutes(rt, h.Carrier)
+	route.SellerRoutes(rt, h.Seller)
+	route.EmployeeRoutes(rt, h.Employee)
+	route.SectionRoutes(rt, h.Section)
+	route.ProductRoutes(rt, h.Product)
+	route.ProductRecordRoutes(rt, h.ProductRecord)
+	route.ProductTypeRoutes(rt, h.ProductType)
+	// - order-creating endpoints accept an Idempotency-Key header so retries do not duplicate orders
+	rt.Group(func(rt chi.Router) {
+		rt.Use(mw.Idempotency(idempotencyService))
+		route.ProductBatchRoutes(rt, h.ProductBatch)
+		route.PurchaseOrderRoutes(rt, h.PurchaseOrder)
+		route.InboundOrderRoutes(rt, h.InboundOrder)
+	})
+	route.OrderDetailRoutes(rt, h.OrderDetail)
+	route.OrderStatusRoutes(rt, h.OrderStatus)
+	route.InvoiceRoutes(rt, h.Invoice)
+	route.StockRoutes(rt, h.Stock)
+	route.TransferOrderRoutes(rt, h.TransferOrder)
+	route.CountSessionRoutes(rt, h.CountSession)
+	route.RecallRoutes(rt, h.Recall)
+	route.CountryRoutes(rt, h.Country)
+	route.ProvinceRoutes(rt, h.Province)
+	route.LocalityRoutes(rt, h.Locality)
+	route.AuditRoutes(rt, h.Audit)
+	route.ImportRoutes(rt, h.Import)
+	route.GraphQLRoutes(rt, h.GraphQL)
+	route.WebhookRoutes(rt, h.Webhook)
+	route.OpenAPIRoutes(rt, spec)
+
+	return rt
+}
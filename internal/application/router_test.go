@@ -0,0 +1,132 @@
+package application
+
+import (
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/application/route"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/openapi"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestNewRouter_EveryRouteDocumented fails when a route is added to the router without its OpenAPI operation
+func TestNewRouter_EveryRouteDocumented(t *testing.T) {
+	// Arrange
+	rt := NewRouter(Handlers{}, nil)
+
+	// Act
+	missing := openapi.Undocumented(rt, route.Operations)
+
+	// Assert
+	require.Empty(t, missing, "add the routes to route.Operations")
+}
+
+func TestNewRouter_OpenAPIDocument(t *testing.T) {
+	// Arrange
+	rt := NewRouter(Handlers{}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+	res := httptest.NewRecorder()
+
+	// Act
+	rt.ServeHTTP(res, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "application/json; charset=utf-8", res.Header().Get("Content-Type"))
+
+	var doc openapi.Document
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), &doc))
+	require.Equal(t, openapi.Version, doc.OpenAPI)
+	require.Len(t, doc.Paths["/api/v1/sections/{id}"], 3)
+
+	post := doc.Paths["/api/v1/sections"]["post"]
+	require.NotNil(t, post)
+	require.Equal(t, "#/components/schemas/SectionRequest", post.RequestBody.Content[openapi.MediaTypeJSON].Schema.Ref)
+
+	section := doc.Components.Schemas["SectionRequest"]
+	require.NotNil(t, section)
+	require.Equal(t, []string{
+		"section_number",
+		"current_temperature",
+		"minimum_temperature",
+		"current_capacity",
+		"minimum_capacity",
+		"maximum_capacity",
+	}, section.Required)
+}
+
+func TestNewRouter_SwaggerUI(t *testing.T) {
+	// Arrange
+	rt := NewRouter(Handlers{}, nil)
+	req := httptest.NewRequest(http.MethodGet, "/docs", nil)
+	res := httptest.NewRecorder()
+
+	// Act
+	rt.ServeHTTP(res, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Contains(t, res.Body.String(), "/openapi.json")
+}
+
+func TestNewRouter_ValidatesRequests(t *testing.T) {
+	testCases := []struct {
+		title    string
+		method   string
+		target   string
+		body     string
+		status   int
+		expected string
+	}{
+		{
+			title:    "body that does not match the schema",
+			method:   http.MethodPost,
+			target:   "/api/v1/productBatches",
+			body:     `{"batch_number": 1, "current_quantity": -1, "current_temperature": 5, "due_date": "2025-13-01", "initial_quantity": 10, "manufacturing_date": "2025-01-01", "manufacturing_hour": 24, "minimum_temperature": 1, "section_id": 1}`,
+			status:   http.StatusUnprocessableEntity,
+			expected: `{"message": "request body does not match its schema", "errors": [{"pointer": "/product_id", "message": "is required"}, {"pointer": "/current_quantity", "message": "must be at least 0"}, {"pointer": "/due_date", "message": "must be a date in the YYYY-MM-DD format"}, {"pointer": "/manufacturing_hour", "message": "must be at most 23"}]}`,
+		},
+		{
+			title:    "malformed body",
+			method:   http.MethodPost,
+			target:   "/api/v1/sections",
+			body:     `{"section_number": `,
+			status:   http.StatusBadRequest,
+			expected: `{"message": "malformed JSON body: unexpected EOF"}`,
+		},
+		{
+			title:    "invalid path parameter",
+			method:   http.MethodGet,
+			target:   "/api/v1/sections/abc",
+			status:   http.StatusBadRequest,
+			expected: `{"message": "invalid request parameters", "errors": [{"parameter": "id", "message": "must be an integer"}]}`,
+		},
+		{
+			title:    "graphql query of the wrong type",
+			method:   http.MethodPost,
+			target:   "/graphql",
+			body:     `{"query": {"warehouses": "id"}, "variables": {"id": 1}}`,
+			status:   http.StatusUnprocessableEntity,
+			expected: `{"message": "request body does not match its schema", "errors": [{"pointer": "/query", "message": "must be a string"}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			// Arrange
+			rt := NewRouter(Handlers{}, nil)
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			res := httptest.NewRecorder()
+
+			// Act
+			rt.ServeHTTP(res, req)
+
+			// Assert
+			require.Equal(t, tc.status, res.Code)
+			require.JSONEq(t, tc.expected, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Package audit carries the request metadata (actor and request ID) that is stamped on every audit log entry.
+//
+// The metadata travels in the context.Context of the request, which the repositories hand to GORM, so the callbacks
+// that write the trail read it from the statement being executed.
+package audit
+
+import "context"
+
+// Metadata identifies who performed a mutation and as part of which request
+type Metadata struct {
+	Actor     string
+	RequestId string
+}
+
+type contextKey struct{}
+
+// WithMetadata returns a copy of ctx carrying the given metadata
+func WithMetadata(ctx context.Context, md Metadata) context.Context {
+	return context.WithValue(ctx, contextKey{}, md)
+}
+
+// FromContext returns the metadata stored in ctx, if any
+func FromContext(ctx context.Context) (Metadata, bool) {
+	if ctx == nil {
+		return Metadata{}, false
+	}
+	md, ok := ctx.Value(contextKey{}).(Metadata)
+	return md, ok
+}
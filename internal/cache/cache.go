@@ -0,0 +1,18 @@
+// Package cache stores serialized values for a limited time, either in the memory of the process or in a
+// Redis-compatible server shared by every instance of the API.
+//
+// Values are stored as bytes so that both backends behave the same way: a cached value is never shared with the
+// caller that stored it, and mutating what Get returned does not alter the cache.
+package cache
+
+import "time"
+
+// Cache is a key-value store whose entries expire
+type Cache interface {
+	// Get returns the value stored for key, and false when there is none or it expired
+	Get(key string) ([]byte, bool, error)
+	// Set stores value for key during ttl
+	Set(key string, value []byte, ttl time.Duration) error
+	// Delete removes the entries of the keys, missing keys are ignored
+	Delete(keys ...string) error
+}
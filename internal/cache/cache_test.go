@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// backends returns every backend along with a function that moves its clock forward
+func backends(t *testing.T) map[string]struct {
+	cache   Cache
+	advance func(time.Duration)
+} {
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	lru := NewLRU(10)
+	lru.now = func() time.Time { return now }
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return map[string]struct {
+		cache   Cache
+		advance func(time.Duration)
+	}{
+		"lru":   {cache: lru, advance: func(d time.Duration) { now = now.Add(d) }},
+		"redis": {cache: NewRedis(client, "frescos:"), advance: server.FastForward},
+	}
+}
+
+func TestCache(t *testing.T) {
+	for name, backend := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			c := backend.cache
+
+			// Arrange
+			require.NoError(t, c.Set("a", []byte("1"), time.Minute))
+			require.NoError(t, c.Set("b", []byte("2"), 2*time.Minute))
+			require.NoError(t, c.Set("c", []byte("3"), 2*time.Minute))
+
+			// Act
+			value, hit, err := c.Get("a")
+			require.NoError(t, err)
+			value[0] = 'x'
+			again, _, _ := c.Get("a")
+			require.NoError(t, c.Delete("b", "missing"))
+			_, deletedHit, _ := c.Get("b")
+			backend.advance(time.Minute)
+			_, expiredHit, _ := c.Get("a")
+			_, liveHit, _ := c.Get("c")
+
+			// Assert
+			require.True(t, hit)
+			require.Equal(t, []byte("1"), again)
+			require.False(t, deletedHit)
+			require.False(t, expiredHit)
+			require.True(t, liveHit)
+		})
+	}
+}
+
+func TestLRU_Eviction(t *testing.T) {
+	// Arrange
+	c := NewLRU(2)
+	require.NoError(t, c.Set("a", []byte("1"), time.Minute))
+	require.NoError(t, c.Set("b", []byte("2"), time.Minute))
+
+	// Act - reading a makes b the least recently used entry
+	_, _, _ = c.Get("a")
+	require.NoError(t, c.Set("c", []byte("3"), time.Minute))
+	_, hitA, _ := c.Get("a")
+	_, hitB, _ := c.Get("b")
+	_, hitC, _ := c.Get("c")
+
+	// Assert
+	require.True(t, hitA)
+	require.False(t, hitB)
+	require.True(t, hitC)
+	require.Equal(t, 2, c.Len())
+}
+
+func TestRedis_Unavailable(t *testing.T) {
+	// Arrange
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	defer client.Close()
+	c := NewRedis(client, "")
+	server.Close()
+
+	// Act
+	_, hit, err := c.Get("a")
+
+	// Assert
+	require.Error(t, err)
+	require.False(t, hit)
+}
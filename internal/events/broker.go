@@ -0,0 +1,88 @@
+// Package events publishes the changes made through the services to the subscribers of the process, such as the
+// streams of the gRPC API and the webhooks.
+//
+// The services are wrapped by decorators that publish a Change once a mutation succeeds, so that a change is seen
+// by the subscribers whichever API it was made through. The changes of the orders are published by the outbox relay
+// once their transaction committed.
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Resource names the kind of entity that changed
+type Resource string
+
+const (
+	ResourceProductBatch  Resource = "product_batch"
+	ResourceSection       Resource = "section"
+	ResourcePurchaseOrder Resource = "purchase_order"
+	ResourceInboundOrder  Resource = "inbound_order"
+)
+
+// Kind is what happened to an entity
+type Kind string
+
+const (
+	KindCreated Kind = "created"
+	KindUpdated Kind = "updated"
+	KindDeleted Kind = "deleted"
+)
+
+// Change is a change made to an entity
+type Change struct {
+	Resource Resource
+	Kind     Kind
+	// Entity is the model as it is after the change, or as it was before its deletion
+	Entity     any
+	OccurredAt time.Time
+}
+
+// Broker fans the published changes out to every subscriber. It is safe for concurrent use.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[chan Change]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[chan Change]struct{})}
+}
+
+// Subscribe returns a channel that receives every change published from now on, and a function that cancels the
+// subscription. Publish never waits for a subscriber: once buffer changes are pending the subscriber is dropped
+// and its channel is closed, so that a slow subscriber neither blocks the services nor silently misses changes.
+func (b *Broker) Subscribe(buffer int) (<-chan Change, func()) {
+	ch := make(chan Change, buffer)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[ch]; ok {
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Publish sends change to every subscriber
+func (b *Broker) Publish(change Change) {
+	if change.OccurredAt.IsZero() {
+		change.OccurredAt = time.Now()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- change:
+		default:
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
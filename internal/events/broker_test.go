@@ -0,0 +1,61 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker_Publish(t *testing.T) {
+	// Arrange
+	broker := NewBroker()
+	first, cancelFirst := broker.Subscribe(1)
+	defer cancelFirst()
+	second, cancelSecond := broker.Subscribe(1)
+	defer cancelSecond()
+
+	// Act
+	broker.Publish(Change{Resource: ResourceSection, Kind: KindCreated, Entity: models.Section{Id: 1}})
+
+	// Assert
+	for _, ch := range []<-chan Change{first, second} {
+		change := <-ch
+		require.Equal(t, ResourceSection, change.Resource)
+		require.Equal(t, models.Section{Id: 1}, change.Entity)
+		require.False(t, change.OccurredAt.IsZero())
+	}
+}
+
+func TestBroker_DropsSlowSubscribers(t *testing.T) {
+	// Arrange
+	broker := NewBroker()
+	slow, cancel := broker.Subscribe(1)
+
+	// Act
+	broker.Publish(Change{Resource: ResourceSection, Kind: KindCreated})
+	broker.Publish(Change{Resource: ResourceSection, Kind: KindUpdated})
+
+	// Assert
+	change, ok := <-slow
+	require.True(t, ok)
+	require.Equal(t, KindCreated, change.Kind)
+	_, ok = <-slow
+	require.False(t, ok)
+	cancel()
+}
+
+func TestBroker_Unsubscribe(t *testing.T) {
+	// Arrange
+	broker := NewBroker()
+	ch, cancel := broker.Subscribe(1)
+
+	// Act
+	cancel()
+	cancel()
+	broker.Publish(Change{Resource: ResourceSection, Kind: KindCreated})
+
+	// Assert
+	_, ok := <-ch
+	require.False(t, ok)
+}
@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// ProductBatchService publishes the changes made through a service.ProductBatchService
+type ProductBatchService struct {
+	service.ProductBatchService
+	broker *Broker
+}
+
+func NewProductBatchService(sv service.ProductBatchService, broker *Broker) *ProductBatchService {
+	return &ProductBatchService{ProductBatchService: sv, broker: broker}
+}
+
+func (s *ProductBatchService) Register(ctx context.Context, batch models.ProductBatch) (models.ProductBatch, error) {
+	created, err := s.ProductBatchService.Register(ctx, batch)
+	if err == nil {
+		s.broker.Publish(Change{Resource: ResourceProductBatch, Kind: KindCreated, Entity: created})
+	}
+	return created, err
+}
+
+func (s *ProductBatchService) Modify(ctx context.Context, batch models.ProductBatch) (models.ProductBatch, error) {
+	updated, err := s.ProductBatchService.Modify(ctx, batch)
+	if err == nil {
+		s.broker.Publish(Change{Resource: ResourceProductBatch, Kind: KindUpdated, Entity: updated})
+	}
+	return updated, err
+}
+
+func (s *ProductBatchService) PartialModify(ctx context.Context, id int, fields map[string]any) (models.ProductBatch, error) {
+	updated, err := s.ProductBatchService.PartialModify(ctx, id, fields)
+	if err == nil {
+		s.broker.Publish(Change{Resource: ResourceProductBatch, Kind: KindUpdated, Entity: updated})
+	}
+	return updated, err
+}
+
+// Remove deletes the batch and publishes it as it was before its deletion
+func (s *ProductBatchService) Remove(ctx context.Context, id int) error {
+	batch, err := s.ProductBatchService.Retrieve(ctx, id)
+	if err != nil {
+		return s.ProductBatchService.Remove(ctx, id)
+	}
+	if err := s.ProductBatchService.Remove(ctx, id); err != nil {
+		return err
+	}
+	s.broker.Publish(Change{Resource: ResourceProductBatch, Kind: KindDeleted, Entity: batch})
+	return nil
+}
+
+func (s *ProductBatchService) ChangeStatus(ctx context.Context, id int, status string, reason string, reasonCode *string, employeeId *int) (models.ProductBatch, error) {
+	updated, err := s.ProductBatchService.ChangeStatus(ctx, id, status, reason, reasonCode, employeeId)
+	if err == nil {
+		s.broker.Publish(Change{Resource: ResourceProductBatch, Kind: KindUpdated, Entity: updated})
+	}
+	return updated, err
+}
+
+// ExpireDue publishes every batch that was expired
+func (s *ProductBatchService) ExpireDue(ctx context.Context) ([]models.ProductBatch, error) {
+	expired, err := s.ProductBatchService.ExpireDue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, batch := range expired {
+		s.broker.Publish(Change{Resource: ResourceProductBatch, Kind: KindUpdated, Entity: batch})
+	}
+	return expired, nil
+}
+
+// SectionService publishes the changes made through a service.SectionService
+type SectionService struct {
+	service.SectionService
+	broker *Broker
+}
+
+func NewSectionService(sv service.SectionService, broker *Broker) *SectionService {
+	return &SectionService{SectionService: sv, broker: broker}
+}
+
+func (s *SectionService) Register(ctx context.Context, section models.Section) (models.Section, error) {
+	created, err := s.SectionService.Register(ctx, section)
+	if err == nil {
+		s.broker.Publish(Change{Resource: ResourceSection, Kind: KindCreated, Entity: created})
+	}
+	return created, err
+}
+
+func (s *SectionService) Modify(ctx context.Context, section models.Section) (models.Section, error) {
+	updated, err := s.SectionService.Modify(ctx, section)
+	if err == nil {
+		s.broker.Publish(Change{Resource: ResourceSection, Kind: KindUpdated, Entity: updated})
+	}
+	return updated, err
+}
+
+func (s *SectionService) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Section, error) {
+	updated, err := s.SectionService.PartialModify(ctx, id, fields)
+	if err == nil {
+		s.broker.Publish(Change{Resource: ResourceSection, Kind: KindUpdated, Entity: updated})
+	}
+	return updated, err
+}
+
+// Remove deletes the section and publishes it as it was before its deletion
+func (s *SectionService) Remove(ctx context.Context, id int) error {
+	section, err := s.SectionService.Retrieve(ctx, id)
+	if err != nil {
+		return s.SectionService.Remove(ctx, id)
+	}
+	if err := s.SectionService.Remove(ctx, id); err != nil {
+		return err
+	}
+	s.broker.Publish(Change{Resource: ResourceSection, Kind: KindDeleted, Entity: section})
+	return nil
+}
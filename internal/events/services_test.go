@@ -0,0 +1,101 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// batchService keeps the product batches in memory
+type batchService struct {
+	service.ProductBatchService
+	batches map[int]models.ProductBatch
+}
+
+func (s *batchService) Retrieve(ctx context.Context, id int) (models.ProductBatch, error) {
+	batch, ok := s.batches[id]
+	if !ok {
+		return models.ProductBatch{}, repository.ErrEntityNotFound
+	}
+	return batch, nil
+}
+
+func (s *batchService) Register(ctx context.Context, batch models.ProductBatch) (models.ProductBatch, error) {
+	if _, ok := s.batches[batch.Id]; ok {
+		return models.ProductBatch{}, repository.ErrEntityAlreadyExists
+	}
+	s.batches[batch.Id] = batch
+	return batch, nil
+}
+
+func (s *batchService) Remove(ctx context.Context, id int) error {
+	if _, ok := s.batches[id]; !ok {
+		return repository.ErrEntityNotFound
+	}
+	delete(s.batches, id)
+	return nil
+}
+
+// ExpireDue expires every batch kept
+func (s *batchService) ExpireDue(ctx context.Context) ([]models.ProductBatch, error) {
+	expired := make([]models.ProductBatch, 0, len(s.batches))
+	for id, batch := range s.batches {
+		batch.Status = models.ProductBatchExpired
+		s.batches[id] = batch
+		expired = append(expired, batch)
+	}
+	return expired, nil
+}
+
+func TestProductBatchService(t *testing.T) {
+	// Arrange
+	broker := NewBroker()
+	changes, cancel := broker.Subscribe(10)
+	defer cancel()
+	sv := NewProductBatchService(&batchService{batches: map[int]models.ProductBatch{}}, broker)
+	batch := models.ProductBatch{Id: 1, BatchNumber: 100, SectionId: 2}
+
+	// Act
+	_, createErr := sv.Register(context.Background(), batch)
+	_, conflictErr := sv.Register(context.Background(), batch)
+	removeErr := sv.Remove(context.Background(), 1)
+	missingErr := sv.Remove(context.Background(), 1)
+
+	// Assert
+	require.NoError(t, createErr)
+	require.True(t, errors.Is(conflictErr, repository.ErrEntityAlreadyExists))
+	require.NoError(t, removeErr)
+	require.True(t, errors.Is(missingErr, repository.ErrEntityNotFound))
+
+	created := <-changes
+	require.Equal(t, Change{Resource: ResourceProductBatch, Kind: KindCreated, Entity: batch, OccurredAt: created.OccurredAt}, created)
+	deleted := <-changes
+	require.Equal(t, KindDeleted, deleted.Kind)
+	require.Equal(t, batch, deleted.Entity)
+	require.Empty(t, changes)
+}
+
+func TestProductBatchService_ExpireDue(t *testing.T) {
+	// Arrange
+	broker := NewBroker()
+	changes, cancel := broker.Subscribe(10)
+	defer cancel()
+	batch := models.ProductBatch{Id: 1, BatchNumber: 100, Status: models.ProductBatchAvailable}
+	sv := NewProductBatchService(&batchService{batches: map[int]models.ProductBatch{1: batch}}, broker)
+
+	// Act
+	expired, err := sv.ExpireDue(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	updated := <-changes
+	require.Equal(t, KindUpdated, updated.Kind)
+	require.Equal(t, expired[0], updated.Entity)
+	require.Empty(t, changes)
+}
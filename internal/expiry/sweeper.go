@@ -0,0 +1,67 @@
+// Package expiry moves the product batches past their due date to expired.
+//
+// The Sweeper asks the product batch service to expire the due batches when it starts and then on every interval,
+// so a batch is expired at most an interval after its due date has passed. Expired batches cannot be picked or
+// transferred any more and their stock no longer counts as available. The changes are attributed to the Actor in the
+// audit trail.
+package expiry
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"log"
+	"time"
+)
+
+const (
+	// DefaultInterval is the time between two sweeps
+	DefaultInterval = time.Hour
+	// Actor is the actor the sweeps are recorded as
+	Actor = "expiry-sweeper"
+)
+
+// Sweeper expires the due product batches in the background
+type Sweeper struct {
+	batches service.ProductBatchService
+	// interval is the time between two sweeps
+	interval time.Duration
+}
+
+// NewSweeper returns a sweeper that expires the due batches of batches, a zero interval takes its default value
+func NewSweeper(batches service.ProductBatchService, interval time.Duration) *Sweeper {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Sweeper{batches: batches, interval: interval}
+}
+
+// Run sweeps until ctx is done. Errors are logged, the next sweep tries again.
+func (s *Sweeper) Run(ctx context.Context) {
+	ctx = audit.WithMetadata(ctx, audit.Metadata{Actor: Actor})
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sweep(ctx); err != nil {
+			log.Printf("expiry: expiring the due product batches: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep expires the batches past their due date and logs how many were expired
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	expired, err := s.batches.ExpireDue(ctx)
+	if err != nil {
+		return err
+	}
+	if len(expired) > 0 {
+		log.Printf("expiry: %d product batches expired", len(expired))
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// batchService counts the sweeps and keeps the actor of the last one, every sweep expires the batches kept and fails
+// with err if given
+type batchService struct {
+	service.ProductBatchService
+	batches []models.ProductBatch
+	sweeps  int
+	actor   string
+	err     error
+}
+
+func (s *batchService) ExpireDue(ctx context.Context) ([]models.ProductBatch, error) {
+	s.sweeps++
+	md, _ := audit.FromContext(ctx)
+	s.actor = md.Actor
+	if s.err != nil {
+		return nil, s.err
+	}
+	expired := s.batches
+	s.batches = nil
+	return expired, nil
+}
+
+func TestSweeper_Sweep(t *testing.T) {
+	tests := []struct {
+		title         string
+		err           error
+		expectedError error
+	}{
+		{title: "Success"},
+		{title: "Error - Service", err: errors.New("connection refused"), expectedError: errors.New("connection refused")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			batches := &batchService{batches: []models.ProductBatch{{Id: 1, Status: models.ProductBatchAvailable}}, err: tt.err}
+			sweeper := NewSweeper(batches, 0)
+
+			// Act
+			err := sweeper.Sweep(context.Background())
+
+			// Assert
+			require.Equal(t, tt.expectedError, err)
+			require.Equal(t, 1, batches.sweeps)
+			require.Equal(t, DefaultInterval, sweeper.interval)
+		})
+	}
+}
+
+func TestSweeper_Run_SweepsOnStart(t *testing.T) {
+	// Arrange
+	batches := &batchService{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	NewSweeper(batches, time.Minute).Run(ctx)
+
+	// Assert
+	require.Equal(t, 1, batches.sweeps)
+	require.Equal(t, Actor, batches.actor)
+}
@@ -0,0 +1,193 @@
+// Package graph serves the models of the API and their relations through GraphQL.
+//
+// The resolvers read the entities from the existing services. Relations are resolved through the loaders of the
+// request, which fetch the related entities of every item of a list in a single service call.
+package graph
+
+import (
+	"context"
+	_ "embed"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Services are the services the resolvers read the entities from
+type Services struct {
+	Warehouse     service.WarehouseService
+	Section       service.SectionService
+	ProductBatch  service.ProductBatchService
+	Product       service.ProductService
+	Seller        service.SellerService
+	ProductRecord service.ProductRecordService
+	PurchaseOrder service.PurchaseOrderService
+	OrderDetail   service.OrderDetailService
+}
+
+// Schema is the executable GraphQL schema of the API
+type Schema struct {
+	schema   *graphql.Schema
+	services Services
+}
+
+// NewSchema returns the schema resolved with services
+func NewSchema(services Services) *Schema {
+	return &Schema{
+		schema:   graphql.MustParseSchema(schemaSDL, &queryResolver{services: services}),
+		services: services,
+	}
+}
+
+// Exec executes a query with a new set of loaders, which cache the entities for the duration of the query only
+func (s *Schema) Exec(ctx context.Context, query string, operationName string, variables map[string]any) *graphql.Response {
+	ctx = context.WithValue(ctx, loadersKey{}, newLoaders(s.services))
+	return s.schema.Exec(ctx, query, operationName, variables)
+}
+
+// loadersKey is the context key of the loaders of a query
+type loadersKey struct{}
+
+// loaders holds the loaders of a query, by id and by foreign key
+type loaders struct {
+	warehouses     *Loader[int, models.Warehouse]
+	sections       *Loader[int, models.Section]
+	products       *Loader[int, models.Product]
+	sellers        *Loader[int, models.Seller]
+	productRecords *Loader[int, models.ProductRecord]
+
+	sectionsByWarehouse    *Loader[int, []models.Section]
+	batchesBySection       *Loader[int, []models.ProductBatch]
+	detailsByPurchaseOrder *Loader[int, []models.OrderDetail]
+}
+
+func newLoaders(s Services) *loaders {
+	l := &loaders{}
+	l.warehouses = NewLoader(primed(byId(s.Warehouse.RetrieveByIds, func(w models.Warehouse) int { return w.Id }), l.queueWarehouses))
+	l.sections = NewLoader(primed(byId(s.Section.RetrieveByIds, func(s models.Section) int { return s.Id }), l.queueSections))
+	l.products = NewLoader(primed(byId(s.Product.RetrieveByIds, func(p models.Product) int { return p.Id }), l.queueProducts))
+	l.sellers = NewLoader(byId(s.Seller.RetrieveByIds, func(s models.Seller) int { return s.Id }))
+	l.productRecords = NewLoader(primed(byId(s.ProductRecord.RetrieveByIds, func(r models.ProductRecord) int { return r.Id }), l.queueProductRecords))
+
+	l.sectionsByWarehouse = NewLoader(primedGroups(groupBy(s.Section.RetrieveByWarehouses, func(s models.Section) int { return s.WarehouseId }), l.queueSections))
+	l.batchesBySection = NewLoader(primedGroups(groupBy(s.ProductBatch.RetrieveBySections, func(b models.ProductBatch) int { return b.SectionId }), l.queueProductBatches))
+	l.detailsByPurchaseOrder = NewLoader(primedGroups(groupBy(s.OrderDetail.RetrieveByPurchaseOrders, func(d models.OrderDetail) int { return d.PurchaseOrderID }), l.queueOrderDetails))
+	return l
+}
+
+// The queue methods announce the relations the given entities are about to look up. They are called on the
+// entities of every batch, and not only by the resolvers of a list, because the items of a list are resolved
+// concurrently: the first item may look its relations up before the last one is even resolved.
+
+func (l *loaders) queueWarehouses(warehouses ...models.Warehouse) {
+	for _, w := range warehouses {
+		l.sectionsByWarehouse.Queue(w.Id)
+	}
+}
+
+func (l *loaders) queueSections(sections ...models.Section) {
+	for _, s := range sections {
+		l.warehouses.Queue(s.WarehouseId)
+		l.batchesBySection.Queue(s.Id)
+	}
+}
+
+func (l *loaders) queueProductBatches(batches ...models.ProductBatch) {
+	for _, b := range batches {
+		l.sections.Queue(b.SectionId)
+		l.products.Queue(b.ProductId)
+	}
+}
+
+func (l *loaders) queueProducts(products ...models.Product) {
+	for _, p := range products {
+		if p.SellerId != nil {
+			l.sellers.Queue(*p.SellerId)
+		}
+	}
+}
+
+func (l *loaders) queuePurchaseOrders(orders ...models.PurchaseOrder) {
+	for _, o := range orders {
+		l.warehouses.Queue(o.WarehouseID)
+		l.detailsByPurchaseOrder.Queue(o.Id)
+	}
+}
+
+func (l *loaders) queueOrderDetails(details ...models.OrderDetail) {
+	for _, d := range details {
+		l.productRecords.Queue(d.ProductRecordID)
+	}
+}
+
+func (l *loaders) queueProductRecords(records ...models.ProductRecord) {
+	for _, r := range records {
+		l.products.Queue(r.ProductId)
+	}
+}
+
+// loadersFrom returns the loaders of the query being executed
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// byId returns a batch function that looks the entities of a batch up by their ids in a single call and indexes them
+// by id
+func byId[T any](retrieveByIds func(context.Context, []int) ([]T, error), id func(T) int) func(context.Context, []int) (map[int]T, error) {
+	return func(ctx context.Context, keys []int) (map[int]T, error) {
+		entities, err := retrieveByIds(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[int]T, len(entities))
+		for _, entity := range entities {
+			values[id(entity)] = entity
+		}
+		return values, nil
+	}
+}
+
+// primed returns a batch function that queues the relations of the entities fetched by fetch
+func primed[T any](fetch func(context.Context, []int) (map[int]T, error), queue func(...T)) func(context.Context, []int) (map[int]T, error) {
+	return func(ctx context.Context, keys []int) (map[int]T, error) {
+		values, err := fetch(ctx, keys)
+		for _, value := range values {
+			queue(value)
+		}
+		return values, err
+	}
+}
+
+// primedGroups returns a batch function that queues the relations of the groups of entities fetched by fetch
+func primedGroups[T any](fetch func(context.Context, []int) (map[int][]T, error), queue func(...T)) func(context.Context, []int) (map[int][]T, error) {
+	return func(ctx context.Context, keys []int) (map[int][]T, error) {
+		values, err := fetch(ctx, keys)
+		for _, group := range values {
+			queue(group...)
+		}
+		return values, err
+	}
+}
+
+// groupBy returns a batch function that looks the entities of a batch up by a foreign key in a single call and groups
+// them by it
+func groupBy[T any](retrieveByKeys func(context.Context, []int) ([]T, error), key func(T) int) func(context.Context, []int) (map[int][]T, error) {
+	return func(ctx context.Context, keys []int) (map[int][]T, error) {
+		entities, err := retrieveByKeys(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[int][]T, len(keys))
+		for _, k := range keys {
+			values[k] = nil
+		}
+		for _, entity := range entities {
+			if group, ok := values[key(entity)]; ok {
+				values[key(entity)] = append(group, entity)
+			}
+		}
+		return values, nil
+	}
+}
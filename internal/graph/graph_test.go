@@ -0,0 +1,338 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// stub is a read-only service over a fixed set of entities that counts the lookups it serves
+type stub[T any] struct {
+	entities []T
+	id       func(T) int
+	all      atomic.Int32
+	batched  atomic.Int32
+	single   atomic.Int32
+}
+
+func newStub[T any](id func(T) int, entities ...T) *stub[T] {
+	return &stub[T]{entities: entities, id: id}
+}
+
+func (s *stub[T]) RetrieveAll(ctx context.Context) ([]T, error) {
+	s.all.Add(1)
+	return s.entities, nil
+}
+
+func (s *stub[T]) RetrieveByIds(ctx context.Context, ids []int) ([]T, error) {
+	return s.retrieveBy(s.id, ids), nil
+}
+
+// retrieveBy counts a batched lookup and returns the entities whose key is one of keys
+func (s *stub[T]) retrieveBy(key func(T) int, keys []int) []T {
+	s.batched.Add(1)
+	entities := make([]T, 0, len(keys))
+	for _, entity := range s.entities {
+		if slices.Contains(keys, key(entity)) {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+func (s *stub[T]) Retrieve(ctx context.Context, id int) (T, error) {
+	s.single.Add(1)
+	for _, entity := range s.entities {
+		if s.id(entity) == id {
+			return entity, nil
+		}
+	}
+	var zero T
+	return zero, repository.ErrEntityNotFound
+}
+
+func (s *stub[T]) Register(ctx context.Context, entity T) (T, error) { return entity, nil }
+func (s *stub[T]) Modify(ctx context.Context, entity T) (T, error)   { return entity, nil }
+func (s *stub[T]) PartialModify(ctx context.Context, id int, fields map[string]any) (T, error) {
+	return s.Retrieve(ctx, id)
+}
+func (s *stub[T]) Remove(ctx context.Context, id int) error { return nil }
+
+type sectionStub struct{ *stub[models.Section] }
+
+func (s sectionStub) RetrieveByWarehouses(ctx context.Context, warehouseIds []int) ([]models.Section, error) {
+	return s.retrieveBy(func(s models.Section) int { return s.WarehouseId }, warehouseIds), nil
+}
+
+func (sectionStub) RetrieveSectionReport(ctx context.Context, sectionId *int) (interface{}, error) {
+	return nil, nil
+}
+
+type productBatchStub struct{ *stub[models.ProductBatch] }
+
+func (s productBatchStub) RetrieveBySections(ctx context.Context, sectionIds []int) ([]models.ProductBatch, error) {
+	return s.retrieveBy(func(b models.ProductBatch) int { return b.SectionId }, sectionIds), nil
+}
+
+func (productBatchStub) Trace(ctx context.Context, batchNumber string) (models.BatchTrace, error) {
+	return models.BatchTrace{}, nil
+}
+
+func (productBatchStub) ChangeStatus(ctx context.Context, id int, status string, reason string, reasonCode *string, employeeId *int) (models.ProductBatch, error) {
+	return models.ProductBatch{}, nil
+}
+
+func (productBatchStub) RetrieveStatusChanges(ctx context.Context, id int) ([]models.ProductBatchStatusChange, error) {
+	return nil, nil
+}
+
+func (productBatchStub) RetrieveExpiring(ctx context.Context, until time.Time) ([]models.ProductBatch, error) {
+	return nil, nil
+}
+
+func (productBatchStub) ExpireDue(ctx context.Context) ([]models.ProductBatch, error) {
+	return nil, nil
+}
+
+type productStub struct{ *stub[models.Product] }
+
+func (productStub) RetrieveRecordsCountByProductId(ctx context.Context, id int) (models.ProductReport, error) {
+	return models.ProductReport{}, nil
+}
+func (productStub) RetrieveRecordsCount(ctx context.Context) ([]models.ProductReport, error) {
+	return nil, nil
+}
+
+type purchaseOrderStub struct{ *stub[models.PurchaseOrder] }
+
+func (purchaseOrderStub) RetrieveByBuyer(ctx context.Context, id int) ([]models.PurchaseOrder, error) {
+	return nil, nil
+}
+
+type productRecordStub struct{ *stub[models.ProductRecord] }
+
+func (productRecordStub) RetrieveCurrentPrice(ctx context.Context, productId int) (models.ProductPrice, error) {
+	return models.ProductPrice{}, nil
+}
+func (productRecordStub) RetrievePriceAt(ctx context.Context, productId int, at time.Time) (models.ProductPrice, error) {
+	return models.ProductPrice{}, nil
+}
+func (productRecordStub) RetrievePriceTrend(ctx context.Context, productId int, from time.Time, to time.Time) (models.ProductPriceTrend, error) {
+	return models.ProductPriceTrend{}, nil
+}
+
+type orderDetailStub struct{ *stub[models.OrderDetail] }
+
+func (s orderDetailStub) RetrieveByPurchaseOrders(ctx context.Context, purchaseOrderIds []int) ([]models.OrderDetail, error) {
+	return s.retrieveBy(func(d models.OrderDetail) int { return d.PurchaseOrderID }, purchaseOrderIds), nil
+}
+
+func (orderDetailStub) RetrieveLines(ctx context.Context, purchaseOrderId int) (models.PurchaseOrderLines, error) {
+	return models.PurchaseOrderLines{}, nil
+}
+func (orderDetailStub) RetrieveLine(ctx context.Context, purchaseOrderId int, id int) (models.OrderDetail, error) {
+	return models.OrderDetail{}, nil
+}
+func (orderDetailStub) AddLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error) {
+	return detail, nil
+}
+func (orderDetailStub) ModifyLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error) {
+	return detail, nil
+}
+func (orderDetailStub) RemoveLine(ctx context.Context, purchaseOrderId int, id int) error { return nil }
+func (orderDetailStub) RetrievePicks(ctx context.Context, purchaseOrderId int, id int) ([]models.OrderDetailPick, error) {
+	return nil, nil
+}
+func (orderDetailStub) Pick(ctx context.Context, purchaseOrderId int, id int, pick models.OrderDetailPick) (models.OrderDetailPick, error) {
+	return pick, nil
+}
+
+type fixture struct {
+	warehouses     *stub[models.Warehouse]
+	sections       *stub[models.Section]
+	batches        *stub[models.ProductBatch]
+	products       *stub[models.Product]
+	sellers        *stub[models.Seller]
+	productRecords *stub[models.ProductRecord]
+	purchaseOrders *stub[models.PurchaseOrder]
+	orderDetails   *stub[models.OrderDetail]
+	schema         *Schema
+}
+
+func newFixture() *fixture {
+	sellerId := 1
+	f := &fixture{
+		warehouses: newStub(func(w models.Warehouse) int { return w.Id },
+			models.Warehouse{Id: 1, WarehouseCode: "WH-1"},
+			models.Warehouse{Id: 2, WarehouseCode: "WH-2"},
+		),
+		sections: newStub(func(s models.Section) int { return s.Id },
+			models.Section{Id: 1, SectionNumber: "S-1", WarehouseId: 1},
+			models.Section{Id: 2, SectionNumber: "S-2", WarehouseId: 1},
+			models.Section{Id: 3, SectionNumber: "S-3", WarehouseId: 2},
+		),
+		batches: newStub(func(b models.ProductBatch) int { return b.Id },
+			models.ProductBatch{Id: 1, BatchNumber: 100, SectionId: 1, ProductId: 1},
+			models.ProductBatch{Id: 2, BatchNumber: 101, SectionId: 2, ProductId: 2},
+			models.ProductBatch{Id: 3, BatchNumber: 102, SectionId: 3, ProductId: 1},
+		),
+		products: newStub(func(p models.Product) int { return p.Id },
+			models.Product{Id: 1, ProductCode: "P-1", SellerId: &sellerId},
+			models.Product{Id: 2, ProductCode: "P-2"},
+		),
+		sellers: newStub(func(s models.Seller) int { return s.Id },
+			models.Seller{Id: 1, Name: "Acme"},
+		),
+		productRecords: newStub(func(r models.ProductRecord) int { return r.Id },
+			models.ProductRecord{Id: 1, SalePrice: 12.5, ProductId: 1},
+			models.ProductRecord{Id: 2, SalePrice: 8, ProductId: 2},
+		),
+		purchaseOrders: newStub(func(o models.PurchaseOrder) int { return o.Id },
+			models.PurchaseOrder{Id: 1, OrderNumber: "PO-1", OrderDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), WarehouseID: 1},
+			models.PurchaseOrder{Id: 2, OrderNumber: "PO-2", OrderDate: time.Date(2025, 7, 2, 0, 0, 0, 0, time.UTC), WarehouseID: 2},
+		),
+		orderDetails: newStub(func(d models.OrderDetail) int { return d.Id },
+			models.OrderDetail{Id: 1, Quantity: 3, ProductRecordID: 1, PurchaseOrderID: 1},
+			models.OrderDetail{Id: 2, Quantity: 5, ProductRecordID: 2, PurchaseOrderID: 1},
+			models.OrderDetail{Id: 3, Quantity: 1, ProductRecordID: 1, PurchaseOrderID: 2},
+		),
+	}
+	f.schema = NewSchema(Services{
+		Warehouse:     f.warehouses,
+		Section:       sectionStub{f.sections},
+		ProductBatch:  productBatchStub{f.batches},
+		Product:       productStub{f.products},
+		Seller:        f.sellers,
+		ProductRecord: productRecordStub{f.productRecords},
+		PurchaseOrder: purchaseOrderStub{f.purchaseOrders},
+		OrderDetail:   orderDetailStub{f.orderDetails},
+	})
+	return f
+}
+
+func TestSchema_NestedWarehouseQueryIsBatched(t *testing.T) {
+	// Arrange
+	f := newFixture()
+	query := `{
+		warehouses {
+			warehouseCode
+			sections {
+				sectionNumber
+				productBatches {
+					batchNumber
+					product { productCode seller { name } }
+				}
+			}
+		}
+	}`
+
+	// Act
+	result := f.schema.Exec(context.Background(), query, "", nil)
+
+	// Assert
+	require.Empty(t, result.Errors)
+	require.JSONEq(t, `{"warehouses": [
+		{"warehouseCode": "WH-1", "sections": [
+			{"sectionNumber": "S-1", "productBatches": [{"batchNumber": 100, "product": {"productCode": "P-1", "seller": {"name": "Acme"}}}]},
+			{"sectionNumber": "S-2", "productBatches": [{"batchNumber": 101, "product": {"productCode": "P-2", "seller": null}}]}
+		]},
+		{"warehouseCode": "WH-2", "sections": [
+			{"sectionNumber": "S-3", "productBatches": [{"batchNumber": 102, "product": {"productCode": "P-1", "seller": {"name": "Acme"}}}]}
+		]}
+	]}`, string(result.Data))
+	require.EqualValues(t, 1, f.warehouses.all.Load())
+	require.EqualValues(t, 1, f.sections.batched.Load())
+	require.EqualValues(t, 1, f.batches.batched.Load())
+	require.EqualValues(t, 1, f.products.batched.Load())
+	require.EqualValues(t, 1, f.sellers.batched.Load())
+	require.EqualValues(t, 0, f.sections.all.Load()+f.batches.all.Load()+f.products.all.Load()+f.sellers.all.Load())
+	require.EqualValues(t, 0, f.products.single.Load()+f.sellers.single.Load())
+}
+
+func TestSchema_NestedPurchaseOrderQueryIsBatched(t *testing.T) {
+	// Arrange
+	f := newFixture()
+	query := `query Orders {
+		purchaseOrders {
+			orderNumber
+			orderDate
+			warehouse { warehouseCode }
+			orderDetails { quantity productRecord { salePrice product { productCode } } }
+		}
+	}`
+
+	// Act
+	result := f.schema.Exec(context.Background(), query, "Orders", nil)
+
+	// Assert
+	require.Empty(t, result.Errors)
+	require.JSONEq(t, `{"purchaseOrders": [
+		{"orderNumber": "PO-1", "orderDate": "2025-07-01T00:00:00Z", "warehouse": {"warehouseCode": "WH-1"}, "orderDetails": [
+			{"quantity": 3, "productRecord": {"salePrice": 12.5, "product": {"productCode": "P-1"}}},
+			{"quantity": 5, "productRecord": {"salePrice": 8, "product": {"productCode": "P-2"}}}
+		]},
+		{"orderNumber": "PO-2", "orderDate": "2025-07-02T00:00:00Z", "warehouse": {"warehouseCode": "WH-2"}, "orderDetails": [
+			{"quantity": 1, "productRecord": {"salePrice": 12.5, "product": {"productCode": "P-1"}}}
+		]}
+	]}`, string(result.Data))
+	require.EqualValues(t, 1, f.warehouses.batched.Load())
+	require.EqualValues(t, 1, f.orderDetails.batched.Load())
+	require.EqualValues(t, 1, f.productRecords.batched.Load())
+	require.EqualValues(t, 1, f.products.batched.Load())
+	require.EqualValues(t, 0, f.warehouses.all.Load()+f.orderDetails.all.Load()+f.productRecords.all.Load()+f.products.all.Load())
+}
+
+func TestSchema_SingleEntity(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "Success - Warehouse found",
+			query:    `query ($id: Int!) { warehouse(id: $id) { warehouseCode sections { sectionNumber } } }`,
+			expected: `{"warehouse": {"warehouseCode": "WH-2", "sections": [{"sectionNumber": "S-3"}]}}`,
+		},
+		{
+			name:     "Success - Missing warehouse is null",
+			query:    `query ($id: Int!) { warehouse(id: $id) { warehouseCode } }`,
+			expected: `{"warehouse": null}`,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			f := newFixture()
+			variables := map[string]any{"id": []int{2, 9}[i]}
+
+			// Act
+			result := f.schema.Exec(context.Background(), tt.query, "", variables)
+
+			// Assert
+			require.Empty(t, result.Errors)
+			require.JSONEq(t, tt.expected, string(result.Data))
+		})
+	}
+}
+
+func TestSchema_InvalidQuery(t *testing.T) {
+	// Arrange
+	f := newFixture()
+
+	// Act
+	result := f.schema.Exec(context.Background(), `{ warehouses { unknown } }`, "", nil)
+
+	// Assert
+	require.NotEmpty(t, result.Errors)
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "unknown")
+}
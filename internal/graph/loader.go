@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"context"
+	"sync"
+)
+
+// Loader batches and caches the lookups of a single GraphQL request, so that resolving a relation for every item of
+// a list costs one service call instead of one per item.
+//
+// The resolver of a list announces the keys its items will look up with Queue. The first Load then fetches every
+// queued key in a single batch, and the following ones are served from the cache.
+type Loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	// fetching serializes the batches, while mu only guards the queue and the cache so that a batch can queue the
+	// keys of other loaders
+	fetching sync.Mutex
+	mu       sync.Mutex
+	pending  []K
+	queued   map[K]bool
+	results  map[K]result[V]
+}
+
+type result[V any] struct {
+	value V
+	found bool
+	err   error
+}
+
+// NewLoader returns a loader that fetches the values of a batch of keys with fetch. Keys missing from the map
+// returned by fetch have no value.
+func NewLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *Loader[K, V] {
+	return &Loader[K, V]{fetch: fetch, queued: make(map[K]bool), results: make(map[K]result[V])}
+}
+
+// Queue announces keys that are about to be loaded, they are fetched in the batch of the next Load
+func (l *Loader[K, V]) Queue(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		l.enqueue(key)
+	}
+}
+
+// Load returns the value of key, and whether it has one, fetching it along with every queued key
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, bool, error) {
+	l.fetching.Lock()
+	defer l.fetching.Unlock()
+
+	l.mu.Lock()
+	if r, ok := l.results[key]; ok {
+		l.mu.Unlock()
+		return r.value, r.found, r.err
+	}
+	l.enqueue(key)
+	keys := l.pending
+	l.pending = nil
+	clear(l.queued)
+	l.mu.Unlock()
+
+	values, err := l.fetch(ctx, keys)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, k := range keys {
+		value, found := values[k]
+		l.results[k] = result[V]{value: value, found: found, err: err}
+	}
+	r := l.results[key]
+	return r.value, r.found, r.err
+}
+
+func (l *Loader[K, V]) enqueue(key K) {
+	if _, loaded := l.results[key]; loaded || l.queued[key] {
+		return
+	}
+	l.queued[key] = true
+	l.pending = append(l.pending, key)
+}
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoader_BatchesQueuedKeys(t *testing.T) {
+	// Arrange
+	var batches [][]int
+	loader := NewLoader(func(_ context.Context, keys []int) (map[int]string, error) {
+		batches = append(batches, keys)
+		values := make(map[int]string)
+		for _, key := range keys {
+			if key != 3 {
+				values[key] = "value"
+			}
+		}
+		return values, nil
+	})
+	ctx := context.Background()
+
+	// Act
+	loader.Queue(1, 2, 3, 2)
+	first, found, err := loader.Load(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	_, missing, err := loader.Load(ctx, 3)
+	require.NoError(t, err)
+	_, _, err = loader.Load(ctx, 4)
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, "value", first)
+	require.False(t, missing)
+	require.Equal(t, [][]int{{1, 2, 3}, {4}}, batches)
+}
+
+func TestLoader_CachesErrors(t *testing.T) {
+	// Arrange
+	calls := 0
+	fetchErr := errors.New("database unavailable")
+	loader := NewLoader(func(_ context.Context, keys []int) (map[int]string, error) {
+		calls++
+		return nil, fetchErr
+	})
+	ctx := context.Background()
+
+	// Act
+	loader.Queue(1, 2)
+	_, _, first := loader.Load(ctx, 1)
+	_, _, second := loader.Load(ctx, 2)
+
+	// Assert
+	require.ErrorIs(t, first, fetchErr)
+	require.ErrorIs(t, second, fetchErr)
+	require.Equal(t, 1, calls)
+}
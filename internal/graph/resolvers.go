@@ -0,0 +1,388 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+// queryResolver resolves the fields of the Query type
+type queryResolver struct {
+	services Services
+}
+
+func (q *queryResolver) Warehouses(ctx context.Context) ([]*warehouseResolver, error) {
+	warehouses, err := q.services.Warehouse.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newWarehouseResolvers(ctx, warehouses), nil
+}
+
+func (q *queryResolver) Warehouse(ctx context.Context, args struct{ ID int32 }) (*warehouseResolver, error) {
+	warehouse, err := q.services.Warehouse.Retrieve(ctx, int(args.ID))
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return newWarehouseResolvers(ctx, []models.Warehouse{warehouse})[0], nil
+}
+
+func (q *queryResolver) Sections(ctx context.Context) ([]*sectionResolver, error) {
+	sections, err := q.services.Section.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newSectionResolvers(ctx, sections), nil
+}
+
+func (q *queryResolver) Section(ctx context.Context, args struct{ ID int32 }) (*sectionResolver, error) {
+	section, err := q.services.Section.Retrieve(ctx, int(args.ID))
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return newSectionResolvers(ctx, []models.Section{section})[0], nil
+}
+
+func (q *queryResolver) ProductBatches(ctx context.Context) ([]*productBatchResolver, error) {
+	batches, err := q.services.ProductBatch.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newProductBatchResolvers(ctx, batches), nil
+}
+
+func (q *queryResolver) Products(ctx context.Context) ([]*productResolver, error) {
+	products, err := q.services.Product.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newProductResolvers(ctx, products), nil
+}
+
+func (q *queryResolver) Product(ctx context.Context, args struct{ ID int32 }) (*productResolver, error) {
+	product, err := q.services.Product.Retrieve(ctx, int(args.ID))
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return newProductResolvers(ctx, []models.Product{product})[0], nil
+}
+
+func (q *queryResolver) Sellers(ctx context.Context) ([]*sellerResolver, error) {
+	sellers, err := q.services.Seller.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*sellerResolver, len(sellers))
+	for i := range sellers {
+		resolvers[i] = &sellerResolver{sellers[i]}
+	}
+	return resolvers, nil
+}
+
+func (q *queryResolver) Seller(ctx context.Context, args struct{ ID int32 }) (*sellerResolver, error) {
+	seller, err := q.services.Seller.Retrieve(ctx, int(args.ID))
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return &sellerResolver{seller}, nil
+}
+
+func (q *queryResolver) ProductRecords(ctx context.Context) ([]*productRecordResolver, error) {
+	records, err := q.services.ProductRecord.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newProductRecordResolvers(ctx, records), nil
+}
+
+func (q *queryResolver) PurchaseOrders(ctx context.Context) ([]*purchaseOrderResolver, error) {
+	orders, err := q.services.PurchaseOrder.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newPurchaseOrderResolvers(ctx, orders), nil
+}
+
+func (q *queryResolver) PurchaseOrder(ctx context.Context, args struct{ ID int32 }) (*purchaseOrderResolver, error) {
+	order, err := q.services.PurchaseOrder.Retrieve(ctx, int(args.ID))
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return newPurchaseOrderResolvers(ctx, []models.PurchaseOrder{order})[0], nil
+}
+
+// notFoundAsNull resolves a missing entity as null instead of an error
+func notFoundAsNull(err error) error {
+	if errors.Is(err, repository.ErrEntityNotFound) || errors.Is(err, repository.ErrProductNotFound) ||
+		errors.Is(err, service.ErrEntityNotFound) || errors.Is(err, service.ErrProductNotFound) {
+		return nil
+	}
+	return err
+}
+
+// load returns the resolver of the entity loaded by key, nil when there is none
+func load[V any, R any](ctx context.Context, loader *Loader[int, V], key int, resolve func(V) *R) (*R, error) {
+	value, found, err := loader.Load(ctx, key)
+	if err != nil || !found {
+		return nil, err
+	}
+	return resolve(value), nil
+}
+
+// warehouseResolver resolves the fields of the Warehouse type
+type warehouseResolver struct {
+	m models.Warehouse
+}
+
+// newWarehouseResolvers returns the resolvers of warehouses and queues the lookups of their sections
+func newWarehouseResolvers(ctx context.Context, warehouses []models.Warehouse) []*warehouseResolver {
+	loadersFrom(ctx).queueWarehouses(warehouses...)
+	resolvers := make([]*warehouseResolver, len(warehouses))
+	for i := range warehouses {
+		resolvers[i] = &warehouseResolver{warehouses[i]}
+	}
+	return resolvers
+}
+
+func (r *warehouseResolver) ID() int32                 { return int32(r.m.Id) }
+func (r *warehouseResolver) WarehouseCode() string     { return r.m.WarehouseCode }
+func (r *warehouseResolver) Address() string           { return r.m.Address }
+func (r *warehouseResolver) Telephone() string         { return r.m.Telephone }
+func (r *warehouseResolver) MinimumCapacity() int32    { return int32(r.m.MinimumCapacity) }
+func (r *warehouseResolver) MinimumTemperature() int32 { return int32(r.m.MinimumTemperature) }
+func (r *warehouseResolver) LocalityId() int32         { return int32(r.m.LocalityId) }
+
+func (r *warehouseResolver) Sections(ctx context.Context) ([]*sectionResolver, error) {
+	sections, _, err := loadersFrom(ctx).sectionsByWarehouse.Load(ctx, r.m.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newSectionResolvers(ctx, sections), nil
+}
+
+// sectionResolver resolves the fields of the Section type
+type sectionResolver struct {
+	m models.Section
+}
+
+// newSectionResolvers returns the resolvers of sections and queues the lookups of their warehouse and batches
+func newSectionResolvers(ctx context.Context, sections []models.Section) []*sectionResolver {
+	loadersFrom(ctx).queueSections(sections...)
+	resolvers := make([]*sectionResolver, len(sections))
+	for i := range sections {
+		resolvers[i] = &sectionResolver{sections[i]}
+	}
+	return resolvers
+}
+
+func (r *sectionResolver) ID() int32                   { return int32(r.m.Id) }
+func (r *sectionResolver) SectionNumber() string       { return r.m.SectionNumber }
+func (r *sectionResolver) CurrentTemperature() float64 { return r.m.CurrentTemperature }
+func (r *sectionResolver) MinimumTemperature() float64 { return r.m.MinimumTemperature }
+func (r *sectionResolver) CurrentCapacity() int32      { return int32(r.m.CurrentCapacity) }
+func (r *sectionResolver) MinimumCapacity() int32      { return int32(r.m.MinimumCapacity) }
+func (r *sectionResolver) MaximumCapacity() int32      { return int32(r.m.MaximumCapacity) }
+func (r *sectionResolver) WarehouseId() int32          { return int32(r.m.WarehouseId) }
+func (r *sectionResolver) ProductTypeId() int32        { return int32(r.m.ProductTypeId) }
+
+func (r *sectionResolver) Warehouse(ctx context.Context) (*warehouseResolver, error) {
+	return load(ctx, loadersFrom(ctx).warehouses, r.m.WarehouseId, func(w models.Warehouse) *warehouseResolver {
+		return newWarehouseResolvers(ctx, []models.Warehouse{w})[0]
+	})
+}
+
+func (r *sectionResolver) ProductBatches(ctx context.Context) ([]*productBatchResolver, error) {
+	batches, _, err := loadersFrom(ctx).batchesBySection.Load(ctx, r.m.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newProductBatchResolvers(ctx, batches), nil
+}
+
+// productBatchResolver resolves the fields of the ProductBatch type
+type productBatchResolver struct {
+	m models.ProductBatch
+}
+
+// newProductBatchResolvers returns the resolvers of batches and queues the lookups of their section and product
+func newProductBatchResolvers(ctx context.Context, batches []models.ProductBatch) []*productBatchResolver {
+	loadersFrom(ctx).queueProductBatches(batches...)
+	resolvers := make([]*productBatchResolver, len(batches))
+	for i := range batches {
+		resolvers[i] = &productBatchResolver{batches[i]}
+	}
+	return resolvers
+}
+
+func (r *productBatchResolver) ID() int32                   { return int32(r.m.Id) }
+func (r *productBatchResolver) BatchNumber() int32          { return int32(r.m.BatchNumber) }
+func (r *productBatchResolver) CurrentQuantity() int32      { return int32(r.m.CurrentQuantity) }
+func (r *productBatchResolver) CurrentTemperature() float64 { return r.m.CurrentTemperature }
+func (r *productBatchResolver) DueDate() string             { return r.m.DueDate }
+func (r *productBatchResolver) InitialQuantity() int32      { return int32(r.m.InitialQuantity) }
+func (r *productBatchResolver) ManufacturingDate() string   { return r.m.ManufacturingDate }
+func (r *productBatchResolver) ManufacturingHour() int32    { return int32(r.m.ManufacturingHour) }
+func (r *productBatchResolver) MinimumTemperature() float64 { return r.m.MinimumTemperature }
+func (r *productBatchResolver) SectionId() int32            { return int32(r.m.SectionId) }
+func (r *productBatchResolver) ProductId() int32            { return int32(r.m.ProductId) }
+func (r *productBatchResolver) Status() string              { return r.m.Status }
+
+func (r *productBatchResolver) Section(ctx context.Context) (*sectionResolver, error) {
+	return load(ctx, loadersFrom(ctx).sections, r.m.SectionId, func(s models.Section) *sectionResolver {
+		return newSectionResolvers(ctx, []models.Section{s})[0]
+	})
+}
+
+func (r *productBatchResolver) Product(ctx context.Context) (*productResolver, error) {
+	return load(ctx, loadersFrom(ctx).products, r.m.ProductId, func(p models.Product) *productResolver {
+		return newProductResolvers(ctx, []models.Product{p})[0]
+	})
+}
+
+// productResolver resolves the fields of the Product type
+type productResolver struct {
+	m models.Product
+}
+
+// newProductResolvers returns the resolvers of products and queues the lookups of their seller
+func newProductResolvers(ctx context.Context, products []models.Product) []*productResolver {
+	loadersFrom(ctx).queueProducts(products...)
+	resolvers := make([]*productResolver, len(products))
+	for i := range products {
+		resolvers[i] = &productResolver{products[i]}
+	}
+	return resolvers
+}
+
+func (r *productResolver) ID() int32               { return int32(r.m.Id) }
+func (r *productResolver) ProductCode() string     { return r.m.ProductCode }
+func (r *productResolver) Description() string     { return r.m.Description }
+func (r *productResolver) Width() float64          { return r.m.Width }
+func (r *productResolver) Height() float64         { return r.m.Height }
+func (r *productResolver) Length() float64         { return r.m.Length }
+func (r *productResolver) NetWeight() float64      { return r.m.NetWeight }
+func (r *productResolver) ExpirationRate() float64 { return r.m.ExpirationRate }
+func (r *productResolver) RecommendedFreezingTemperature() float64 {
+	return r.m.RecommendedFreezingTemperature
+}
+func (r *productResolver) FreezingRate() float64 { return r.m.FreezingRate }
+func (r *productResolver) ProductTypeId() int32  { return int32(r.m.ProductTypeId) }
+
+func (r *productResolver) SellerId() *int32 {
+	if r.m.SellerId == nil {
+		return nil
+	}
+	id := int32(*r.m.SellerId)
+	return &id
+}
+
+func (r *productResolver) Seller(ctx context.Context) (*sellerResolver, error) {
+	if r.m.SellerId == nil {
+		return nil, nil
+	}
+	return load(ctx, loadersFrom(ctx).sellers, *r.m.SellerId, func(s models.Seller) *sellerResolver {
+		return &sellerResolver{s}
+	})
+}
+
+// sellerResolver resolves the fields of the Seller type
+type sellerResolver struct {
+	m models.Seller
+}
+
+func (r *sellerResolver) ID() int32         { return int32(r.m.Id) }
+func (r *sellerResolver) Name() string      { return r.m.Name }
+func (r *sellerResolver) Address() string   { return r.m.Address }
+func (r *sellerResolver) Telephone() string { return r.m.Telephone }
+func (r *sellerResolver) LocalityId() int32 { return int32(r.m.LocalityId) }
+
+// purchaseOrderResolver resolves the fields of the PurchaseOrder type
+type purchaseOrderResolver struct {
+	m models.PurchaseOrder
+}
+
+// newPurchaseOrderResolvers returns the resolvers of orders and queues the lookups of their warehouse and details
+func newPurchaseOrderResolvers(ctx context.Context, orders []models.PurchaseOrder) []*purchaseOrderResolver {
+	loadersFrom(ctx).queuePurchaseOrders(orders...)
+	resolvers := make([]*purchaseOrderResolver, len(orders))
+	for i := range orders {
+		resolvers[i] = &purchaseOrderResolver{orders[i]}
+	}
+	return resolvers
+}
+
+func (r *purchaseOrderResolver) ID() int32            { return int32(r.m.Id) }
+func (r *purchaseOrderResolver) OrderNumber() string  { return r.m.OrderNumber }
+func (r *purchaseOrderResolver) OrderDate() string    { return r.m.OrderDate.Format(time.RFC3339) }
+func (r *purchaseOrderResolver) TracingCode() string  { return r.m.TracingCode }
+func (r *purchaseOrderResolver) BuyerId() int32       { return int32(r.m.BuyerID) }
+func (r *purchaseOrderResolver) WarehouseId() int32   { return int32(r.m.WarehouseID) }
+func (r *purchaseOrderResolver) CarrierId() int32     { return int32(r.m.CarrierID) }
+func (r *purchaseOrderResolver) OrderStatusId() int32 { return int32(r.m.OrderStatusID) }
+
+func (r *purchaseOrderResolver) Warehouse(ctx context.Context) (*warehouseResolver, error) {
+	return load(ctx, loadersFrom(ctx).warehouses, r.m.WarehouseID, func(w models.Warehouse) *warehouseResolver {
+		return newWarehouseResolvers(ctx, []models.Warehouse{w})[0]
+	})
+}
+
+func (r *purchaseOrderResolver) OrderDetails(ctx context.Context) ([]*orderDetailResolver, error) {
+	details, _, err := loadersFrom(ctx).detailsByPurchaseOrder.Load(ctx, r.m.Id)
+	if err != nil {
+		return nil, err
+	}
+	loadersFrom(ctx).queueOrderDetails(details...)
+	resolvers := make([]*orderDetailResolver, len(details))
+	for i := range details {
+		resolvers[i] = &orderDetailResolver{details[i]}
+	}
+	return resolvers, nil
+}
+
+// orderDetailResolver resolves the fields of the OrderDetail type
+type orderDetailResolver struct {
+	m models.OrderDetail
+}
+
+func (r *orderDetailResolver) ID() int32                { return int32(r.m.Id) }
+func (r *orderDetailResolver) Quantity() int32          { return int32(r.m.Quantity) }
+func (r *orderDetailResolver) CleanLinesStatus() string { return r.m.CleanLinesStatus }
+func (r *orderDetailResolver) Temperature() float64     { return r.m.Temperature }
+func (r *orderDetailResolver) ProductRecordId() int32   { return int32(r.m.ProductRecordID) }
+func (r *orderDetailResolver) PurchaseOrderId() int32   { return int32(r.m.PurchaseOrderID) }
+
+func (r *orderDetailResolver) ProductRecord(ctx context.Context) (*productRecordResolver, error) {
+	return load(ctx, loadersFrom(ctx).productRecords, r.m.ProductRecordID, func(p models.ProductRecord) *productRecordResolver {
+		return newProductRecordResolvers(ctx, []models.ProductRecord{p})[0]
+	})
+}
+
+// productRecordResolver resolves the fields of the ProductRecord type
+type productRecordResolver struct {
+	m models.ProductRecord
+}
+
+// newProductRecordResolvers returns the resolvers of records and queues the lookups of their product
+func newProductRecordResolvers(ctx context.Context, records []models.ProductRecord) []*productRecordResolver {
+	loadersFrom(ctx).queueProductRecords(records...)
+	resolvers := make([]*productRecordResolver, len(records))
+	for i := range records {
+		resolvers[i] = &productRecordResolver{records[i]}
+	}
+	return resolvers
+}
+
+func (r *productRecordResolver) ID() int32              { return int32(r.m.Id) }
+func (r *productRecordResolver) LastUpdate() string     { return r.m.LastUpdate.Format(time.RFC3339) }
+func (r *productRecordResolver) PurchasePrice() float64 { return r.m.PurchasePrice }
+func (r *productRecordResolver) SalePrice() float64     { return r.m.SalePrice }
+func (r *productRecordResolver) ProductId() int32       { return int32(r.m.ProductId) }
+
+func (r *productRecordResolver) Product(ctx context.Context) (*productResolver, error) {
+	return load(ctx, loadersFrom(ctx).products, r.m.ProductId, func(p models.Product) *productResolver {
+		return newProductResolvers(ctx, []models.Product{p})[0]
+	})
+}
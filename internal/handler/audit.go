@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"time"
+)
+
+// AuditHandler is a struct with methods that represent handlers for the audit trail
+type AuditHandler struct {
+	service service.AuditService
+}
+
+func NewAuditHandler(service service.AuditService) *AuditHandler {
+	return &AuditHandler{service: service}
+}
+
+// GetAuditLogs handles GET requests to search the audit trail. Every query parameter is an optional filter:
+// entity, id, actor, action, request_id, from and to (RFC 3339 dates).
+func (h *AuditHandler) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+	filter := models.AuditFilter{
+		EntityType: query.Get("entity"),
+		EntityId:   query.Get("id"),
+		Actor:      query.Get("actor"),
+		Action:     query.Get("action"),
+		RequestId:  query.Get("request_id"),
+	}
+
+	switch filter.Action {
+	case "", models.AuditActionCreate, models.AuditActionUpdate, models.AuditActionDelete:
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidAuditAction.Error(), http.StatusBadRequest))
+		return
+	}
+
+	for param, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		date, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidDate.Error(), http.StatusBadRequest))
+			return
+		}
+		*target = &date
+	}
+
+	entries, err := h.service.RetrieveAll(r.Context(), filter)
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(entries, http.StatusOK))
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type AuditServiceMock struct {
+	mock.Mock
+}
+
+func (m *AuditServiceMock) RetrieveAll(ctx context.Context, filter models.AuditFilter) ([]models.AuditLog, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]models.AuditLog), args.Error(1)
+}
+
+type AuditHandlerTestSuite struct {
+	suite.Suite
+	mock    *AuditServiceMock
+	handler *AuditHandler
+	path    string
+}
+
+func (s *AuditHandlerTestSuite) SetupTest() {
+	s.mock = new(AuditServiceMock)
+	s.handler = NewAuditHandler(s.mock)
+	s.path = "/api/v1/audit"
+}
+
+func (s *AuditHandlerTestSuite) TestGetAuditLogs_Success() {
+	// Arrange
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	filter := models.AuditFilter{EntityType: "sections", EntityId: "5", Action: models.AuditActionUpdate, From: &from}
+	entries := []models.AuditLog{
+		{
+			Id:         1,
+			Actor:      "jdoe",
+			RequestId:  "req-1",
+			Action:     models.AuditActionUpdate,
+			EntityType: "sections",
+			EntityId:   "5",
+			Before:     json.RawMessage(`{"current_temperature":4}`),
+			After:      json.RawMessage(`{"current_temperature":8}`),
+			Diff:       json.RawMessage(`{"current_temperature":{"from":4,"to":8}}`),
+			CreatedAt:  time.Date(2025, 7, 2, 10, 0, 0, 0, time.UTC),
+		},
+	}
+	s.mock.On("RetrieveAll", filter).Return(entries, nil)
+
+	request := httptest.NewRequest(http.MethodGet, s.path+"?entity=sections&id=5&action=update&from=2025-07-01T00:00:00Z", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetAuditLogs(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: entries})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *AuditHandlerTestSuite) TestGetAuditLogs_InvalidAction() {
+	// Arrange
+	request := httptest.NewRequest(http.MethodGet, s.path+"?action=read", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetAuditLogs(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.JSONEq(`{"message":"`+ErrInvalidAuditAction.Error()+`"}`, recorder.Body.String())
+	s.mock.AssertNotCalled(s.T(), "RetrieveAll", mock.Anything)
+}
+
+func (s *AuditHandlerTestSuite) TestGetAuditLogs_InvalidDate() {
+	// Arrange
+	request := httptest.NewRequest(http.MethodGet, s.path+"?to=yesterday", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetAuditLogs(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.JSONEq(`{"message":"`+ErrInvalidDate.Error()+`"}`, recorder.Body.String())
+}
+
+func (s *AuditHandlerTestSuite) TestGetAuditLogs_ServiceError() {
+	// Arrange
+	s.mock.On("RetrieveAll", models.AuditFilter{}).Return([]models.AuditLog{}, errors.New("db error"))
+
+	request := httptest.NewRequest(http.MethodGet, s.path, nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetAuditLogs(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusInternalServerError, recorder.Code)
+	s.JSONEq(`{"message":"db error"}`, recorder.Body.String())
+}
+
+func TestAuditHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditHandlerTestSuite))
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"encoding/json"
 	"errors"
 
 	"fmt"
@@ -9,6 +8,7 @@ import (
 	"github.com/go-chi/render"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
@@ -33,7 +33,7 @@ func (h *BuyerHandler) GetBuyers(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
 	fmt.Println("Consultando buyers")
-	value, err := h.service.RetrieveAll()
+	value, err := h.service.RetrieveAll(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -53,7 +53,7 @@ func (h *BuyerHandler) GetBuyer(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, response.NewErrorResponse(errors.New("invalid request").Error(), http.StatusBadRequest))
 		return
 	}
-	value, err := h.service.Retrieve(id)
+	value, err := h.service.Retrieve(r.Context(), id)
 
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
@@ -79,7 +79,7 @@ func (h *BuyerHandler) PostBuyer(w http.ResponseWriter, r *http.Request) {
 		LastName:     *bodyRequest.LastName,
 	}
 
-	value, err := h.service.Register(buyer)
+	value, err := h.service.Register(r.Context(), buyer)
 
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
@@ -97,7 +97,7 @@ func (h *BuyerHandler) DeleteBuyer(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, response.NewErrorResponse(errors.New("invalid request").Error(), http.StatusBadRequest))
 		return
 	}
-	err = h.service.Remove(id)
+	err = h.service.Remove(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -116,14 +116,17 @@ func (h *BuyerHandler) PatchBuyer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	fields, err := patch.Decode(r, buyerPatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse(errors.New("unexpected JSON format, check the request body").Error(), http.StatusBadRequest))
 		return
 	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
 
-	buyer, err := h.service.PartialModify(id, fields)
+	buyer, err := h.service.PartialModify(r.Context(), id, fields)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -146,12 +149,12 @@ func (h *BuyerHandler) GetBuyerPurchaseOrderReport(w http.ResponseWriter, r *htt
 	} else {
 		id = 0 // valor por defecto si no hay query param
 	}
-	report, err := h.service.RetrieveByPurchaseOrderReport(id)
+	report, err := h.service.RetrieveByPurchaseOrderReport(r.Context(), id)
 
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
 	}
-	_ = render.Render(w, r, response.NewResponse(report, http.StatusOK))
+	response.RenderReport(w, r, "buyers_purchase_orders_report", report)
 
 }
@@ -30,37 +30,37 @@ type BuyerHandlerTestSuite struct {
 
 // Mock methods for BuyerService
 
-func (s *BuyerServiceMock) RetrieveAll() ([]models.Buyer, error) {
+func (s *BuyerServiceMock) RetrieveAll(ctx context.Context) ([]models.Buyer, error) {
 	args := s.Called()
 	return args.Get(0).([]models.Buyer), args.Error(1)
 }
 
-func (s *BuyerServiceMock) Retrieve(id int) (models.Buyer, error) {
+func (s *BuyerServiceMock) Retrieve(ctx context.Context, id int) (models.Buyer, error) {
 	args := s.Called(id)
 	return args.Get(0).(models.Buyer), args.Error(1)
 }
 
-func (s *BuyerServiceMock) Register(buyer models.Buyer) (models.Buyer, error) {
+func (s *BuyerServiceMock) Register(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
 	args := s.Called(buyer)
 	return args.Get(0).(models.Buyer), args.Error(1)
 }
 
-func (s *BuyerServiceMock) Modify(buyer models.Buyer) (models.Buyer, error) {
+func (s *BuyerServiceMock) Modify(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
 	args := s.Called(buyer)
 	return args.Get(0).(models.Buyer), args.Error(1)
 }
 
-func (s *BuyerServiceMock) PartialModify(id int, fields map[string]any) (models.Buyer, error) {
+func (s *BuyerServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Buyer, error) {
 	args := s.Called(id, fields)
 	return args.Get(0).(models.Buyer), args.Error(1)
 }
 
-func (s *BuyerServiceMock) Remove(id int) error {
+func (s *BuyerServiceMock) Remove(ctx context.Context, id int) error {
 	args := s.Called(id)
 	return args.Error(0)
 }
 
-func (s *BuyerServiceMock) RetrieveByPurchaseOrderReport(id int) ([]models.BuyerReport, error) {
+func (s *BuyerServiceMock) RetrieveByPurchaseOrderReport(ctx context.Context, id int) ([]models.BuyerReport, error) {
 	args := s.Called(id)
 	return args.Get(0).([]models.BuyerReport), args.Error(1)
 }
@@ -260,7 +260,7 @@ func (s *BuyerHandlerTestSuite) TestPostBuyer_BadRequest() {
 		"last_name":  "Sharpless",
 	}
 
-	expectedResponse := response.Response{Message: "card number Id must be not null", StatusCode: http.StatusBadRequest}
+	expectedResponse := response.Response{Message: "card_number_id must not be null", StatusCode: http.StatusBadRequest}
 
 	requestBodyBytes, _ := json.Marshal(requestBody)
 	request := httptest.NewRequest(http.MethodPost, s.path, bytes.NewBuffer(requestBodyBytes))
@@ -562,6 +562,32 @@ func (s *BuyerHandlerTestSuite) TestGetBuyerPurchaseOrderReport_SuccessAll() {
 	s.JSONEq(string(expectedBody), res.Body.String())
 }
 
+func (s *BuyerHandlerTestSuite) TestGetBuyerPurchaseOrderReport_CSV() {
+	expected := []models.BuyerReport{
+		{
+			Buyer: models.Buyer{
+				Id:           1,
+				CardNumberId: "111",
+				FirstName:    "John",
+				LastName:     "Doe, Jr",
+			},
+			PurchaseOrdersCount: 3,
+		},
+	}
+	s.mock.On("RetrieveByPurchaseOrderReport", 0).Return(expected, nil)
+
+	req := httptest.NewRequest(http.MethodGet, s.path, nil)
+	req.Header.Set("Accept", "text/csv")
+	res := httptest.NewRecorder()
+
+	s.handler.GetBuyerPurchaseOrderReport(res, req)
+
+	s.Equal(http.StatusOK, res.Code)
+	s.Equal("text/csv; charset=utf-8", res.Header().Get("Content-Type"))
+	s.Equal(`attachment; filename=buyers_purchase_orders_report.csv`, res.Header().Get("Content-Disposition"))
+	s.Equal("id,card_number_id,first_name,last_name,purchase_orders_count\n1,111,John,\"Doe, Jr\",3\n", res.Body.String())
+}
+
 func (s *BuyerHandlerTestSuite) TestGetBuyerPurchaseOrderReport_SuccessWithId() {
 	id := 1
 	expected := []models.BuyerReport{
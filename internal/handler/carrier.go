@@ -6,9 +6,9 @@ import (
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"net/http"
-	"encoding/json"
 
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 
@@ -29,7 +29,7 @@ func NewCarrierDefault(sv service.CarrierService) *CarrierDefault {
 
 func (h *CarrierDefault) GetCarriers(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	carriers, err := h.sv.RetrieveAll()
+	carriers, err := h.sv.RetrieveAll(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse("something went wrong", http.StatusInternalServerError))
 		return
@@ -47,7 +47,7 @@ func (h *CarrierDefault) GetCarrier(w http.ResponseWriter, r *http.Request) {
 			return
 	}
 
-	carrier, err := h.sv.Retrieve(id)
+	carrier, err := h.sv.Retrieve(r.Context(), id)
 	if err != nil {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 			return
@@ -73,7 +73,7 @@ func (h *CarrierDefault) PostCarrier(w http.ResponseWriter, r *http.Request) {
 		*carrierJson.LocalityId,
 	)
 
-	carrierResponse, err := h.sv.Register(*carrier)
+	carrierResponse, err := h.sv.Register(r.Context(), *carrier)
 	if err != nil {
 		if errors.Is(err, repository.ErrEntityAlreadyExists) {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
@@ -119,7 +119,7 @@ func (h *CarrierDefault) PutCarrier(w http.ResponseWriter, r *http.Request) {
 		*data.LocalityId,
 	)
 
-	updatedCarrier, err := h.sv.Modify(*carrier)
+	updatedCarrier, err := h.sv.Modify(r.Context(), *carrier)
 	if err != nil {
 		if errors.Is(err, repository.ErrEntityAlreadyExists) {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
@@ -141,14 +141,17 @@ func (h *CarrierDefault) PatchCarrier(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	fields, err := patch.Decode(r, carrierPatch, loader(r.Context(), h.sv.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
 		return
 	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
 
-	carrierResponse, err := h.sv.PartialModify(id, fields)
+	carrierResponse, err := h.sv.PartialModify(r.Context(), id, fields)
 	if err != nil {
 		if errors.Is(err, repository.ErrEntityNotFound) {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
@@ -175,7 +178,7 @@ func (h *CarrierDefault) DeleteCarrier(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.sv.Remove(id)
+	err = h.sv.Remove(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
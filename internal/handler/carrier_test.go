@@ -31,32 +31,32 @@ type CarrierHandlerTestSuite struct {
 
 // Mock methods for CarrierService
 
-func (s *CarrierServiceMock) RetrieveAll() ([]models.Carrier, error) {
+func (s *CarrierServiceMock) RetrieveAll(ctx context.Context) ([]models.Carrier, error) {
 	args := s.Called()
 	return args.Get(0).([]models.Carrier), args.Error(1)
 }
 
-func (s *CarrierServiceMock) Retrieve(id int) (models.Carrier, error) {
+func (s *CarrierServiceMock) Retrieve(ctx context.Context, id int) (models.Carrier, error) {
 	args := s.Called(id)
 	return args.Get(0).(models.Carrier), args.Error(1)
 }
 
-func (s *CarrierServiceMock) Register(carrier models.Carrier) (models.Carrier, error) {
+func (s *CarrierServiceMock) Register(ctx context.Context, carrier models.Carrier) (models.Carrier, error) {
 	args := s.Called(carrier)
 	return args.Get(0).(models.Carrier), args.Error(1)
 }
 
-func (s *CarrierServiceMock) Modify(carrier models.Carrier) (models.Carrier, error) {
+func (s *CarrierServiceMock) Modify(ctx context.Context, carrier models.Carrier) (models.Carrier, error) {
 	args := s.Called(carrier)
 	return args.Get(0).(models.Carrier), args.Error(1)
 }
 
-func (s *CarrierServiceMock) PartialModify(id int, fields map[string]any) (models.Carrier, error) {
+func (s *CarrierServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Carrier, error) {
 	args := s.Called(id, fields)
 	return args.Get(0).(models.Carrier), args.Error(1)
 }
 
-func (s *CarrierServiceMock) Remove(id int) error {
+func (s *CarrierServiceMock) Remove(ctx context.Context, id int) error {
 	args := s.Called(id)
 	return args.Error(0)
 }
@@ -292,7 +292,7 @@ func (s *CarrierHandlerTestSuite) TestPostCarrier_BadRequest_MissingCode() {
 		"telephone":			telephone,
 		"locality_id":			localityId,
 	}
-	expectedResponse := response.Response{Message: "cid code must not be null", StatusCode: http.StatusBadRequest}
+	expectedResponse := response.Response{Message: "cid must not be null", StatusCode: http.StatusBadRequest}
 	expectedBody, _ = json.Marshal(expectedResponse)
 
 	requestBodyBytes, _ := json.Marshal(requestBody)
@@ -593,7 +593,7 @@ func (s *CarrierHandlerTestSuite) TestPutCarrier_BadRequest() {
 		"telephone":			telephone,
 		"locality_id":			localityId,
 	}
-	expectedResponse := response.Response{Message: "cid code must not be null", StatusCode: http.StatusBadRequest}
+	expectedResponse := response.Response{Message: "cid must not be null", StatusCode: http.StatusBadRequest}
 	expectedBody, _ = json.Marshal(expectedResponse)
 
 	requestBodyBytes, _ := json.Marshal(requestBody)
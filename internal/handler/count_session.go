@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// CountSessionHandler is a struct with methods that represent handlers for the physical counts of the sections
+type CountSessionHandler struct {
+	service service.CountSessionService
+}
+
+func NewCountSessionHandler(service service.CountSessionService) *CountSessionHandler {
+	return &CountSessionHandler{service: service}
+}
+
+// GetCountSessions handles GET requests for every count session
+func (h *CountSessionHandler) GetCountSessions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	sessions, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		renderCountSessionError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(sessions, http.StatusOK))
+}
+
+// GetCountSession handles GET requests for a count session with its lines
+func (h *CountSessionHandler) GetCountSession(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	session, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderCountSessionError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(session, http.StatusOK))
+}
+
+// PostCountSession handles POST requests that open a count session for a section
+func (h *CountSessionHandler) PostCountSession(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.CountSessionRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	session, err := h.service.Open(r.Context(), *data.SectionId)
+	if err != nil {
+		renderCountSessionError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(session, http.StatusCreated))
+}
+
+// PostCount handles POST requests that record the quantity of a batch of a count session
+func (h *CountSessionHandler) PostCount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.CountRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	line, err := h.service.RecordCount(r.Context(), id, *data.ProductBatchId, *data.CountedQuantity, *data.EmployeeId)
+	if err != nil {
+		renderCountSessionError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(line, http.StatusOK))
+}
+
+// PostApprove handles POST requests that approve a count session and post its variances
+func (h *CountSessionHandler) PostApprove(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	session, err := h.service.Approve(r.Context(), id)
+	if err != nil {
+		renderCountSessionError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(session, http.StatusOK))
+}
+
+// GetCountSessionReportWarehouses handles GET requests for the variances of the count sessions of every warehouse,
+// or of the one given by the id query parameter
+func (h *CountSessionHandler) GetCountSessionReportWarehouses(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var id *int
+	if idParam := r.URL.Query().Get("id"); idParam != "" {
+		value, err := strconv.Atoi(idParam)
+		if err != nil || value < 1 {
+			_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+			return
+		}
+		id = &value
+	}
+
+	variances, err := h.service.RetrieveWarehouseVariances(r.Context(), id)
+	if err != nil {
+		renderCountSessionError(w, r, err)
+		return
+	}
+
+	response.RenderReport(w, r, "count_variances_by_warehouse", variances)
+}
+
+// GetCountSessionReportEmployees handles GET requests for the variances of the batches counted by every employee, or
+// by the one given by the id query parameter
+func (h *CountSessionHandler) GetCountSessionReportEmployees(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var id *int
+	if idParam := r.URL.Query().Get("id"); idParam != "" {
+		value, err := strconv.Atoi(idParam)
+		if err != nil || value < 1 {
+			_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+			return
+		}
+		id = &value
+	}
+
+	variances, err := h.service.RetrieveEmployeeVariances(r.Context(), id)
+	if err != nil {
+		renderCountSessionError(w, r, err)
+		return
+	}
+
+	response.RenderReport(w, r, "count_variances_by_employee", variances)
+}
+
+func renderCountSessionError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, repository.ErrCountSessionOpen),
+		errors.Is(err, service.ErrCountSessionClosed),
+		errors.Is(err, repository.ErrStaleEntity),
+		errors.Is(err, repository.ErrInsufficientStock),
+		errors.Is(err, repository.ErrProductBatchNotAvailable),
+		errors.Is(err, repository.ErrForeignKeyViolation):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrInvalidCountedQuantity),
+		errors.Is(err, service.ErrBatchNotInCountSession),
+		errors.Is(err, service.ErrEmployeeNotInWarehouse),
+		errors.Is(err, service.ErrCountSessionIncomplete):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
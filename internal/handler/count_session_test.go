@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type CountSessionServiceMock struct {
+	mock.Mock
+}
+
+func (m *CountSessionServiceMock) RetrieveAll(ctx context.Context) ([]models.CountSession, error) {
+	args := m.Called()
+	return args.Get(0).([]models.CountSession), args.Error(1)
+}
+
+func (m *CountSessionServiceMock) Retrieve(ctx context.Context, id int) (models.CountSession, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.CountSession), args.Error(1)
+}
+
+func (m *CountSessionServiceMock) Open(ctx context.Context, sectionId int) (models.CountSession, error) {
+	args := m.Called(sectionId)
+	return args.Get(0).(models.CountSession), args.Error(1)
+}
+
+func (m *CountSessionServiceMock) RecordCount(ctx context.Context, id int, productBatchId int, quantity int, employeeId int) (models.CountLine, error) {
+	args := m.Called(id, productBatchId, quantity, employeeId)
+	return args.Get(0).(models.CountLine), args.Error(1)
+}
+
+func (m *CountSessionServiceMock) Approve(ctx context.Context, id int) (models.CountSession, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.CountSession), args.Error(1)
+}
+
+func (m *CountSessionServiceMock) RetrieveWarehouseVariances(ctx context.Context, warehouseId *int) ([]models.WarehouseCountVariance, error) {
+	args := m.Called(warehouseId)
+	return args.Get(0).([]models.WarehouseCountVariance), args.Error(1)
+}
+
+func (m *CountSessionServiceMock) RetrieveEmployeeVariances(ctx context.Context, employeeId *int) ([]models.EmployeeCountVariance, error) {
+	args := m.Called(employeeId)
+	return args.Get(0).([]models.EmployeeCountVariance), args.Error(1)
+}
+
+type CountSessionHandlerTestSuite struct {
+	suite.Suite
+	mock    *CountSessionServiceMock
+	handler *CountSessionHandler
+}
+
+func (s *CountSessionHandlerTestSuite) SetupTest() {
+	s.mock = new(CountSessionServiceMock)
+	s.handler = NewCountSessionHandler(s.mock)
+}
+
+func (s *CountSessionHandlerTestSuite) TestPostCountSession_Success() {
+	// Arrange
+	session := models.CountSession{Id: 5, SectionId: 4, Status: models.CountSessionOpen, Lines: []models.CountLine{
+		{Id: 1, CountSessionId: 5, ProductBatchId: 3, ExpectedQuantity: 200},
+	}}
+	s.mock.On("Open", 4).Return(session, nil)
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/countSessions", strings.NewReader(`{"section_id":4}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostCountSession(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: session})
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *CountSessionHandlerTestSuite) TestPostCount_Errors() {
+	tests := []struct {
+		title        string
+		err          error
+		expectedCode int
+	}{
+		{title: "session not found", err: repository.ErrEntityNotFound, expectedCode: http.StatusNotFound},
+		{title: "session approved", err: service.ErrCountSessionClosed, expectedCode: http.StatusConflict},
+		{title: "batch not in session", err: service.ErrBatchNotInCountSession, expectedCode: http.StatusUnprocessableEntity},
+		{title: "employee of another warehouse", err: service.ErrEmployeeNotInWarehouse, expectedCode: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			s.mock.On("RecordCount", 5, 3, 194, 2).Return(models.CountLine{}, tt.err)
+			body := `{"product_batch_id":3,"counted_quantity":194,"employee_id":2}`
+			request := withId(httptest.NewRequest(http.MethodPost, "/api/v1/countSessions/5/counts", strings.NewReader(body)), "5")
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.PostCount(recorder, request)
+
+			// Assert
+			s.Equal(tt.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func (s *CountSessionHandlerTestSuite) TestPostApprove_Incomplete() {
+	// Arrange
+	s.mock.On("Approve", 5).Return(models.CountSession{}, service.ErrCountSessionIncomplete)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostApprove(recorder, withId(httptest.NewRequest(http.MethodPost, "/api/v1/countSessions/5/approve", nil), "5"))
+
+	// Assert
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (s *CountSessionHandlerTestSuite) TestGetCountSessionReportEmployees_Success() {
+	// Arrange
+	id := 2
+	variances := []models.EmployeeCountVariance{{
+		Employee:      models.Employee{Id: 2, FirstName: "Ana", WarehouseId: 1},
+		CountVariance: models.CountVariance{LinesCount: 12, MismatchesCount: 3, NetVariance: -4, AbsoluteVariance: 10},
+	}}
+	s.mock.On("RetrieveEmployeeVariances", &id).Return(variances, nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetCountSessionReportEmployees(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/countSessions/reportEmployees?id=2", nil))
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: variances})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *CountSessionHandlerTestSuite) TestGetCountSessionReportWarehouses_InvalidId() {
+	// Arrange
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetCountSessionReportWarehouses(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/countSessions/reportWarehouses?id=abc", nil))
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RetrieveWarehouseVariances", mock.Anything)
+}
+
+func TestCountSessionHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CountSessionHandlerTestSuite))
+}
@@ -0,0 +1,329 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// CountryHandler is a struct with methods that represent handlers for the countries, the browsing of their
+// provinces and localities and the management of their tax rules
+type CountryHandler struct {
+	service service.CountryService
+}
+
+func NewCountryHandler(service service.CountryService) *CountryHandler {
+	return &CountryHandler{service: service}
+}
+
+func (h *CountryHandler) GetCountries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	countries, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(countries, http.StatusOK))
+}
+
+func (h *CountryHandler) GetCountry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	country, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(country, http.StatusOK))
+}
+
+func (h *CountryHandler) PostCountry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.CountryRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	country, err := h.service.Register(r.Context(), models.Country{Country: *data.Country})
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(country, http.StatusCreated))
+}
+
+func (h *CountryHandler) PutCountry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.CountryRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	country, err := h.service.Modify(r.Context(), models.Country{Id: id, Country: *data.Country})
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(country, http.StatusOK))
+}
+
+func (h *CountryHandler) PatchCountry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	fields, err := patch.Decode(r, countryPatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
+
+	country, err := h.service.PartialModify(r.Context(), id, fields)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(country, http.StatusOK))
+}
+
+// DeleteCountry handles DELETE requests for a country, rejected while the country has provinces or tax rules
+func (h *CountryHandler) DeleteCountry(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	if err = h.service.Remove(r.Context(), id); err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
+}
+
+// GetCountryProvinces handles GET requests for the provinces of a country
+func (h *CountryHandler) GetCountryProvinces(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	provinces, err := h.service.RetrieveProvinces(r.Context(), id)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(provinces, http.StatusOK))
+}
+
+// GetCountryProvinceLocalities handles GET requests for the localities of a province of a country
+func (h *CountryHandler) GetCountryProvinceLocalities(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	provinceId, err := strconv.Atoi(chi.URLParam(r, "provinceId"))
+	if err != nil || provinceId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	localities, err := h.service.RetrieveLocalities(r.Context(), id, provinceId)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(localities, http.StatusOK))
+}
+
+// GetCountryTaxRules handles GET requests for the tax rules of a country
+func (h *CountryHandler) GetCountryTaxRules(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	rules, err := h.service.RetrieveTaxRules(r.Context(), id)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(rules, http.StatusOK))
+}
+
+// GetCountryTaxRule handles GET requests for a tax rule of a country
+func (h *CountryHandler) GetCountryTaxRule(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	taxRuleId, err := strconv.Atoi(chi.URLParam(r, "taxRuleId"))
+	if err != nil || taxRuleId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	rule, err := h.service.RetrieveTaxRule(r.Context(), id, taxRuleId)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(rule, http.StatusOK))
+}
+
+// PostCountryTaxRule handles POST requests that add a tax rule to a country
+func (h *CountryHandler) PostCountryTaxRule(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.TaxRuleRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	rule, err := h.service.RegisterTaxRule(r.Context(), models.TaxRule{CountryId: id, Name: *data.Name, Rate: *data.Rate})
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(rule, http.StatusCreated))
+}
+
+// PutCountryTaxRule handles PUT requests that replace a tax rule of a country
+func (h *CountryHandler) PutCountryTaxRule(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	taxRuleId, err := strconv.Atoi(chi.URLParam(r, "taxRuleId"))
+	if err != nil || taxRuleId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.TaxRuleRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	rule, err := h.service.ModifyTaxRule(r.Context(), models.TaxRule{Id: taxRuleId, CountryId: id, Name: *data.Name, Rate: *data.Rate})
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(rule, http.StatusOK))
+}
+
+// DeleteCountryTaxRule handles DELETE requests for a tax rule of a country
+func (h *CountryHandler) DeleteCountryTaxRule(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	taxRuleId, err := strconv.Atoi(chi.URLParam(r, "taxRuleId"))
+	if err != nil || taxRuleId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	if err = h.service.RemoveTaxRule(r.Context(), id, taxRuleId); err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
+}
+
+// renderLocationError renders the errors returned by the country, province and locality services
+func renderLocationError(w http.ResponseWriter, r *http.Request, err error) {
+	var validationErr *patch.ValidationError
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, repository.ErrEntityAlreadyExists), errors.Is(err, repository.ErrForeignKeyViolation),
+		errors.Is(err, service.ErrCountryInUse), errors.Is(err, service.ErrProvinceInUse),
+		errors.Is(err, service.ErrLocalityInUse):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrInvalidCountryName), errors.Is(err, service.ErrInvalidProvinceName),
+		errors.Is(err, service.ErrInvalidTaxRuleName), errors.Is(err, service.ErrInvalidTaxRate):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	case errors.Is(err, service.ErrEmptyLocalitySearch):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+	case errors.As(err, &validationErr):
+		_ = render.Render(w, r, response.NewValidationErrorResponse(err.Error(), validationErr.Errors, http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
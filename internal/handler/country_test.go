@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type CountryServiceMock struct {
+	mock.Mock
+}
+
+func (m *CountryServiceMock) RetrieveAll(ctx context.Context) ([]models.Country, error) {
+	args := m.Called()
+	return args.Get(0).([]models.Country), args.Error(1)
+}
+
+func (m *CountryServiceMock) Retrieve(ctx context.Context, id int) (models.Country, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.Country), args.Error(1)
+}
+
+func (m *CountryServiceMock) Register(ctx context.Context, country models.Country) (models.Country, error) {
+	args := m.Called(country)
+	return args.Get(0).(models.Country), args.Error(1)
+}
+
+func (m *CountryServiceMock) Modify(ctx context.Context, country models.Country) (models.Country, error) {
+	args := m.Called(country)
+	return args.Get(0).(models.Country), args.Error(1)
+}
+
+func (m *CountryServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Country, error) {
+	args := m.Called(id, fields)
+	return args.Get(0).(models.Country), args.Error(1)
+}
+
+func (m *CountryServiceMock) Remove(ctx context.Context, id int) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *CountryServiceMock) RetrieveProvinces(ctx context.Context, countryId int) ([]models.Province, error) {
+	args := m.Called(countryId)
+	return args.Get(0).([]models.Province), args.Error(1)
+}
+
+func (m *CountryServiceMock) RetrieveLocalities(ctx context.Context, countryId int, provinceId int) ([]models.Locality, error) {
+	args := m.Called(countryId, provinceId)
+	return args.Get(0).([]models.Locality), args.Error(1)
+}
+
+func (m *CountryServiceMock) RetrieveTaxRules(ctx context.Context, countryId int) ([]models.TaxRule, error) {
+	args := m.Called(countryId)
+	return args.Get(0).([]models.TaxRule), args.Error(1)
+}
+
+func (m *CountryServiceMock) RetrieveTaxRule(ctx context.Context, countryId int, id int) (models.TaxRule, error) {
+	args := m.Called(countryId, id)
+	return args.Get(0).(models.TaxRule), args.Error(1)
+}
+
+func (m *CountryServiceMock) RegisterTaxRule(ctx context.Context, rule models.TaxRule) (models.TaxRule, error) {
+	args := m.Called(rule)
+	return args.Get(0).(models.TaxRule), args.Error(1)
+}
+
+func (m *CountryServiceMock) ModifyTaxRule(ctx context.Context, rule models.TaxRule) (models.TaxRule, error) {
+	args := m.Called(rule)
+	return args.Get(0).(models.TaxRule), args.Error(1)
+}
+
+func (m *CountryServiceMock) RemoveTaxRule(ctx context.Context, countryId int, id int) error {
+	args := m.Called(countryId, id)
+	return args.Error(0)
+}
+
+type CountryHandlerTestSuite struct {
+	suite.Suite
+	mock    *CountryServiceMock
+	handler *CountryHandler
+	path    string
+}
+
+func (s *CountryHandlerTestSuite) SetupTest() {
+	s.mock = new(CountryServiceMock)
+	s.handler = NewCountryHandler(s.mock)
+	s.path = "/api/v1/countries"
+}
+
+// withProvince adds the id and provinceId URL parameters to the request
+func withProvince(request *http.Request, id string, provinceId string) *http.Request {
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", id)
+	ctx.URLParams.Add("provinceId", provinceId)
+	return request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+}
+
+func (s *CountryHandlerTestSuite) TestPostCountry_Success() {
+	// Arrange
+	s.mock.On("Register", models.Country{Country: "Chile"}).Return(models.Country{Id: 4, Country: "Chile"}, nil)
+	request := httptest.NewRequest(http.MethodPost, s.path, strings.NewReader(`{"country":"Chile"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostCountry(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(`{"data":{"id":4,"country":"Chile"}}`, recorder.Body.String())
+}
+
+func (s *CountryHandlerTestSuite) TestPostCountry_BlankName() {
+	// Arrange
+	s.mock.On("Register", models.Country{Country: " "}).Return(models.Country{}, service.ErrInvalidCountryName)
+	request := httptest.NewRequest(http.MethodPost, s.path, strings.NewReader(`{"country":" "}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostCountry(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (s *CountryHandlerTestSuite) TestDeleteCountry_InUse() {
+	// Arrange
+	s.mock.On("Remove", 1).Return(service.ErrCountryInUse)
+	request := withId(httptest.NewRequest(http.MethodDelete, s.path+"/1", nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.DeleteCountry(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusConflict, recorder.Code)
+}
+
+func (s *CountryHandlerTestSuite) TestGetCountryProvinces_NotFound() {
+	// Arrange
+	s.mock.On("RetrieveProvinces", 9).Return([]models.Province(nil), repository.ErrEntityNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, s.path+"/9/provinces", nil), "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetCountryProvinces(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *CountryHandlerTestSuite) TestGetCountryProvinceLocalities_Success() {
+	// Arrange
+	localities := []models.Locality{{Id: 2, Locality: "Córdoba", ProvinceId: 2}}
+	s.mock.On("RetrieveLocalities", 1, 2).Return(localities, nil)
+	request := withProvince(httptest.NewRequest(http.MethodGet, s.path+"/1/provinces/2/localities", nil), "1", "2")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetCountryProvinceLocalities(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: localities})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *CountryHandlerTestSuite) TestGetCountryProvinceLocalities_InvalidProvinceId() {
+	// Arrange
+	request := withProvince(httptest.NewRequest(http.MethodGet, s.path+"/1/provinces/x/localities", nil), "1", "x")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetCountryProvinceLocalities(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RetrieveLocalities", mock.Anything, mock.Anything)
+}
+
+// withTaxRule adds the id and taxRuleId URL parameters to the request
+func withTaxRule(request *http.Request, id string, taxRuleId string) *http.Request {
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", id)
+	ctx.URLParams.Add("taxRuleId", taxRuleId)
+	return request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+}
+
+func (s *CountryHandlerTestSuite) TestPostCountryTaxRule_Success() {
+	// Arrange
+	s.mock.On("RegisterTaxRule", models.TaxRule{CountryId: 1, Name: "IVA", Rate: 21}).Return(models.TaxRule{Id: 3, CountryId: 1, Name: "IVA", Rate: 21}, nil)
+	request := withId(httptest.NewRequest(http.MethodPost, s.path+"/1/taxRules", strings.NewReader(`{"name":"IVA","rate":21}`)), "1")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostCountryTaxRule(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(`{"data":{"id":3,"country_id":1,"name":"IVA","rate":21}}`, recorder.Body.String())
+}
+
+func (s *CountryHandlerTestSuite) TestPostCountryTaxRule_MissingRate() {
+	// Arrange
+	request := withId(httptest.NewRequest(http.MethodPost, s.path+"/1/taxRules", strings.NewReader(`{"name":"IVA"}`)), "1")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostCountryTaxRule(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RegisterTaxRule", mock.Anything)
+}
+
+func (s *CountryHandlerTestSuite) TestPutCountryTaxRule_InvalidRate() {
+	// Arrange
+	rule := models.TaxRule{Id: 2, CountryId: 1, Name: "IVA", Rate: 121}
+	s.mock.On("ModifyTaxRule", rule).Return(models.TaxRule{}, service.ErrInvalidTaxRate)
+	request := withTaxRule(httptest.NewRequest(http.MethodPut, s.path+"/1/taxRules/2", strings.NewReader(`{"name":"IVA","rate":121}`)), "1", "2")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PutCountryTaxRule(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (s *CountryHandlerTestSuite) TestDeleteCountryTaxRule_NotFound() {
+	// Arrange
+	s.mock.On("RemoveTaxRule", 1, 9).Return(repository.ErrEntityNotFound)
+	request := withTaxRule(httptest.NewRequest(http.MethodDelete, s.path+"/1/taxRules/9", nil), "1", "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.DeleteCountryTaxRule(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestCountryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CountryHandlerTestSuite))
+}
@@ -1,11 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
@@ -24,7 +25,7 @@ func NewEmployeeHandler(service service.EmployeeService) *EmployeeHandler {
 
 // GetEmployees handles GET requests to retrieve all employees
 func (h *EmployeeHandler) GetEmployees(w http.ResponseWriter, r *http.Request) {
-	employees, err := h.service.RetrieveAll()
+	employees, err := h.service.RetrieveAll(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNoContent))
 		return
@@ -41,7 +42,7 @@ func (h *EmployeeHandler) GetEmployee(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	employee, err := h.service.Retrieve(id)
+	employee, err := h.service.Retrieve(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -63,7 +64,7 @@ func (h *EmployeeHandler) CreateEmployee(w http.ResponseWriter, r *http.Request)
 		LastName:     *data.LastName,
 		WarehouseId:  *data.WarehouseId,
 	}
-	employeeRes, err := h.service.Register(employee)
+	employeeRes, err := h.service.Register(r.Context(), employee)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -92,7 +93,7 @@ func (h *EmployeeHandler) PutEmployee(w http.ResponseWriter, r *http.Request) {
 		LastName:     *data.LastName,
 		WarehouseId:  *data.WarehouseId,
 	}
-	updatedEmployee, err := h.service.Modify(employee)
+	updatedEmployee, err := h.service.Modify(r.Context(), employee)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -108,14 +109,17 @@ func (h *EmployeeHandler) PatchEmployee(w http.ResponseWriter, r *http.Request)
 		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
 		return
 	}
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	fields, err := patch.Decode(r, employeePatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
 		return
 	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
 
-	updatedEmployee, err := h.service.PartialModify(id, fields)
+	updatedEmployee, err := h.service.PartialModify(r.Context(), id, fields)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -132,7 +136,7 @@ func (h *EmployeeHandler) DeleteEmployee(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err = h.service.Remove(id)
+	err = h.service.Remove(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -154,23 +158,23 @@ func (h *EmployeeHandler) GetInboundOrdersReport(w http.ResponseWriter, r *http.
 			return
 		}
 
-		report, err := h.service.RetrieveInboundOrdersReportById(id)
+		report, err := h.service.RetrieveInboundOrdersReportById(r.Context(), id)
 
 		if err != nil {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 			return
 		}
 
-		_ = render.Render(w, r, response.NewResponse(report, http.StatusOK))
+		response.RenderReport(w, r, "employees_inbound_orders_report", report)
 
 		return
 	}
 
 	// Get report for all employees
-	report, err := h.service.RetrieveInboundOrdersReport()
+	report, err := h.service.RetrieveInboundOrdersReport(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
 	}
-	_ = render.Render(w, r, response.NewResponse(report, http.StatusOK))
+	response.RenderReport(w, r, "employees_inbound_orders_report", report)
 }
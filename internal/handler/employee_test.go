@@ -31,42 +31,42 @@ type EmployeeHandlerTestSuite struct {
 
 // Mock methods for EmployeeService
 
-func (m *EmployeeServiceMock) RetrieveAll() ([]models.Employee, error) {
+func (m *EmployeeServiceMock) RetrieveAll(ctx context.Context) ([]models.Employee, error) {
 	args := m.Called()
 	return args.Get(0).([]models.Employee), args.Error(1)
 }
 
-func (m *EmployeeServiceMock) Retrieve(id int) (models.Employee, error) {
+func (m *EmployeeServiceMock) Retrieve(ctx context.Context, id int) (models.Employee, error) {
 	args := m.Called(id)
 	return args.Get(0).(models.Employee), args.Error(1)
 }
 
-func (m *EmployeeServiceMock) Register(employee models.Employee) (models.Employee, error) {
+func (m *EmployeeServiceMock) Register(ctx context.Context, employee models.Employee) (models.Employee, error) {
 	args := m.Called(employee)
 	return args.Get(0).(models.Employee), args.Error(1)
 }
 
-func (m *EmployeeServiceMock) Modify(employee models.Employee) (models.Employee, error) {
+func (m *EmployeeServiceMock) Modify(ctx context.Context, employee models.Employee) (models.Employee, error) {
 	args := m.Called(employee)
 	return args.Get(0).(models.Employee), args.Error(1)
 }
 
-func (m *EmployeeServiceMock) PartialModify(id int, fields map[string]any) (models.Employee, error) {
+func (m *EmployeeServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Employee, error) {
 	args := m.Called(id, fields)
 	return args.Get(0).(models.Employee), args.Error(1)
 }
 
-func (m *EmployeeServiceMock) Remove(id int) error {
+func (m *EmployeeServiceMock) Remove(ctx context.Context, id int) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *EmployeeServiceMock) RetrieveInboundOrdersReport() ([]models.EmployeeInboundOrdersReport, error) {
+func (m *EmployeeServiceMock) RetrieveInboundOrdersReport(ctx context.Context) ([]models.EmployeeInboundOrdersReport, error) {
 	args := m.Called()
 	return args.Get(0).([]models.EmployeeInboundOrdersReport), args.Error(1)
 }
 
-func (m *EmployeeServiceMock) RetrieveInboundOrdersReportById(id int) (models.EmployeeInboundOrdersReport, error) {
+func (m *EmployeeServiceMock) RetrieveInboundOrdersReportById(ctx context.Context, id int) (models.EmployeeInboundOrdersReport, error) {
 	args := m.Called(id)
 	return args.Get(0).(models.EmployeeInboundOrdersReport), args.Error(1)
 }
@@ -292,7 +292,7 @@ func (s *EmployeeHandlerTestSuite) TestPostEmployee_BadRequest_MissingCardNumber
 		"last_name":    "Doe",
 		"warehouse_id": 1,
 	}
-	expectedResponse := response.Response{Message: "CardNumberId must not be null", StatusCode: http.StatusUnprocessableEntity}
+	expectedResponse := response.Response{Message: "card_number_id must not be null", StatusCode: http.StatusUnprocessableEntity}
 
 	requestBodyBytes, _ := json.Marshal(requestBody)
 	request := httptest.NewRequest(http.MethodPost, s.path, bytes.NewBuffer(requestBodyBytes))
@@ -851,6 +851,36 @@ func (s *EmployeeHandlerTestSuite) TestGetInboundOrdersReport_AllEmployees_Ok()
 	s.JSONEq(string(expectedBody), recorder.Body.String())
 }
 
+func (s *EmployeeHandlerTestSuite) TestGetInboundOrdersReport_AllEmployees_XLSX() {
+	// Arrange
+	expectedReports := []models.EmployeeInboundOrdersReport{
+		{
+			Employee: models.Employee{
+				Id:           1,
+				CardNumberId: "123456789",
+				FirstName:    "John",
+				LastName:     "Doe",
+				WarehouseId:  1,
+			},
+			InboundOrdersCount: 5,
+		},
+	}
+
+	s.mock.On("RetrieveInboundOrdersReport").Return(expectedReports, nil)
+
+	request := httptest.NewRequest(http.MethodGet, fmt.Sprint(s.path, "/reportInboundOrders?format=xlsx"), nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetInboundOrdersReport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal(response.ContentTypeXLSX, recorder.Header().Get("Content-Type"))
+	s.Equal(`attachment; filename=employees_inbound_orders_report.xlsx`, recorder.Header().Get("Content-Disposition"))
+	s.Equal("PK", recorder.Body.String()[:2])
+}
+
 func (s *EmployeeHandlerTestSuite) TestGetInboundOrdersReport_AllEmployees_InternalError() {
 	// Arrange
 	var expectedBody []byte
@@ -9,4 +9,12 @@ var (
 	ErrInvalidId = errors.New("invalid ID, must be a positive integer greater than zero")
 	// ErrUnexpectedJSON is returned when the JSON is not valid
 	ErrUnexpectedJSON = errors.New("unexpected JSON format, check the request body")
+	// ErrInvalidDate is returned when a date query parameter does not follow the RFC 3339 format
+	ErrInvalidDate = errors.New("invalid date, must follow the RFC 3339 format")
+	// ErrInvalidAuditAction is returned when the audit action filter is not create, update or delete
+	ErrInvalidAuditAction = errors.New("invalid action, must be one of create, update or delete")
+	// ErrUnsupportedImportResource is returned when the resource of an import cannot be imported
+	ErrUnsupportedImportResource = errors.New("unsupported import resource, must be one of products, sellers, localities or productBatches")
+	// ErrUnsupportedImportFormat is returned when the body of an import is neither CSV nor JSON Lines
+	ErrUnsupportedImportFormat = errors.New("unsupported import format, the Content-Type must be text/csv or application/x-ndjson")
 )
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"encoding/json"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/graph"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+)
+
+// GraphQLHandler serves the GraphQL queries of the API
+type GraphQLHandler struct {
+	schema *graph.Schema
+}
+
+func NewGraphQLHandler(schema *graph.Schema) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema}
+}
+
+// PostGraphQL executes a query. The result is written as a GraphQL response, with the errors of the fields that
+// could not be resolved next to the data, instead of the envelope of the REST endpoints.
+func (h *GraphQLHandler) PostGraphQL(w http.ResponseWriter, r *http.Request) {
+	data := &request.GraphQLRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	result := h.schema.Exec(r.Context(), *data.Query, data.OperationName, data.Variables)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/graph"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type OrderDetailServiceMock struct {
+	mock.Mock
+}
+
+func (m *OrderDetailServiceMock) RetrieveAll(ctx context.Context) ([]models.OrderDetail, error) {
+	args := m.Called()
+	return args.Get(0).([]models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) RetrieveByPurchaseOrders(ctx context.Context, purchaseOrderIds []int) ([]models.OrderDetail, error) {
+	args := m.Called(purchaseOrderIds)
+	return args.Get(0).([]models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) Retrieve(ctx context.Context, id int) (models.OrderDetail, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) Register(ctx context.Context, detail models.OrderDetail) (models.OrderDetail, error) {
+	args := m.Called(detail)
+	return args.Get(0).(models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) Modify(ctx context.Context, detail models.OrderDetail) (models.OrderDetail, error) {
+	args := m.Called(detail)
+	return args.Get(0).(models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.OrderDetail, error) {
+	args := m.Called(id, fields)
+	return args.Get(0).(models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) Remove(ctx context.Context, id int) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *OrderDetailServiceMock) RetrieveLines(ctx context.Context, purchaseOrderId int) (models.PurchaseOrderLines, error) {
+	args := m.Called(purchaseOrderId)
+	return args.Get(0).(models.PurchaseOrderLines), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) RetrieveLine(ctx context.Context, purchaseOrderId int, id int) (models.OrderDetail, error) {
+	args := m.Called(purchaseOrderId, id)
+	return args.Get(0).(models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) AddLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error) {
+	args := m.Called(purchaseOrderId, detail)
+	return args.Get(0).(models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) ModifyLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error) {
+	args := m.Called(purchaseOrderId, detail)
+	return args.Get(0).(models.OrderDetail), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) RemoveLine(ctx context.Context, purchaseOrderId int, id int) error {
+	args := m.Called(purchaseOrderId, id)
+	return args.Error(0)
+}
+
+func (m *OrderDetailServiceMock) RetrievePicks(ctx context.Context, purchaseOrderId int, id int) ([]models.OrderDetailPick, error) {
+	args := m.Called(purchaseOrderId, id)
+	return args.Get(0).([]models.OrderDetailPick), args.Error(1)
+}
+
+func (m *OrderDetailServiceMock) Pick(ctx context.Context, purchaseOrderId int, id int, pick models.OrderDetailPick) (models.OrderDetailPick, error) {
+	args := m.Called(purchaseOrderId, id, pick)
+	return args.Get(0).(models.OrderDetailPick), args.Error(1)
+}
+
+func TestGraphQLHandler_PostGraphQL(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		sellers        []models.Seller
+		sellersErr     error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Success - Query resolved",
+			body:           `{"query": "{ sellers { id name } }"}`,
+			sellers:        []models.Seller{{Id: 1, Name: "Acme"}},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data": {"sellers": [{"id": 1, "name": "Acme"}]}}`,
+		},
+		{
+			name:           "Error - Service failure reported next to the data",
+			body:           `{"query": "{ sellers { id } }"}`,
+			sellersErr:     errors.New("database unavailable"),
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data": null, "errors": [{"message": "database unavailable", "path": ["sellers"]}]}`,
+		},
+		{
+			name:           "Error - Query missing",
+			body:           `{"operationName": "Sellers"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"message": "query must not be null"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			sellerService := &SellerServiceMock{}
+			if tt.sellers != nil || tt.sellersErr != nil {
+				sellerService.On("RetrieveAll").Return(tt.sellers, tt.sellersErr)
+			}
+			h := NewGraphQLHandler(graph.NewSchema(graph.Services{
+				Warehouse:     &WarehouseServiceMock{},
+				Section:       &SectionServiceMock{},
+				ProductBatch:  &ProductBatchServiceMock{},
+				Product:       &ProductServiceMock{},
+				Seller:        sellerService,
+				ProductRecord: &ProductRecordServiceMock{},
+				PurchaseOrder: &PurchaseOrderServiceMock{},
+				OrderDetail:   &OrderDetailServiceMock{},
+			}))
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			res := httptest.NewRecorder()
+
+			// Act
+			h.PostGraphQL(res, req)
+
+			// Assert
+			require.Equal(t, tt.expectedStatus, res.Code)
+			require.JSONEq(t, tt.expectedBody, res.Body.String())
+			sellerService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"mime"
+	"net/http"
+)
+
+// importResource describes how the rows of an importable resource are decoded and converted into models
+type importResource struct {
+	// newRequest returns the request struct a row is decoded into and validated with
+	newRequest func() render.Binder
+	// toModel converts a validated request struct into the model to create
+	toModel func(data render.Binder) any
+}
+
+// importResources holds the resources that can be imported, by the name used in the route
+var importResources = map[string]importResource{
+	"products": {
+		newRequest: func() render.Binder { return &request.ProductRequest{} },
+		toModel: func(data render.Binder) any {
+			p := data.(*request.ProductRequest)
+			return *models.NewProduct(0, *p.ProductCode, *p.Description, *p.Width, *p.Height, *p.Length,
+				*p.NetWeight, *p.ExpirationRate, *p.RecommendedFreezingTemperature, *p.FreezingRate,
+				*p.ProductTypeId, p.SellerId)
+		},
+	},
+	"sellers": {
+		newRequest: func() render.Binder { return &request.SellerRequest{} },
+		toModel: func(data render.Binder) any {
+			s := data.(*request.SellerRequest)
+			return models.Seller{
+				Name:       *s.Name,
+				Address:    *s.Address,
+				Telephone:  *s.Telephone,
+				LocalityId: *s.LocalityId,
+			}
+		},
+	},
+	"localities": {
+		newRequest: func() render.Binder { return &request.LocalityRequest{} },
+		toModel: func(data render.Binder) any {
+			l := data.(*request.LocalityRequest)
+			return models.LocalityDoc{
+				Id:       l.Id,
+				Locality: *l.Locality,
+				Province: *l.Province,
+				Country:  *l.Country,
+			}
+		},
+	},
+	"productBatches": {
+		newRequest: func() render.Binder { return &request.ProductBatchRequest{} },
+		toModel: func(data render.Binder) any {
+			b := data.(*request.ProductBatchRequest)
+			return models.NewProductBatch(0, *b.BatchNumber, *b.CurrentQuantity, *b.CurrentTemperature, *b.DueDate,
+				*b.InitialQuantity, *b.ManufacturingDate, *b.ManufacturingHour, *b.MinimumTemperature, *b.SectionId,
+				*b.ProductId)
+		},
+	},
+}
+
+// ImportHandler serves the bulk import of resources
+type ImportHandler struct {
+	sv service.ImportService
+}
+
+func NewImportHandler(sv service.ImportService) *ImportHandler {
+	return &ImportHandler{sv: sv}
+}
+
+// PostImport creates the resources of every row of a CSV or JSON Lines body and returns a report row by row. The
+// mode query parameter selects between an atomic import, the default, and a best-effort one.
+func (h *ImportHandler) PostImport(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "resource")
+	resource, ok := importResources[name]
+	if !ok {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrUnsupportedImportResource.Error(), http.StatusNotFound))
+		return
+	}
+
+	mode := r.URL.Query().Get("mode")
+	if mode == "" {
+		mode = models.ImportModeAtomic
+	}
+
+	var decoder request.RowDecoder
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		decoder = request.NewCSVDecoder(r.Body)
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		decoder = request.NewJSONLinesDecoder(r.Body)
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(ErrUnsupportedImportFormat.Error(), http.StatusUnsupportedMediaType))
+		return
+	}
+
+	report, err := h.sv.Import(r.Context(), name, mode, &importRows{decoder: decoder, resource: resource, r: r})
+	var streamErr *request.StreamError
+	switch {
+	case errors.Is(err, service.ErrInvalidImportMode), errors.Is(err, service.ErrEmptyImport), errors.As(err, &streamErr):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	case err != nil:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	status := http.StatusOK
+	switch {
+	case report.Failed == 0:
+		status = http.StatusCreated
+	case report.Created == 0:
+		status = http.StatusUnprocessableEntity
+	}
+	_ = render.Render(w, r, response.NewResponse(report, status))
+}
+
+// importRows reads the rows of an import body as they are requested, so the body is never fully loaded in memory
+type importRows struct {
+	decoder  request.RowDecoder
+	resource importResource
+	r        *http.Request
+}
+
+// Next decodes and validates the next row, a row that is malformed or invalid is returned with its error set
+func (i *importRows) Next() (models.ImportRow, error) {
+	data := i.resource.newRequest()
+
+	line, err := i.decoder.Decode(data)
+	var rowErr *request.RowError
+	switch {
+	case errors.As(err, &rowErr):
+		return models.ImportRow{Line: line, Err: err}, nil
+	case err != nil:
+		return models.ImportRow{}, err
+	}
+
+	if err := data.Bind(i.r); err != nil {
+		return models.ImportRow{Line: line, Err: err}, nil
+	}
+	return models.ImportRow{Line: line, Entity: i.resource.toModel(data)}, nil
+}
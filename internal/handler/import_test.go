@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type ImportServiceMock struct {
+	mock.Mock
+	// rows holds every row read by the last call to Import
+	rows []models.ImportRow
+}
+
+func (m *ImportServiceMock) Import(ctx context.Context, resource string, mode string, rows service.ImportRowReader) (models.ImportReport, error) {
+	m.rows = nil
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return models.ImportReport{}, err
+		}
+		m.rows = append(m.rows, row)
+	}
+	args := m.Called(resource, mode)
+	return args.Get(0).(models.ImportReport), args.Error(1)
+}
+
+type ImportHandlerTestSuite struct {
+	suite.Suite
+	mock    *ImportServiceMock
+	handler *ImportHandler
+}
+
+func (s *ImportHandlerTestSuite) SetupTest() {
+	s.mock = new(ImportServiceMock)
+	s.handler = NewImportHandler(s.mock)
+}
+
+func (s *ImportHandlerTestSuite) newRequest(resource string, query string, contentType string, body string) *http.Request {
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/imports/"+resource+query, strings.NewReader(body))
+	request.Header.Set("Content-Type", contentType)
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("resource", resource)
+	return request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_CSVCreated() {
+	// Arrange
+	id := 1
+	report := models.ImportReport{Resource: "sellers", Mode: models.ImportModeAtomic, Total: 1, Created: 1, Committed: true,
+		Rows: []models.ImportRowResult{{Line: 2, Id: &id, Status: models.ImportStatusCreated}}}
+	s.mock.On("Import", "sellers", models.ImportModeAtomic).Return(report, nil)
+
+	body := "name,address,telephone,locality_id\nAcme,Street 1,555,1\n"
+	request := s.newRequest("sellers", "", "text/csv; charset=utf-8", body)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: report})
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+	s.Equal([]models.ImportRow{{Line: 2, Entity: models.Seller{Name: "Acme", Address: "Street 1", Telephone: "555", LocalityId: 1}}}, s.mock.rows)
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_JSONLinesInvalidRows() {
+	// Arrange
+	report := models.ImportReport{Resource: "localities", Mode: models.ImportModeBestEffort, Total: 2, Failed: 2, Committed: true}
+	s.mock.On("Import", "localities", models.ImportModeBestEffort).Return(report, nil)
+
+	body := "{\"id\":1,\"locality_name\":\"Palermo\"}\n{\"id\":\n"
+	request := s.newRequest("localities", "?mode=best-effort", "application/x-ndjson", body)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+	s.Len(s.mock.rows, 2)
+	s.EqualError(s.mock.rows[0].Err, "province_name must not be null")
+	s.Error(s.mock.rows[1].Err)
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_PartiallyCreated() {
+	// Arrange
+	report := models.ImportReport{Resource: "products", Mode: models.ImportModeBestEffort, Total: 2, Created: 1, Failed: 1, Committed: true}
+	s.mock.On("Import", "products", models.ImportModeBestEffort).Return(report, nil)
+
+	request := s.newRequest("products", "?mode=best-effort", "application/x-ndjson", "")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusOK, recorder.Code)
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_UnknownColumn() {
+	// Arrange
+	request := s.newRequest("productBatches", "", "text/csv", "batch_number,color\n1,red\n")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "Import", mock.Anything, mock.Anything)
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_InvalidMode() {
+	// Arrange
+	s.mock.On("Import", "sellers", "partial").Return(models.ImportReport{}, service.ErrInvalidImportMode)
+
+	request := s.newRequest("sellers", "?mode=partial", "text/csv", "")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_UnsupportedResource() {
+	// Arrange
+	request := s.newRequest("warehouses", "", "text/csv", "")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_UnsupportedFormat() {
+	// Arrange
+	request := s.newRequest("sellers", "", "application/json", "[]")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusUnsupportedMediaType, recorder.Code)
+}
+
+func (s *ImportHandlerTestSuite) TestPostImport_ServiceError() {
+	// Arrange
+	s.mock.On("Import", "sellers", models.ImportModeAtomic).Return(models.ImportReport{}, errors.New("db error"))
+
+	request := s.newRequest("sellers", "", "text/csv", "")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostImport(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusInternalServerError, recorder.Code)
+	s.mock.AssertExpectations(s.T())
+}
+
+func TestImportHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportHandlerTestSuite))
+}
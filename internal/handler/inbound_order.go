@@ -1,11 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
@@ -25,7 +26,7 @@ func NewInboundOrderHandler(service service.InboundOrderService) *InboundOrderHa
 func (h *InboundOrderHandler) GetInboundOrders(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	inboundOrders, err := h.service.RetrieveAll()
+	inboundOrders, err := h.service.RetrieveAll(r.Context())
 
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
@@ -45,7 +46,7 @@ func (h *InboundOrderHandler) GetInboundOrder(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	inboundOrder, err := h.service.Retrieve(id)
+	inboundOrder, err := h.service.Retrieve(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -73,7 +74,7 @@ func (h *InboundOrderHandler) PostInboundOrder(w http.ResponseWriter, r *http.Re
 		WarehouseId:    *data.WarehouseId,
 	}
 
-	createdInboundOrder, err := h.service.Register(inboundOrder)
+	createdInboundOrder, err := h.service.Register(r.Context(), inboundOrder)
 
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
@@ -109,7 +110,7 @@ func (h *InboundOrderHandler) PutInboundOrder(w http.ResponseWriter, r *http.Req
 		WarehouseId:    *data.WarehouseId,
 	}
 
-	updatedInboundOrder, err := h.service.Modify(inboundOrder)
+	updatedInboundOrder, err := h.service.Modify(r.Context(), inboundOrder)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -128,14 +129,17 @@ func (h *InboundOrderHandler) PatchInboundOrder(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	fields, err := patch.Decode(r, inboundOrderPatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
 		return
 	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
 
-	updatedInboundOrder, err := h.service.PartialModify(id, fields)
+	updatedInboundOrder, err := h.service.PartialModify(r.Context(), id, fields)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -154,7 +158,7 @@ func (h *InboundOrderHandler) DeleteInboundOrder(w http.ResponseWriter, r *http.
 		return
 	}
 
-	err = h.service.Remove(id)
+	err = h.service.Remove(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
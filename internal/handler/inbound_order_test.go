@@ -31,32 +31,32 @@ type InboundOrderHandlerTestSuite struct {
 
 // Mock methods for InboundOrderService
 
-func (m *InboundOrderServiceMock) RetrieveAll() ([]models.InboundOrder, error) {
+func (m *InboundOrderServiceMock) RetrieveAll(ctx context.Context) ([]models.InboundOrder, error) {
 	args := m.Called()
 	return args.Get(0).([]models.InboundOrder), args.Error(1)
 }
 
-func (m *InboundOrderServiceMock) Retrieve(id int) (models.InboundOrder, error) {
+func (m *InboundOrderServiceMock) Retrieve(ctx context.Context, id int) (models.InboundOrder, error) {
 	args := m.Called(id)
 	return args.Get(0).(models.InboundOrder), args.Error(1)
 }
 
-func (m *InboundOrderServiceMock) Register(inboundOrder models.InboundOrder) (models.InboundOrder, error) {
+func (m *InboundOrderServiceMock) Register(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error) {
 	args := m.Called(inboundOrder)
 	return args.Get(0).(models.InboundOrder), args.Error(1)
 }
 
-func (m *InboundOrderServiceMock) Modify(inboundOrder models.InboundOrder) (models.InboundOrder, error) {
+func (m *InboundOrderServiceMock) Modify(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error) {
 	args := m.Called(inboundOrder)
 	return args.Get(0).(models.InboundOrder), args.Error(1)
 }
 
-func (m *InboundOrderServiceMock) PartialModify(id int, fields map[string]any) (models.InboundOrder, error) {
+func (m *InboundOrderServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.InboundOrder, error) {
 	args := m.Called(id, fields)
 	return args.Get(0).(models.InboundOrder), args.Error(1)
 }
 
-func (m *InboundOrderServiceMock) Remove(id int) error {
+func (m *InboundOrderServiceMock) Remove(ctx context.Context, id int) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"embed"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"html/template"
+	"net/http"
+	"strconv"
+)
+
+//go:embed templates/invoice.html
+var templates embed.FS
+
+// invoiceTemplate renders an invoice as a printable page
+var invoiceTemplate = template.Must(template.ParseFS(templates, "templates/invoice.html"))
+
+// InvoiceHandler is a struct with methods that represent handlers for the pricing and the invoices of the purchase
+// orders
+type InvoiceHandler struct {
+	service service.InvoiceService
+}
+
+func NewInvoiceHandler(service service.InvoiceService) *InvoiceHandler {
+	return &InvoiceHandler{service: service}
+}
+
+// GetPricedPurchaseOrder handles GET requests for a purchase order along with its line amounts, taxes and totals
+func (h *InvoiceHandler) GetPricedPurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	order, err := h.service.RetrievePricedOrder(r.Context(), id)
+	if err != nil {
+		renderInvoiceError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(order, http.StatusOK))
+}
+
+// GetInvoice handles GET requests for the invoice of a purchase order, as JSON or, with format=html or an
+// Accept: text/html header, as a printable page
+func (h *InvoiceHandler) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	invoice, err := h.service.RetrieveInvoice(r.Context(), id)
+	if err != nil {
+		renderInvoiceError(w, r, err)
+		return
+	}
+
+	response.RenderDocument(w, r, invoiceFileName(invoice), invoiceTemplate, invoice)
+}
+
+func invoiceFileName(invoice models.Invoice) string {
+	return "invoice_" + invoice.Number
+}
+
+func renderInvoiceError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type InvoiceServiceMock struct {
+	mock.Mock
+}
+
+func (m *InvoiceServiceMock) RetrievePricedOrder(ctx context.Context, id int) (models.PricedPurchaseOrder, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.PricedPurchaseOrder), args.Error(1)
+}
+
+func (m *InvoiceServiceMock) RetrieveInvoice(ctx context.Context, id int) (models.Invoice, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.Invoice), args.Error(1)
+}
+
+type InvoiceHandlerTestSuite struct {
+	suite.Suite
+	mock    *InvoiceServiceMock
+	handler *InvoiceHandler
+	invoice models.Invoice
+}
+
+func (s *InvoiceHandlerTestSuite) SetupTest() {
+	s.mock = new(InvoiceServiceMock)
+	s.handler = NewInvoiceHandler(s.mock)
+	s.invoice = models.Invoice{
+		Number:      "INV-00000001",
+		IssuedAt:    time.Date(2025, time.June, 10, 12, 30, 0, 0, time.UTC),
+		OrderNumber: "PO-001",
+		OrderDate:   time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC),
+		TracingCode: "TR-001",
+		Buyer:       models.Buyer{Id: 1, CardNumberId: "B-1", FirstName: "Ana", LastName: "Pérez"},
+		Warehouse:   models.Warehouse{Id: 1, WarehouseCode: "W-AR", Address: "Calle 7"},
+		Country:     models.Country{Id: 1, Country: "Argentina"},
+		PurchaseOrderPricing: models.NewPurchaseOrderPricing(
+			[]models.PricedOrderDetail{{OrderDetail: models.OrderDetail{Id: 1, Quantity: 2, ProductRecordID: 3, PurchaseOrderID: 1}, UnitPrice: 10}},
+			[]models.TaxRule{{Id: 1, CountryId: 1, Name: "IVA", Rate: 21}},
+		),
+	}
+}
+
+func (s *InvoiceHandlerTestSuite) TestGetPricedPurchaseOrder_Success() {
+	// Arrange
+	order := models.NewPricedPurchaseOrder(
+		models.PurchaseOrder{Id: 1, OrderNumber: "PO-001", OrderStatusID: models.OrderStatusPending},
+		s.invoice.PurchaseOrderPricing,
+	)
+	s.mock.On("RetrievePricedOrder", 1).Return(order, nil)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/1", nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetPricedPurchaseOrder(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: order})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+	s.Contains(recorder.Body.String(), `"total":24.2`)
+	s.NotContains(recorder.Body.String(), `"order_details"`)
+}
+
+func (s *InvoiceHandlerTestSuite) TestGetPricedPurchaseOrder_InvalidId() {
+	// Arrange
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/abc", nil), "abc")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetPricedPurchaseOrder(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RetrievePricedOrder", mock.Anything)
+}
+
+func (s *InvoiceHandlerTestSuite) TestGetPricedPurchaseOrder_NotFound() {
+	// Arrange
+	s.mock.On("RetrievePricedOrder", 9).Return(models.PricedPurchaseOrder{}, repository.ErrEntityNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/9", nil), "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetPricedPurchaseOrder(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *InvoiceHandlerTestSuite) TestGetInvoice_JSON() {
+	// Arrange
+	s.mock.On("RetrieveInvoice", 1).Return(s.invoice, nil)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/1/invoice", nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetInvoice(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: s.invoice})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal("application/json", recorder.Header().Get("Content-Type"))
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *InvoiceHandlerTestSuite) TestGetInvoice_HTML() {
+	tests := []struct {
+		title  string
+		target string
+		accept string
+	}{
+		{title: "format query", target: "/api/v1/purchaseOrders/1/invoice?format=html"},
+		{title: "accept header", target: "/api/v1/purchaseOrders/1/invoice", accept: "text/html,application/xhtml+xml"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			s.mock.On("RetrieveInvoice", 1).Return(s.invoice, nil)
+			request := withId(httptest.NewRequest(http.MethodGet, tt.target, nil), "1")
+			request.Header.Set("Accept", tt.accept)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.GetInvoice(recorder, request)
+
+			// Assert
+			s.Equal(http.StatusOK, recorder.Code)
+			s.Equal("text/html; charset=utf-8", recorder.Header().Get("Content-Type"))
+			s.Equal(`inline; filename=invoice_INV-00000001.html`, recorder.Header().Get("Content-Disposition"))
+			s.Contains(recorder.Body.String(), "Factura INV-00000001")
+			s.Contains(recorder.Body.String(), "Ana Pérez")
+			s.Contains(recorder.Body.String(), "IVA (21.00%)")
+			s.Contains(recorder.Body.String(), "24.20")
+		})
+	}
+}
+
+func (s *InvoiceHandlerTestSuite) TestGetInvoice_Error() {
+	// Arrange
+	s.mock.On("RetrieveInvoice", 1).Return(models.Invoice{}, errors.New("connection refused"))
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/1/invoice?format=html", nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetInvoice(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusInternalServerError, recorder.Code)
+}
+
+func TestInvoiceHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceHandlerTestSuite))
+}
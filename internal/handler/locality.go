@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"errors"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
@@ -20,7 +23,7 @@ func NewLocalityHandler(service service.LocalityService) *LocalityHandler {
 }
 
 func (h *LocalityHandler) GetLocalities(w http.ResponseWriter, r *http.Request) {
-	localities, err := h.service.RetrieveAll()
+	localities, err := h.service.RetrieveAll(r.Context())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -31,12 +34,12 @@ func (h *LocalityHandler) GetLocalities(w http.ResponseWriter, r *http.Request)
 func (h *LocalityHandler) GetLocality(w http.ResponseWriter, r *http.Request) {
 	idParam := r.URL.Query().Get("id")
 	if idParam == "" {
-		localites, err := h.service.RetrieveAllLocalitiesBySeller()
+		localites, err := h.service.RetrieveAllLocalitiesBySeller(r.Context())
 		if err != nil {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 			return
 		}
-		_ = render.Render(w, r, response.NewResponse(localites, http.StatusOK))
+		response.RenderReport(w, r, "localities_sellers_report", localites)
 		return
 	}
 
@@ -46,7 +49,7 @@ func (h *LocalityHandler) GetLocality(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	locality, err := h.service.RetrieveLocalityBySeller(id)
+	locality, err := h.service.RetrieveLocalityBySeller(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -54,7 +57,7 @@ func (h *LocalityHandler) GetLocality(w http.ResponseWriter, r *http.Request) {
 
 	res := []models.LocalitySellerCount{locality}
 
-	_ = render.Render(w, r, response.NewResponse(res, http.StatusOK))
+	response.RenderReport(w, r, "localities_sellers_report", res)
 }
 
 func (h *LocalityHandler) PostLocality(w http.ResponseWriter, r *http.Request) {
@@ -70,7 +73,7 @@ func (h *LocalityHandler) PostLocality(w http.ResponseWriter, r *http.Request) {
 		Country:  *data.Country,
 	}
 
-	localityCreated, err := h.service.RegisterWithNames(locality)
+	localityCreated, err := h.service.RegisterWithNames(r.Context(), locality)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
 		return
@@ -92,7 +95,7 @@ func (h *LocalityHandler) GetCarrier(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		carriers, err := h.service.RetrieveCarriersByLocality(id)
+		carriers, err := h.service.RetrieveCarriersByLocality(r.Context(), id)
 		if err != nil {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 			return
@@ -101,7 +104,7 @@ func (h *LocalityHandler) GetCarrier(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	carriers, err := h.service.RetrieveCarriers()
+	carriers, err := h.service.RetrieveCarriers(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -109,3 +112,82 @@ func (h *LocalityHandler) GetCarrier(w http.ResponseWriter, r *http.Request) {
 
 	_ = render.Render(w, r, response.NewResponse(carriers, http.StatusOK))
 }
+
+// GetLocalityById handles GET requests for a locality
+func (h *LocalityHandler) GetLocalityById(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	locality, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(locality, http.StatusOK))
+}
+
+func (h *LocalityHandler) PatchLocality(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	fields, err := patch.Decode(r, localityPatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
+
+	locality, err := h.service.PartialModify(r.Context(), id, fields)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(locality, http.StatusOK))
+}
+
+// DeleteLocality handles DELETE requests for a locality, rejected while sellers, carriers or warehouses use it
+func (h *LocalityHandler) DeleteLocality(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	if err = h.service.Remove(r.Context(), id); err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
+}
+
+// SearchLocalities handles GET requests for the localities whose name contains the name query parameter, ignoring
+// case and accents
+func (h *LocalityHandler) SearchLocalities(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	localities, err := h.service.SearchByName(r.Context(), r.URL.Query().Get("name"))
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(localities, http.StatusOK))
+}
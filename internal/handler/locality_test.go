@@ -2,10 +2,12 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"github.com/stretchr/testify/mock"
@@ -19,61 +21,66 @@ type LocalityServiceMock struct {
 	mock.Mock
 }
 
-func (m *LocalityServiceMock) RetrieveAll() ([]models.Locality, error) {
+func (m *LocalityServiceMock) RetrieveAll(ctx context.Context) ([]models.Locality, error) {
 	args := m.Called()
 	return args.Get(0).([]models.Locality), args.Error(1)
 }
 
-func (m *LocalityServiceMock) Retrieve(id int) (models.Locality, error) {
+func (m *LocalityServiceMock) Retrieve(ctx context.Context, id int) (models.Locality, error) {
 	args := m.Called(id)
 	return args.Get(0).(models.Locality), args.Error(1)
 }
 
-func (m *LocalityServiceMock) RetrieveLocalityBySeller(id int) (models.LocalitySellerCount, error) {
+func (m *LocalityServiceMock) RetrieveLocalityBySeller(ctx context.Context, id int) (models.LocalitySellerCount, error) {
 	args := m.Called(id)
 	return args.Get(0).(models.LocalitySellerCount), args.Error(1)
 }
 
-func (m *LocalityServiceMock) RetrieveCarriers() ([]models.LocalityCarrierCount, error) {
+func (m *LocalityServiceMock) RetrieveCarriers(ctx context.Context) ([]models.LocalityCarrierCount, error) {
 	args := m.Called()
 	return args.Get(0).([]models.LocalityCarrierCount), args.Error(1)
 }
 
-func (m *LocalityServiceMock) Register(locality models.Locality) (models.Locality, error) {
+func (m *LocalityServiceMock) Register(ctx context.Context, locality models.Locality) (models.Locality, error) {
 	args := m.Called(locality)
 	return args.Get(0).(models.Locality), args.Error(1)
 }
 
-func (m *LocalityServiceMock) RegisterWithNames(locality models.LocalityDoc) (models.LocalityDoc, error) {
+func (m *LocalityServiceMock) RegisterWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error) {
 	args := m.Called(locality)
 	return args.Get(0).(models.LocalityDoc), args.Error(1)
 }
 
-func (m *LocalityServiceMock) Modify(locality models.Locality) (models.Locality, error) {
+func (m *LocalityServiceMock) Modify(ctx context.Context, locality models.Locality) (models.Locality, error) {
 	args := m.Called(locality)
 	return args.Get(0).(models.Locality), args.Error(1)
 }
 
-func (m *LocalityServiceMock) PartialModify(id int, fields map[string]any) (models.Locality, error) {
+func (m *LocalityServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Locality, error) {
 	args := m.Called(id, fields)
 	return args.Get(0).(models.Locality), args.Error(1)
 }
 
-func (m *LocalityServiceMock) Remove(id int) error {
+func (m *LocalityServiceMock) Remove(ctx context.Context, id int) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *LocalityServiceMock) RetrieveAllLocalitiesBySeller() ([]models.LocalitySellerCount, error) {
+func (m *LocalityServiceMock) RetrieveAllLocalitiesBySeller(ctx context.Context) ([]models.LocalitySellerCount, error) {
 	args := m.Called()
 	return args.Get(0).([]models.LocalitySellerCount), args.Error(1)
 }
 
-func (m *LocalityServiceMock) RetrieveCarriersByLocality(id int) ([]models.LocalityCarrierCount, error) {
+func (m *LocalityServiceMock) RetrieveCarriersByLocality(ctx context.Context, id int) ([]models.LocalityCarrierCount, error) {
 	args := m.Called(id)
 	return args.Get(0).([]models.LocalityCarrierCount), args.Error(1)
 }
 
+func (m *LocalityServiceMock) SearchByName(ctx context.Context, name string) ([]models.LocalityDoc, error) {
+	args := m.Called(name)
+	return args.Get(0).([]models.LocalityDoc), args.Error(1)
+}
+
 type LocalityHandlerTestSuite struct {
 	suite.Suite
 	mock    *LocalityServiceMock
@@ -595,6 +602,77 @@ func (s *LocalityHandlerTestSuite) TestGetCarriersById_InternalError() {
 	s.JSONEq(string(expectedBody), recorder.Body.String())
 }
 
+func (s *LocalityHandlerTestSuite) TestGetLocalityById_NotFound() {
+	// Arrange
+	s.mock.On("Retrieve", 9).Return(models.Locality{}, repository.ErrEntityNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, s.path+"/9", nil), "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetLocalityById(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *LocalityHandlerTestSuite) TestPatchLocality_Success() {
+	// Arrange
+	updated := models.Locality{Id: 1, Locality: "Rosario", ProvinceId: 3}
+	s.mock.On("PartialModify", 1, map[string]any{"locality_name": "Rosario"}).Return(updated, nil)
+	request := withId(httptest.NewRequest(http.MethodPatch, s.path+"/1", bytes.NewBufferString(`{"locality_name":"Rosario"}`)), "1")
+	request.Header.Set("Content-Type", "application/merge-patch+json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PatchLocality(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(`{"data":{"locality_id":1,"locality_name":"Rosario","province_id":3}}`, recorder.Body.String())
+}
+
+func (s *LocalityHandlerTestSuite) TestDeleteLocality_InUse() {
+	// Arrange
+	s.mock.On("Remove", 1).Return(service.ErrLocalityInUse)
+	request := withId(httptest.NewRequest(http.MethodDelete, s.path+"/1", nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.DeleteLocality(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusConflict, recorder.Code)
+}
+
+func (s *LocalityHandlerTestSuite) TestSearchLocalities_Success() {
+	// Arrange
+	localities := []models.LocalityDoc{{Id: 2, Locality: "Córdoba", Province: "Córdoba", Country: "Argentina"}}
+	s.mock.On("SearchByName", "cordoba").Return(localities, nil)
+	request := httptest.NewRequest(http.MethodGet, s.path+"/search?name=cordoba", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.SearchLocalities(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: localities})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *LocalityHandlerTestSuite) TestSearchLocalities_EmptyName() {
+	// Arrange
+	s.mock.On("SearchByName", "").Return([]models.LocalityDoc(nil), service.ErrEmptyLocalitySearch)
+	request := httptest.NewRequest(http.MethodGet, s.path+"/search", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.SearchLocalities(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+}
+
 func TestLocalityHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(LocalityHandlerTestSuite))
 }
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// OrderDetailHandler is a struct with methods that represent handlers for the lines of the purchase orders
+type OrderDetailHandler struct {
+	service service.OrderDetailService
+}
+
+func NewOrderDetailHandler(service service.OrderDetailService) *OrderDetailHandler {
+	return &OrderDetailHandler{service: service}
+}
+
+// GetOrderDetails handles GET requests for the lines of a purchase order along with its totals
+func (h *OrderDetailHandler) GetOrderDetails(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	purchaseOrderId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || purchaseOrderId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	lines, err := h.service.RetrieveLines(r.Context(), purchaseOrderId)
+	if err != nil {
+		renderOrderDetailError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(lines, http.StatusOK))
+}
+
+func (h *OrderDetailHandler) GetOrderDetail(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	purchaseOrderId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || purchaseOrderId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	id, err := strconv.Atoi(chi.URLParam(r, "detailId"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	detail, err := h.service.RetrieveLine(r.Context(), purchaseOrderId, id)
+	if err != nil {
+		renderOrderDetailError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(detail, http.StatusOK))
+}
+
+// PostOrderDetail handles POST requests that add a line to a purchase order not shipped yet
+func (h *OrderDetailHandler) PostOrderDetail(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	purchaseOrderId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || purchaseOrderId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.PurchaseOrderLineRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	detail, err := h.service.AddLine(r.Context(), purchaseOrderId, models.OrderDetail{
+		Quantity:         *data.Quantity,
+		CleanLinesStatus: *data.CleanLinesStatus,
+		Temperature:      *data.Temperature,
+		ProductRecordID:  *data.ProductRecordID,
+	})
+	if err != nil {
+		renderOrderDetailError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(detail, http.StatusCreated))
+}
+
+// PutOrderDetail handles PUT requests that replace a line of a purchase order not shipped yet
+func (h *OrderDetailHandler) PutOrderDetail(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	purchaseOrderId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || purchaseOrderId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	id, err := strconv.Atoi(chi.URLParam(r, "detailId"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.PurchaseOrderLineRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	detail, err := h.service.ModifyLine(r.Context(), purchaseOrderId, models.OrderDetail{
+		Id:               id,
+		Quantity:         *data.Quantity,
+		CleanLinesStatus: *data.CleanLinesStatus,
+		Temperature:      *data.Temperature,
+		ProductRecordID:  *data.ProductRecordID,
+	})
+	if err != nil {
+		renderOrderDetailError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(detail, http.StatusOK))
+}
+
+// DeleteOrderDetail handles DELETE requests that remove a line of a purchase order not shipped yet
+func (h *OrderDetailHandler) DeleteOrderDetail(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	purchaseOrderId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || purchaseOrderId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	id, err := strconv.Atoi(chi.URLParam(r, "detailId"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	if err = h.service.RemoveLine(r.Context(), purchaseOrderId, id); err != nil {
+		renderOrderDetailError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
+}
+
+// GetOrderDetailPicks handles GET requests for the product batches picked for a line of a purchase order
+func (h *OrderDetailHandler) GetOrderDetailPicks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	purchaseOrderId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || purchaseOrderId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	id, err := strconv.Atoi(chi.URLParam(r, "detailId"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	picks, err := h.service.RetrievePicks(r.Context(), purchaseOrderId, id)
+	if err != nil {
+		renderOrderDetailError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(picks, http.StatusOK))
+}
+
+// PostOrderDetailPick handles POST requests that pick stock of a product batch for a line of a purchase order
+func (h *OrderDetailHandler) PostOrderDetailPick(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	purchaseOrderId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || purchaseOrderId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	id, err := strconv.Atoi(chi.URLParam(r, "detailId"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.PurchaseOrderPickRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	pick, err := h.service.Pick(r.Context(), purchaseOrderId, id, models.OrderDetailPick{
+		ProductBatchId: *data.ProductBatchId,
+		Quantity:       *data.Quantity,
+		EmployeeId:     data.EmployeeId,
+	})
+	if err != nil {
+		renderOrderDetailError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(pick, http.StatusCreated))
+}
+
+// renderOrderDetailError renders the errors returned by the service of the purchase order lines
+func renderOrderDetailError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, service.ErrPurchaseOrderShipped), errors.Is(err, repository.ErrForeignKeyViolation),
+		errors.Is(err, repository.ErrOrderDetailOverPicked), errors.Is(err, repository.ErrInsufficientStock),
+		errors.Is(err, repository.ErrProductBatchRecalled), errors.Is(err, repository.ErrProductBatchNotAvailable),
+		errors.Is(err, service.ErrOrderDetailQuantityBelowPicked), errors.Is(err, service.ErrOrderDetailProductPicked),
+		errors.Is(err, service.ErrOrderDetailPicked):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrInvalidOrderDetailQuantity), errors.Is(err, service.ErrPickedProductMismatch),
+		errors.Is(err, service.ErrBatchNotInOrderWarehouse):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
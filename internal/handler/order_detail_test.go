@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type OrderDetailHandlerTestSuite struct {
+	suite.Suite
+	mock    *OrderDetailServiceMock
+	handler *OrderDetailHandler
+	path    string
+}
+
+func (s *OrderDetailHandlerTestSuite) SetupTest() {
+	s.mock = new(OrderDetailServiceMock)
+	s.handler = NewOrderDetailHandler(s.mock)
+	s.path = "/api/v1/purchaseOrders/1/details"
+}
+
+// withDetail adds the id and detailId URL parameters to the request
+func withDetail(request *http.Request, id string, detailId string) *http.Request {
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", id)
+	ctx.URLParams.Add("detailId", detailId)
+	return request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+}
+
+func (s *OrderDetailHandlerTestSuite) TestGetOrderDetails_Success() {
+	// Arrange
+	lines := models.PurchaseOrderLines{
+		PurchaseOrderTotals: models.PurchaseOrderTotals{PurchaseOrderId: 1, LinesCount: 1, TotalQuantity: 4, TotalAmount: 50},
+		OrderDetails:        []models.OrderDetail{{Id: 3, Quantity: 4, ProductRecordID: 2, PurchaseOrderID: 1}},
+	}
+	s.mock.On("RetrieveLines", 1).Return(lines, nil)
+	request := withId(httptest.NewRequest(http.MethodGet, s.path, nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetOrderDetails(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: lines})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *OrderDetailHandlerTestSuite) TestGetOrderDetails_OrderNotFound() {
+	// Arrange
+	s.mock.On("RetrieveLines", 9).Return(models.PurchaseOrderLines{}, repository.ErrEntityNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/9/details", nil), "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetOrderDetails(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *OrderDetailHandlerTestSuite) TestPostOrderDetail_Success() {
+	// Arrange
+	detail := models.OrderDetail{Quantity: 4, CleanLinesStatus: "OK", Temperature: 3.5, ProductRecordID: 2}
+	created := detail
+	created.Id, created.PurchaseOrderID = 3, 1
+	s.mock.On("AddLine", 1, detail).Return(created, nil)
+	body := `{"quantity":4,"clean_lines_status":"OK","temperature":3.5,"product_record_id":2}`
+	request := withId(httptest.NewRequest(http.MethodPost, s.path, strings.NewReader(body)), "1")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostOrderDetail(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: created})
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *OrderDetailHandlerTestSuite) TestPostOrderDetail_MissingField() {
+	// Arrange
+	request := withId(httptest.NewRequest(http.MethodPost, s.path, strings.NewReader(`{"quantity":4}`)), "1")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostOrderDetail(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "AddLine", mock.Anything, mock.Anything)
+}
+
+func (s *OrderDetailHandlerTestSuite) TestPutOrderDetail_Shipped() {
+	// Arrange
+	detail := models.OrderDetail{Id: 3, Quantity: 4, CleanLinesStatus: "OK", Temperature: 3.5, ProductRecordID: 2}
+	s.mock.On("ModifyLine", 1, detail).Return(models.OrderDetail{}, service.ErrPurchaseOrderShipped)
+	body := `{"quantity":4,"clean_lines_status":"OK","temperature":3.5,"product_record_id":2}`
+	request := withDetail(httptest.NewRequest(http.MethodPut, s.path+"/3", strings.NewReader(body)), "1", "3")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PutOrderDetail(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusConflict, recorder.Code)
+}
+
+func (s *OrderDetailHandlerTestSuite) TestPutOrderDetail_BelowPicked() {
+	// Arrange
+	detail := models.OrderDetail{Id: 3, Quantity: 1, CleanLinesStatus: "OK", Temperature: 3.5, ProductRecordID: 2}
+	s.mock.On("ModifyLine", 1, detail).Return(models.OrderDetail{}, service.ErrOrderDetailQuantityBelowPicked)
+	body := `{"quantity":1,"clean_lines_status":"OK","temperature":3.5,"product_record_id":2}`
+	request := withDetail(httptest.NewRequest(http.MethodPut, s.path+"/3", strings.NewReader(body)), "1", "3")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PutOrderDetail(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusConflict, recorder.Code)
+}
+
+func (s *OrderDetailHandlerTestSuite) TestPutOrderDetail_InvalidQuantity() {
+	// Arrange
+	detail := models.OrderDetail{Id: 3, Quantity: 0, CleanLinesStatus: "OK", Temperature: 3.5, ProductRecordID: 2}
+	s.mock.On("ModifyLine", 1, detail).Return(models.OrderDetail{}, service.ErrInvalidOrderDetailQuantity)
+	body := `{"quantity":0,"clean_lines_status":"OK","temperature":3.5,"product_record_id":2}`
+	request := withDetail(httptest.NewRequest(http.MethodPut, s.path+"/3", strings.NewReader(body)), "1", "3")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PutOrderDetail(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (s *OrderDetailHandlerTestSuite) TestDeleteOrderDetail_Success() {
+	// Arrange
+	s.mock.On("RemoveLine", 1, 3).Return(nil)
+	request := withDetail(httptest.NewRequest(http.MethodDelete, s.path+"/3", nil), "1", "3")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.DeleteOrderDetail(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNoContent, recorder.Code)
+}
+
+func (s *OrderDetailHandlerTestSuite) TestDeleteOrderDetail_InvalidDetailId() {
+	// Arrange
+	request := withDetail(httptest.NewRequest(http.MethodDelete, s.path+"/x", nil), "1", "x")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.DeleteOrderDetail(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RemoveLine", mock.Anything, mock.Anything)
+}
+
+func TestOrderDetailHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderDetailHandlerTestSuite))
+}
+
+func (s *OrderDetailHandlerTestSuite) TestGetOrderDetailPicks_Success() {
+	// Arrange
+	picks := []models.OrderDetailPick{{Id: 1, OrderDetailId: 3, ProductBatchId: 2, Quantity: 4, StockMovementId: 9}}
+	s.mock.On("RetrievePicks", 1, 3).Return(picks, nil)
+	request := withDetail(httptest.NewRequest(http.MethodGet, s.path+"/3/picks", nil), "1", "3")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetOrderDetailPicks(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: picks})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *OrderDetailHandlerTestSuite) TestPostOrderDetailPick() {
+	employeeId := 2
+	tests := []struct {
+		title          string
+		body           string
+		serviceError   error
+		expectedStatus int
+	}{
+		{title: "Success - Picked", body: `{"product_batch_id":2,"quantity":4,"employee_id":2}`, expectedStatus: http.StatusCreated},
+		{title: "Error - Missing quantity", body: `{"product_batch_id":2}`, expectedStatus: http.StatusBadRequest},
+		{title: "Error - Over picked", body: `{"product_batch_id":2,"quantity":4,"employee_id":2}`, serviceError: repository.ErrOrderDetailOverPicked, expectedStatus: http.StatusConflict},
+		{title: "Error - Insufficient stock", body: `{"product_batch_id":2,"quantity":4,"employee_id":2}`, serviceError: repository.ErrInsufficientStock, expectedStatus: http.StatusConflict},
+		{title: "Error - Batch not available", body: `{"product_batch_id":2,"quantity":4,"employee_id":2}`, serviceError: repository.ErrProductBatchNotAvailable, expectedStatus: http.StatusConflict},
+		{title: "Error - Other product", body: `{"product_batch_id":2,"quantity":4,"employee_id":2}`, serviceError: service.ErrPickedProductMismatch, expectedStatus: http.StatusUnprocessableEntity},
+		{title: "Error - Other warehouse", body: `{"product_batch_id":2,"quantity":4,"employee_id":2}`, serviceError: service.ErrBatchNotInOrderWarehouse, expectedStatus: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			pick := models.OrderDetailPick{ProductBatchId: 2, Quantity: 4, EmployeeId: &employeeId}
+			created := pick
+			created.Id, created.OrderDetailId, created.StockMovementId = 1, 3, 9
+			if tt.serviceError != nil {
+				created = models.OrderDetailPick{}
+			}
+			s.mock.On("Pick", 1, 3, pick).Return(created, tt.serviceError)
+			request := withDetail(httptest.NewRequest(http.MethodPost, s.path+"/3/picks", strings.NewReader(tt.body)), "1", "3")
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.PostOrderDetailPick(recorder, request)
+
+			// Assert
+			s.Equal(tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus == http.StatusCreated {
+				expectedBody, _ := json.Marshal(response.Response{Data: created})
+				s.JSONEq(string(expectedBody), recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// OrderStatusHandler is a struct with methods that represent handlers for the statuses of the purchase orders
+type OrderStatusHandler struct {
+	service service.OrderStatusService
+}
+
+func NewOrderStatusHandler(service service.OrderStatusService) *OrderStatusHandler {
+	return &OrderStatusHandler{service: service}
+}
+
+func (h *OrderStatusHandler) GetOrderStatuses(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	statuses, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(statuses, http.StatusOK))
+}
+
+func (h *OrderStatusHandler) GetOrderStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	status, err := h.service.Retrieve(r.Context(), id)
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+		return
+	case err != nil:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(status, http.StatusOK))
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type OrderStatusServiceMock struct {
+	mock.Mock
+}
+
+func (m *OrderStatusServiceMock) RetrieveAll(ctx context.Context) ([]models.OrderStatus, error) {
+	args := m.Called()
+	return args.Get(0).([]models.OrderStatus), args.Error(1)
+}
+
+func (m *OrderStatusServiceMock) Retrieve(ctx context.Context, id int) (models.OrderStatus, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.OrderStatus), args.Error(1)
+}
+
+type OrderStatusHandlerTestSuite struct {
+	suite.Suite
+	mock    *OrderStatusServiceMock
+	handler *OrderStatusHandler
+	path    string
+}
+
+func (s *OrderStatusHandlerTestSuite) SetupTest() {
+	s.mock = new(OrderStatusServiceMock)
+	s.handler = NewOrderStatusHandler(s.mock)
+	s.path = "/api/v1/orderStatuses"
+}
+
+func (s *OrderStatusHandlerTestSuite) TestGetOrderStatuses_Success() {
+	// Arrange
+	s.mock.On("RetrieveAll").Return([]models.OrderStatus{{Id: 1, Name: "Pendiente", Description: "La orden está pendiente de procesamiento"}}, nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetOrderStatuses(recorder, httptest.NewRequest(http.MethodGet, s.path, nil))
+
+	// Assert
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(`{"data":[{"id":1,"name":"Pendiente","description":"La orden está pendiente de procesamiento"}]}`, recorder.Body.String())
+}
+
+func (s *OrderStatusHandlerTestSuite) TestGetOrderStatus() {
+	testCases := []struct {
+		title        string
+		id           string
+		status       models.OrderStatus
+		err          error
+		expectedCode int
+	}{
+		{title: "Success", id: "2", status: models.OrderStatus{Id: 2, Name: "En tránsito"}, expectedCode: http.StatusOK},
+		{title: "Error - Not found", id: "9", err: repository.ErrEntityNotFound, expectedCode: http.StatusNotFound},
+		{title: "Error - Invalid id", id: "abc", expectedCode: http.StatusBadRequest},
+		{title: "Error - Service", id: "1", err: errors.New("connection refused"), expectedCode: http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.title, func() {
+			// Arrange
+			s.SetupTest()
+			s.mock.On("Retrieve", mock.Anything).Return(tc.status, tc.err)
+			request := withId(httptest.NewRequest(http.MethodGet, s.path+"/"+tc.id, nil), tc.id)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.GetOrderStatus(recorder, request)
+
+			// Assert
+			s.Equal(tc.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func TestOrderStatusHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderStatusHandlerTestSuite))
+}
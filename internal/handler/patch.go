@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+)
+
+// patch schemas of the resources that support PATCH, built from their models
+var (
+	buyerPatch        = patch.NewSchema(models.Buyer{})
+	carrierPatch      = patch.NewSchema(models.Carrier{})
+	countryPatch      = patch.NewSchema(models.Country{})
+	employeePatch     = patch.NewSchema(models.Employee{})
+	inboundOrderPatch = patch.NewSchema(models.InboundOrder{})
+	localityPatch     = patch.NewSchema(models.Locality{})
+	productPatch      = patch.NewSchema(models.Product{})
+	productTypePatch  = patch.NewSchema(models.ProductType{})
+	provincePatch     = patch.NewSchema(models.Province{})
+	sectionPatch      = patch.NewSchema(models.Section{})
+	sellerPatch       = patch.NewSchema(models.Seller{})
+	warehousePatch    = patch.NewSchema(models.Warehouse{})
+
+	webhookSubscriptionPatch = patch.NewSchema(models.WebhookSubscription{})
+)
+
+// loader adapts a Retrieve service method into the loader used by JSON Patch test, move and copy operations
+func loader[T any](ctx context.Context, retrieve func(ctx context.Context, id int) (T, error), id int) patch.Loader {
+	return func() (any, error) {
+		return retrieve(ctx, id)
+	}
+}
+
+// renderPatchError renders the error returned by patch.Decode, other than a malformed body which every handler
+// reports with its own message
+func renderPatchError(w http.ResponseWriter, r *http.Request, err error) {
+	var validationErr *patch.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		_ = render.Render(w, r, response.NewValidationErrorResponse(err.Error(), validationErr.Errors, http.StatusUnprocessableEntity))
+	case errors.Is(err, patch.ErrUnsupportedMediaType):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnsupportedMediaType))
+	case errors.Is(err, patch.ErrMalformedPatch):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+	case errors.Is(err, patch.ErrTestFailed):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, repository.ErrEntityNotFound), errors.Is(err, repository.ErrProductNotFound),
+		errors.Is(err, service.ErrProductNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
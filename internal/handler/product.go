@@ -1,12 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
 	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
@@ -31,7 +31,7 @@ func (h *ProductDefault) GetProducts(w http.ResponseWriter, r *http.Request) {
 	// ...
 	// process
 	// - get all Products
-	v, err := h.sv.RetrieveAll()
+	v, err := h.sv.RetrieveAll(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -62,7 +62,7 @@ func (h *ProductDefault) PostProduct(w http.ResponseWriter, r *http.Request) {
 		*data.ProductTypeId,
 		data.SellerId,
 	)
-	createdProduct, errService := h.sv.Register(*product)
+	createdProduct, errService := h.sv.Register(r.Context(), *product)
 	if errService != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(errService.Error(), http.StatusBadRequest))
 		return
@@ -78,7 +78,7 @@ func (h *ProductDefault) GetProduct(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, response.NewErrorResponse(errConverter.Error(), http.StatusBadRequest))
 		return
 	}
-	p, errServiceFindById := h.sv.Retrieve(id)
+	p, errServiceFindById := h.sv.Retrieve(r.Context(), id)
 	if errServiceFindById != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(errServiceFindById.Error(), http.StatusNotFound))
 		return
@@ -98,16 +98,19 @@ func (h *ProductDefault) PatchProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 2. Decode the JSON body into a map, not a full struct.
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	// 2. Decode the patch into a map of fields validated against the product model.
+	fields, err := patch.Decode(r, productPatch, loader(r.Context(), h.sv.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse("Invalid request body", http.StatusBadRequest))
 		return
 	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
 
 	// 3. Call the service with the ID and the map of fields.
-	updatedProduct, err := h.sv.PartialModify(id, fields)
+	updatedProduct, err := h.sv.PartialModify(r.Context(), id, fields)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -126,7 +129,7 @@ func (h *ProductDefault) DeleteProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	errServiceDelete := h.sv.Remove(id)
+	errServiceDelete := h.sv.Remove(r.Context(), id)
 
 	if errServiceDelete != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(errServiceDelete.Error(), http.StatusNotFound))
@@ -141,12 +144,12 @@ func (h *ProductDefault) GetProductReport(w http.ResponseWriter, r *http.Request
 	idParam := r.URL.Query().Get("id")
 	if idParam == "" {
 
-		value, err := h.sv.RetrieveRecordsCount()
+		value, err := h.sv.RetrieveRecordsCount(r.Context())
 		if err != nil {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 			return
 		}
-		_ = render.Render(w, r, response.NewResponse(value, http.StatusOK))
+		response.RenderReport(w, r, "products_records_report", value)
 		return
 	}
 	id, errConverter := strconv.Atoi(idParam)
@@ -155,7 +158,7 @@ func (h *ProductDefault) GetProductReport(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	value, err := h.sv.RetrieveRecordsCountByProductId(id)
+	value, err := h.sv.RetrieveRecordsCountByProductId(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, service.ErrProductNotFound) {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
@@ -164,5 +167,5 @@ func (h *ProductDefault) GetProductReport(w http.ResponseWriter, r *http.Request
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
 	}
-	_ = render.Render(w, r, response.NewResponse(value, http.StatusOK))
+	response.RenderReport(w, r, "products_records_report", value)
 }
@@ -1,12 +1,16 @@
 package handler
 
 import (
+	"errors"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
+	"strconv"
 )
 
 // NewProductDefault is a function that returns a new instance of ProductDefault
@@ -43,10 +47,89 @@ func (h *ProductBatchDefault) PostProductBatch(w http.ResponseWriter, r *http.Re
 		*data.SectionId,
 		*data.ProductId,
 	)
-	createdProductBatch, errService := h.sv.Register(product)
+	createdProductBatch, errService := h.sv.Register(r.Context(), product)
 	if errService != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(errService.Error(), http.StatusConflict))
 		return
 	}
 	_ = render.Render(w, r, response.NewResponse(createdProductBatch, http.StatusCreated))
 }
+
+// GetProductBatchTrace handles GET requests that follow a product batch, by its number, from the seller of its
+// product to the buyers it was shipped to
+func (h *ProductBatchDefault) GetProductBatchTrace(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	trace, err := h.sv.Trace(r.Context(), chi.URLParam(r, "batchNumber"))
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+		return
+	case err != nil:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+	_ = render.Render(w, r, response.NewResponse(trace, http.StatusOK))
+}
+
+// PostProductBatchStatus handles POST requests that move a product batch to another status for a reason
+func (h *ProductBatchDefault) PostProductBatchStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.ProductBatchStatusRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	batch, err := h.sv.ChangeStatus(r.Context(), id, *data.Status, *data.Reason, data.ReasonCode, data.EmployeeId)
+	if err != nil {
+		renderProductBatchStatusError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(batch, http.StatusOK))
+}
+
+// GetProductBatchStatusChanges handles GET requests for the status changes of a product batch, oldest first
+func (h *ProductBatchDefault) GetProductBatchStatusChanges(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	changes, err := h.sv.RetrieveStatusChanges(r.Context(), id)
+	if err != nil {
+		renderProductBatchStatusError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(changes, http.StatusOK))
+}
+
+func renderProductBatchStatusError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, service.ErrInvalidProductBatchStatusChange),
+		errors.Is(err, repository.ErrProductBatchRecalled),
+		errors.Is(err, repository.ErrStaleEntity),
+		errors.Is(err, repository.ErrForeignKeyViolation):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrInvalidProductBatchStatus),
+		errors.Is(err, service.ErrProductBatchStatusReasonRequired),
+		errors.Is(err, service.ErrInvalidDisposalReasonCode):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
@@ -2,15 +2,20 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"github.com/go-chi/chi/v5"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 type ProductBatchServiceMock struct {
@@ -27,35 +32,65 @@ type ProductBatchHandlerTestSuite struct {
 // Mock methods for SellerService
 // Mock methods for SectionService
 
-func (p *ProductBatchServiceMock) RetrieveAll() ([]models.ProductBatch, error) {
+func (p *ProductBatchServiceMock) RetrieveAll(ctx context.Context) ([]models.ProductBatch, error) {
 	args := p.Called()
 	return args.Get(0).([]models.ProductBatch), args.Error(1)
 }
 
-func (p *ProductBatchServiceMock) Retrieve(id int) (models.ProductBatch, error) {
+func (p *ProductBatchServiceMock) RetrieveBySections(ctx context.Context, sectionIds []int) ([]models.ProductBatch, error) {
+	args := p.Called(sectionIds)
+	return args.Get(0).([]models.ProductBatch), args.Error(1)
+}
+
+func (p *ProductBatchServiceMock) Retrieve(ctx context.Context, id int) (models.ProductBatch, error) {
 	args := p.Called(id)
 	return args.Get(0).(models.ProductBatch), args.Error(1)
 }
 
-func (p *ProductBatchServiceMock) Modify(productBatch models.ProductBatch) (models.ProductBatch, error) {
+func (p *ProductBatchServiceMock) Modify(ctx context.Context, productBatch models.ProductBatch) (models.ProductBatch, error) {
 	args := p.Called(productBatch)
 	return args.Get(0).(models.ProductBatch), args.Error(1)
 }
 
-func (p *ProductBatchServiceMock) PartialModify(id int, fields map[string]any) (models.ProductBatch, error) {
+func (p *ProductBatchServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.ProductBatch, error) {
 	args := p.Called(id, fields)
 	return args.Get(0).(models.ProductBatch), args.Error(1)
 }
-func (p *ProductBatchServiceMock) Remove(id int) error {
+func (p *ProductBatchServiceMock) Remove(ctx context.Context, id int) error {
 	args := p.Called(id)
 	return args.Error(0)
 }
 
-func (p *ProductBatchServiceMock) Register(productBatch models.ProductBatch) (models.ProductBatch, error) {
+func (p *ProductBatchServiceMock) Register(ctx context.Context, productBatch models.ProductBatch) (models.ProductBatch, error) {
 	args := p.Called(productBatch)
 	return args.Get(0).(models.ProductBatch), args.Error(1)
 }
 
+func (p *ProductBatchServiceMock) Trace(ctx context.Context, batchNumber string) (models.BatchTrace, error) {
+	args := p.Called(batchNumber)
+	return args.Get(0).(models.BatchTrace), args.Error(1)
+}
+
+func (p *ProductBatchServiceMock) ChangeStatus(ctx context.Context, id int, status string, reason string, reasonCode *string, employeeId *int) (models.ProductBatch, error) {
+	args := p.Called(id, status, reason, reasonCode, employeeId)
+	return args.Get(0).(models.ProductBatch), args.Error(1)
+}
+
+func (p *ProductBatchServiceMock) RetrieveStatusChanges(ctx context.Context, id int) ([]models.ProductBatchStatusChange, error) {
+	args := p.Called(id)
+	return args.Get(0).([]models.ProductBatchStatusChange), args.Error(1)
+}
+
+func (p *ProductBatchServiceMock) RetrieveExpiring(ctx context.Context, until time.Time) ([]models.ProductBatch, error) {
+	args := p.Called(until)
+	return args.Get(0).([]models.ProductBatch), args.Error(1)
+}
+
+func (p *ProductBatchServiceMock) ExpireDue(ctx context.Context) ([]models.ProductBatch, error) {
+	args := p.Called()
+	return args.Get(0).([]models.ProductBatch), args.Error(1)
+}
+
 func (p *ProductBatchHandlerTestSuite) SetupTest() {
 	p.mock = new(ProductBatchServiceMock)
 	p.handler = NewProductBatchDefault(p.mock)
@@ -168,6 +203,129 @@ func (p *ProductBatchHandlerTestSuite) TestPostSeller_errorService() {
 
 }
 
+// withBatchNumber adds the batchNumber URL parameter to the request
+func withBatchNumber(request *http.Request, batchNumber string) *http.Request {
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("batchNumber", batchNumber)
+	return request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+}
+
+func (p *ProductBatchHandlerTestSuite) TestGetProductBatchTrace_Ok() {
+	// Arrange
+	sellerId := 1
+	trace := models.BatchTrace{
+		ProductBatch: models.ProductBatch{Id: 1, BatchNumber: 40, SectionId: 1, ProductId: 1},
+		Product:      models.Product{Id: 1, Description: "Apple", SellerId: &sellerId},
+		Seller:       &models.Seller{Id: 1, Name: "Acme"},
+		Receipts:     []models.BatchReceipt{{InboundOrder: models.InboundOrder{Id: 1, EmployeeId: 2, ProductBatchId: 1, WarehouseId: 1}}},
+		Section:      models.Section{Id: 1, WarehouseId: 1},
+		Warehouse:    models.Warehouse{Id: 1, WarehouseCode: "WH-1"},
+		Shipments:    []models.BatchShipment{{PurchaseOrder: models.PurchaseOrder{Id: 3, BuyerID: 1, CarrierID: 2}, PickedQuantity: 5}},
+	}
+	p.mock.On("Trace", "40").Return(trace, nil)
+	request := withBatchNumber(httptest.NewRequest(http.MethodGet, p.path+"/40/trace", nil), "40")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	p.handler.GetProductBatchTrace(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: trace})
+	p.Equal(http.StatusOK, recorder.Code)
+	p.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (p *ProductBatchHandlerTestSuite) TestGetProductBatchTrace_NotFound() {
+	// Arrange
+	p.mock.On("Trace", "99").Return(models.BatchTrace{}, repository.ErrEntityNotFound)
+	request := withBatchNumber(httptest.NewRequest(http.MethodGet, p.path+"/99/trace", nil), "99")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	p.handler.GetProductBatchTrace(recorder, request)
+
+	// Assert
+	p.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (p *ProductBatchHandlerTestSuite) TestPostProductBatchStatus() {
+	employeeId := 2
+	quarantined := models.ProductBatch{Id: 1, BatchNumber: 1, Status: models.ProductBatchQuarantined}
+
+	tests := []struct {
+		title          string
+		id             string
+		body           string
+		serviceError   error
+		expectedStatus int
+	}{
+		{title: "Success", id: "1", body: `{"status":"quarantined","reason":"Damaged packaging","employee_id":2}`, expectedStatus: http.StatusOK},
+		{title: "Error - Invalid id", id: "abc", body: `{"status":"quarantined","reason":"Damaged packaging"}`, expectedStatus: http.StatusBadRequest},
+		{title: "Error - Missing reason", id: "1", body: `{"status":"quarantined"}`, expectedStatus: http.StatusBadRequest},
+		{title: "Error - Not found", id: "1", body: `{"status":"quarantined","reason":"Damaged packaging","employee_id":2}`, serviceError: repository.ErrEntityNotFound, expectedStatus: http.StatusNotFound},
+		{title: "Error - Invalid change", id: "1", body: `{"status":"quarantined","reason":"Damaged packaging","employee_id":2}`, serviceError: service.ErrInvalidProductBatchStatusChange, expectedStatus: http.StatusConflict},
+		{title: "Error - Blank reason", id: "1", body: `{"status":"quarantined","reason":"Damaged packaging","employee_id":2}`, serviceError: service.ErrProductBatchStatusReasonRequired, expectedStatus: http.StatusUnprocessableEntity},
+		{title: "Error - Disposal without reason code", id: "1", body: `{"status":"quarantined","reason":"Damaged packaging","employee_id":2}`, serviceError: service.ErrInvalidDisposalReasonCode, expectedStatus: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		p.Run(tt.title, func() {
+			// Arrange
+			p.SetupTest()
+			p.mock.On("ChangeStatus", 1, models.ProductBatchQuarantined, "Damaged packaging", (*string)(nil), &employeeId).Return(quarantined, tt.serviceError)
+			request := withId(httptest.NewRequest(http.MethodPost, p.path+"/"+tt.id+"/status", strings.NewReader(tt.body)), tt.id)
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			// Act
+			p.handler.PostProductBatchStatus(recorder, request)
+
+			// Assert
+			p.Equal(tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus == http.StatusOK {
+				expectedBody, _ := json.Marshal(response.Response{Data: quarantined})
+				p.JSONEq(string(expectedBody), recorder.Body.String())
+			}
+		})
+	}
+}
+
+func (p *ProductBatchHandlerTestSuite) TestGetProductBatchStatusChanges_Ok() {
+	// Arrange
+	changes := []models.ProductBatchStatusChange{{
+		Id:             1,
+		ProductBatchId: 1,
+		FromStatus:     models.ProductBatchReceived,
+		ToStatus:       models.ProductBatchAvailable,
+		Reason:         "Inspection passed",
+		ChangedAt:      time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
+	}}
+	p.mock.On("RetrieveStatusChanges", 1).Return(changes, nil)
+	request := withId(httptest.NewRequest(http.MethodGet, p.path+"/1/statusChanges", nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	p.handler.GetProductBatchStatusChanges(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: changes})
+	p.Equal(http.StatusOK, recorder.Code)
+	p.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (p *ProductBatchHandlerTestSuite) TestGetProductBatchStatusChanges_NotFound() {
+	// Arrange
+	p.mock.On("RetrieveStatusChanges", 9).Return([]models.ProductBatchStatusChange(nil), repository.ErrEntityNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, p.path+"/9/statusChanges", nil), "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	p.handler.GetProductBatchStatusChanges(recorder, request)
+
+	// Assert
+	p.Equal(http.StatusNotFound, recorder.Code)
+}
+
 // Run the test suite
 func TestProductBatchHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProductBatchHandlerTestSuite))
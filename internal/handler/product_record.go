@@ -1,17 +1,17 @@
 package handler
 
 import (
-	"encoding/json"
 	"errors"
-	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 func NewProductRecordHandler(sv service.ProductRecordService) *ProductRecordHandler {
@@ -30,7 +30,7 @@ func (h *ProductRecordHandler) GetProductRecords(w http.ResponseWriter, r *http.
 
 	w.Header().Set("Content-Type", "application/json")
 
-	value, err := h.service.RetrieveAll()
+	value, err := h.service.RetrieveAll(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -49,7 +49,7 @@ func (h *ProductRecordHandler) GetProductRecord(w http.ResponseWriter, r *http.R
 		_ = render.Render(w, r, response.NewErrorResponse(errors.New("invalid request").Error(), http.StatusBadRequest))
 		return
 	}
-	value, err := h.service.Retrieve(id)
+	value, err := h.service.Retrieve(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -69,14 +69,12 @@ func (h *ProductRecordHandler) PostProductRecord(w http.ResponseWriter, r *http.
 	}
 
 	productRecord := models.ProductRecord{
-		LastUpdate:    *bodyRequest.LastUpdate,
 		PurchasePrice: *bodyRequest.PurchasePrice,
 		SalePrice:     *bodyRequest.SalePrice,
 		ProductId:     *bodyRequest.ProductId,
 	}
 
-	fmt.Println(productRecord)
-	value, err := h.service.Register(productRecord)
+	value, err := h.service.Register(r.Context(), productRecord)
 
 	if err != nil {
 
@@ -91,45 +89,88 @@ func (h *ProductRecordHandler) PostProductRecord(w http.ResponseWriter, r *http.
 
 }
 
-func (h *ProductRecordHandler) PatchProductRecord(w http.ResponseWriter, r *http.Request) {
-
+// GetProductCurrentPrice handles GET requests for the price of a product in effect now
+func (h *ProductRecordHandler) GetProductCurrentPrice(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	id, err := strconv.Atoi(chi.URLParam(r, "id"))
-	if err != nil || id < 1 {
-		_ = render.Render(w, r, response.NewErrorResponse(errors.New("invalid request").Error(), http.StatusBadRequest))
+	productId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || productId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	price, err := h.service.RetrieveCurrentPrice(r.Context(), productId)
+	if err != nil {
+		renderProductPriceError(w, r, err)
 		return
 	}
 
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
+	_ = render.Render(w, r, response.NewResponse(price, http.StatusOK))
+}
+
+// GetProductPriceAt handles GET requests for the price of a product in effect at the RFC 3339 date of the query
+func (h *ProductRecordHandler) GetProductPriceAt(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	productId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || productId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+	at, err := time.Parse(time.RFC3339, r.URL.Query().Get("date"))
 	if err != nil {
-		_ = render.Render(w, r, response.NewErrorResponse(errors.New("unexpected JSON format, check the request body").Error(), http.StatusBadRequest))
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidDate.Error(), http.StatusBadRequest))
 		return
 	}
 
-	value, err := h.service.PartialModify(id, fields)
+	price, err := h.service.RetrievePriceAt(r.Context(), productId, at)
 	if err != nil {
-		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		renderProductPriceError(w, r, err)
 		return
 	}
-	_ = render.Render(w, r, response.NewResponse(value, http.StatusOK))
 
+	_ = render.Render(w, r, response.NewResponse(price, http.StatusOK))
 }
 
-func (h *ProductRecordHandler) DeleteProductRecord(w http.ResponseWriter, r *http.Request) {
+// GetProductPriceTrend handles GET requests for the trend of the prices of a product between the RFC 3339 dates
+// from and to of the query
+func (h *ProductRecordHandler) GetProductPriceTrend(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	id, err := strconv.Atoi(chi.URLParam(r, "id"))
-	if err != nil || id < 1 {
-		_ = render.Render(w, r, response.NewErrorResponse(errors.New("invalid request").Error(), http.StatusBadRequest))
+	productId, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || productId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
 		return
 	}
-	err = h.service.Remove(id)
+	from, err := time.Parse(time.RFC3339, r.URL.Query().Get("from"))
 	if err != nil {
-		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidDate.Error(), http.StatusBadRequest))
+		return
+	}
+	to, err := time.Parse(time.RFC3339, r.URL.Query().Get("to"))
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidDate.Error(), http.StatusBadRequest))
+		return
+	}
+
+	trend, err := h.service.RetrievePriceTrend(r.Context(), productId, from, to)
+	if err != nil {
+		renderProductPriceError(w, r, err)
 		return
 	}
-	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
 
+	_ = render.Render(w, r, response.NewResponse(trend, http.StatusOK))
+}
+
+// renderProductPriceError renders the errors returned by the price lookups, a product without a price in effect
+// is not found either
+func renderProductPriceError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrProductNotFound), errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, service.ErrInvalidDateRange):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
 }
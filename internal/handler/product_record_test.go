@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
@@ -16,6 +17,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 )
 
 type ProductRecordServiceMock struct {
@@ -31,34 +33,39 @@ type ProductRecordHandlerTestSuite struct {
 
 // Mock methods for BuyerService
 
-func (s *ProductRecordServiceMock) RetrieveAll() ([]models.ProductRecord, error) {
+func (s *ProductRecordServiceMock) RetrieveAll(ctx context.Context) ([]models.ProductRecord, error) {
 	args := s.Called()
 	return args.Get(0).([]models.ProductRecord), args.Error(1)
 }
 
-func (s *ProductRecordServiceMock) Retrieve(id int) (models.ProductRecord, error) {
+func (s *ProductRecordServiceMock) RetrieveByIds(ctx context.Context, ids []int) ([]models.ProductRecord, error) {
+	args := s.Called(ids)
+	return args.Get(0).([]models.ProductRecord), args.Error(1)
+}
+
+func (s *ProductRecordServiceMock) Retrieve(ctx context.Context, id int) (models.ProductRecord, error) {
 	args := s.Called(id)
 	return args.Get(0).(models.ProductRecord), args.Error(1)
 }
 
-func (s *ProductRecordServiceMock) Register(productRecord models.ProductRecord) (models.ProductRecord, error) {
+func (s *ProductRecordServiceMock) Register(ctx context.Context, productRecord models.ProductRecord) (models.ProductRecord, error) {
 	args := s.Called(productRecord)
 	return args.Get(0).(models.ProductRecord), args.Error(1)
 }
 
-func (s *ProductRecordServiceMock) Modify(productRecord models.ProductRecord) (models.ProductRecord, error) {
-	args := s.Called(productRecord)
-	return args.Get(0).(models.ProductRecord), args.Error(1)
+func (s *ProductRecordServiceMock) RetrieveCurrentPrice(ctx context.Context, productId int) (models.ProductPrice, error) {
+	args := s.Called(productId)
+	return args.Get(0).(models.ProductPrice), args.Error(1)
 }
 
-func (s *ProductRecordServiceMock) PartialModify(id int, fields map[string]any) (models.ProductRecord, error) {
-	args := s.Called(id, fields)
-	return args.Get(0).(models.ProductRecord), args.Error(1)
+func (s *ProductRecordServiceMock) RetrievePriceAt(ctx context.Context, productId int, at time.Time) (models.ProductPrice, error) {
+	args := s.Called(productId, at)
+	return args.Get(0).(models.ProductPrice), args.Error(1)
 }
 
-func (s *ProductRecordServiceMock) Remove(id int) error {
-	args := s.Called(id)
-	return args.Error(0)
+func (s *ProductRecordServiceMock) RetrievePriceTrend(ctx context.Context, productId int, from time.Time, to time.Time) (models.ProductPriceTrend, error) {
+	args := s.Called(productId, from, to)
+	return args.Get(0).(models.ProductPriceTrend), args.Error(1)
 }
 
 func (s *ProductRecordHandlerTestSuite) SetupTest() {
@@ -75,14 +82,14 @@ func (s *ProductRecordHandlerTestSuite) TestGetProductRecords_Success() {
 	expectedProductRecord := []models.ProductRecord{
 		{
 			Id:            1,
-			LastUpdate:    "2022-22-10",
+			LastUpdate:    time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC),
 			PurchasePrice: 4.99,
 			SalePrice:     5.99,
 			ProductId:     1,
 		},
 		{
 			Id:            2,
-			LastUpdate:    "2022-22-11",
+			LastUpdate:    time.Date(2022, 11, 22, 10, 0, 0, 0, time.UTC),
 			PurchasePrice: 4.99,
 			SalePrice:     6.99,
 			ProductId:     1,
@@ -135,7 +142,7 @@ func (s *ProductRecordHandlerTestSuite) TestGetProductRecord_Success() {
 	id := 1
 	expectedProductRecord := models.ProductRecord{
 		Id:            1,
-		LastUpdate:    "2022-22-10",
+		LastUpdate:    time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC),
 		PurchasePrice: 4.99,
 		SalePrice:     5.99,
 		ProductId:     1,
@@ -221,14 +228,13 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_Success() {
 
 	requestBody := map[string]interface{}{
 
-		"last_update":    "2022-22-10",
 		"purchase_price": 4.99,
 		"sale_price":     5.99,
 		"product_id":     1,
 	}
 	expectedProductRecord := models.ProductRecord{
 		Id:            1,
-		LastUpdate:    "2022-22-10",
+		LastUpdate:    time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC),
 		PurchasePrice: 4.99,
 		SalePrice:     5.99,
 		ProductId:     1,
@@ -237,7 +243,7 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_Success() {
 	expectedResponse := response.Response{Data: expectedProductRecord, StatusCode: http.StatusCreated}
 
 	inputProductRecord := models.ProductRecord{
-		LastUpdate: "2022-22-10", PurchasePrice: 4.99, SalePrice: 5.99, ProductId: 1}
+		PurchasePrice: 4.99, SalePrice: 5.99, ProductId: 1}
 
 	s.mock.On("Register", inputProductRecord).Return(expectedProductRecord, nil)
 
@@ -260,11 +266,10 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_BadRequest() {
 	var expectedBody []byte
 
 	requestBody := map[string]interface{}{
-		"last_update":    "2022-22-10",
 		"purchase_price": 4.99,
 	}
 
-	expectedResponse := response.Response{Message: "sale price must be not null and greater than 0", StatusCode: http.StatusUnprocessableEntity}
+	expectedResponse := response.Response{Message: "sale_price must not be null", StatusCode: http.StatusUnprocessableEntity}
 
 	requestBodyBytes, _ := json.Marshal(requestBody)
 	request := httptest.NewRequest(http.MethodPost, s.path, bytes.NewBuffer(requestBodyBytes))
@@ -286,7 +291,6 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_InternalError() {
 	var expectedBody []byte
 
 	requestBody := map[string]interface{}{
-		"last_update":    "2022-22-10",
 		"purchase_price": 4.99,
 		"sale_price":     5.99,
 		"product_id":     1,
@@ -297,7 +301,7 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_InternalError() {
 	expectedResponse := response.Response{Message: expectedError.Error(), StatusCode: http.StatusInternalServerError}
 
 	inputProductRecord := models.ProductRecord{
-		LastUpdate: "2022-22-10", PurchasePrice: 4.99, SalePrice: 5.99, ProductId: 1}
+		PurchasePrice: 4.99, SalePrice: 5.99, ProductId: 1}
 
 	s.mock.On("Register", inputProductRecord).Return(models.ProductRecord{}, expectedError)
 
@@ -322,7 +326,6 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_Conflict() {
 	var expectedBody []byte
 
 	requestBody := map[string]interface{}{
-		"last_update":    "2022-22-10",
 		"purchase_price": 4.99,
 		"sale_price":     5.99,
 		"product_id":     999,
@@ -333,7 +336,7 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_Conflict() {
 	expectedResponse := response.Response{Message: expectedError.Error(), StatusCode: http.StatusConflict}
 
 	inputProductRecord := models.ProductRecord{
-		LastUpdate: "2022-22-10", PurchasePrice: 4.99, SalePrice: 5.99, ProductId: 999}
+		PurchasePrice: 4.99, SalePrice: 5.99, ProductId: 999}
 
 	s.mock.On("Register", inputProductRecord).Return(models.ProductRecord{}, expectedError)
 
@@ -353,221 +356,80 @@ func (s *ProductRecordHandlerTestSuite) TestPostProductRecord_Conflict() {
 }
 
 // PatchBuyer tests
-func (s *ProductRecordHandlerTestSuite) TestPatchProductRecord_Success() {
+func (s *ProductRecordHandlerTestSuite) TestGetProductCurrentPrice_Success() {
 	// Arrange
-	var expectedBody []byte
-	id := 1
-
-	fields := map[string]interface{}{
-		"sale_price": 5.99,
-	}
-	expectedProductRecord := models.ProductRecord{
-		Id:            1,
-		LastUpdate:    "2022-22-10",
-		PurchasePrice: 4.99,
-		SalePrice:     5.99,
-		ProductId:     1,
-	}
-
-	expectedResponse := response.Response{Data: expectedProductRecord, StatusCode: http.StatusOK}
-
-	s.mock.On("PartialModify", id, fields).Return(expectedProductRecord, nil)
-
-	requestBodyBytes, _ := json.Marshal(fields)
-	request := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%d", s.path, id), bytes.NewBuffer(requestBodyBytes))
-	request.Header.Set("Content-Type", "application/json")
+	price := models.ProductPrice{ProductId: 1, ProductRecordId: 7, EffectiveFrom: time.Date(2024, 6, 17, 16, 45, 0, 0, time.UTC), PurchasePrice: 5, SalePrice: 20, MarginPercentage: 75}
+	s.mock.On("RetrieveCurrentPrice", 1).Return(price, nil)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/products/1/prices/current", nil), "1")
 	recorder := httptest.NewRecorder()
 
-	// Add chi context for URL parameters
-	ctx := chi.NewRouteContext()
-	ctx.URLParams.Add("id", strconv.Itoa(id))
-	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
-
 	// Act
-	s.handler.PatchProductRecord(recorder, request)
-
-	expectedBody, _ = json.Marshal(expectedResponse)
+	s.handler.GetProductCurrentPrice(recorder, request)
 
 	// Assert
-	s.Equal(expectedResponse.StatusCode, recorder.Code)
+	expectedBody, _ := json.Marshal(response.Response{Data: price})
+	s.Equal(http.StatusOK, recorder.Code)
 	s.JSONEq(string(expectedBody), recorder.Body.String())
 }
 
-func (s *ProductRecordHandlerTestSuite) TestPatchProductRecord_BadRequest() {
+func (s *ProductRecordHandlerTestSuite) TestGetProductCurrentPrice_ProductNotFound() {
 	// Arrange
-	var expectedBody []byte
-	id := 1
-
-	expectedError := errors.New("unexpected JSON format, check the request body")
-	expectedResponse := response.Response{Message: expectedError.Error(), StatusCode: http.StatusBadRequest}
-
-	request := httptest.NewRequest(http.MethodPatch, fmt.Sprint(s.path, "/", id), bytes.NewBuffer([]byte("invalid json")))
-	request.Header.Set("Content-Type", "application/json")
+	s.mock.On("RetrieveCurrentPrice", 9).Return(models.ProductPrice{}, repository.ErrProductNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/products/9/prices/current", nil), "9")
 	recorder := httptest.NewRecorder()
 
-	// Add chi context for URL parameters
-	ctx := chi.NewRouteContext()
-	ctx.URLParams.Add("id", strconv.Itoa(id))
-	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
-
 	// Act
-	s.handler.PatchProductRecord(recorder, request)
-
-	expectedBody, _ = json.Marshal(expectedResponse)
+	s.handler.GetProductCurrentPrice(recorder, request)
 
 	// Assert
-	s.Equal(expectedResponse.StatusCode, recorder.Code)
-	s.JSONEq(string(expectedBody), recorder.Body.String())
+	s.Equal(http.StatusNotFound, recorder.Code)
 }
 
-func (s *ProductRecordHandlerTestSuite) TestPatchProductRecord_BadRequest_InvalidId() {
+func (s *ProductRecordHandlerTestSuite) TestGetProductPriceAt_Success() {
 	// Arrange
-	var expectedBody []byte
-	id := -1
-	expectedError := errors.New("invalid request")
-	expectedResponse := response.Response{Message: expectedError.Error(), StatusCode: http.StatusBadRequest}
-
-	fields := map[string]interface{}{
-
-		"last_update":    "2022-22-10",
-		"purchase_price": 4.99,
-	}
-
-	requestBodyBytes, _ := json.Marshal(fields)
-	request := httptest.NewRequest(http.MethodPatch, fmt.Sprint(s.path, "/", id), bytes.NewBuffer(requestBodyBytes))
-	request.Header.Set("Content-Type", "application/json")
+	at := time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC)
+	price := models.ProductPrice{ProductId: 1, ProductRecordId: 1, PurchasePrice: 3.99, SalePrice: 29.99, MarginPercentage: 86.7}
+	s.mock.On("RetrievePriceAt", 1, at).Return(price, nil)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/products/1/prices/at?date=2024-06-12T00:00:00Z", nil), "1")
 	recorder := httptest.NewRecorder()
 
-	// Add chi context for URL parameters
-	ctx := chi.NewRouteContext()
-	ctx.URLParams.Add("id", strconv.Itoa(id))
-	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
-
 	// Act
-	s.handler.PatchProductRecord(recorder, request)
-
-	expectedBody, _ = json.Marshal(expectedResponse)
+	s.handler.GetProductPriceAt(recorder, request)
 
 	// Assert
-	s.Equal(expectedResponse.StatusCode, recorder.Code)
+	expectedBody, _ := json.Marshal(response.Response{Data: price})
+	s.Equal(http.StatusOK, recorder.Code)
 	s.JSONEq(string(expectedBody), recorder.Body.String())
 }
 
-func (s *ProductRecordHandlerTestSuite) TestPatchProductRecord_InternalError() {
+func (s *ProductRecordHandlerTestSuite) TestGetProductPriceAt_InvalidDate() {
 	// Arrange
-	var expectedBody []byte
-	id := 1
-
-	fields := map[string]interface{}{
-
-		"last_update":    "2022-22-10",
-		"purchase_price": 4.99,
-	}
-
-	expectedError := errors.New("partial update failed")
-	expectedResponse := response.Response{Message: expectedError.Error(), StatusCode: http.StatusInternalServerError}
-
-	s.mock.On("PartialModify", id, fields).Return(models.ProductRecord{}, expectedError)
-
-	requestBodyBytes, _ := json.Marshal(fields)
-	request := httptest.NewRequest(http.MethodPatch, fmt.Sprint(s.path, "/", id), bytes.NewBuffer(requestBodyBytes))
-	request.Header.Set("Content-Type", "application/json")
-	recorder := httptest.NewRecorder()
-
-	// Add chi context for URL parameters
-	ctx := chi.NewRouteContext()
-	ctx.URLParams.Add("id", strconv.Itoa(id))
-	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
-
-	// Act
-	s.handler.PatchProductRecord(recorder, request)
-	expectedBody, _ = json.Marshal(expectedResponse)
-
-	// Assert
-	s.Equal(expectedResponse.StatusCode, recorder.Code)
-	s.JSONEq(string(expectedBody), recorder.Body.String())
-}
-
-// DeleteBuyer tests
-func (s *ProductRecordHandlerTestSuite) TestDeleteProductRecord_Success() {
-	// Arrange
-	var expectedBody []byte
-	id := 1
-	expectedResponse := response.Response{Data: nil, StatusCode: http.StatusNoContent}
-
-	s.mock.On("Remove", id).Return(nil)
-
-	request := httptest.NewRequest(http.MethodDelete, fmt.Sprint(s.path, "/", id), nil)
-	recorder := httptest.NewRecorder()
-
-	// Add chi context for URL parameters
-	ctx := chi.NewRouteContext()
-	ctx.URLParams.Add("id", strconv.Itoa(id))
-	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
-
-	// Act
-	s.handler.DeleteProductRecord(recorder, request)
-
-	expectedBody, _ = json.Marshal(expectedResponse)
-
-	// Assert
-	s.Equal(expectedResponse.StatusCode, recorder.Code)
-	s.JSONEq(string(expectedBody), recorder.Body.String())
-}
-
-func (s *ProductRecordHandlerTestSuite) TestDeleteProductRecord_InvalidId() {
-	// Arrange
-	var expectedBody []byte
-	id := "invalid"
-	expectedError := errors.New("invalid request")
-	expectedResponse := response.Response{Message: expectedError.Error(), StatusCode: http.StatusBadRequest}
-
-	request := httptest.NewRequest(http.MethodDelete, fmt.Sprint(s.path, "/", id), nil)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/products/1/prices/at?date=2024-06-12", nil), "1")
 	recorder := httptest.NewRecorder()
 
-	// Add chi context for URL parameters
-	ctx := chi.NewRouteContext()
-	ctx.URLParams.Add("id", id)
-	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
-
 	// Act
-	s.handler.DeleteProductRecord(recorder, request)
-
-	expectedBody, _ = json.Marshal(expectedResponse)
+	s.handler.GetProductPriceAt(recorder, request)
 
 	// Assert
-	s.Equal(expectedResponse.StatusCode, recorder.Code)
-	s.JSONEq(string(expectedBody), recorder.Body.String())
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RetrievePriceAt", mock.Anything, mock.Anything)
 }
 
-func (s *ProductRecordHandlerTestSuite) TestDeleteProductRecord_InternalError() {
+func (s *ProductRecordHandlerTestSuite) TestGetProductPriceTrend_InvalidRange() {
 	// Arrange
-	var expectedBody []byte
-	id := 1
-	expectedError := errors.New("delete failed")
-	expectedResponse := response.Response{Message: expectedError.Error(), StatusCode: http.StatusInternalServerError}
-
-	s.mock.On("Remove", id).Return(expectedError)
-
-	request := httptest.NewRequest(http.MethodDelete, fmt.Sprint(s.path, "/", id), nil)
+	from := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.mock.On("RetrievePriceTrend", 1, from, to).Return(models.ProductPriceTrend{}, service.ErrInvalidDateRange)
+	request := withId(httptest.NewRequest(http.MethodGet, "/api/v1/products/1/prices/trend?from=2024-07-01T00:00:00Z&to=2024-06-01T00:00:00Z", nil), "1")
 	recorder := httptest.NewRecorder()
 
-	// Add chi context for URL parameters
-	ctx := chi.NewRouteContext()
-	ctx.URLParams.Add("id", strconv.Itoa(id))
-	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
-
 	// Act
-	s.handler.DeleteProductRecord(recorder, request)
-
-	expectedBody, _ = json.Marshal(expectedResponse)
+	s.handler.GetProductPriceTrend(recorder, request)
 
 	// Assert
-	s.Equal(expectedResponse.StatusCode, recorder.Code)
-	s.JSONEq(string(expectedBody), recorder.Body.String())
+	s.Equal(http.StatusBadRequest, recorder.Code)
 }
 
-// Run the test suite
 func TestProductRecordHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProductRecordHandlerTestSuite))
 }
@@ -23,12 +23,12 @@ type ProductServiceMock struct {
 	mock.Mock
 }
 
-func (p *ProductServiceMock) RetrieveRecordsCountByProductId(id int) (models.ProductReport, error) {
+func (p *ProductServiceMock) RetrieveRecordsCountByProductId(ctx context.Context, id int) (models.ProductReport, error) {
 	args := p.Called(id)
 	return args.Get(0).(models.ProductReport), args.Error(1)
 }
 
-func (p *ProductServiceMock) RetrieveRecordsCount() ([]models.ProductReport, error) {
+func (p *ProductServiceMock) RetrieveRecordsCount(ctx context.Context) ([]models.ProductReport, error) {
 	args := p.Called()
 	return args.Get(0).([]models.ProductReport), args.Error(1)
 }
@@ -42,32 +42,37 @@ type ProductHandlerTestSuite struct {
 
 // Mock methods for SectionService
 
-func (p *ProductServiceMock) RetrieveAll() ([]models.Product, error) {
+func (p *ProductServiceMock) RetrieveAll(ctx context.Context) ([]models.Product, error) {
 	args := p.Called()
 	return args.Get(0).([]models.Product), args.Error(1)
 }
 
-func (p *ProductServiceMock) Retrieve(id int) (models.Product, error) {
+func (p *ProductServiceMock) RetrieveByIds(ctx context.Context, ids []int) ([]models.Product, error) {
+	args := p.Called(ids)
+	return args.Get(0).([]models.Product), args.Error(1)
+}
+
+func (p *ProductServiceMock) Retrieve(ctx context.Context, id int) (models.Product, error) {
 	args := p.Called(id)
 	return args.Get(0).(models.Product), args.Error(1)
 }
 
-func (p *ProductServiceMock) Register(product models.Product) (models.Product, error) {
+func (p *ProductServiceMock) Register(ctx context.Context, product models.Product) (models.Product, error) {
 	args := p.Called(product)
 	return args.Get(0).(models.Product), args.Error(1)
 }
 
-func (p *ProductServiceMock) Modify(product models.Product) (models.Product, error) {
+func (p *ProductServiceMock) Modify(ctx context.Context, product models.Product) (models.Product, error) {
 	args := p.Called(product)
 	return args.Get(0).(models.Product), args.Error(1)
 }
 
-func (p *ProductServiceMock) PartialModify(id int, fields map[string]any) (models.Product, error) {
+func (p *ProductServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Product, error) {
 	args := p.Called(id, fields)
 	return args.Get(0).(models.Product), args.Error(1)
 }
 
-func (p *ProductServiceMock) Remove(id int) error {
+func (p *ProductServiceMock) Remove(ctx context.Context, id int) error {
 	args := p.Called(id)
 	return args.Error(0)
 }
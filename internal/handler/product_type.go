@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// ProductTypeHandler is a struct with methods that represent handlers for the product types
+type ProductTypeHandler struct {
+	service service.ProductTypeService
+}
+
+func NewProductTypeHandler(service service.ProductTypeService) *ProductTypeHandler {
+	return &ProductTypeHandler{service: service}
+}
+
+func (h *ProductTypeHandler) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	productTypes, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(productTypes, http.StatusOK))
+}
+
+func (h *ProductTypeHandler) GetProductType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	productType, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderProductTypeError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(productType, http.StatusOK))
+}
+
+func (h *ProductTypeHandler) PostProductType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.ProductTypeRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	productType, err := h.service.Register(r.Context(), models.ProductType{Name: *data.Name, Description: *data.Description})
+	if err != nil {
+		renderProductTypeError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(productType, http.StatusCreated))
+}
+
+func (h *ProductTypeHandler) PutProductType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.ProductTypeRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	productType, err := h.service.Modify(r.Context(), models.ProductType{Id: id, Name: *data.Name, Description: *data.Description})
+	if err != nil {
+		renderProductTypeError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(productType, http.StatusOK))
+}
+
+func (h *ProductTypeHandler) PatchProductType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	fields, err := patch.Decode(r, productTypePatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
+
+	productType, err := h.service.PartialModify(r.Context(), id, fields)
+	if err != nil {
+		renderProductTypeError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(productType, http.StatusOK))
+}
+
+// DeleteProductType handles DELETE requests for a product type, rejected while products or sections use it
+func (h *ProductTypeHandler) DeleteProductType(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	if err = h.service.Remove(r.Context(), id); err != nil {
+		renderProductTypeError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
+}
+
+// GetProductTypeReportUsage handles GET requests for the number of products and sections of every product type, or
+// of the one given by the id query parameter
+func (h *ProductTypeHandler) GetProductTypeReportUsage(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var id *int
+	if idParam := r.URL.Query().Get("id"); idParam != "" {
+		value, err := strconv.Atoi(idParam)
+		if err != nil || value < 1 {
+			_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+			return
+		}
+		id = &value
+	}
+
+	usages, err := h.service.RetrieveUsage(r.Context(), id)
+	if err != nil {
+		renderProductTypeError(w, r, err)
+		return
+	}
+
+	response.RenderReport(w, r, "product_types_usage_report", usages)
+}
+
+// renderProductTypeError renders the errors returned by the product type service
+func renderProductTypeError(w http.ResponseWriter, r *http.Request, err error) {
+	var validationErr *patch.ValidationError
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, repository.ErrEntityAlreadyExists), errors.Is(err, service.ErrProductTypeInUse):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrInvalidProductTypeName):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	case errors.As(err, &validationErr):
+		_ = render.Render(w, r, response.NewValidationErrorResponse(err.Error(), validationErr.Errors, http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
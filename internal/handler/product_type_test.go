@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type ProductTypeServiceMock struct {
+	mock.Mock
+}
+
+func (m *ProductTypeServiceMock) RetrieveAll(ctx context.Context) ([]models.ProductType, error) {
+	args := m.Called()
+	return args.Get(0).([]models.ProductType), args.Error(1)
+}
+
+func (m *ProductTypeServiceMock) Retrieve(ctx context.Context, id int) (models.ProductType, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.ProductType), args.Error(1)
+}
+
+func (m *ProductTypeServiceMock) Register(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	args := m.Called(productType)
+	return args.Get(0).(models.ProductType), args.Error(1)
+}
+
+func (m *ProductTypeServiceMock) Modify(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	args := m.Called(productType)
+	return args.Get(0).(models.ProductType), args.Error(1)
+}
+
+func (m *ProductTypeServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.ProductType, error) {
+	args := m.Called(id, fields)
+	return args.Get(0).(models.ProductType), args.Error(1)
+}
+
+func (m *ProductTypeServiceMock) Remove(ctx context.Context, id int) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *ProductTypeServiceMock) RetrieveUsage(ctx context.Context, id *int) ([]models.ProductTypeUsage, error) {
+	args := m.Called(id)
+	return args.Get(0).([]models.ProductTypeUsage), args.Error(1)
+}
+
+type ProductTypeHandlerTestSuite struct {
+	suite.Suite
+	mock    *ProductTypeServiceMock
+	handler *ProductTypeHandler
+	path    string
+}
+
+func (s *ProductTypeHandlerTestSuite) SetupTest() {
+	s.mock = new(ProductTypeServiceMock)
+	s.handler = NewProductTypeHandler(s.mock)
+	s.path = "/api/v1/productTypes"
+}
+
+func (s *ProductTypeHandlerTestSuite) TestPostProductType_Success() {
+	// Arrange
+	productType := models.ProductType{Name: "Fruits", Description: "Fresh fruits"}
+	created := productType
+	created.Id = 7
+	s.mock.On("Register", productType).Return(created, nil)
+
+	body := `{"name":"Fruits","description":"Fresh fruits"}`
+	request := httptest.NewRequest(http.MethodPost, s.path, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostProductType(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(`{"data":{"id":7,"name":"Fruits","description":"Fresh fruits"}}`, recorder.Body.String())
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *ProductTypeHandlerTestSuite) TestPostProductType_MissingName() {
+	// Arrange
+	request := httptest.NewRequest(http.MethodPost, s.path, strings.NewReader(`{"description":"Fresh fruits"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostProductType(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "Register", mock.Anything)
+}
+
+func (s *ProductTypeHandlerTestSuite) TestPostProductType_DuplicatedName() {
+	// Arrange
+	s.mock.On("Register", mock.Anything).Return(models.ProductType{}, repository.ErrEntityAlreadyExists)
+	request := httptest.NewRequest(http.MethodPost, s.path, strings.NewReader(`{"name":"Fruits","description":""}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostProductType(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusConflict, recorder.Code)
+}
+
+func (s *ProductTypeHandlerTestSuite) TestGetProductType_NotFound() {
+	// Arrange
+	s.mock.On("Retrieve", 9).Return(models.ProductType{}, repository.ErrEntityNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, s.path+"/9", nil), "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetProductType(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *ProductTypeHandlerTestSuite) TestDeleteProductType_InUse() {
+	// Arrange
+	s.mock.On("Remove", 1).Return(service.ErrProductTypeInUse)
+	request := withId(httptest.NewRequest(http.MethodDelete, s.path+"/1", nil), "1")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.DeleteProductType(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusConflict, recorder.Code)
+	s.Contains(recorder.Body.String(), service.ErrProductTypeInUse.Error())
+}
+
+func (s *ProductTypeHandlerTestSuite) TestDeleteProductType_InvalidId() {
+	// Arrange
+	request := withId(httptest.NewRequest(http.MethodDelete, s.path+"/0", nil), "0")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.DeleteProductType(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "Remove", mock.Anything)
+}
+
+func (s *ProductTypeHandlerTestSuite) TestGetProductTypeReportUsage_All() {
+	// Arrange
+	usages := []models.ProductTypeUsage{{ProductTypeId: 1, Name: "Fruits", ProductsCount: 4, SectionsCount: 2}}
+	s.mock.On("RetrieveUsage", (*int)(nil)).Return(usages, nil)
+	request := httptest.NewRequest(http.MethodGet, s.path+"/reportUsage", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetProductTypeReportUsage(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: usages})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *ProductTypeHandlerTestSuite) TestGetProductTypeReportUsage_NotFound() {
+	// Arrange
+	s.mock.On("RetrieveUsage", mock.MatchedBy(func(id *int) bool { return id != nil && *id == 9 })).
+		Return([]models.ProductTypeUsage(nil), repository.ErrEntityNotFound)
+	request := httptest.NewRequest(http.MethodGet, s.path+"/reportUsage?id=9", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetProductTypeReportUsage(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestProductTypeHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductTypeHandlerTestSuite))
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// ProvinceHandler is a struct with methods that represent handlers for the provinces
+type ProvinceHandler struct {
+	service service.ProvinceService
+}
+
+func NewProvinceHandler(service service.ProvinceService) *ProvinceHandler {
+	return &ProvinceHandler{service: service}
+}
+
+func (h *ProvinceHandler) GetProvinces(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	provinces, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(provinces, http.StatusOK))
+}
+
+func (h *ProvinceHandler) GetProvince(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	province, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(province, http.StatusOK))
+}
+
+func (h *ProvinceHandler) PostProvince(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.ProvinceRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	province, err := h.service.Register(r.Context(), models.Province{Province: *data.Province, CountryId: *data.CountryId})
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(province, http.StatusCreated))
+}
+
+func (h *ProvinceHandler) PutProvince(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.ProvinceRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	province, err := h.service.Modify(r.Context(), models.Province{Id: id, Province: *data.Province, CountryId: *data.CountryId})
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(province, http.StatusOK))
+}
+
+func (h *ProvinceHandler) PatchProvince(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	fields, err := patch.Decode(r, provincePatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
+
+	province, err := h.service.PartialModify(r.Context(), id, fields)
+	if err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(province, http.StatusOK))
+}
+
+// DeleteProvince handles DELETE requests for a province, rejected while the province has localities
+func (h *ProvinceHandler) DeleteProvince(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	if err = h.service.Remove(r.Context(), id); err != nil {
+		renderLocationError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
+}
@@ -33,7 +33,7 @@ func (h *PurchaseOrderHandler) GetPurchaseOrdersReport(w http.ResponseWriter, r
 	}
 
 	// Suponiendo que tienes un servicio llamado h.sv con método ReportByBuyerID
-	report, err := h.sv.RetrieveByBuyer(id)
+	report, err := h.sv.RetrieveByBuyer(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -74,7 +74,7 @@ func (h *PurchaseOrderHandler) PostPurchaseOrders(w http.ResponseWriter, r *http
 		OrderDetails:  &orDetail,
 	}
 
-	createdPurchaseOrder, err := h.sv.Register(purchaseOrders)
+	createdPurchaseOrder, err := h.sv.Register(r.Context(), purchaseOrders)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
 		return
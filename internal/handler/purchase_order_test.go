@@ -2,6 +2,7 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,36 +28,36 @@ type PurchaseOrderHandlerTestSuite struct {
 }
 
 // Mock methods for PurchaseOrder
-func (s *PurchaseOrderServiceMock) RetrieveAll() ([]models.PurchaseOrder, error) {
+func (s *PurchaseOrderServiceMock) RetrieveAll(ctx context.Context) ([]models.PurchaseOrder, error) {
 	args := s.Called()
 	return args.Get(0).([]models.PurchaseOrder), args.Error(1)
 }
 
-func (s *PurchaseOrderServiceMock) Retrieve(id int) (models.PurchaseOrder, error) {
+func (s *PurchaseOrderServiceMock) Retrieve(ctx context.Context, id int) (models.PurchaseOrder, error) {
 	args := s.Called(id)
 	return args.Get(0).(models.PurchaseOrder), args.Error(1)
 }
 
-func (s *PurchaseOrderServiceMock) Register(po models.PurchaseOrder) (models.PurchaseOrder, error) {
+func (s *PurchaseOrderServiceMock) Register(ctx context.Context, po models.PurchaseOrder) (models.PurchaseOrder, error) {
 	args := s.Called(po)
 	return args.Get(0).(models.PurchaseOrder), args.Error(1)
 }
 
-func (s *PurchaseOrderServiceMock) Modify(po models.PurchaseOrder) (models.PurchaseOrder, error) {
+func (s *PurchaseOrderServiceMock) Modify(ctx context.Context, po models.PurchaseOrder) (models.PurchaseOrder, error) {
 	args := s.Called(po)
 	return args.Get(0).(models.PurchaseOrder), args.Error(1)
 }
 
-func (s *PurchaseOrderServiceMock) PartialModify(id int, fields map[string]any) (models.PurchaseOrder, error) {
+func (s *PurchaseOrderServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.PurchaseOrder, error) {
 	args := s.Called(id, fields)
 	return args.Get(0).(models.PurchaseOrder), args.Error(1)
 }
 
-func (s *PurchaseOrderServiceMock) Remove(id int) error {
+func (s *PurchaseOrderServiceMock) Remove(ctx context.Context, id int) error {
 	args := s.Called(id)
 	return args.Error(0)
 }
-func (s *PurchaseOrderServiceMock) RetrieveByBuyer(id int) ([]models.PurchaseOrder, error) {
+func (s *PurchaseOrderServiceMock) RetrieveByBuyer(ctx context.Context, id int) ([]models.PurchaseOrder, error) {
 	args := s.Called(id)
 	return args.Get(0).([]models.PurchaseOrder), args.Error(1)
 }
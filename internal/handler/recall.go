@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// RecallHandler is a struct with methods that represent handlers for the recall campaigns
+type RecallHandler struct {
+	service service.RecallService
+}
+
+func NewRecallHandler(service service.RecallService) *RecallHandler {
+	return &RecallHandler{service: service}
+}
+
+// GetRecalls handles GET requests for every recall
+func (h *RecallHandler) GetRecalls(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	recalls, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(recalls, http.StatusOK))
+}
+
+// GetRecall handles GET requests for a recall with its batches
+func (h *RecallHandler) GetRecall(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	recall, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(recall, http.StatusOK))
+}
+
+// PostRecall handles POST requests that open a recall for a product or a set of batch numbers
+func (h *RecallHandler) PostRecall(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.RecallRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	var batchNumbers []string
+	if data.BatchNumbers != nil {
+		batchNumbers = *data.BatchNumbers
+	}
+	recall, err := h.service.Open(r.Context(), models.Recall{
+		ProductId: data.ProductId,
+		Severity:  *data.Severity,
+		Reason:    *data.Reason,
+	}, batchNumbers)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(recall, http.StatusCreated))
+}
+
+// PostQuarantine handles POST requests that quarantine a recalled batch
+func (h *RecallHandler) PostQuarantine(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.RecallBatchRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	line, err := h.service.Quarantine(r.Context(), id, *data.ProductBatchId)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(line, http.StatusOK))
+}
+
+// PostDisposal handles POST requests that dispose of a quarantined batch
+func (h *RecallHandler) PostDisposal(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.RecallDisposalRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	line, err := h.service.Dispose(r.Context(), id, *data.ProductBatchId, data.EmployeeId)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(line, http.StatusOK))
+}
+
+// PostClose handles POST requests that close a recall whose batches were all disposed
+func (h *RecallHandler) PostClose(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	recall, err := h.service.Close(r.Context(), id)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(recall, http.StatusOK))
+}
+
+// GetRecallStock handles GET requests for the stock of the batches of a recall per warehouse
+func (h *RecallHandler) GetRecallStock(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	stock, err := h.service.RetrieveStock(r.Context(), id)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(stock, http.StatusOK))
+}
+
+// GetRecallOrders handles GET requests for the purchase orders the batches of a recall were picked for, per buyer
+func (h *RecallHandler) GetRecallOrders(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	orders, err := h.service.RetrieveOrders(r.Context(), id)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(orders, http.StatusOK))
+}
+
+// GetRecallReport handles GET requests for the report of every recall, or of the one given by the id query parameter
+func (h *RecallHandler) GetRecallReport(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var id *int
+	if idParam := r.URL.Query().Get("id"); idParam != "" {
+		value, err := strconv.Atoi(idParam)
+		if err != nil || value < 1 {
+			_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+			return
+		}
+		id = &value
+	}
+
+	reports, err := h.service.RetrieveReports(r.Context(), id)
+	if err != nil {
+		renderRecallError(w, r, err)
+		return
+	}
+
+	response.RenderReport(w, r, "recalls", reports)
+}
+
+func renderRecallError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, repository.ErrProductBatchRecalled),
+		errors.Is(err, service.ErrRecallClosed),
+		errors.Is(err, service.ErrInvalidRecallBatchStatus),
+		errors.Is(err, repository.ErrStaleEntity),
+		errors.Is(err, repository.ErrForeignKeyViolation):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrInvalidRecallSeverity),
+		errors.Is(err, service.ErrInvalidRecallScope),
+		errors.Is(err, service.ErrBatchNotInRecall),
+		errors.Is(err, service.ErrRecallIncomplete):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type RecallServiceMock struct {
+	mock.Mock
+}
+
+func (m *RecallServiceMock) RetrieveAll(ctx context.Context) ([]models.Recall, error) {
+	args := m.Called()
+	return args.Get(0).([]models.Recall), args.Error(1)
+}
+
+func (m *RecallServiceMock) Retrieve(ctx context.Context, id int) (models.Recall, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.Recall), args.Error(1)
+}
+
+func (m *RecallServiceMock) Open(ctx context.Context, recall models.Recall, batchNumbers []string) (models.Recall, error) {
+	args := m.Called(recall, batchNumbers)
+	return args.Get(0).(models.Recall), args.Error(1)
+}
+
+func (m *RecallServiceMock) Quarantine(ctx context.Context, id int, productBatchId int) (models.RecallBatch, error) {
+	args := m.Called(id, productBatchId)
+	return args.Get(0).(models.RecallBatch), args.Error(1)
+}
+
+func (m *RecallServiceMock) Dispose(ctx context.Context, id int, productBatchId int, employeeId *int) (models.RecallBatch, error) {
+	args := m.Called(id, productBatchId, employeeId)
+	return args.Get(0).(models.RecallBatch), args.Error(1)
+}
+
+func (m *RecallServiceMock) Close(ctx context.Context, id int) (models.Recall, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.Recall), args.Error(1)
+}
+
+func (m *RecallServiceMock) RetrieveStock(ctx context.Context, id int) ([]models.RecallStock, error) {
+	args := m.Called(id)
+	return args.Get(0).([]models.RecallStock), args.Error(1)
+}
+
+func (m *RecallServiceMock) RetrieveOrders(ctx context.Context, id int) ([]models.RecallOrders, error) {
+	args := m.Called(id)
+	return args.Get(0).([]models.RecallOrders), args.Error(1)
+}
+
+func (m *RecallServiceMock) RetrieveReports(ctx context.Context, id *int) ([]models.RecallReport, error) {
+	args := m.Called(id)
+	return args.Get(0).([]models.RecallReport), args.Error(1)
+}
+
+type RecallHandlerTestSuite struct {
+	suite.Suite
+	mock    *RecallServiceMock
+	handler *RecallHandler
+}
+
+func (s *RecallHandlerTestSuite) SetupTest() {
+	s.mock = new(RecallServiceMock)
+	s.handler = NewRecallHandler(s.mock)
+}
+
+func (s *RecallHandlerTestSuite) TestPostRecall_Success() {
+	// Arrange
+	recall := models.Recall{Id: 1, Severity: models.RecallSeverityHigh, Reason: "Listeria", Status: models.RecallOpen, Batches: []models.RecallBatch{
+		{Id: 1, RecallId: 1, ProductBatchId: 6, Status: models.RecallBatchRecalled, RecalledQuantity: 200},
+	}}
+	s.mock.On("Open", models.Recall{Severity: models.RecallSeverityHigh, Reason: "Listeria"}, []string{"6"}).Return(recall, nil)
+	body := `{"batch_numbers":["6"],"severity":"high","reason":"Listeria"}`
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/recalls", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostRecall(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: recall})
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *RecallHandlerTestSuite) TestPostRecall_Errors() {
+	tests := []struct {
+		title        string
+		err          error
+		expectedCode int
+	}{
+		{title: "batch not found", err: repository.ErrEntityNotFound, expectedCode: http.StatusNotFound},
+		{title: "batch already recalled", err: repository.ErrProductBatchRecalled, expectedCode: http.StatusConflict},
+		{title: "product and batch numbers", err: service.ErrInvalidRecallScope, expectedCode: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			s.mock.On("Open", mock.Anything, mock.Anything).Return(models.Recall{}, tt.err)
+			body := `{"batch_numbers":["6"],"severity":"high","reason":"Listeria"}`
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/recalls", strings.NewReader(body))
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.PostRecall(recorder, request)
+
+			// Assert
+			s.Equal(tt.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func (s *RecallHandlerTestSuite) TestPostDisposal_Errors() {
+	tests := []struct {
+		title        string
+		err          error
+		expectedCode int
+	}{
+		{title: "recall not found", err: repository.ErrEntityNotFound, expectedCode: http.StatusNotFound},
+		{title: "batch not quarantined", err: service.ErrInvalidRecallBatchStatus, expectedCode: http.StatusConflict},
+		{title: "recall closed", err: service.ErrRecallClosed, expectedCode: http.StatusConflict},
+		{title: "batch not in recall", err: service.ErrBatchNotInRecall, expectedCode: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			employeeId := 1
+			s.mock.On("Dispose", 2, 6, &employeeId).Return(models.RecallBatch{}, tt.err)
+			body := `{"product_batch_id":6,"employee_id":1}`
+			request := withId(httptest.NewRequest(http.MethodPost, "/api/v1/recalls/2/dispose", strings.NewReader(body)), "2")
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.PostDisposal(recorder, request)
+
+			// Assert
+			s.Equal(tt.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func (s *RecallHandlerTestSuite) TestPostClose_Incomplete() {
+	// Arrange
+	s.mock.On("Close", 2).Return(models.Recall{}, service.ErrRecallIncomplete)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostClose(recorder, withId(httptest.NewRequest(http.MethodPost, "/api/v1/recalls/2/close", nil), "2"))
+
+	// Assert
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (s *RecallHandlerTestSuite) TestGetRecallOrders_Success() {
+	// Arrange
+	orders := []models.RecallOrders{{
+		Buyer:          models.Buyer{Id: 1, FirstName: "Ana"},
+		PurchaseOrders: []models.RecallPurchaseOrder{{PurchaseOrderId: 1, OrderNumber: "PO-1", OrderStatusId: 1, PickedQuantity: 20}},
+	}}
+	s.mock.On("RetrieveOrders", 2).Return(orders, nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetRecallOrders(recorder, withId(httptest.NewRequest(http.MethodGet, "/api/v1/recalls/2/orders", nil), "2"))
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: orders})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *RecallHandlerTestSuite) TestGetRecallReport_InvalidId() {
+	// Arrange
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetRecallReport(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/recalls/report?id=0", nil))
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RetrieveReports", mock.Anything)
+}
+
+func TestRecallHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RecallHandlerTestSuite))
+}
@@ -1,13 +1,13 @@
 package handler
 
 import (
-	"encoding/json"
 	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
@@ -24,7 +24,7 @@ type SectionHandler struct {
 
 func (s *SectionHandler) GetSections(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	sections, err := s.sv.RetrieveAll()
+	sections, err := s.sv.RetrieveAll(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -45,7 +45,7 @@ func (s *SectionHandler) GetSection(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
 		return
 	}
-	section, err := s.sv.Retrieve(id)
+	section, err := s.sv.Retrieve(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -74,7 +74,7 @@ func (s *SectionHandler) PostSection(w http.ResponseWriter, r *http.Request) {
 		ProductTypeId:      *data.ProductTypeId,
 	}
 
-	createdSection, err := s.sv.Register(section)
+	createdSection, err := s.sv.Register(r.Context(), section)
 
 	if err != nil {
 		if errors.Is(err, repository.ErrEntityAlreadyExists) {
@@ -95,13 +95,16 @@ func (s *SectionHandler) PatchSection(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, response.NewResponse(err.Error(), http.StatusBadRequest))
 		return
 	}
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	fields, err := patch.Decode(r, sectionPatch, loader(r.Context(), s.sv.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
 		return
 	}
-	updatedSection, err := s.sv.PartialModify(id, fields)
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
+	updatedSection, err := s.sv.PartialModify(r.Context(), id, fields)
 
 	if err != nil {
 		if errors.Is(err, repository.ErrEntityNotFound) {
@@ -122,7 +125,7 @@ func (s *SectionHandler) DeleteSection(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, response.NewResponse(err.Error(), http.StatusBadRequest))
 		return
 	}
-	err = s.sv.Remove(id)
+	err = s.sv.Remove(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewResponse(err.Error(), http.StatusNotFound))
 		return
@@ -146,11 +149,11 @@ func (h *SectionHandler) GetSectionReportProducts(w http.ResponseWriter, r *http
 			return
 		}
 		// Llamamos al servicio para un ID específico
-		data, err = h.sv.RetrieveSectionReport(&id)
+		data, err = h.sv.RetrieveSectionReport(r.Context(), &id)
 
 	} else {
 		// Si no hay ID, llamamos al servicio para obtener todos los reportes
-		data, err = h.sv.RetrieveSectionReport(nil)
+		data, err = h.sv.RetrieveSectionReport(r.Context(), nil)
 	}
 
 	if err != nil {
@@ -162,5 +165,5 @@ func (h *SectionHandler) GetSectionReportProducts(w http.ResponseWriter, r *http
 		return
 	}
 
-	_ = render.Render(w, r, response.NewResponse(data, http.StatusOK))
+	response.RenderReport(w, r, "sections_products_report", data)
 }
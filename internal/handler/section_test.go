@@ -9,12 +9,14 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -31,36 +33,46 @@ type SectionHandlerTestSuite struct {
 
 // Mock methods for SectionService
 
-func (s *SectionServiceMock) RetrieveAll() ([]models.Section, error) {
+func (s *SectionServiceMock) RetrieveAll(ctx context.Context) ([]models.Section, error) {
 	args := s.Called()
 	return args.Get(0).([]models.Section), args.Error(1)
 }
 
-func (s *SectionServiceMock) Retrieve(id int) (models.Section, error) {
+func (s *SectionServiceMock) RetrieveByIds(ctx context.Context, ids []int) ([]models.Section, error) {
+	args := s.Called(ids)
+	return args.Get(0).([]models.Section), args.Error(1)
+}
+
+func (s *SectionServiceMock) RetrieveByWarehouses(ctx context.Context, warehouseIds []int) ([]models.Section, error) {
+	args := s.Called(warehouseIds)
+	return args.Get(0).([]models.Section), args.Error(1)
+}
+
+func (s *SectionServiceMock) Retrieve(ctx context.Context, id int) (models.Section, error) {
 	args := s.Called(id)
 	return args.Get(0).(models.Section), args.Error(1)
 }
 
-func (s *SectionServiceMock) Register(section models.Section) (models.Section, error) {
+func (s *SectionServiceMock) Register(ctx context.Context, section models.Section) (models.Section, error) {
 	args := s.Called(section)
 	return args.Get(0).(models.Section), args.Error(1)
 }
 
-func (s *SectionServiceMock) Modify(section models.Section) (models.Section, error) {
+func (s *SectionServiceMock) Modify(ctx context.Context, section models.Section) (models.Section, error) {
 	args := s.Called(section)
 	return args.Get(0).(models.Section), args.Error(1)
 }
 
-func (s *SectionServiceMock) PartialModify(id int, fields map[string]any) (models.Section, error) {
+func (s *SectionServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Section, error) {
 	args := s.Called(id, fields)
 	return args.Get(0).(models.Section), args.Error(1)
 }
 
-func (s *SectionServiceMock) Remove(id int) error {
+func (s *SectionServiceMock) Remove(ctx context.Context, id int) error {
 	args := s.Called(id)
 	return args.Error(0)
 }
-func (s *SectionServiceMock) RetrieveSectionReport(sectionId *int) (interface{}, error) {
+func (s *SectionServiceMock) RetrieveSectionReport(ctx context.Context, sectionId *int) (interface{}, error) {
 	//TODO implement me
 	panic("implement me")
 }
@@ -499,6 +511,120 @@ func (s *SectionHandlerTestSuite) TestPatchSection_BadRequest_InvalidBody() {
 	s.Contains(recorder.Body.String(), "invalid character")
 }
 
+func (s *SectionHandlerTestSuite) TestPatchSection_UnprocessableEntity_InvalidFields() {
+	// Arrange
+	id := 1
+	body := `{"warehouses_id": 2, "current_capacity": "full", "section_number": null, "id": 9}`
+
+	request := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%d", s.path, id), strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/merge-patch+json")
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", strconv.Itoa(id))
+	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PatchSection(recorder, request)
+
+	// Assert
+	var resp struct {
+		Errors []patch.FieldError `json:"errors"`
+	}
+	s.NoError(json.Unmarshal(recorder.Body.Bytes(), &resp))
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+	s.Equal([]patch.FieldError{
+		{Pointer: "/current_capacity", Message: "must be an integer"},
+		{Pointer: "/id", Message: "field is read-only"},
+		{Pointer: "/section_number", Message: "must not be null"},
+		{Pointer: "/warehouses_id", Message: "unknown field"},
+	}, resp.Errors)
+	s.mock.AssertNotCalled(s.T(), "PartialModify", mock.Anything, mock.Anything)
+}
+
+func (s *SectionHandlerTestSuite) TestPatchSection_JSONPatch_Ok() {
+	// Arrange
+	id := 1
+	current := models.Section{Id: id, SectionNumber: "A01", CurrentCapacity: 60, MinimumCapacity: 10, MaximumCapacity: 100}
+	fields := map[string]interface{}{
+		"current_capacity": float64(70),
+		"section_number":   "A02",
+	}
+	expectedSection := current
+	expectedSection.CurrentCapacity = 70
+	expectedSection.SectionNumber = "A02"
+
+	s.mock.On("Retrieve", id).Return(current, nil)
+	s.mock.On("PartialModify", id, fields).Return(expectedSection, nil)
+
+	body := `[
+		{"op": "test", "path": "/current_capacity", "value": 60},
+		{"op": "replace", "path": "/current_capacity", "value": 70},
+		{"op": "add", "path": "/section_number", "value": "A02"}
+	]`
+	request := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%d", s.path, id), strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json-patch+json")
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", strconv.Itoa(id))
+	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PatchSection(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: expectedSection})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *SectionHandlerTestSuite) TestPatchSection_JSONPatch_TestFailed() {
+	// Arrange
+	id := 1
+	current := models.Section{Id: id, SectionNumber: "A01", CurrentCapacity: 60}
+	s.mock.On("Retrieve", id).Return(current, nil)
+
+	body := `[{"op": "test", "path": "/current_capacity", "value": 50}, {"op": "replace", "path": "/current_capacity", "value": 70}]`
+	request := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%d", s.path, id), strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json-patch+json")
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", strconv.Itoa(id))
+	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PatchSection(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusConflict, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "PartialModify", mock.Anything, mock.Anything)
+}
+
+func (s *SectionHandlerTestSuite) TestPatchSection_UnsupportedMediaType() {
+	// Arrange
+	id := 1
+	request := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%d", s.path, id), strings.NewReader(`current_capacity=70`))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", strconv.Itoa(id))
+	request = request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PatchSection(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusUnsupportedMediaType, recorder.Code)
+}
+
 func (s *SectionHandlerTestSuite) TestDeleteSection_NoContent() {
 	// Arrange
 	id := 1
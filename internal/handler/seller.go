@@ -1,11 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 	"net/http"
@@ -26,7 +27,7 @@ func NewSellerHandler(service service.SellerService) *SellerHandler {
 func (h *SellerHandler) GetSellers(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
-	sellers, err := h.service.RetrieveAll()
+	sellers, err := h.service.RetrieveAll(r.Context())
 
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
@@ -47,7 +48,7 @@ func (h *SellerHandler) GetSeller(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	seller, err := h.service.Retrieve(id)
+	seller, err := h.service.Retrieve(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -74,7 +75,7 @@ func (h *SellerHandler) PostSeller(w http.ResponseWriter, r *http.Request) {
 		LocalityId: *data.LocalityId,
 	}
 
-	createdSeller, err := h.service.Register(seller)
+	createdSeller, err := h.service.Register(r.Context(), seller)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -110,7 +111,7 @@ func (h *SellerHandler) PutSeller(w http.ResponseWriter, r *http.Request) {
 		LocalityId: *data.LocalityId,
 	}
 
-	updatedSeller, err := h.service.Modify(seller)
+	updatedSeller, err := h.service.Modify(r.Context(), seller)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -130,14 +131,17 @@ func (h *SellerHandler) PatchSeller(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	fields, err := patch.Decode(r, sellerPatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
 		return
 	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
 
-	updatedSeller, err := h.service.PartialModify(id, fields)
+	updatedSeller, err := h.service.PartialModify(r.Context(), id, fields)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -157,7 +161,7 @@ func (h *SellerHandler) DeleteSeller(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.service.Remove(id)
+	err = h.service.Remove(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
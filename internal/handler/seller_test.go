@@ -30,32 +30,37 @@ type SellerHandlerTestSuite struct {
 
 // Mock methods for SellerService
 
-func (s *SellerServiceMock) RetrieveAll() ([]models.Seller, error) {
+func (s *SellerServiceMock) RetrieveAll(ctx context.Context) ([]models.Seller, error) {
 	args := s.Called()
 	return args.Get(0).([]models.Seller), args.Error(1)
 }
 
-func (s *SellerServiceMock) Retrieve(id int) (models.Seller, error) {
+func (s *SellerServiceMock) RetrieveByIds(ctx context.Context, ids []int) ([]models.Seller, error) {
+	args := s.Called(ids)
+	return args.Get(0).([]models.Seller), args.Error(1)
+}
+
+func (s *SellerServiceMock) Retrieve(ctx context.Context, id int) (models.Seller, error) {
 	args := s.Called(id)
 	return args.Get(0).(models.Seller), args.Error(1)
 }
 
-func (s *SellerServiceMock) Register(seller models.Seller) (models.Seller, error) {
+func (s *SellerServiceMock) Register(ctx context.Context, seller models.Seller) (models.Seller, error) {
 	args := s.Called(seller)
 	return args.Get(0).(models.Seller), args.Error(1)
 }
 
-func (s *SellerServiceMock) Modify(seller models.Seller) (models.Seller, error) {
+func (s *SellerServiceMock) Modify(ctx context.Context, seller models.Seller) (models.Seller, error) {
 	args := s.Called(seller)
 	return args.Get(0).(models.Seller), args.Error(1)
 }
 
-func (s *SellerServiceMock) PartialModify(id int, fields map[string]any) (models.Seller, error) {
+func (s *SellerServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Seller, error) {
 	args := s.Called(id, fields)
 	return args.Get(0).(models.Seller), args.Error(1)
 }
 
-func (s *SellerServiceMock) Remove(id int) error {
+func (s *SellerServiceMock) Remove(ctx context.Context, id int) error {
 	args := s.Called(id)
 	return args.Error(0)
 }
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// StockHandler is a struct with methods that represent handlers for the stock ledger and its balances
+type StockHandler struct {
+	service service.StockService
+}
+
+func NewStockHandler(service service.StockService) *StockHandler {
+	return &StockHandler{service: service}
+}
+
+// GetStock handles GET requests for the stock of the products per warehouse. The product_id and warehouse_id query
+// parameters are optional filters.
+func (h *StockHandler) GetStock(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var productId, warehouseId int
+	for param, target := range map[string]*int{"product_id": &productId, "warehouse_id": &warehouseId} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+		id, err := strconv.Atoi(value)
+		if err != nil || id < 1 {
+			_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+			return
+		}
+		*target = id
+	}
+
+	balances, err := h.service.RetrieveBalances(r.Context(), productId, warehouseId)
+	if err != nil {
+		renderStockError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(balances, http.StatusOK))
+}
+
+// GetStockMovements handles GET requests for the ledger of the batch given by the product_batch_id query parameter
+func (h *StockHandler) GetStockMovements(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	productBatchId, err := strconv.Atoi(r.URL.Query().Get("product_batch_id"))
+	if err != nil || productBatchId < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	movements, err := h.service.RetrieveMovements(r.Context(), productBatchId)
+	if err != nil {
+		renderStockError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(movements, http.StatusOK))
+}
+
+// PostStockMovement handles POST requests that post a pick, an adjustment or a disposal to the ledger
+func (h *StockHandler) PostStockMovement(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.StockMovementRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	movement, err := h.service.RegisterMovement(r.Context(), models.StockMovement{
+		ProductBatchId: *data.ProductBatchId,
+		Quantity:       *data.Quantity,
+		Reason:         *data.Reason,
+		ReasonCode:     data.ReasonCode,
+		EmployeeId:     data.EmployeeId,
+	})
+	if err != nil {
+		renderStockError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(movement, http.StatusCreated))
+}
+
+// PostStockAdjustment handles POST requests that adjust the stock of a batch with a reason code
+func (h *StockHandler) PostStockAdjustment(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.StockAdjustmentRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	movement, err := h.service.RegisterAdjustment(r.Context(), models.StockMovement{
+		ProductBatchId: *data.ProductBatchId,
+		Quantity:       *data.Quantity,
+		ReasonCode:     data.ReasonCode,
+		EmployeeId:     data.EmployeeId,
+	})
+	if err != nil {
+		renderStockError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(movement, http.StatusCreated))
+}
+
+// GetStockReportWaste handles GET requests for the stock removed because it spoiled or was damaged. The product_id,
+// seller_id and warehouse_id query parameters are optional filters.
+func (h *StockHandler) GetStockReportWaste(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var productId, sellerId, warehouseId int
+	for param, target := range map[string]*int{"product_id": &productId, "seller_id": &sellerId, "warehouse_id": &warehouseId} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+		id, err := strconv.Atoi(value)
+		if err != nil || id < 1 {
+			_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+			return
+		}
+		*target = id
+	}
+
+	reports, err := h.service.RetrieveWaste(r.Context(), productId, sellerId, warehouseId)
+	if err != nil {
+		renderStockError(w, r, err)
+		return
+	}
+
+	response.RenderReport(w, r, "waste_report", reports)
+}
+
+func renderStockError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, repository.ErrInsufficientStock),
+		errors.Is(err, repository.ErrSectionCapacityExceeded),
+		errors.Is(err, repository.ErrProductBatchNotAvailable),
+		errors.Is(err, repository.ErrForeignKeyViolation):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrInvalidStockMovementReason),
+		errors.Is(err, service.ErrInvalidAdjustmentReasonCode),
+		errors.Is(err, service.ErrInvalidDisposalReasonCode),
+		errors.Is(err, service.ErrInvalidStockMovementQuantity):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type StockServiceMock struct {
+	mock.Mock
+}
+
+func (m *StockServiceMock) RetrieveBalances(ctx context.Context, productId int, warehouseId int) ([]models.StockBalance, error) {
+	args := m.Called(productId, warehouseId)
+	return args.Get(0).([]models.StockBalance), args.Error(1)
+}
+
+func (m *StockServiceMock) RetrieveMovements(ctx context.Context, productBatchId int) ([]models.StockMovement, error) {
+	args := m.Called(productBatchId)
+	return args.Get(0).([]models.StockMovement), args.Error(1)
+}
+
+func (m *StockServiceMock) RegisterMovement(ctx context.Context, movement models.StockMovement) (models.StockMovement, error) {
+	args := m.Called(movement)
+	return args.Get(0).(models.StockMovement), args.Error(1)
+}
+
+func (m *StockServiceMock) RegisterAdjustment(ctx context.Context, movement models.StockMovement) (models.StockMovement, error) {
+	args := m.Called(movement)
+	return args.Get(0).(models.StockMovement), args.Error(1)
+}
+
+func (m *StockServiceMock) RetrieveWaste(ctx context.Context, productId int, sellerId int, warehouseId int) ([]models.WasteReport, error) {
+	args := m.Called(productId, sellerId, warehouseId)
+	return args.Get(0).([]models.WasteReport), args.Error(1)
+}
+
+type StockHandlerTestSuite struct {
+	suite.Suite
+	mock    *StockServiceMock
+	handler *StockHandler
+}
+
+func (s *StockHandlerTestSuite) SetupTest() {
+	s.mock = new(StockServiceMock)
+	s.handler = NewStockHandler(s.mock)
+}
+
+func (s *StockHandlerTestSuite) TestGetStock_Success() {
+	tests := []struct {
+		title       string
+		target      string
+		productId   int
+		warehouseId int
+	}{
+		{title: "no filters", target: "/api/v1/stock"},
+		{title: "by product", target: "/api/v1/stock?product_id=1", productId: 1},
+		{title: "by product and warehouse", target: "/api/v1/stock?product_id=1&warehouse_id=2", productId: 1, warehouseId: 2},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			balances := []models.StockBalance{{ProductId: 1, WarehouseId: 2, OnHand: 300, Blocked: 40, Reserved: 20, Available: 240}}
+			s.mock.On("RetrieveBalances", tt.productId, tt.warehouseId).Return(balances, nil)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.GetStock(recorder, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			// Assert
+			expectedBody, _ := json.Marshal(response.Response{Data: balances})
+			s.Equal(http.StatusOK, recorder.Code)
+			s.JSONEq(string(expectedBody), recorder.Body.String())
+		})
+	}
+}
+
+func (s *StockHandlerTestSuite) TestGetStock_InvalidFilter() {
+	// Arrange
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetStock(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/stock?warehouse_id=abc", nil))
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RetrieveBalances", mock.Anything, mock.Anything)
+}
+
+func (s *StockHandlerTestSuite) TestGetStockMovements_Success() {
+	// Arrange
+	movements := []models.StockMovement{{Id: 1, ProductBatchId: 3, SectionId: 4, Quantity: 200, Reason: models.StockMovementReceipt}}
+	s.mock.On("RetrieveMovements", 3).Return(movements, nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetStockMovements(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/stock/movements?product_batch_id=3", nil))
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: movements})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *StockHandlerTestSuite) TestGetStockMovements_MissingBatch() {
+	// Arrange
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetStockMovements(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/stock/movements", nil))
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (s *StockHandlerTestSuite) TestPostStockMovement_Success() {
+	// Arrange
+	employeeId := 2
+	reasonCode := models.AdjustmentDamaged
+	movement := models.StockMovement{ProductBatchId: 3, Quantity: -5, Reason: models.StockMovementDisposal, ReasonCode: &reasonCode, EmployeeId: &employeeId}
+	created := movement
+	created.Id, created.SectionId, created.Actor = 9, 4, "ana"
+	s.mock.On("RegisterMovement", movement).Return(created, nil)
+	body := `{"product_batch_id":3,"quantity":-5,"reason":"disposal","reason_code":"damaged","employee_id":2}`
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/stock/movements", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostStockMovement(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: created})
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *StockHandlerTestSuite) TestPostStockMovement_Errors() {
+	tests := []struct {
+		title        string
+		err          error
+		expectedCode int
+	}{
+		{title: "batch not found", err: repository.ErrEntityNotFound, expectedCode: http.StatusNotFound},
+		{title: "insufficient stock", err: repository.ErrInsufficientStock, expectedCode: http.StatusConflict},
+		{title: "unknown employee", err: repository.ErrForeignKeyViolation, expectedCode: http.StatusConflict},
+		{title: "invalid quantity", err: service.ErrInvalidStockMovementQuantity, expectedCode: http.StatusUnprocessableEntity},
+		{title: "disposal without reason code", err: service.ErrInvalidDisposalReasonCode, expectedCode: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			s.mock.On("RegisterMovement", mock.Anything).Return(models.StockMovement{}, tt.err)
+			body := `{"product_batch_id":3,"quantity":-5,"reason":"disposal","reason_code":"damaged"}`
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/stock/movements", strings.NewReader(body))
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.PostStockMovement(recorder, request)
+
+			// Assert
+			s.Equal(tt.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func (s *StockHandlerTestSuite) TestPostStockMovement_MissingField() {
+	// Arrange
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/stock/movements", strings.NewReader(`{"quantity":-5,"reason":"disposal","reason_code":"damaged"}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostStockMovement(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "RegisterMovement", mock.Anything)
+}
+
+func (s *StockHandlerTestSuite) TestPostStockAdjustment_Success() {
+	// Arrange
+	employeeId := 2
+	reasonCode := models.AdjustmentTemperatureExcursion
+	movement := models.StockMovement{ProductBatchId: 3, Quantity: -40, ReasonCode: &reasonCode, EmployeeId: &employeeId}
+	created := movement
+	created.Id, created.SectionId, created.Reason = 10, 4, models.StockMovementAdjustment
+	s.mock.On("RegisterAdjustment", movement).Return(created, nil)
+	body := `{"product_batch_id":3,"quantity":-40,"reason_code":"temperature_excursion","employee_id":2}`
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/stock/adjustments", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostStockAdjustment(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: created})
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *StockHandlerTestSuite) TestPostStockAdjustment_Errors() {
+	tests := []struct {
+		title        string
+		err          error
+		expectedCode int
+	}{
+		{title: "section full", err: repository.ErrSectionCapacityExceeded, expectedCode: http.StatusConflict},
+		{title: "invalid reason code", err: service.ErrInvalidAdjustmentReasonCode, expectedCode: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			s.mock.On("RegisterAdjustment", mock.Anything).Return(models.StockMovement{}, tt.err)
+			body := `{"product_batch_id":3,"quantity":5,"reason_code":"found","employee_id":2}`
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/stock/adjustments", strings.NewReader(body))
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.PostStockAdjustment(recorder, request)
+
+			// Assert
+			s.Equal(tt.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func (s *StockHandlerTestSuite) TestGetStockReportWaste_Success() {
+	// Arrange
+	reports := []models.WasteReport{{ProductId: 1, ProductDescription: "Yogur", WarehouseId: 1, WarehouseCode: "W1", ExpiredQuantity: 12, TotalQuantity: 12}}
+	s.mock.On("RetrieveWaste", 0, 2, 1).Return(reports, nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetStockReportWaste(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/stock/reportWaste?seller_id=2&warehouse_id=1", nil))
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: reports})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func TestStockHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(StockHandlerTestSuite))
+}
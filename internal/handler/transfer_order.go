@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// TransferOrderHandler is a struct with methods that represent handlers for the transfer orders between warehouses
+type TransferOrderHandler struct {
+	service service.TransferOrderService
+}
+
+func NewTransferOrderHandler(service service.TransferOrderService) *TransferOrderHandler {
+	return &TransferOrderHandler{service: service}
+}
+
+// GetTransferOrders handles GET requests for every transfer order
+func (h *TransferOrderHandler) GetTransferOrders(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	orders, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		renderTransferOrderError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(orders, http.StatusOK))
+}
+
+// GetTransferOrder handles GET requests for a transfer order with its lines
+func (h *TransferOrderHandler) GetTransferOrder(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	order, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderTransferOrderError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(order, http.StatusOK))
+}
+
+// PostTransferOrder handles POST requests that draft a transfer order for the given product batches
+func (h *TransferOrderHandler) PostTransferOrder(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.TransferOrderRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	lines := make([]models.TransferOrderLine, 0, len(*data.ProductBatchIds))
+	for _, productBatchId := range *data.ProductBatchIds {
+		lines = append(lines, models.TransferOrderLine{ProductBatchId: productBatchId})
+	}
+
+	order, err := h.service.Register(r.Context(), models.TransferOrder{
+		SourceWarehouseId:      *data.SourceWarehouseId,
+		SourceSectionId:        *data.SourceSectionId,
+		DestinationWarehouseId: *data.DestinationWarehouseId,
+		DestinationSectionId:   *data.DestinationSectionId,
+		CarrierId:              data.CarrierId,
+		Lines:                  lines,
+	})
+	if err != nil {
+		renderTransferOrderError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(order, http.StatusCreated))
+}
+
+// PostDispatch handles POST requests that dispatch a draft transfer order
+func (h *TransferOrderHandler) PostDispatch(w http.ResponseWriter, r *http.Request) {
+	h.move(w, r, h.service.Dispatch)
+}
+
+// PostTransit handles POST requests that hand a dispatched transfer order to its carrier
+func (h *TransferOrderHandler) PostTransit(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.TransferOrderTransitRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	h.move(w, r, func(ctx context.Context, id int) (models.TransferOrder, error) {
+		return h.service.MarkInTransit(ctx, id, data.CarrierId)
+	})
+}
+
+// PostReceive handles POST requests that receive a transfer order in transit
+func (h *TransferOrderHandler) PostReceive(w http.ResponseWriter, r *http.Request) {
+	h.move(w, r, h.service.Receive)
+}
+
+// move parses the id of the transfer order and renders the result of moving it to its next status
+func (h *TransferOrderHandler) move(w http.ResponseWriter, r *http.Request, apply func(ctx context.Context, id int) (models.TransferOrder, error)) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	order, err := apply(r.Context(), id)
+	if err != nil {
+		renderTransferOrderError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(order, http.StatusOK))
+}
+
+func renderTransferOrderError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, service.ErrInvalidTransferOrderStatus),
+		errors.Is(err, repository.ErrStaleEntity),
+		errors.Is(err, repository.ErrSectionCapacityExceeded),
+		errors.Is(err, repository.ErrInsufficientStock),
+		errors.Is(err, repository.ErrProductBatchRecalled),
+		errors.Is(err, repository.ErrProductBatchNotAvailable),
+		errors.Is(err, repository.ErrForeignKeyViolation),
+		errors.Is(err, service.ErrBatchInOpenTransfer):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+	case errors.Is(err, service.ErrSameWarehouseTransfer),
+		errors.Is(err, service.ErrSectionNotInWarehouse),
+		errors.Is(err, service.ErrEmptyTransferOrder),
+		errors.Is(err, service.ErrDuplicatedTransferBatch),
+		errors.Is(err, service.ErrBatchNotInSection),
+		errors.Is(err, service.ErrEmptyTransferBatch),
+		errors.Is(err, service.ErrTransferOrderCarrierRequired):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type TransferOrderServiceMock struct {
+	mock.Mock
+}
+
+func (m *TransferOrderServiceMock) RetrieveAll(ctx context.Context) ([]models.TransferOrder, error) {
+	args := m.Called()
+	return args.Get(0).([]models.TransferOrder), args.Error(1)
+}
+
+func (m *TransferOrderServiceMock) Retrieve(ctx context.Context, id int) (models.TransferOrder, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.TransferOrder), args.Error(1)
+}
+
+func (m *TransferOrderServiceMock) Register(ctx context.Context, order models.TransferOrder) (models.TransferOrder, error) {
+	args := m.Called(order)
+	return args.Get(0).(models.TransferOrder), args.Error(1)
+}
+
+func (m *TransferOrderServiceMock) Dispatch(ctx context.Context, id int) (models.TransferOrder, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.TransferOrder), args.Error(1)
+}
+
+func (m *TransferOrderServiceMock) MarkInTransit(ctx context.Context, id int, carrierId *int) (models.TransferOrder, error) {
+	args := m.Called(id, carrierId)
+	return args.Get(0).(models.TransferOrder), args.Error(1)
+}
+
+func (m *TransferOrderServiceMock) Receive(ctx context.Context, id int) (models.TransferOrder, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.TransferOrder), args.Error(1)
+}
+
+type TransferOrderHandlerTestSuite struct {
+	suite.Suite
+	mock    *TransferOrderServiceMock
+	handler *TransferOrderHandler
+}
+
+func (s *TransferOrderHandlerTestSuite) SetupTest() {
+	s.mock = new(TransferOrderServiceMock)
+	s.handler = NewTransferOrderHandler(s.mock)
+}
+
+func (s *TransferOrderHandlerTestSuite) TestPostTransferOrder_Success() {
+	// Arrange
+	order := models.TransferOrder{
+		SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2,
+		Lines: []models.TransferOrderLine{{ProductBatchId: 3}, {ProductBatchId: 4}},
+	}
+	created := order
+	created.Id, created.Status = 1, models.TransferOrderDraft
+	created.Lines = []models.TransferOrderLine{{Id: 1, TransferOrderId: 1, ProductBatchId: 3, Quantity: 200}, {Id: 2, TransferOrderId: 1, ProductBatchId: 4, Quantity: 150}}
+	s.mock.On("Register", order).Return(created, nil)
+	body := `{"source_warehouse_id":1,"source_section_id":1,"destination_warehouse_id":2,"destination_section_id":2,"product_batch_ids":[3,4]}`
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/transferOrders", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostTransferOrder(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: created})
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *TransferOrderHandlerTestSuite) TestPostTransferOrder_MissingField() {
+	// Arrange
+	body := `{"source_warehouse_id":1,"source_section_id":1,"destination_warehouse_id":2,"destination_section_id":2}`
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/transferOrders", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostTransferOrder(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "Register", mock.Anything)
+}
+
+func (s *TransferOrderHandlerTestSuite) TestPostTransit_Success() {
+	// Arrange
+	carrierId := 2
+	moved := models.TransferOrder{Id: 1, CarrierId: &carrierId, Status: models.TransferOrderInTransit}
+	s.mock.On("MarkInTransit", 1, &carrierId).Return(moved, nil)
+	request := withId(httptest.NewRequest(http.MethodPost, "/api/v1/transferOrders/1/transit", strings.NewReader(`{"carrier_id":2}`)), "1")
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostTransit(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: moved})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *TransferOrderHandlerTestSuite) TestPostDispatch_InvalidId() {
+	// Arrange
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostDispatch(recorder, withId(httptest.NewRequest(http.MethodPost, "/api/v1/transferOrders/0/dispatch", nil), "0"))
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "Dispatch", mock.Anything)
+}
+
+func (s *TransferOrderHandlerTestSuite) TestPostReceive_Errors() {
+	tests := []struct {
+		title        string
+		err          error
+		expectedCode int
+	}{
+		{title: "order not found", err: repository.ErrEntityNotFound, expectedCode: http.StatusNotFound},
+		{title: "not in transit", err: service.ErrInvalidTransferOrderStatus, expectedCode: http.StatusConflict},
+		{title: "received by another request", err: repository.ErrStaleEntity, expectedCode: http.StatusConflict},
+		{title: "destination section full", err: repository.ErrSectionCapacityExceeded, expectedCode: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.title, func() {
+			// Arrange
+			s.SetupTest()
+			s.mock.On("Receive", 1).Return(models.TransferOrder{}, tt.err)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			s.handler.PostReceive(recorder, withId(httptest.NewRequest(http.MethodPost, "/api/v1/transferOrders/1/receive", nil), "1"))
+
+			// Assert
+			s.Equal(tt.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func TestTransferOrderHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferOrderHandlerTestSuite))
+}
@@ -2,13 +2,13 @@ package handler
 
 import (
 	"errors"
-	"encoding/json"
 	"net/http"
 	"strconv"
 
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
 
@@ -29,7 +29,7 @@ func NewWarehouseDefault(sv service.WarehouseService) *WarehouseDefault {
 
 func (h *WarehouseDefault) GetWarehouses(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	warehouses, err := h.sv.RetrieveAll()
+	warehouses, err := h.sv.RetrieveAll(r.Context())
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -47,7 +47,7 @@ func (h *WarehouseDefault) GetWarehouse(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	warehouse, err := h.sv.Retrieve(id)
+	warehouse, err := h.sv.Retrieve(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
 		return
@@ -74,7 +74,7 @@ func (h *WarehouseDefault) PostWarehouse(w http.ResponseWriter, r *http.Request)
 		*warehouseJson.LocalityId,
 	)
 
-	warehouseResponse, err := h.sv.Register(*warehouse)
+	warehouseResponse, err := h.sv.Register(r.Context(), *warehouse)
 	if err != nil {
 		if errors.Is(err, repository.ErrLocalityNotFound) {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
@@ -117,7 +117,7 @@ func (h *WarehouseDefault) PutWarehouse(w http.ResponseWriter, r *http.Request)
 		*data.LocalityId,
 	)
 
-	updatedWarehouse, err := h.sv.Modify(*warehouse)
+	updatedWarehouse, err := h.sv.Modify(r.Context(), *warehouse)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
@@ -135,14 +135,17 @@ func (h *WarehouseDefault) PatchWarehouse(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	var fields map[string]interface{}
-	err = json.NewDecoder(r.Body).Decode(&fields)
-	if err != nil {
+	fields, err := patch.Decode(r, warehousePatch, loader(r.Context(), h.sv.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
 		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
 		return
 	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
 
-	warehouseResponse, err := h.sv.PartialModify(id, fields)
+	warehouseResponse, err := h.sv.PartialModify(r.Context(), id, fields)
 	if err != nil {
 		if errors.Is(err, repository.ErrEntityNotFound) {
 			_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
@@ -165,7 +168,7 @@ func (h *WarehouseDefault) DeleteWarehouse(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	err = h.sv.Remove(id)
+	err = h.sv.Remove(r.Context(), id)
 	if err != nil {
 		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
 		return
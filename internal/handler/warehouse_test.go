@@ -31,32 +31,37 @@ type WarehouseHandlerTestSuite struct {
 
 // Mock methods for WarehouseService
 
-func (s *WarehouseServiceMock) RetrieveAll() ([]models.Warehouse, error) {
+func (s *WarehouseServiceMock) RetrieveAll(ctx context.Context) ([]models.Warehouse, error) {
 	args := s.Called()
 	return args.Get(0).([]models.Warehouse), args.Error(1)
 }
 
-func (s *WarehouseServiceMock) Retrieve(id int) (models.Warehouse, error) {
+func (s *WarehouseServiceMock) RetrieveByIds(ctx context.Context, ids []int) ([]models.Warehouse, error) {
+	args := s.Called(ids)
+	return args.Get(0).([]models.Warehouse), args.Error(1)
+}
+
+func (s *WarehouseServiceMock) Retrieve(ctx context.Context, id int) (models.Warehouse, error) {
 	args := s.Called(id)
 	return args.Get(0).(models.Warehouse), args.Error(1)
 }
 
-func (s *WarehouseServiceMock) Register(warehouse models.Warehouse) (models.Warehouse, error) {
+func (s *WarehouseServiceMock) Register(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
 	args := s.Called(warehouse)
 	return args.Get(0).(models.Warehouse), args.Error(1)
 }
 
-func (s *WarehouseServiceMock) Modify(warehouse models.Warehouse) (models.Warehouse, error) {
+func (s *WarehouseServiceMock) Modify(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
 	args := s.Called(warehouse)
 	return args.Get(0).(models.Warehouse), args.Error(1)
 }
 
-func (s *WarehouseServiceMock) PartialModify(id int, fields map[string]any) (models.Warehouse, error) {
+func (s *WarehouseServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Warehouse, error) {
 	args := s.Called(id, fields)
 	return args.Get(0).(models.Warehouse), args.Error(1)
 }
 
-func (s *WarehouseServiceMock) Remove(id int) error {
+func (s *WarehouseServiceMock) Remove(ctx context.Context, id int) error {
 	args := s.Called(id)
 	return args.Error(0)
 }
@@ -297,7 +302,7 @@ func (s *WarehouseHandlerTestSuite) TestPostWarehouse_BadRequest_MissingCode() {
 		"minimum_temperature":	minimumTemperature,
 		"locality_id":			localityId,
 	}
-	expectedResponse := response.Response{Message: "warehouse code must not be null", StatusCode: http.StatusBadRequest}
+	expectedResponse := response.Response{Message: "warehouse_code must not be null", StatusCode: http.StatusBadRequest}
 	expectedBody, _ = json.Marshal(expectedResponse)
 
 	requestBodyBytes, _ := json.Marshal(requestBody)
@@ -570,7 +575,7 @@ func (s *WarehouseHandlerTestSuite) TestPutWarehouse_BadRequest() {
 		"minimum_temperature":	minimumTemperature,
 		"locality_id":			localityId,
 	}
-	expectedResponse := response.Response{Message: "warehouse code must not be null", StatusCode: http.StatusBadRequest}
+	expectedResponse := response.Response{Message: "warehouse_code must not be null", StatusCode: http.StatusBadRequest}
 	expectedBody, _ = json.Marshal(expectedResponse)
 
 	requestBodyBytes, _ := json.Marshal(requestBody)
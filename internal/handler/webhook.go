@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+// WebhookHandler is a struct with methods that represent handlers for the webhook subscriptions and their deliveries
+type WebhookHandler struct {
+	service service.WebhookService
+}
+
+func NewWebhookHandler(service service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+func (h *WebhookHandler) GetWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	subscriptions, err := h.service.RetrieveAll(r.Context())
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(subscriptions, http.StatusOK))
+}
+
+func (h *WebhookHandler) GetWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	subscription, err := h.service.Retrieve(r.Context(), id)
+	if err != nil {
+		renderWebhookError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(subscription, http.StatusOK))
+}
+
+func (h *WebhookHandler) PostWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	data := &request.WebhookSubscriptionRequest{}
+	if err := render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	subscription, err := h.service.Register(r.Context(), subscriptionFromRequest(0, data))
+	if err != nil {
+		renderWebhookError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(subscription, http.StatusCreated))
+}
+
+func (h *WebhookHandler) PutWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	data := &request.WebhookSubscriptionRequest{}
+	if err = render.Bind(r, data); err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+		return
+	}
+
+	subscription, err := h.service.Modify(r.Context(), subscriptionFromRequest(id, data))
+	if err != nil {
+		renderWebhookError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(subscription, http.StatusOK))
+}
+
+// PatchWebhookSubscription updates the url or the active flag of a subscription, the secret and the event types
+// are replaced with PUT
+func (h *WebhookHandler) PatchWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	fields, err := patch.Decode(r, webhookSubscriptionPatch, loader(r.Context(), h.service.Retrieve, id))
+	if errors.Is(err, patch.ErrMalformedPatch) {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrUnexpectedJSON.Error(), http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		renderPatchError(w, r, err)
+		return
+	}
+
+	subscription, err := h.service.PartialModify(r.Context(), id, fields)
+	if err != nil {
+		renderWebhookError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(subscription, http.StatusOK))
+}
+
+func (h *WebhookHandler) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	if err = h.service.Remove(r.Context(), id); err != nil {
+		renderWebhookError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(nil, http.StatusNoContent))
+}
+
+// GetWebhookDeliveries handles GET requests for the deliveries of a subscription, the latest first
+func (h *WebhookHandler) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	deliveries, err := h.service.RetrieveDeliveries(r.Context(), id)
+	if err != nil {
+		renderWebhookError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(deliveries, http.StatusOK))
+}
+
+// GetDeadLetters handles GET requests for the deliveries that failed every attempt
+func (h *WebhookHandler) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	deliveries, err := h.service.RetrieveDeadLetters(r.Context())
+	if err != nil {
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(deliveries, http.StatusOK))
+}
+
+// PostRedeliver handles POST requests that attempt a delivery again right away. The response holds the delivery
+// with the outcome of the attempt, a failed attempt is not an error of the request.
+func (h *WebhookHandler) PostRedeliver(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		_ = render.Render(w, r, response.NewErrorResponse(ErrInvalidId.Error(), http.StatusBadRequest))
+		return
+	}
+
+	delivery, err := h.service.Redeliver(r.Context(), id)
+	if err != nil {
+		renderWebhookError(w, r, err)
+		return
+	}
+
+	_ = render.Render(w, r, response.NewResponse(delivery, http.StatusOK))
+}
+
+// subscriptionFromRequest returns the subscription described by a request, active unless stated otherwise
+func subscriptionFromRequest(id int, data *request.WebhookSubscriptionRequest) models.WebhookSubscription {
+	active := true
+	if data.Active != nil {
+		active = *data.Active
+	}
+	return models.WebhookSubscription{
+		Id:         id,
+		Url:        *data.Url,
+		Secret:     *data.Secret,
+		EventTypes: *data.EventTypes,
+		Active:     active,
+	}
+}
+
+// renderWebhookError renders the errors returned by the webhook service
+func renderWebhookError(w http.ResponseWriter, r *http.Request, err error) {
+	var validationErr *patch.ValidationError
+	switch {
+	case errors.Is(err, repository.ErrEntityNotFound):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusNotFound))
+	case errors.Is(err, service.ErrInvalidWebhookUrl), errors.Is(err, service.ErrUnknownWebhookEventType),
+		errors.Is(err, service.ErrEmptyWebhookEventTypes):
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+	case errors.As(err, &validationErr):
+		_ = render.Render(w, r, response.NewValidationErrorResponse(err.Error(), validationErr.Errors, http.StatusUnprocessableEntity))
+	default:
+		_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type WebhookServiceMock struct {
+	mock.Mock
+}
+
+func (m *WebhookServiceMock) RetrieveAll(ctx context.Context) ([]models.WebhookSubscription, error) {
+	args := m.Called()
+	return args.Get(0).([]models.WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookServiceMock) Retrieve(ctx context.Context, id int) (models.WebhookSubscription, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookServiceMock) Register(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	args := m.Called(subscription)
+	return args.Get(0).(models.WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookServiceMock) Modify(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	args := m.Called(subscription)
+	return args.Get(0).(models.WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookServiceMock) PartialModify(ctx context.Context, id int, fields map[string]any) (models.WebhookSubscription, error) {
+	args := m.Called(id, fields)
+	return args.Get(0).(models.WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookServiceMock) Remove(ctx context.Context, id int) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *WebhookServiceMock) Publish(ctx context.Context, event models.WebhookEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func (m *WebhookServiceMock) DeliverDue(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *WebhookServiceMock) RetrieveDeliveries(ctx context.Context, subscriptionId int) ([]models.WebhookDelivery, error) {
+	args := m.Called(subscriptionId)
+	return args.Get(0).([]models.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookServiceMock) RetrieveDeadLetters(ctx context.Context) ([]models.WebhookDelivery, error) {
+	args := m.Called()
+	return args.Get(0).([]models.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookServiceMock) Redeliver(ctx context.Context, id int) (models.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.WebhookDelivery), args.Error(1)
+}
+
+type WebhookHandlerTestSuite struct {
+	suite.Suite
+	mock    *WebhookServiceMock
+	handler *WebhookHandler
+	path    string
+}
+
+func (s *WebhookHandlerTestSuite) SetupTest() {
+	s.mock = new(WebhookServiceMock)
+	s.handler = NewWebhookHandler(s.mock)
+	s.path = "/api/v1/webhooks"
+}
+
+// withId adds the id URL parameter to the request
+func withId(request *http.Request, id string) *http.Request {
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add("id", id)
+	return request.WithContext(context.WithValue(request.Context(), chi.RouteCtxKey, ctx))
+}
+
+func (s *WebhookHandlerTestSuite) TestPostWebhookSubscription_Success() {
+	// Arrange
+	subscription := models.WebhookSubscription{
+		Url:        "https://example.com/hooks",
+		Secret:     "0123456789abcdef",
+		EventTypes: []string{models.WebhookEventPurchaseOrderCreated},
+		Active:     true,
+	}
+	created := subscription
+	created.Id = 1
+	s.mock.On("Register", subscription).Return(created, nil)
+
+	body := `{"url":"https://example.com/hooks","secret":"0123456789abcdef","event_types":["purchase_order.created"]}`
+	request := httptest.NewRequest(http.MethodPost, s.path+"/subscriptions", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostWebhookSubscription(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusCreated, recorder.Code)
+	s.NotContains(recorder.Body.String(), "0123456789abcdef")
+	s.JSONEq(`{"data":{"id":1,"url":"https://example.com/hooks","event_types":["purchase_order.created"],"active":true,"created_at":"0001-01-01T00:00:00Z"}}`, recorder.Body.String())
+	s.mock.AssertExpectations(s.T())
+}
+
+func (s *WebhookHandlerTestSuite) TestPostWebhookSubscription_MissingSecret() {
+	// Arrange
+	body := `{"url":"https://example.com/hooks","event_types":["purchase_order.created"]}`
+	request := httptest.NewRequest(http.MethodPost, s.path+"/subscriptions", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostWebhookSubscription(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "Register", mock.Anything)
+}
+
+func (s *WebhookHandlerTestSuite) TestPostWebhookSubscription_UnknownEventType() {
+	// Arrange
+	s.mock.On("Register", mock.Anything).Return(models.WebhookSubscription{}, service.ErrUnknownWebhookEventType)
+
+	body := `{"url":"https://example.com/hooks","secret":"0123456789abcdef","event_types":["order.shipped"],"active":false}`
+	request := httptest.NewRequest(http.MethodPost, s.path+"/subscriptions", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostWebhookSubscription(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusUnprocessableEntity, recorder.Code)
+	s.False(s.mock.Calls[0].Arguments.Get(0).(models.WebhookSubscription).Active)
+}
+
+func (s *WebhookHandlerTestSuite) TestGetWebhookSubscription_NotFound() {
+	// Arrange
+	s.mock.On("Retrieve", 9).Return(models.WebhookSubscription{}, repository.ErrEntityNotFound)
+	request := withId(httptest.NewRequest(http.MethodGet, s.path+"/subscriptions/9", nil), "9")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetWebhookSubscription(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *WebhookHandlerTestSuite) TestGetDeadLetters_Success() {
+	// Arrange
+	deliveries := []models.WebhookDelivery{{Id: 3, SubscriptionId: 1, EventId: "evt-1", Status: models.WebhookDeliveryDead, Attempts: 10, Payload: json.RawMessage(`{}`)}}
+	s.mock.On("RetrieveDeadLetters").Return(deliveries, nil)
+	request := httptest.NewRequest(http.MethodGet, s.path+"/deadLetters", nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.GetDeadLetters(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: deliveries})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *WebhookHandlerTestSuite) TestPostRedeliver_Success() {
+	// Arrange
+	delivery := models.WebhookDelivery{Id: 3, Status: models.WebhookDeliveryDelivered, Attempts: 1, ResponseStatus: http.StatusOK, Payload: json.RawMessage(`{}`)}
+	s.mock.On("Redeliver", mock.Anything, 3).Return(delivery, nil)
+	request := withId(httptest.NewRequest(http.MethodPost, s.path+"/deliveries/3/redeliver", nil), "3")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostRedeliver(recorder, request)
+
+	// Assert
+	expectedBody, _ := json.Marshal(response.Response{Data: delivery})
+	s.Equal(http.StatusOK, recorder.Code)
+	s.JSONEq(string(expectedBody), recorder.Body.String())
+}
+
+func (s *WebhookHandlerTestSuite) TestPostRedeliver_InvalidId() {
+	// Arrange
+	request := withId(httptest.NewRequest(http.MethodPost, s.path+"/deliveries/x/redeliver", nil), "x")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	s.handler.PostRedeliver(recorder, request)
+
+	// Assert
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.mock.AssertNotCalled(s.T(), "Redeliver", mock.Anything, mock.Anything)
+}
+
+func TestWebhookHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookHandlerTestSuite))
+}
@@ -0,0 +1,31 @@
+// Package middleware contains the HTTP middlewares shared by every route of the API.
+package middleware
+
+import (
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"net/http"
+)
+
+const (
+	// ActorHeader is the header used by clients to identify the user performing the request
+	ActorHeader = "X-Actor"
+	// AnonymousActor is recorded when a request does not identify its actor
+	AnonymousActor = "anonymous"
+)
+
+// Audit stores the actor and the request ID in the context of the request so that every mutation performed while
+// serving it is attributed in the audit trail. It must be mounted after chi's middleware.RequestID.
+func Audit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := audit.Metadata{
+			Actor:     r.Header.Get(ActorHeader),
+			RequestId: middleware.GetReqID(r.Context()),
+		}
+		if md.Actor == "" {
+			md.Actor = AnonymousActor
+		}
+
+		next.ServeHTTP(w, r.WithContext(audit.WithMetadata(r.Context(), md)))
+	})
+}
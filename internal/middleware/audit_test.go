@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	t.Run("stores the actor and request id in the context of the request", func(t *testing.T) {
+		var fromContext audit.Metadata
+		handler := middleware.RequestID(Audit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fromContext, _ = audit.FromContext(r.Context())
+		})))
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/sections/5", nil)
+		request.Header.Set(ActorHeader, "jdoe")
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		require.Equal(t, "jdoe", fromContext.Actor)
+		require.NotEmpty(t, fromContext.RequestId)
+	})
+
+	t.Run("defaults to the anonymous actor", func(t *testing.T) {
+		var md audit.Metadata
+		handler := Audit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			md, _ = audit.FromContext(r.Context())
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.Equal(t, AnonymousActor, md.Actor)
+	})
+}
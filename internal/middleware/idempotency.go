@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"io"
+	"log"
+	"net/http"
+)
+
+const (
+	// IdempotencyKeyHeader is the header clients use to make a POST request safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from a previous request with the same key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// Idempotency makes POST requests sent with an Idempotency-Key header safe to retry. The first request is executed
+// and its response stored; a retry with the same key and body gets the stored response back, while reusing the key
+// with a different body is rejected with 422. Responses with a 5xx status are not stored so the request can be retried,
+// and neither are the responses that fail to be stored, whose key is released instead of being left in progress.
+func Idempotency(sv service.IdempotencyService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			record, err := sv.Begin(r.Context(), key, r.Method, r.URL.Path, body)
+			switch {
+			case errors.Is(err, service.ErrInvalidIdempotencyKey):
+				_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusBadRequest))
+				return
+			case errors.Is(err, service.ErrIdempotencyKeyMismatch):
+				_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusUnprocessableEntity))
+				return
+			case errors.Is(err, service.ErrIdempotencyKeyInProgress):
+				_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusConflict))
+				return
+			case err != nil:
+				_ = render.Render(w, r, response.NewErrorResponse(err.Error(), http.StatusInternalServerError))
+				return
+			}
+
+			if record.Completed() {
+				w.Header().Set("Content-Type", record.ContentType)
+				w.Header().Set(IdempotentReplayedHeader, "true")
+				w.WriteHeader(record.StatusCode)
+				_, _ = w.Write(record.Response)
+				return
+			}
+
+			// Free the key if the handler panics, the panic is handled by the Recoverer middleware
+			defer func() {
+				if rec := recover(); rec != nil {
+					release(r, sv, record)
+					panic(rec)
+				}
+			}()
+
+			buf := &bytes.Buffer{}
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(buf)
+
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError {
+				release(r, sv, record)
+				return
+			}
+			if _, err = sv.Complete(r.Context(), record, status, ww.Header().Get("Content-Type"), buf.Bytes()); err != nil {
+				log.Printf("idempotency: storing the response of key %s: %v", record.Key, err)
+				release(r, sv, record)
+			}
+		})
+	}
+}
+
+// release frees the key of a request whose response is not stored. A key that cannot be freed stays in progress until
+// it expires, so the failure is logged.
+func release(r *http.Request, sv service.IdempotencyService, record models.IdempotencyKey) {
+	if err := sv.Release(r.Context(), record); err != nil {
+		log.Printf("idempotency: releasing key %s: %v", record.Key, err)
+	}
+}
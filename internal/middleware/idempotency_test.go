@@ -0,0 +1,182 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	_default "github.com/miloalej-dev/W17-G1-Bootcamp/internal/service/default"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// idempotencyRepositoryStub is an in-memory repository.IdempotencyRepository, its updates fail with updateErr if given
+type idempotencyRepositoryStub struct {
+	mu        sync.Mutex
+	lastId    int
+	records   map[string]models.IdempotencyKey
+	updateErr error
+}
+
+func newIdempotencyRepositoryStub() *idempotencyRepositoryStub {
+	return &idempotencyRepositoryStub{records: make(map[string]models.IdempotencyKey)}
+}
+
+func (r *idempotencyRepositoryStub) FindByKey(ctx context.Context, key string) (models.IdempotencyKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record, ok := r.records[key]
+	if !ok {
+		return models.IdempotencyKey{}, repository.ErrEntityNotFound
+	}
+	return record, nil
+}
+
+func (r *idempotencyRepositoryStub) Create(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.records[record.Key]; ok {
+		return models.IdempotencyKey{}, repository.ErrEntityAlreadyExists
+	}
+	r.lastId++
+	record.Id = r.lastId
+	r.records[record.Key] = record
+	return record, nil
+}
+
+func (r *idempotencyRepositoryStub) Update(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.updateErr != nil {
+		return models.IdempotencyKey{}, r.updateErr
+	}
+	r.records[record.Key] = record
+	return record, nil
+}
+
+func (r *idempotencyRepositoryStub) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, record := range r.records {
+		if record.Id == id {
+			delete(r.records, key)
+			return nil
+		}
+	}
+	return repository.ErrEntityNotFound
+}
+
+func TestIdempotency(t *testing.T) {
+	const path = "/api/v1/purchaseOrders"
+
+	newHandler := func(status int, calls *int) http.Handler {
+		sv := _default.NewIdempotencyDefault(newIdempotencyRepositoryStub(), 0)
+		return Idempotency(sv)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"data":{"id":` + strconv.Itoa(*calls) + `}}`))
+		}))
+	}
+	send := func(handler http.Handler, key string, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if key != "" {
+			request.Header.Set(IdempotencyKeyHeader, key)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	t.Run("requests without a key are always executed", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(http.StatusCreated, &calls)
+
+		send(handler, "", `{"order_number":"PO-1"}`)
+		send(handler, "", `{"order_number":"PO-1"}`)
+
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("a retry with the same key and body replays the original response", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(http.StatusCreated, &calls)
+
+		first := send(handler, "key-1", `{"order_number":"PO-1"}`)
+		retry := send(handler, "key-1", `{"order_number":"PO-1"}`)
+
+		require.Equal(t, 1, calls)
+		require.Equal(t, http.StatusCreated, retry.Code)
+		require.Equal(t, first.Body.String(), retry.Body.String())
+		require.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+		require.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+		require.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("the same key with a different body is rejected", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(http.StatusCreated, &calls)
+
+		send(handler, "key-1", `{"order_number":"PO-1"}`)
+		recorder := send(handler, "key-1", `{"order_number":"PO-2"}`)
+
+		require.Equal(t, 1, calls)
+		require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	})
+
+	t.Run("a key still in progress is rejected", func(t *testing.T) {
+		calls := 0
+		sv := _default.NewIdempotencyDefault(newIdempotencyRepositoryStub(), 0)
+		handler := Idempotency(sv)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+		}))
+		_, err := sv.Begin(context.Background(), "key-1", http.MethodPost, path, []byte(`{}`))
+		require.NoError(t, err)
+
+		recorder := send(handler, "key-1", `{}`)
+
+		require.Equal(t, 0, calls)
+		require.Equal(t, http.StatusConflict, recorder.Code)
+	})
+
+	t.Run("server errors release the key", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(http.StatusInternalServerError, &calls)
+
+		send(handler, "key-1", `{"order_number":"PO-1"}`)
+		send(handler, "key-1", `{"order_number":"PO-1"}`)
+
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("a response that fails to be stored releases the key", func(t *testing.T) {
+		calls := 0
+		rp := newIdempotencyRepositoryStub()
+		rp.updateErr = errors.New("connection refused")
+		handler := Idempotency(_default.NewIdempotencyDefault(rp, 0))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		send(handler, "key-1", `{"order_number":"PO-1"}`)
+		recorder := send(handler, "key-1", `{"order_number":"PO-1"}`)
+
+		require.Equal(t, 2, calls)
+		require.Equal(t, http.StatusCreated, recorder.Code)
+	})
+
+	t.Run("an oversized key is rejected", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(http.StatusCreated, &calls)
+
+		recorder := send(handler, strings.Repeat("k", 256), `{}`)
+
+		require.Equal(t, 0, calls)
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
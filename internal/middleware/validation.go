@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"errors"
+	"github.com/go-chi/render"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/openapi"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/response"
+	"net/http"
+)
+
+// Validate rejects the requests whose path parameters, query parameters or JSON body do not match the OpenAPI
+// document of spec before they reach the handlers. Every rejection has the same shape: a message and the errors of
+// the invalid fields, pointed to by a JSON pointer for the body or by name for the parameters.
+func Validate(spec *openapi.Spec) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			err := spec.Validate(r)
+
+			var requestErr *openapi.RequestError
+			switch {
+			case errors.As(err, &requestErr) && len(requestErr.Errors) == 0:
+				_ = render.Render(w, r, response.NewErrorResponse(requestErr.Message, requestErr.Status))
+				return
+			case errors.As(err, &requestErr):
+				_ = render.Render(w, r, response.NewValidationErrorResponse(requestErr.Message, requestErr.Errors, requestErr.Status))
+				return
+			}
+			// a spec that cannot be generated is reported by the /openapi.json route, requests are still served
+			next.ServeHTTP(w, r)
+		})
+	}
+}
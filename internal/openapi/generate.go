@@ -0,0 +1,220 @@
+package openapi
+
+import (
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MediaTypeJSON is the media type of the JSON bodies of the API
+const MediaTypeJSON = "application/json"
+
+// Operation documents a route of the router
+type Operation struct {
+	// Summary is a short description of the operation
+	Summary string
+	// Tag groups the operations of a resource
+	Tag string
+	// Request is a value of the request struct bound from the body, nil when the operation has no body
+	Request any
+	// RequestMediaTypes are the media types accepted for the body, application/json by default
+	RequestMediaTypes []string
+	// Patch is a value of the model whose patch schema describes the body of a PATCH operation
+	Patch any
+	// Response is a value of the type rendered in the data field of a successful response, nil when it has no data
+	Response any
+	// ResponseMediaType is the media type of a successful response that is not an API envelope, such as a file
+	ResponseMediaType string
+	// Status is the status code of a successful response, 200 by default
+	Status int
+	// Parameters are the query and header parameters accepted by the operation
+	Parameters []Parameter
+	// Errors are the status codes of the error responses of the operation
+	Errors []int
+}
+
+// Key returns the key of the route with the given method and chi pattern in the operations of Generate
+func Key(method, pattern string) string {
+	return method + " " + pattern
+}
+
+// paramPattern matches a chi url parameter along with its optional regular expression
+var paramPattern = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?}`)
+
+// Generate returns the OpenAPI document of the routes of router, described by the operations keyed by Key. It fails
+// when a route is not documented, so that the document never falls behind the router.
+func Generate(info Info, router chi.Routes, operations map[string]Operation) (*Document, error) {
+	if missing := Undocumented(router, operations); len(missing) > 0 {
+		return nil, fmt.Errorf("routes missing from the OpenAPI document: %s", strings.Join(missing, ", "))
+	}
+
+	s := newSchemas()
+	s.components["ErrorResponse"] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"message": {Type: "string"},
+			"errors":  {Type: "array", Items: s.of(reflect.TypeOf(FieldError{}))},
+		},
+	}
+
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       info,
+		Paths:      make(map[string]map[string]*PathItem),
+		Components: Components{Schemas: s.components},
+	}
+
+	// operations are visited in order so that the names of the components do not depend on map iteration
+	keys := make([]string, 0, len(operations))
+	for key := range operations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		method, pattern, _ := strings.Cut(key, " ")
+		path, params := convertPath(pattern)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*PathItem)
+		}
+		doc.Paths[path][strings.ToLower(method)] = s.pathItem(method, path, params, operations[key])
+	}
+	return doc, nil
+}
+
+// Undocumented returns the routes of router that have no operation, sorted by key
+func Undocumented(router chi.Routes, operations map[string]Operation) []string {
+	var missing []string
+	_ = chi.Walk(router, func(method string, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if _, ok := operations[Key(method, route)]; !ok {
+			missing = append(missing, Key(method, route))
+		}
+		return nil
+	})
+	sort.Strings(missing)
+	return missing
+}
+
+// convertPath converts a chi pattern into an OpenAPI path and returns the names of its parameters
+func convertPath(pattern string) (string, []string) {
+	var params []string
+	path := paramPattern.ReplaceAllStringFunc(pattern, func(match string) string {
+		name := paramPattern.FindStringSubmatch(match)[1]
+		params = append(params, name)
+		return "{" + name + "}"
+	})
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path, params
+}
+
+func (s *schemas) pathItem(method, path string, params []string, op Operation) *PathItem {
+	item := &PathItem{
+		Summary:     op.Summary,
+		OperationId: operationId(method, path),
+		Responses:   make(map[string]*Response),
+	}
+	if op.Tag != "" {
+		item.Tags = []string{op.Tag}
+	}
+
+	for _, name := range params {
+		item.Parameters = append(item.Parameters, Parameter{Name: name, In: "path", Required: true, Schema: pathSchema(name)})
+	}
+	item.Parameters = append(item.Parameters, op.Parameters...)
+
+	switch {
+	case op.Patch != nil:
+		item.RequestBody = &RequestBody{Required: true, Content: s.patchContent(op.Patch)}
+	case op.Request != nil:
+		mediaTypes := op.RequestMediaTypes
+		if len(mediaTypes) == 0 {
+			mediaTypes = []string{MediaTypeJSON}
+		}
+		body := &RequestBody{Required: true, Content: make(map[string]MediaType)}
+		schema := s.of(reflect.TypeOf(op.Request))
+		for _, mediaType := range mediaTypes {
+			body.Content[mediaType] = MediaType{Schema: schema}
+		}
+		item.RequestBody = body
+	}
+
+	status := op.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &Response{Description: http.StatusText(status)}
+	switch {
+	case op.ResponseMediaType != "":
+		success.Content = map[string]MediaType{op.ResponseMediaType: {Schema: &Schema{}}}
+	case op.Response != nil:
+		success.Content = map[string]MediaType{MediaTypeJSON: {Schema: &Schema{
+			Type:       "object",
+			Properties: map[string]*Schema{"data": s.of(reflect.TypeOf(op.Response))},
+		}}}
+	}
+	item.Responses[strconv.Itoa(status)] = success
+
+	for _, code := range op.Errors {
+		item.Responses[strconv.Itoa(code)] = &Response{
+			Description: http.StatusText(code),
+			Content:     map[string]MediaType{MediaTypeJSON: {Schema: &Schema{Ref: "#/components/schemas/ErrorResponse"}}},
+		}
+	}
+	return item
+}
+
+// patchContent describes the merge patch and JSON Patch bodies accepted for model
+func (s *schemas) patchContent(model any) map[string]MediaType {
+	merge := &Schema{Type: "object", Properties: make(map[string]*Schema), AdditionalProperties: false}
+	var names []any
+	for _, f := range patch.NewSchema(model).Fields() {
+		merge.Properties[f.Name] = s.of(f.Type)
+		names = append(names, patch.Pointer(f.Name))
+	}
+
+	operation := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"op":    {Type: "string", Enum: []any{"add", "remove", "replace", "move", "copy", "test"}},
+			"path":  {Type: "string", Enum: names},
+			"from":  {Type: "string", Enum: names},
+			"value": {},
+		},
+		Required: []string{"op", "path"},
+	}
+
+	return map[string]MediaType{
+		MediaTypeJSON:             {Schema: merge},
+		patch.MergePatchMediaType: {Schema: merge},
+		patch.JSONPatchMediaType:  {Schema: &Schema{Type: "array", Items: operation}},
+	}
+}
+
+// pathSchema returns the schema of a path parameter, the id parameters of the API, such as id or provinceId, are
+// integers
+func pathSchema(name string) *Schema {
+	if name == "id" || strings.HasSuffix(name, "Id") {
+		return &Schema{Type: "integer"}
+	}
+	return &Schema{Type: "string"}
+}
+
+// operationId builds a unique identifier from the method and path, such as getApiV1SectionsId
+func operationId(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '{' || r == '}' || r == '-' || r == '.' || r == '_'
+	}) {
+		b.WriteString(capitalize(part))
+	}
+	return b.String()
+}
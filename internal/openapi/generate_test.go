@@ -0,0 +1,122 @@
+package openapi
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type item struct {
+	Id        int        `json:"id"`
+	Name      string     `json:"name"`
+	Price     float64    `json:"price"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Tags      []string   `json:"tags"`
+	internal  string
+}
+
+type itemRequest struct {
+	Name  *string  `json:"name" required:"true"`
+	Price *float64 `json:"price" required:"true"`
+	Note  *string  `json:"note"`
+}
+
+func (i *itemRequest) Bind(r *http.Request) error {
+	if i.Name == nil {
+		return errors.New("name is required")
+	}
+	if i.Price == nil {
+		return errors.New("price is required")
+	}
+	return nil
+}
+
+func newItemRouter() chi.Router {
+	rt := chi.NewRouter()
+	noop := func(w http.ResponseWriter, r *http.Request) {}
+	rt.Route("/api/v1/items", func(r chi.Router) {
+		r.Get("/", noop)
+		r.Post("/", noop)
+		r.Get("/{id:[0-9]+}", noop)
+	})
+	return rt
+}
+
+var itemOperations = map[string]Operation{
+	"GET /api/v1/items/":            {Summary: "List items", Response: []item{}},
+	"POST /api/v1/items/":           {Summary: "Create an item", Request: itemRequest{}, Response: item{}, Status: http.StatusCreated, Errors: []int{http.StatusBadRequest}},
+	"GET /api/v1/items/{id:[0-9]+}": {Summary: "Get an item", Response: item{}, Errors: []int{http.StatusNotFound}},
+}
+
+func TestGenerate(t *testing.T) {
+	// Act
+	doc, err := Generate(Info{Title: "items", Version: "1"}, newItemRouter(), itemOperations)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, Version, doc.OpenAPI)
+	require.Contains(t, doc.Paths, "/api/v1/items")
+	require.Contains(t, doc.Paths, "/api/v1/items/{id}")
+
+	get := doc.Paths["/api/v1/items/{id}"]["get"]
+	require.Equal(t, "getApiV1ItemsId", get.OperationId)
+	require.Equal(t, []Parameter{{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "integer"}}}, get.Parameters)
+	require.Contains(t, get.Responses, "200")
+	require.Contains(t, get.Responses, "404")
+
+	post := doc.Paths["/api/v1/items"]["post"]
+	require.Contains(t, post.Responses, "201")
+	require.Equal(t, "#/components/schemas/itemRequest", post.RequestBody.Content[MediaTypeJSON].Schema.Ref)
+
+	request := doc.Components.Schemas["itemRequest"]
+	require.Equal(t, []string{"name", "price"}, request.Required)
+	require.Equal(t, []string{"string", "null"}, request.Properties["note"].Type)
+
+	model := doc.Components.Schemas["item"]
+	require.Nil(t, model.Required)
+	require.Len(t, model.Properties, 5)
+	require.Equal(t, "integer", model.Properties["id"].Type)
+	require.Equal(t, "number", model.Properties["price"].Type)
+	require.Equal(t, "date-time", model.Properties["expires_at"].Format)
+	require.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}}, model.Properties["tags"])
+}
+
+func TestGenerate_UndocumentedRoute(t *testing.T) {
+	// Arrange
+	operations := map[string]Operation{"GET /api/v1/items/": itemOperations["GET /api/v1/items/"]}
+
+	// Act
+	doc, err := Generate(Info{}, newItemRouter(), operations)
+
+	// Assert
+	require.Nil(t, doc)
+	require.EqualError(t, err, "routes missing from the OpenAPI document: GET /api/v1/items/{id:[0-9]+}, POST /api/v1/items/")
+}
+
+func TestGenerate_PatchBody(t *testing.T) {
+	// Arrange
+	rt := chi.NewRouter()
+	rt.Patch("/items/{id}", func(w http.ResponseWriter, r *http.Request) {})
+	operations := map[string]Operation{"PATCH /items/{id}": {Patch: item{}, Response: item{}}}
+
+	// Act
+	doc, err := Generate(Info{}, rt, operations)
+
+	// Assert
+	require.NoError(t, err)
+	body := doc.Paths["/items/{id}"]["patch"].RequestBody
+	require.Len(t, body.Content, 3)
+
+	merge := body.Content["application/merge-patch+json"].Schema
+	require.Equal(t, false, merge.AdditionalProperties)
+	require.NotContains(t, merge.Properties, "id")
+	require.NotContains(t, merge.Properties, "tags")
+	require.Contains(t, merge.Properties, "name")
+
+	jsonPatch := body.Content["application/json-patch+json"].Schema
+	require.Equal(t, "array", jsonPatch.Type)
+	require.Equal(t, []any{"/expires_at", "/name", "/price"}, jsonPatch.Items.Properties["path"].Enum)
+}
@@ -0,0 +1,29 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+)
+
+//go:embed ui/index.html
+var uiPage []byte
+
+// Handler serves the OpenAPI document of spec as JSON
+func Handler(spec *Spec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		doc, err := spec.Document()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_ = json.NewEncoder(w).Encode(doc)
+	}
+}
+
+// UIHandler serves the Swagger UI page that renders the document served at /openapi.json
+func UIHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(uiPage)
+}
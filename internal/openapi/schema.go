@@ -0,0 +1,196 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// field is a struct field serialized by encoding/json
+type field struct {
+	name  string
+	index []int
+	typ   reflect.Type
+	tag   reflect.StructTag
+}
+
+// schemas builds the component schemas of the struct types reachable from the documented types
+type schemas struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{components: make(map[string]*Schema), names: make(map[reflect.Type]string)}
+}
+
+// of returns the schema of t, struct types are added to the components and referenced
+func (s *schemas) of(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+	if t.Kind() == reflect.Pointer {
+		return s.of(t.Elem()).Nullable()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: s.of(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.of(t.Elem())}
+	case reflect.Struct:
+		return &Schema{Ref: "#/components/schemas/" + s.component(t)}
+	}
+	return &Schema{}
+}
+
+// component adds the schema of the struct type t to the components and returns its name
+func (s *schemas) component(t reflect.Type) string {
+	if name, ok := s.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := s.components[name]; taken || name == "" {
+		pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+		name = name + capitalize(pkg)
+	}
+	s.names[t] = name
+	// reserve the name before visiting the fields, so that recursive types reference it
+	s.components[name] = &Schema{}
+
+	schema := &Schema{Type: "object", Required: required(t), Properties: make(map[string]*Schema)}
+	for _, f := range fields(t) {
+		typ := f.typ
+		// a request field is a pointer to tell a missing value apart, a required one does not accept null either
+		if typ.Kind() == reflect.Pointer && contains(schema.Required, f.name) {
+			typ = typ.Elem()
+		}
+		schema.Properties[f.name] = constrain(s.of(typ), f.tag)
+	}
+	s.components[name] = schema
+	return name
+}
+
+// constrain adds the validation keywords declared in the struct tag of a field to its schema: minimum, maximum,
+// minLength, maxLength, pattern, format and enum, whose values are separated by commas
+func constrain(schema *Schema, tag reflect.StructTag) *Schema {
+	if schema.Ref != "" {
+		return schema
+	}
+	if value, ok := tag.Lookup("minimum"); ok {
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			schema.Minimum = &number
+		}
+	}
+	if value, ok := tag.Lookup("maximum"); ok {
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			schema.Maximum = &number
+		}
+	}
+	if value, ok := tag.Lookup("minLength"); ok {
+		if length, err := strconv.Atoi(value); err == nil {
+			schema.MinLength = &length
+		}
+	}
+	if value, ok := tag.Lookup("maxLength"); ok {
+		if length, err := strconv.Atoi(value); err == nil {
+			schema.MaxLength = &length
+		}
+	}
+	if value, ok := tag.Lookup("pattern"); ok {
+		schema.Pattern = value
+	}
+	if value, ok := tag.Lookup("format"); ok {
+		schema.Format = value
+	}
+	if value, ok := tag.Lookup("enum"); ok {
+		for _, option := range strings.Split(value, ",") {
+			schema.Enum = append(schema.Enum, option)
+		}
+	}
+	return schema
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// fields returns the fields of the struct type t as encoding/json serializes them, flattening embedded structs
+func fields(t reflect.Type) []field {
+	var result []field
+	var visit func(t reflect.Type, index []int)
+	visit = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			fieldIndex := append(append([]int{}, index...), i)
+
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+				visit(f.Type, fieldIndex)
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			result = append(result, field{name: name, index: fieldIndex, typ: f.Type, tag: f.Tag})
+		}
+	}
+	visit(t, nil)
+	return result
+}
+
+// required returns the fields of a request struct tagged as required, the tag its Bind method checks them with
+func required(t reflect.Type) []string {
+	var names []string
+	for _, f := range fields(t) {
+		if f.tag.Get("required") == "true" {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,31 @@
+package openapi
+
+import (
+	"github.com/go-chi/chi/v5"
+	"sync"
+)
+
+// Spec is the OpenAPI document of a router. The document is generated the first time it is needed, once every
+// route has been registered, which lets middlewares mounted before the routes use it.
+type Spec struct {
+	info       Info
+	router     chi.Routes
+	operations map[string]Operation
+
+	once sync.Once
+	doc  *Document
+	err  error
+}
+
+// NewSpec returns the spec of the routes of router, described by operations
+func NewSpec(info Info, router chi.Routes, operations map[string]Operation) *Spec {
+	return &Spec{info: info, router: router, operations: operations}
+}
+
+// Document returns the OpenAPI document, generating it on the first call
+func (s *Spec) Document() (*Document, error) {
+	s.once.Do(func() {
+		s.doc, s.err = Generate(s.info, s.router, s.operations)
+	})
+	return s.doc, s.err
+}
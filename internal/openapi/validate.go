@@ -0,0 +1,353 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FieldError describes why a field of the body or a parameter of a request is invalid
+type FieldError struct {
+	// Pointer is the JSON pointer to the invalid field of the body
+	Pointer string `json:"pointer,omitempty"`
+	// Parameter is the name of the invalid path or query parameter
+	Parameter string `json:"parameter,omitempty"`
+	// Message explains why the value is invalid
+	Message string `json:"message"`
+}
+
+// RequestError is returned by Validate when a request does not match its operation. Invalid parameters and
+// malformed bodies are reported with 400 Bad Request and bodies that do not match their schema with 422
+// Unprocessable Entity.
+type RequestError struct {
+	Status  int
+	Message string
+	Errors  []FieldError
+}
+
+func (e *RequestError) Error() string {
+	return e.Message
+}
+
+// Validate checks the path parameters, query parameters and JSON body of r against the operation of the route it
+// matches. Requests that match no documented route, and bodies in media types other than JSON, are left for the
+// handlers to reject. The body of r can still be read afterwards.
+func (s *Spec) Validate(r *http.Request) error {
+	doc, err := s.Document()
+	if err != nil {
+		return err
+	}
+
+	rctx := chi.NewRouteContext()
+	pattern := s.router.Find(rctx, r.Method, r.URL.Path)
+	if pattern == "" {
+		return nil
+	}
+	path, _ := convertPath(pattern)
+	item := doc.Paths[path][strings.ToLower(r.Method)]
+	if item == nil {
+		return nil
+	}
+
+	v := validator{components: doc.Components.Schemas}
+	if errs := v.parameters(item.Parameters, rctx, r); len(errs) > 0 {
+		return &RequestError{Status: http.StatusBadRequest, Message: "invalid request parameters", Errors: errs}
+	}
+	if item.RequestBody == nil {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "" {
+		mediaType = MediaTypeJSON
+	}
+	content, ok := item.RequestBody.Content[mediaType]
+	if !ok || (mediaType != MediaTypeJSON && !strings.HasSuffix(mediaType, "+json")) {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return &RequestError{Status: http.StatusBadRequest, Message: err.Error()}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return &RequestError{Status: http.StatusBadRequest, Message: "request body is required"}
+	}
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return &RequestError{Status: http.StatusBadRequest, Message: "malformed JSON body: " + err.Error()}
+	}
+
+	if errs := v.validate(content.Schema, value, ""); len(errs) > 0 {
+		return &RequestError{Status: http.StatusUnprocessableEntity, Message: "request body does not match its schema", Errors: errs}
+	}
+	return nil
+}
+
+// validator checks values against the schemas of a document
+type validator struct {
+	components map[string]*Schema
+}
+
+// parameters checks the path and query parameters of a request, values are converted to the type of their schema
+// before they are validated
+func (v validator) parameters(params []Parameter, rctx *chi.Context, r *http.Request) []FieldError {
+	var errs []FieldError
+	for _, param := range params {
+		var raw string
+		switch param.In {
+		case "path":
+			raw = rctx.URLParam(param.Name)
+		case "query":
+			values, ok := r.URL.Query()[param.Name]
+			if !ok {
+				if param.Required {
+					errs = append(errs, FieldError{Parameter: param.Name, Message: "is required"})
+				}
+				continue
+			}
+			raw = values[0]
+		default:
+			continue
+		}
+
+		var value any = raw
+		switch param.Schema.Type {
+		case "integer", "number":
+			if _, err := strconv.ParseFloat(raw, 64); err == nil {
+				value = json.Number(raw)
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(raw); err == nil {
+				value = b
+			}
+		}
+		for _, err := range v.validate(param.Schema, value, "") {
+			errs = append(errs, FieldError{Parameter: param.Name, Message: err.Message})
+		}
+	}
+	return errs
+}
+
+// validate checks value, as decoded by a json.Decoder that uses numbers, against schema and returns the errors of
+// every invalid field found under pointer
+func (v validator) validate(schema *Schema, value any, pointer string) []FieldError {
+	if schema == nil {
+		return nil
+	}
+	if schema.Ref != "" {
+		return v.validate(v.components[strings.TrimPrefix(schema.Ref, "#/components/schemas/")], value, pointer)
+	}
+	if len(schema.OneOf) > 0 {
+		return v.oneOf(schema.OneOf, value, pointer)
+	}
+
+	fail := func(format string, args ...any) []FieldError {
+		return []FieldError{{Pointer: pointer, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	types := schemaTypes(schema)
+	kind := jsonType(value)
+	if len(types) > 0 && !allows(types, kind) {
+		return fail("must be %s", describe(types))
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		options := make([]string, len(schema.Enum))
+		for i, option := range schema.Enum {
+			options[i] = fmt.Sprint(option)
+		}
+		return fail("must be one of %s", strings.Join(options, ", "))
+	}
+
+	switch value := value.(type) {
+	case string:
+		length := utf8.RuneCountInString(value)
+		if schema.MinLength != nil && length < *schema.MinLength {
+			return fail("must be at least %d characters long", *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			return fail("must be at most %d characters long", *schema.MaxLength)
+		}
+		if schema.Pattern != "" {
+			if re, err := regexp.Compile(schema.Pattern); err == nil && !re.MatchString(value) {
+				return fail("must match the pattern %s", schema.Pattern)
+			}
+		}
+		switch schema.Format {
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339, value); err != nil {
+				return fail("must be a date-time in the RFC 3339 format")
+			}
+		case "date":
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				return fail("must be a date in the YYYY-MM-DD format")
+			}
+		}
+	case json.Number:
+		number, _ := value.Float64()
+		if schema.Minimum != nil && number < *schema.Minimum {
+			return fail("must be at least %s", formatNumber(*schema.Minimum))
+		}
+		if schema.Maximum != nil && number > *schema.Maximum {
+			return fail("must be at most %s", formatNumber(*schema.Maximum))
+		}
+	case []any:
+		var errs []FieldError
+		for i, item := range value {
+			errs = append(errs, v.validate(schema.Items, item, pointer+"/"+strconv.Itoa(i))...)
+		}
+		return errs
+	case map[string]any:
+		return v.object(schema, value, pointer)
+	}
+	return nil
+}
+
+// object checks the required, known and additional properties of an object
+func (v validator) object(schema *Schema, value map[string]any, pointer string) []FieldError {
+	var errs []FieldError
+	for _, name := range schema.Required {
+		if _, ok := value[name]; !ok {
+			errs = append(errs, FieldError{Pointer: pointer + "/" + escape(name), Message: "is required"})
+		}
+	}
+
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fieldPointer := pointer + "/" + escape(name)
+		if property, ok := schema.Properties[name]; ok {
+			errs = append(errs, v.validate(property, value[name], fieldPointer)...)
+			continue
+		}
+		switch additional := schema.AdditionalProperties.(type) {
+		case bool:
+			if !additional {
+				errs = append(errs, FieldError{Pointer: fieldPointer, Message: "is not allowed"})
+			}
+		case *Schema:
+			errs = append(errs, v.validate(additional, value[name], fieldPointer)...)
+		}
+	}
+	return errs
+}
+
+// oneOf accepts a value valid against any of the schemas, and reports the errors of the first one otherwise
+func (v validator) oneOf(schemas []*Schema, value any, pointer string) []FieldError {
+	var first []FieldError
+	for i, schema := range schemas {
+		errs := v.validate(schema, value, pointer)
+		if len(errs) == 0 {
+			return nil
+		}
+		if i == 0 {
+			first = errs
+		}
+	}
+	return first
+}
+
+// schemaTypes returns the types allowed by schema, none means any
+func schemaTypes(schema *Schema) []string {
+	switch t := schema.Type.(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	case []any:
+		types := make([]string, 0, len(t))
+		for _, item := range t {
+			types = append(types, fmt.Sprint(item))
+		}
+		return types
+	}
+	return nil
+}
+
+// jsonType returns the JSON Schema type of a decoded value, integral numbers are integers
+func jsonType(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case json.Number:
+		if _, err := value.Int64(); err == nil {
+			return "integer"
+		}
+		if number, err := value.Float64(); err == nil && number == math.Trunc(number) {
+			return "integer"
+		}
+		return "number"
+	}
+	return ""
+}
+
+func allows(types []string, kind string) bool {
+	for _, t := range types {
+		if t == kind || (t == "number" && kind == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// describe returns the types of a schema as a phrase, such as "an integer or null"
+func describe(types []string) string {
+	phrases := make([]string, len(types))
+	for i, t := range types {
+		switch t {
+		case "null":
+			phrases[i] = "null"
+		case "integer", "object", "array":
+			phrases[i] = "an " + t
+		default:
+			phrases[i] = "a " + t
+		}
+	}
+	return strings.Join(phrases, " or ")
+}
+
+func inEnum(enum []any, value any) bool {
+	for _, option := range enum {
+		if fmt.Sprint(option) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+// escape escapes a property name as a JSON pointer reference token
+func escape(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
@@ -0,0 +1,139 @@
+package openapi
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type batchRequest struct {
+	Number   *int     `json:"number" minimum:"1" required:"true"`
+	Quantity *int     `json:"quantity" minimum:"0" maximum:"100"`
+	DueDate  *string  `json:"due_date" format:"date"`
+	Status   *string  `json:"status" enum:"active,expired"`
+	Code     *string  `json:"code" minLength:"2" maxLength:"4"`
+	Weight   *float64 `json:"weight"`
+}
+
+func (b *batchRequest) Bind(r *http.Request) error {
+	if b.Number == nil {
+		return errors.New("number is required")
+	}
+	return nil
+}
+
+func newBatchSpec() *Spec {
+	rt := chi.NewRouter()
+	noop := func(w http.ResponseWriter, r *http.Request) {}
+	rt.Route("/batches", func(r chi.Router) {
+		r.Post("/", noop)
+		r.Get("/{id}", noop)
+		r.Patch("/{id}", noop)
+		r.Post("/import", noop)
+	})
+	return NewSpec(Info{}, rt, map[string]Operation{
+		"POST /batches/":       {Request: batchRequest{}},
+		"GET /batches/{id}":    {Parameters: []Parameter{{Name: "format", In: "query", Schema: &Schema{Type: "string", Enum: []any{"csv", "xlsx"}}}}},
+		"PATCH /batches/{id}":  {Patch: item{}},
+		"POST /batches/import": {Request: "", RequestMediaTypes: []string{"text/csv"}},
+	})
+}
+
+func TestSpec_Validate(t *testing.T) {
+	testCases := []struct {
+		title       string
+		method      string
+		target      string
+		contentType string
+		body        string
+		status      int
+		errors      []FieldError
+	}{
+		{title: "valid body", method: http.MethodPost, target: "/batches", body: `{"number": 1, "quantity": 100, "due_date": "2025-01-31", "status": "active", "code": "AB", "weight": 1.5}`},
+		{title: "only the required fields", method: http.MethodPost, target: "/batches", body: `{"number": 1}`},
+		{title: "null optional field", method: http.MethodPost, target: "/batches", body: `{"number": 1, "weight": null}`},
+		{
+			title: "missing required field", method: http.MethodPost, target: "/batches", body: `{"quantity": 1}`,
+			status: http.StatusUnprocessableEntity, errors: []FieldError{{Pointer: "/number", Message: "is required"}},
+		},
+		{
+			title: "null required field", method: http.MethodPost, target: "/batches", body: `{"number": null}`,
+			status: http.StatusUnprocessableEntity, errors: []FieldError{{Pointer: "/number", Message: "must be an integer"}},
+		},
+		{
+			title: "every invalid field", method: http.MethodPost, target: "/batches",
+			body:   `{"number": 1.5, "quantity": 101, "due_date": "31/01/2025", "status": "lost", "code": "ABCDE", "weight": "1"}`,
+			status: http.StatusUnprocessableEntity,
+			errors: []FieldError{
+				{Pointer: "/code", Message: "must be at most 4 characters long"},
+				{Pointer: "/due_date", Message: "must be a date in the YYYY-MM-DD format"},
+				{Pointer: "/number", Message: "must be an integer"},
+				{Pointer: "/quantity", Message: "must be at most 100"},
+				{Pointer: "/status", Message: "must be one of active, expired"},
+				{Pointer: "/weight", Message: "must be a number or null"},
+			},
+		},
+		{
+			title: "below the minimum", method: http.MethodPost, target: "/batches", body: `{"number": 0}`,
+			status: http.StatusUnprocessableEntity, errors: []FieldError{{Pointer: "/number", Message: "must be at least 1"}},
+		},
+		{
+			title: "body is not an object", method: http.MethodPost, target: "/batches", body: `[1]`,
+			status: http.StatusUnprocessableEntity, errors: []FieldError{{Pointer: "", Message: "must be an object"}},
+		},
+		{title: "malformed body", method: http.MethodPost, target: "/batches", body: `{"number": `, status: http.StatusBadRequest},
+		{title: "empty body", method: http.MethodPost, target: "/batches", status: http.StatusBadRequest},
+		{
+			title: "invalid path parameter", method: http.MethodGet, target: "/batches/abc",
+			status: http.StatusBadRequest, errors: []FieldError{{Parameter: "id", Message: "must be an integer"}},
+		},
+		{
+			title: "invalid query parameter", method: http.MethodGet, target: "/batches/1?format=pdf",
+			status: http.StatusBadRequest, errors: []FieldError{{Parameter: "format", Message: "must be one of csv, xlsx"}},
+		},
+		{title: "valid parameters", method: http.MethodGet, target: "/batches/1?format=csv"},
+		{
+			title: "unknown merge patch field", method: http.MethodPatch, target: "/batches/1", contentType: "application/merge-patch+json",
+			body: `{"name": "batch", "id": 2}`, status: http.StatusUnprocessableEntity, errors: []FieldError{{Pointer: "/id", Message: "is not allowed"}},
+		},
+		{
+			title: "invalid JSON Patch operation", method: http.MethodPatch, target: "/batches/1", contentType: "application/json-patch+json",
+			body: `[{"op": "rename", "path": "/name"}]`, status: http.StatusUnprocessableEntity,
+			errors: []FieldError{{Pointer: "/0/op", Message: "must be one of add, remove, replace, move, copy, test"}},
+		},
+		{title: "body in another media type", method: http.MethodPost, target: "/batches/import", contentType: "text/csv", body: "number\n1"},
+		{title: "undocumented media type", method: http.MethodPost, target: "/batches", contentType: "text/plain", body: "number"},
+		{title: "unknown route", method: http.MethodGet, target: "/unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			// Arrange
+			spec := newBatchSpec()
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+
+			// Act
+			err := spec.Validate(req)
+
+			// Assert
+			if tc.status == 0 {
+				require.NoError(t, err)
+				body, _ := io.ReadAll(req.Body)
+				require.Equal(t, tc.body, string(body))
+				return
+			}
+			var requestErr *RequestError
+			require.ErrorAs(t, err, &requestErr)
+			require.Equal(t, tc.status, requestErr.Status)
+			require.Equal(t, tc.errors, requestErr.Errors)
+		})
+	}
+}
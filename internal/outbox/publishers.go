@@ -0,0 +1,100 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"log"
+	"strings"
+)
+
+// BusPublisher publishes the events to the in-process broker, as the change of the entity they carry
+type BusPublisher struct {
+	broker *events.Broker
+}
+
+func NewBusPublisher(broker *events.Broker) *BusPublisher {
+	return &BusPublisher{broker: broker}
+}
+
+// Publish ignores the events of the aggregates the broker does not know of
+func (p *BusPublisher) Publish(_ context.Context, event models.OutboxEvent) error {
+	var change events.Change
+	var err error
+	switch event.AggregateType {
+	case models.OutboxAggregatePurchaseOrder:
+		change.Resource = events.ResourcePurchaseOrder
+		change.Entity, err = decode[models.PurchaseOrder](event.Payload)
+	case models.OutboxAggregateInboundOrder:
+		change.Resource = events.ResourceInboundOrder
+		change.Entity, err = decode[models.InboundOrder](event.Payload)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the kind is the suffix of the event type, such as created in purchase_order.created
+	_, kind, _ := strings.Cut(event.EventType, ".")
+	change.Kind = events.Kind(kind)
+	change.OccurredAt = event.CreatedAt
+	p.broker.Publish(change)
+	return nil
+}
+
+func decode[T any](payload []byte) (T, error) {
+	var entity T
+	err := json.Unmarshal(payload, &entity)
+	return entity, err
+}
+
+// LogPublisher writes a line per event to a logger
+type LogPublisher struct {
+	logger *log.Logger
+}
+
+// NewLogPublisher returns a publisher that logs to logger, or to the standard logger when it is nil
+func NewLogPublisher(logger *log.Logger) *LogPublisher {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogPublisher{logger: logger}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, event models.OutboxEvent) error {
+	p.logger.Printf("outbox: event %d %s of %s %d", event.Id, event.EventType, event.AggregateType, event.AggregateId)
+	return nil
+}
+
+// webhookEventTypes maps the outbox events to the webhook events they are notified as
+var webhookEventTypes = map[string]string{
+	models.OutboxEventPurchaseOrderCreated: models.WebhookEventPurchaseOrderCreated,
+	models.OutboxEventInboundOrderCreated:  models.WebhookEventInboundOrderReceived,
+}
+
+// WebhookPublisher queues the events that webhooks can subscribe to for delivery
+type WebhookPublisher struct {
+	webhooks service.WebhookService
+}
+
+func NewWebhookPublisher(webhooks service.WebhookService) *WebhookPublisher {
+	return &WebhookPublisher{webhooks: webhooks}
+}
+
+// Publish derives the id of the webhook event from the outbox event, so that publishing it again is a no-op
+func (p *WebhookPublisher) Publish(ctx context.Context, event models.OutboxEvent) error {
+	eventType, ok := webhookEventTypes[event.EventType]
+	if !ok {
+		return nil
+	}
+	return p.webhooks.Publish(ctx, models.WebhookEvent{
+		Id:         fmt.Sprintf("outbox:%d", event.Id),
+		Type:       eventType,
+		OccurredAt: event.CreatedAt,
+		Data:       event.Payload,
+	})
+}
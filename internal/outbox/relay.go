@@ -0,0 +1,156 @@
+// Package outbox dispatches the domain events written to the outbox table by the repositories.
+//
+// The repositories record an event in the same transaction as the change it describes, so an event exists if and
+// only if its change was committed. The Relay then hands every pending event to each Publisher and marks it as
+// dispatched once all of them accepted it. Delivery is at least once: an event whose dispatch failed is handed to
+// every publisher again, which must therefore tolerate duplicates. Events of the same aggregate are dispatched in
+// the order they were written, a failed event holds back the later events of its aggregate until it is dispatched.
+// An event still failing after maxAttempts attempts is marked as dead and no longer holds its aggregate back.
+//
+// A single relay is expected to run at a time, two relays would dispatch the same events concurrently.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"log"
+	"time"
+)
+
+const (
+	// DefaultInterval is the time between two rounds of dispatch
+	DefaultInterval = time.Second
+	// batchSize is the number of pending events read by a round of dispatch
+	batchSize = 500
+	// initialBackoff is the delay before the first retry of a failed event, it doubles on every failure
+	initialBackoff = time.Second
+	// maxBackoff is the longest delay between two attempts of an event
+	maxBackoff = 5 * time.Minute
+	// maxAttempts is the number of failed attempts after which an event is marked as dead
+	maxAttempts = 20
+	// maxErrorLength is the size of the last_error column
+	maxErrorLength = 255
+)
+
+// Publisher hands the events of the outbox to a destination
+type Publisher interface {
+	Publish(ctx context.Context, event models.OutboxEvent) error
+}
+
+// PublisherFunc adapts a function into a Publisher
+type PublisherFunc func(ctx context.Context, event models.OutboxEvent) error
+
+func (f PublisherFunc) Publish(ctx context.Context, event models.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+// Relay dispatches the pending events of the outbox to its publishers
+type Relay struct {
+	rp         repository.OutboxRepository
+	publishers []Publisher
+	// interval is the time between two rounds of dispatch
+	interval time.Duration
+	// now returns the current time
+	now func() time.Time
+}
+
+// NewRelay returns a relay that dispatches the events of rp to publishers, a zero interval takes its default value
+func NewRelay(rp repository.OutboxRepository, interval time.Duration, publishers ...Publisher) *Relay {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Relay{rp: rp, publishers: publishers, interval: interval, now: time.Now}
+}
+
+// Run dispatches the pending events until ctx is done. Errors are logged, the next round tries again.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.DispatchPending(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("outbox: dispatching the pending events: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending runs a round of dispatch. Failures of the publishers are recorded in the events and retried with
+// exponential backoff, only the errors of the repository are returned.
+func (r *Relay) DispatchPending(ctx context.Context) error {
+	pending, err := r.rp.FindPending(ctx, r.now(), batchSize)
+	if err != nil {
+		return err
+	}
+
+	// blocked holds the aggregates with an event that is not dispatched yet, their later events must wait for it
+	blocked := make(map[string]bool)
+	for _, event := range pending {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		aggregate := fmt.Sprintf("%s:%d", event.AggregateType, event.AggregateId)
+		if blocked[aggregate] {
+			continue
+		}
+
+		dispatchErr := r.dispatch(ctx, event)
+		now := r.now()
+		event.Attempts++
+		switch {
+		case dispatchErr != nil && event.Attempts >= maxAttempts:
+			log.Printf("outbox: giving up on event %d after %d attempts: %v", event.Id, event.Attempts, dispatchErr)
+			event.Status = models.OutboxDead
+			event.LastError = truncate(dispatchErr.Error(), maxErrorLength)
+			event.NextAttemptAt = nil
+		case dispatchErr != nil:
+			blocked[aggregate] = true
+			next := now.Add(backoff(event.Attempts))
+			event.LastError = truncate(dispatchErr.Error(), maxErrorLength)
+			event.NextAttemptAt = &next
+		default:
+			event.Status = models.OutboxDispatched
+			event.LastError = ""
+			event.NextAttemptAt = nil
+			event.DispatchedAt = &now
+		}
+		if _, err = r.rp.Update(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dispatch hands the event to every publisher, even when one of them fails, and returns their errors
+func (r *Relay) dispatch(ctx context.Context, event models.OutboxEvent) error {
+	var errs []error
+	for _, publisher := range r.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backoff returns the delay before the next attempt of an event that failed attempts times
+func backoff(attempts int) time.Duration {
+	delay := initialBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
+
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length]
+}
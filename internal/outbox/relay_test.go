@@ -0,0 +1,186 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// store keeps the outbox in memory
+type store struct {
+	events []models.OutboxEvent
+}
+
+func (s *store) FindPending(ctx context.Context, now time.Time, limit int) ([]models.OutboxEvent, error) {
+	var pending []models.OutboxEvent
+	waiting := make(map[int]bool)
+	for _, event := range s.events {
+		if event.Status != models.OutboxPending {
+			continue
+		}
+		if event.NextAttemptAt != nil && event.NextAttemptAt.After(now) {
+			waiting[event.AggregateId] = true
+		}
+		if !waiting[event.AggregateId] && len(pending) < limit {
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+func (s *store) Update(ctx context.Context, event models.OutboxEvent) (models.OutboxEvent, error) {
+	for i := range s.events {
+		if s.events[i].Id == event.Id {
+			s.events[i] = event
+		}
+	}
+	return event, nil
+}
+
+func newEvent(id int, aggregateId int) models.OutboxEvent {
+	return models.OutboxEvent{
+		Id:            id,
+		AggregateType: models.OutboxAggregatePurchaseOrder,
+		AggregateId:   aggregateId,
+		EventType:     models.OutboxEventPurchaseOrderCreated,
+		Payload:       json.RawMessage(fmt.Sprintf(`{"id":%d}`, aggregateId)),
+		Status:        models.OutboxPending,
+	}
+}
+
+func TestRelay_DispatchPending(t *testing.T) {
+	// Arrange - the first event of aggregate 1 fails once, aggregate 2 is dispatched meanwhile
+	rp := &store{events: []models.OutboxEvent{newEvent(1, 1), newEvent(2, 2), newEvent(3, 1)}}
+	failing := map[int]bool{1: true}
+	var published []int
+	publisher := PublisherFunc(func(_ context.Context, event models.OutboxEvent) error {
+		if failing[event.Id] {
+			delete(failing, event.Id)
+			return errors.New("bus unavailable")
+		}
+		published = append(published, event.Id)
+		return nil
+	})
+	relay := NewRelay(rp, time.Second, publisher)
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	relay.now = func() time.Time { return now }
+
+	// Act
+	firstErr := relay.DispatchPending(context.Background())
+	afterFirst := append([]int{}, published...)
+	failed := rp.events[0]
+	secondErr := relay.DispatchPending(context.Background())
+	now = now.Add(time.Second)
+	thirdErr := relay.DispatchPending(context.Background())
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.NoError(t, thirdErr)
+	require.Equal(t, []int{2}, afterFirst)
+	require.Equal(t, 1, failed.Attempts)
+	require.Equal(t, "bus unavailable", failed.LastError)
+	require.Equal(t, now, *failed.NextAttemptAt)
+	require.Equal(t, []int{2, 1, 3}, published)
+	for _, event := range rp.events {
+		require.Equal(t, models.OutboxDispatched, event.Status)
+	}
+}
+
+func TestRelay_DispatchPending_EveryPublisher(t *testing.T) {
+	// Arrange
+	rp := &store{events: []models.OutboxEvent{newEvent(1, 1)}}
+	calls := 0
+	count := PublisherFunc(func(context.Context, models.OutboxEvent) error {
+		calls++
+		return nil
+	})
+	failing := PublisherFunc(func(context.Context, models.OutboxEvent) error {
+		return errors.New("log unavailable")
+	})
+	relay := NewRelay(rp, time.Second, failing, count)
+
+	// Act
+	err := relay.DispatchPending(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Equal(t, models.OutboxPending, rp.events[0].Status)
+}
+
+func TestRelay_DispatchPending_Dead(t *testing.T) {
+	// Arrange - the first event of aggregate 1 fails for the last time
+	failed := newEvent(1, 1)
+	failed.Attempts = maxAttempts - 1
+	rp := &store{events: []models.OutboxEvent{failed, newEvent(2, 1)}}
+	var published []int
+	publisher := PublisherFunc(func(_ context.Context, event models.OutboxEvent) error {
+		if event.Id == 1 {
+			return errors.New("bus unavailable")
+		}
+		published = append(published, event.Id)
+		return nil
+	})
+	relay := NewRelay(rp, time.Second, publisher)
+
+	// Act
+	err := relay.DispatchPending(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, models.OutboxDead, rp.events[0].Status)
+	require.Equal(t, maxAttempts, rp.events[0].Attempts)
+	require.Nil(t, rp.events[0].NextAttemptAt)
+	require.Equal(t, []int{2}, published)
+}
+
+// webhookRecorder records the published webhook events
+type webhookRecorder struct {
+	service.WebhookService
+	published []models.WebhookEvent
+}
+
+func (w *webhookRecorder) Publish(ctx context.Context, event models.WebhookEvent) error {
+	w.published = append(w.published, event)
+	return nil
+}
+
+func TestPublishers(t *testing.T) {
+	// Arrange
+	broker := events.NewBroker()
+	changes, cancel := broker.Subscribe(1)
+	defer cancel()
+	webhooks := &webhookRecorder{}
+	event := models.OutboxEvent{
+		Id:            9,
+		AggregateType: models.OutboxAggregateInboundOrder,
+		AggregateId:   4,
+		EventType:     models.OutboxEventInboundOrderCreated,
+		Payload:       json.RawMessage(`{"id":4,"order_number":"ORD-4"}`),
+	}
+
+	// Act
+	busErr := NewBusPublisher(broker).Publish(context.Background(), event)
+	webhookErr := NewWebhookPublisher(webhooks).Publish(context.Background(), event)
+
+	// Assert
+	require.NoError(t, busErr)
+	change := <-changes
+	require.Equal(t, events.ResourceInboundOrder, change.Resource)
+	require.Equal(t, events.KindCreated, change.Kind)
+	require.Equal(t, models.InboundOrder{Id: 4, OrderNumber: "ORD-4"}, change.Entity)
+
+	require.NoError(t, webhookErr)
+	require.Len(t, webhooks.published, 1)
+	require.Equal(t, "outbox:9", webhooks.published[0].Id)
+	require.Equal(t, models.WebhookEventInboundOrderReceived, webhooks.published[0].Type)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// AuditRepository is the storage of the audit trail, entries can only be appended and searched
+type AuditRepository interface {
+	Create(ctx context.Context, entry models.AuditLog) (models.AuditLog, error)
+	FindByFilter(ctx context.Context, filter models.AuditFilter) ([]models.AuditLog, error)
+}
@@ -1,9 +1,12 @@
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 // BuyerRepository is an interface that represents a Buyer repository
 type BuyerRepository interface {
 	Repository[int, models.Buyer]
-	FindByPurchaseOrderReport(id int) ([]models.BuyerReport, error)
+	FindByPurchaseOrderReport(ctx context.Context, id int) ([]models.BuyerReport, error)
 }
@@ -0,0 +1,56 @@
+// Package cached decorates the repositories of the data that is read constantly but rarely changes with a
+// read-through cache.
+//
+// A read is served from the cache when it holds the result, and loaded from the repository and cached otherwise.
+// Writes made through a decorated repository delete the keys of the results they affect, the writes made in the
+// transactions of a Transactor delete them once committed. Results derived from other tables, such as the number of
+// sellers of a locality, are not invalidated by the writes to those tables and may be stale for as long as their TTL.
+//
+// The cache is an optimization only: when it fails the repository is read instead, and the failure is logged.
+// Values are cached as JSON, so only the fields encoding/json serializes survive a round trip.
+package cached
+
+import (
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"log"
+	"time"
+)
+
+const (
+	// DefaultTTL is how long an entity or a list of entities is cached
+	DefaultTTL = 10 * time.Minute
+	// DefaultReportTTL is how long a report is cached, it is shorter since writes to other tables change reports
+	DefaultReportTTL = time.Minute
+)
+
+// readThrough returns the value cached for key, or loads it and caches it for ttl. Errors of load are not cached.
+func readThrough[T any](c cache.Cache, key string, ttl time.Duration, load func() (T, error)) (T, error) {
+	if data, ok, err := c.Get(key); err != nil {
+		log.Printf("cache: reading %s: %v", key, err)
+	} else if ok {
+		var value T
+		if err = json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+		log.Printf("cache: decoding %s: %v", key, err)
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	if data, err := json.Marshal(value); err == nil {
+		if err = c.Set(key, data, ttl); err != nil {
+			log.Printf("cache: writing %s: %v", key, err)
+		}
+	}
+	return value, nil
+}
+
+// invalidate deletes the keys affected by a write
+func invalidate(c cache.Cache, keys ...string) {
+	if err := c.Delete(keys...); err != nil {
+		log.Printf("cache: invalidating %v: %v", keys, err)
+	}
+}
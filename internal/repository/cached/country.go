@@ -0,0 +1,80 @@
+package cached
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"strconv"
+	"time"
+)
+
+// keys of the cached country reads
+const (
+	countriesKey = "countries:all"
+	countryKey   = "countries:id:"
+)
+
+// CountryRepository caches the reads of a repository.CountryRepository
+type CountryRepository struct {
+	repository.CountryRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewCountryRepository returns rp with its reads cached in c, a zero TTL takes its default value
+func NewCountryRepository(rp repository.CountryRepository, c cache.Cache, ttl time.Duration) *CountryRepository {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &CountryRepository{CountryRepository: rp, cache: c, ttl: ttl}
+}
+
+func (r *CountryRepository) FindAll(ctx context.Context) ([]models.Country, error) {
+	return readThrough(r.cache, countriesKey, r.ttl, func() ([]models.Country, error) {
+		return r.CountryRepository.FindAll(ctx)
+	})
+}
+
+func (r *CountryRepository) FindById(ctx context.Context, id int) (models.Country, error) {
+	return readThrough(r.cache, countryKey+strconv.Itoa(id), r.ttl, func() (models.Country, error) {
+		return r.CountryRepository.FindById(ctx, id)
+	})
+}
+
+func (r *CountryRepository) Create(ctx context.Context, country models.Country) (models.Country, error) {
+	created, err := r.CountryRepository.Create(ctx, country)
+	if err == nil {
+		r.invalidate(created.Id)
+	}
+	return created, err
+}
+
+func (r *CountryRepository) Update(ctx context.Context, country models.Country) (models.Country, error) {
+	updated, err := r.CountryRepository.Update(ctx, country)
+	if err == nil {
+		r.invalidate(country.Id)
+	}
+	return updated, err
+}
+
+func (r *CountryRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Country, error) {
+	updated, err := r.CountryRepository.PartialUpdate(ctx, id, fields)
+	if err == nil {
+		r.invalidate(id)
+	}
+	return updated, err
+}
+
+func (r *CountryRepository) Delete(ctx context.Context, id int) error {
+	err := r.CountryRepository.Delete(ctx, id)
+	if err == nil {
+		r.invalidate(id)
+	}
+	return err
+}
+
+// invalidate deletes the reads a write to the country changes
+func (r *CountryRepository) invalidate(id int) {
+	invalidate(r.cache, countriesKey, countryKey+strconv.Itoa(id))
+}
@@ -0,0 +1,140 @@
+package cached
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"strconv"
+	"time"
+)
+
+// keys of the cached locality reads
+const (
+	localitiesKey             = "localities:all"
+	localityKey               = "localities:id:"
+	localitySellersReportKey  = "localities:reportSellers:"
+	localityCarriersReportKey = "localities:reportCarriers:"
+	allReportKey              = "all"
+)
+
+// LocalityRepository caches the reads of a repository.LocalityRepository
+type LocalityRepository struct {
+	repository.LocalityRepository
+	cache     cache.Cache
+	ttl       time.Duration
+	reportTTL time.Duration
+}
+
+// NewLocalityRepository returns rp with its reads cached in c, zero TTLs take their default value
+func NewLocalityRepository(rp repository.LocalityRepository, c cache.Cache, ttl time.Duration, reportTTL time.Duration) *LocalityRepository {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	if reportTTL <= 0 {
+		reportTTL = DefaultReportTTL
+	}
+	return &LocalityRepository{LocalityRepository: rp, cache: c, ttl: ttl, reportTTL: reportTTL}
+}
+
+func (r *LocalityRepository) FindAll(ctx context.Context) ([]models.Locality, error) {
+	return readThrough(r.cache, localitiesKey, r.ttl, func() ([]models.Locality, error) {
+		return r.LocalityRepository.FindAll(ctx)
+	})
+}
+
+func (r *LocalityRepository) FindById(ctx context.Context, id int) (models.Locality, error) {
+	return readThrough(r.cache, localityKey+strconv.Itoa(id), r.ttl, func() (models.Locality, error) {
+		return r.LocalityRepository.FindById(ctx, id)
+	})
+}
+
+func (r *LocalityRepository) FindAllLocality(ctx context.Context) ([]models.LocalitySellerCount, error) {
+	return readThrough(r.cache, localitySellersReportKey+allReportKey, r.reportTTL, func() ([]models.LocalitySellerCount, error) {
+		return r.LocalityRepository.FindAllLocality(ctx)
+	})
+}
+
+func (r *LocalityRepository) FindLocalityBySeller(ctx context.Context, id int) (models.LocalitySellerCount, error) {
+	return readThrough(r.cache, localitySellersReportKey+strconv.Itoa(id), r.reportTTL, func() (models.LocalitySellerCount, error) {
+		return r.LocalityRepository.FindLocalityBySeller(ctx, id)
+	})
+}
+
+func (r *LocalityRepository) FindAllCarriers(ctx context.Context) ([]models.LocalityCarrierCount, error) {
+	return readThrough(r.cache, localityCarriersReportKey+allReportKey, r.reportTTL, func() ([]models.LocalityCarrierCount, error) {
+		return r.LocalityRepository.FindAllCarriers(ctx)
+	})
+}
+
+func (r *LocalityRepository) FindCarriersByLocality(ctx context.Context, id int) ([]models.LocalityCarrierCount, error) {
+	return readThrough(r.cache, localityCarriersReportKey+strconv.Itoa(id), r.reportTTL, func() ([]models.LocalityCarrierCount, error) {
+		return r.LocalityRepository.FindCarriersByLocality(ctx, id)
+	})
+}
+
+func (r *LocalityRepository) Create(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	created, err := r.LocalityRepository.Create(ctx, locality)
+	if err == nil {
+		r.invalidate(created.Id)
+	}
+	return created, err
+}
+
+func (r *LocalityRepository) CreateWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error) {
+	created, err := r.LocalityRepository.CreateWithNames(ctx, locality)
+	if err == nil {
+		r.invalidate(created.Id)
+		invalidateNamedPlaces(r.cache)
+	}
+	return created, err
+}
+
+func (r *LocalityRepository) Update(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	updated, err := r.LocalityRepository.Update(ctx, locality)
+	if err == nil {
+		r.invalidate(locality.Id)
+	}
+	return updated, err
+}
+
+func (r *LocalityRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Locality, error) {
+	updated, err := r.LocalityRepository.PartialUpdate(ctx, id, fields)
+	if err == nil {
+		r.invalidate(id)
+	}
+	return updated, err
+}
+
+func (r *LocalityRepository) Delete(ctx context.Context, id int) error {
+	err := r.LocalityRepository.Delete(ctx, id)
+	if err == nil {
+		r.invalidate(id)
+	}
+	return err
+}
+
+// invalidate deletes the reads a write to the locality changes
+func (r *LocalityRepository) invalidate(id int) {
+	invalidateLocality(r.cache, id)
+}
+
+// invalidateNamedPlaces deletes the lists of countries and provinces, which a locality created with the names of its
+// province and country may add to. Their reads by id are not affected: the lookup of an id that did not exist yet
+// failed, and failed reads are not cached.
+func invalidateNamedPlaces(c cache.Cache) {
+	invalidate(c, countriesKey, provincesKey)
+}
+
+// invalidateLocality deletes the reads a write to a locality changes: the locality itself, the list of localities
+// and the reports, whole or restricted to the locality
+func invalidateLocality(c cache.Cache, id int) {
+	invalidate(c,
+		localitiesKey,
+		localityKey+strconv.Itoa(id),
+		localitySellersReportKey+allReportKey,
+		localitySellersReportKey+strconv.Itoa(id),
+		localityCarriersReportKey+allReportKey,
+		localityCarriersReportKey+strconv.Itoa(id),
+	)
+}
@@ -0,0 +1,126 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// localityStore keeps the localities in memory and counts the reads that reach it
+type localityStore struct {
+	repository.LocalityRepository
+	localities map[int]models.Locality
+	reads      int
+}
+
+func (s *localityStore) FindById(ctx context.Context, id int) (models.Locality, error) {
+	s.reads++
+	locality, ok := s.localities[id]
+	if !ok {
+		return models.Locality{}, repository.ErrEntityNotFound
+	}
+	return locality, nil
+}
+
+func (s *localityStore) FindAllLocality(ctx context.Context) ([]models.LocalitySellerCount, error) {
+	s.reads++
+	count := 0
+	return []models.LocalitySellerCount{{LocalityDoc: models.LocalityDoc{Id: 1, Locality: "Palermo"}, SellerCount: &count}}, nil
+}
+
+func (s *localityStore) Update(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	s.localities[locality.Id] = locality
+	return locality, nil
+}
+
+func (s *localityStore) CreateWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error) {
+	s.localities[locality.Id] = models.Locality{Id: locality.Id, Locality: locality.Locality}
+	return locality, nil
+}
+
+// failingCache fails every operation, like an unreachable Redis server
+type failingCache struct{}
+
+func (failingCache) Get(string) ([]byte, bool, error)        { return nil, false, errors.New("unreachable") }
+func (failingCache) Set(string, []byte, time.Duration) error { return errors.New("unreachable") }
+func (failingCache) Delete(...string) error                  { return errors.New("unreachable") }
+
+func TestLocalityRepository_ReadThrough(t *testing.T) {
+	// Arrange
+	store := &localityStore{localities: map[int]models.Locality{1: {Id: 1, Locality: "Palermo", ProvinceId: 1}}}
+	rp := NewLocalityRepository(store, cache.NewLRU(10), 0, 0)
+
+	// Act
+	first, firstErr := rp.FindById(context.Background(), 1)
+	second, secondErr := rp.FindById(context.Background(), 1)
+	_, missingErr := rp.FindById(context.Background(), 2)
+	_, _ = rp.FindById(context.Background(), 2)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.Equal(t, first, second)
+	require.ErrorIs(t, missingErr, repository.ErrEntityNotFound)
+	// errors are not cached, so the missing locality is read twice
+	require.Equal(t, 3, store.reads)
+}
+
+func TestLocalityRepository_WriteInvalidates(t *testing.T) {
+	// Arrange
+	store := &localityStore{localities: map[int]models.Locality{1: {Id: 1, Locality: "Palermo", ProvinceId: 1}}}
+	rp := NewLocalityRepository(store, cache.NewLRU(10), 0, 0)
+	_, _ = rp.FindById(context.Background(), 1)
+	report, _ := rp.FindAllLocality(context.Background())
+	_, _ = rp.FindAllLocality(context.Background())
+
+	// Act
+	_, err := rp.Update(context.Background(), models.Locality{Id: 1, Locality: "Recoleta", ProvinceId: 1})
+	updated, _ := rp.FindById(context.Background(), 1)
+	_, _ = rp.FindAllLocality(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "Recoleta", updated.Locality)
+	require.Equal(t, 0, *report[0].SellerCount)
+	require.Equal(t, 4, store.reads)
+}
+
+func TestLocalityRepository_CreateWithNamesInvalidatesPlaces(t *testing.T) {
+	// Arrange
+	store := &localityStore{localities: map[int]models.Locality{}}
+	c := cache.NewLRU(10)
+	rp := NewLocalityRepository(store, c, 0, 0)
+	require.NoError(t, c.Set(countriesKey, []byte(`[]`), time.Minute))
+	require.NoError(t, c.Set(provincesKey, []byte(`[]`), time.Minute))
+
+	// Act
+	_, err := rp.CreateWithNames(context.Background(), models.LocalityDoc{Id: 7, Locality: "Palermo", Province: "Buenos Aires", Country: "Argentina"})
+
+	// Assert
+	require.NoError(t, err)
+	_, countriesCached, _ := c.Get(countriesKey)
+	_, provincesCached, _ := c.Get(provincesKey)
+	require.False(t, countriesCached)
+	require.False(t, provincesCached)
+}
+
+func TestLocalityRepository_CacheFailure(t *testing.T) {
+	// Arrange
+	store := &localityStore{localities: map[int]models.Locality{1: {Id: 1, Locality: "Palermo"}}}
+	rp := NewLocalityRepository(store, failingCache{}, 0, 0)
+
+	// Act
+	locality, err := rp.FindById(context.Background(), 1)
+	_, updateErr := rp.Update(context.Background(), models.Locality{Id: 1, Locality: "Recoleta"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "Palermo", locality.Locality)
+	require.NoError(t, updateErr)
+}
@@ -0,0 +1,44 @@
+package cached
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"strconv"
+	"time"
+)
+
+// keys of the cached order status reads
+const (
+	orderStatusesKey = "orderStatuses:all"
+	orderStatusKey   = "orderStatuses:id:"
+)
+
+// OrderStatusRepository caches the reads of a repository.OrderStatusRepository. The order statuses are never
+// written through the API, so nothing invalidates them.
+type OrderStatusRepository struct {
+	repository.OrderStatusRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewOrderStatusRepository returns rp with its reads cached in c, a zero TTL takes its default value
+func NewOrderStatusRepository(rp repository.OrderStatusRepository, c cache.Cache, ttl time.Duration) *OrderStatusRepository {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &OrderStatusRepository{OrderStatusRepository: rp, cache: c, ttl: ttl}
+}
+
+func (r *OrderStatusRepository) FindAll(ctx context.Context) ([]models.OrderStatus, error) {
+	return readThrough(r.cache, orderStatusesKey, r.ttl, func() ([]models.OrderStatus, error) {
+		return r.OrderStatusRepository.FindAll(ctx)
+	})
+}
+
+func (r *OrderStatusRepository) FindById(ctx context.Context, id int) (models.OrderStatus, error) {
+	return readThrough(r.cache, orderStatusKey+strconv.Itoa(id), r.ttl, func() (models.OrderStatus, error) {
+		return r.OrderStatusRepository.FindById(ctx, id)
+	})
+}
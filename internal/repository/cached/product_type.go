@@ -0,0 +1,81 @@
+package cached
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"strconv"
+	"time"
+)
+
+// keys of the cached product type reads
+const (
+	productTypesKey = "productTypes:all"
+	productTypeKey  = "productTypes:id:"
+)
+
+// ProductTypeRepository caches the reads of a repository.ProductTypeRepository. The usage of the product types is
+// not cached, deletions are checked against it.
+type ProductTypeRepository struct {
+	repository.ProductTypeRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewProductTypeRepository returns rp with its reads cached in c, a zero TTL takes its default value
+func NewProductTypeRepository(rp repository.ProductTypeRepository, c cache.Cache, ttl time.Duration) *ProductTypeRepository {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &ProductTypeRepository{ProductTypeRepository: rp, cache: c, ttl: ttl}
+}
+
+func (r *ProductTypeRepository) FindAll(ctx context.Context) ([]models.ProductType, error) {
+	return readThrough(r.cache, productTypesKey, r.ttl, func() ([]models.ProductType, error) {
+		return r.ProductTypeRepository.FindAll(ctx)
+	})
+}
+
+func (r *ProductTypeRepository) FindById(ctx context.Context, id int) (models.ProductType, error) {
+	return readThrough(r.cache, productTypeKey+strconv.Itoa(id), r.ttl, func() (models.ProductType, error) {
+		return r.ProductTypeRepository.FindById(ctx, id)
+	})
+}
+
+func (r *ProductTypeRepository) Create(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	created, err := r.ProductTypeRepository.Create(ctx, productType)
+	if err == nil {
+		r.invalidate(created.Id)
+	}
+	return created, err
+}
+
+func (r *ProductTypeRepository) Update(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	updated, err := r.ProductTypeRepository.Update(ctx, productType)
+	if err == nil {
+		r.invalidate(productType.Id)
+	}
+	return updated, err
+}
+
+func (r *ProductTypeRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.ProductType, error) {
+	updated, err := r.ProductTypeRepository.PartialUpdate(ctx, id, fields)
+	if err == nil {
+		r.invalidate(id)
+	}
+	return updated, err
+}
+
+func (r *ProductTypeRepository) Delete(ctx context.Context, id int) error {
+	err := r.ProductTypeRepository.Delete(ctx, id)
+	if err == nil {
+		r.invalidate(id)
+	}
+	return err
+}
+
+// invalidate deletes the reads a write to the product type changes
+func (r *ProductTypeRepository) invalidate(id int) {
+	invalidate(r.cache, productTypesKey, productTypeKey+strconv.Itoa(id))
+}
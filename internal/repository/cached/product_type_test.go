@@ -0,0 +1,71 @@
+package cached
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// productTypeStore keeps the product types in memory and counts the reads that reach it
+type productTypeStore struct {
+	repository.ProductTypeRepository
+	productTypes map[int]models.ProductType
+	products     int
+	reads        int
+}
+
+func (s *productTypeStore) FindAll(ctx context.Context) ([]models.ProductType, error) {
+	s.reads++
+	var productTypes []models.ProductType
+	for _, productType := range s.productTypes {
+		productTypes = append(productTypes, productType)
+	}
+	return productTypes, nil
+}
+
+func (s *productTypeStore) FindUsage(ctx context.Context, id int) (models.ProductTypeUsage, error) {
+	s.reads++
+	return models.ProductTypeUsage{ProductTypeId: id, ProductsCount: s.products}, nil
+}
+
+func (s *productTypeStore) Delete(ctx context.Context, id int) error {
+	delete(s.productTypes, id)
+	return nil
+}
+
+func TestProductTypeRepository_UsageIsNotCached(t *testing.T) {
+	// Arrange
+	store := &productTypeStore{productTypes: map[int]models.ProductType{1: {Id: 1, Name: "Fruits"}}, products: 1}
+	rp := NewProductTypeRepository(store, cache.NewLRU(10), 0)
+	_, _ = rp.FindUsage(context.Background(), 1)
+
+	// Act
+	store.products = 0
+	usage, err := rp.FindUsage(context.Background(), 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, usage.InUse())
+	require.Equal(t, 2, store.reads)
+}
+
+func TestProductTypeRepository_DeleteInvalidates(t *testing.T) {
+	// Arrange
+	store := &productTypeStore{productTypes: map[int]models.ProductType{1: {Id: 1, Name: "Fruits"}}}
+	rp := NewProductTypeRepository(store, cache.NewLRU(10), 0)
+	_, _ = rp.FindAll(context.Background())
+	_, _ = rp.FindAll(context.Background())
+
+	// Act
+	err := rp.Delete(context.Background(), 1)
+	productTypes, _ := rp.FindAll(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, productTypes)
+	require.Equal(t, 2, store.reads)
+}
@@ -0,0 +1,104 @@
+package cached
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"strconv"
+	"time"
+)
+
+// keys of the cached province reads
+const (
+	provincesKey        = "provinces:all"
+	provinceKey         = "provinces:id:"
+	countryProvincesKey = "provinces:country:"
+)
+
+// ProvinceRepository caches the reads of a repository.ProvinceRepository
+type ProvinceRepository struct {
+	repository.ProvinceRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewProvinceRepository returns rp with its reads cached in c, a zero TTL takes its default value
+func NewProvinceRepository(rp repository.ProvinceRepository, c cache.Cache, ttl time.Duration) *ProvinceRepository {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &ProvinceRepository{ProvinceRepository: rp, cache: c, ttl: ttl}
+}
+
+func (r *ProvinceRepository) FindAll(ctx context.Context) ([]models.Province, error) {
+	return readThrough(r.cache, provincesKey, r.ttl, func() ([]models.Province, error) {
+		return r.ProvinceRepository.FindAll(ctx)
+	})
+}
+
+func (r *ProvinceRepository) FindById(ctx context.Context, id int) (models.Province, error) {
+	return readThrough(r.cache, provinceKey+strconv.Itoa(id), r.ttl, func() (models.Province, error) {
+		return r.ProvinceRepository.FindById(ctx, id)
+	})
+}
+
+func (r *ProvinceRepository) FindByCountry(ctx context.Context, countryId int) ([]models.Province, error) {
+	return readThrough(r.cache, countryProvincesKey+strconv.Itoa(countryId), r.ttl, func() ([]models.Province, error) {
+		return r.ProvinceRepository.FindByCountry(ctx, countryId)
+	})
+}
+
+func (r *ProvinceRepository) Create(ctx context.Context, province models.Province) (models.Province, error) {
+	created, err := r.ProvinceRepository.Create(ctx, province)
+	if err == nil {
+		r.invalidate(created)
+	}
+	return created, err
+}
+
+func (r *ProvinceRepository) Update(ctx context.Context, province models.Province) (models.Province, error) {
+	previous, previousErr := r.ProvinceRepository.FindById(ctx, province.Id)
+	updated, err := r.ProvinceRepository.Update(ctx, province)
+	if err == nil {
+		r.invalidate(updated)
+		if previousErr == nil {
+			r.invalidate(previous)
+		}
+	}
+	return updated, err
+}
+
+func (r *ProvinceRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Province, error) {
+	previous, previousErr := r.ProvinceRepository.FindById(ctx, id)
+	updated, err := r.ProvinceRepository.PartialUpdate(ctx, id, fields)
+	if err == nil {
+		r.invalidate(updated)
+		if previousErr == nil {
+			r.invalidate(previous)
+		}
+	}
+	return updated, err
+}
+
+func (r *ProvinceRepository) Delete(ctx context.Context, id int) error {
+	previous, previousErr := r.ProvinceRepository.FindById(ctx, id)
+	err := r.ProvinceRepository.Delete(ctx, id)
+	if err == nil {
+		if previousErr != nil {
+			previous = models.Province{Id: id}
+		}
+		r.invalidate(previous)
+	}
+	return err
+}
+
+// invalidate deletes the reads a write to the province changes, including the provinces of its country. A province
+// moved to another country is invalidated with both its previous and its new state.
+func (r *ProvinceRepository) invalidate(province models.Province) {
+	invalidate(r.cache,
+		provincesKey,
+		provinceKey+strconv.Itoa(province.Id),
+		countryProvincesKey+strconv.Itoa(province.CountryId),
+	)
+}
@@ -0,0 +1,58 @@
+package cached
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// provinceStore keeps the provinces in memory
+type provinceStore struct {
+	repository.ProvinceRepository
+	provinces map[int]models.Province
+}
+
+func (s *provinceStore) FindById(ctx context.Context, id int) (models.Province, error) {
+	province, ok := s.provinces[id]
+	if !ok {
+		return models.Province{}, repository.ErrEntityNotFound
+	}
+	return province, nil
+}
+
+func (s *provinceStore) FindByCountry(ctx context.Context, countryId int) ([]models.Province, error) {
+	provinces := []models.Province{}
+	for _, province := range s.provinces {
+		if province.CountryId == countryId {
+			provinces = append(provinces, province)
+		}
+	}
+	return provinces, nil
+}
+
+func (s *provinceStore) Update(ctx context.Context, province models.Province) (models.Province, error) {
+	s.provinces[province.Id] = province
+	return province, nil
+}
+
+func TestProvinceRepository_MoveInvalidatesBothCountries(t *testing.T) {
+	// Arrange
+	store := &provinceStore{provinces: map[int]models.Province{15: {Id: 15, Province: "San Salvador", CountryId: 3}}}
+	rp := NewProvinceRepository(store, cache.NewLRU(10), 0)
+	_, _ = rp.FindByCountry(context.Background(), 3)
+	_, _ = rp.FindByCountry(context.Background(), 4)
+
+	// Act
+	_, err := rp.Update(context.Background(), models.Province{Id: 15, Province: "San Salvador", CountryId: 4})
+	previous, _ := rp.FindByCountry(context.Background(), 3)
+	current, _ := rp.FindByCountry(context.Background(), 4)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, previous)
+	require.Equal(t, []models.Province{{Id: 15, Province: "San Salvador", CountryId: 4}}, current)
+}
@@ -0,0 +1,90 @@
+package cached
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// Transactor invalidates the cached reads the units of work of a repository.Transactor change. The repositories of
+// a transaction are not cached, since the cache must not serve what is not committed yet, so the keys they affect
+// are recorded and deleted once the transaction is committed.
+type Transactor struct {
+	repository.Transactor
+	cache cache.Cache
+}
+
+// NewTransactor returns tr with the reads its transactions change invalidated in c
+func NewTransactor(tr repository.Transactor, c cache.Cache) *Transactor {
+	return &Transactor{Transactor: tr, cache: c}
+}
+
+func (t *Transactor) Transaction(ctx context.Context, fn func(repos repository.Repositories) error) error {
+	var localities []int
+	var named bool
+	err := t.Transactor.Transaction(ctx, func(repos repository.Repositories) error {
+		localities, named = nil, false
+		repos.Locality = &localityWrites{LocalityRepository: repos.Locality, ids: &localities, named: &named}
+		return fn(repos)
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range localities {
+		invalidateLocality(t.cache, id)
+	}
+	if named {
+		invalidateNamedPlaces(t.cache)
+	}
+	return nil
+}
+
+// localityWrites records the ids of the localities written through a repository.LocalityRepository, and whether any
+// was created with the names of its province and country
+type localityWrites struct {
+	repository.LocalityRepository
+	ids   *[]int
+	named *bool
+}
+
+func (r *localityWrites) Create(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	created, err := r.LocalityRepository.Create(ctx, locality)
+	if err == nil {
+		*r.ids = append(*r.ids, created.Id)
+	}
+	return created, err
+}
+
+func (r *localityWrites) CreateWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error) {
+	created, err := r.LocalityRepository.CreateWithNames(ctx, locality)
+	if err == nil {
+		*r.ids = append(*r.ids, created.Id)
+		*r.named = true
+	}
+	return created, err
+}
+
+func (r *localityWrites) Update(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	updated, err := r.LocalityRepository.Update(ctx, locality)
+	if err == nil {
+		*r.ids = append(*r.ids, locality.Id)
+	}
+	return updated, err
+}
+
+func (r *localityWrites) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Locality, error) {
+	updated, err := r.LocalityRepository.PartialUpdate(ctx, id, fields)
+	if err == nil {
+		*r.ids = append(*r.ids, id)
+	}
+	return updated, err
+}
+
+func (r *localityWrites) Delete(ctx context.Context, id int) error {
+	err := r.LocalityRepository.Delete(ctx, id)
+	if err == nil {
+		*r.ids = append(*r.ids, id)
+	}
+	return err
+}
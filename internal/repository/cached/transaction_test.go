@@ -0,0 +1,79 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/cache"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// transactor runs the units of work over the locality store, without rolling anything back
+type transactor struct {
+	localities repository.LocalityRepository
+}
+
+func (t transactor) Transaction(ctx context.Context, fn func(repos repository.Repositories) error) error {
+	return fn(repository.Repositories{Locality: t.localities})
+}
+
+func TestTransactor_Transaction(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		expectedReads int
+	}{
+		{name: "Success - Committed writes are invalidated", expectedReads: 2},
+		{name: "Error - Rolled back writes are not invalidated", err: errors.New("rolled back"), expectedReads: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			store := &localityStore{localities: map[int]models.Locality{1: {Id: 1, Locality: "Palermo", ProvinceId: 1}}}
+			c := cache.NewLRU(10)
+			rp := NewLocalityRepository(store, c, 0, 0)
+			tr := NewTransactor(transactor{localities: store}, c)
+			_, _ = rp.FindById(context.Background(), 1)
+
+			// Act
+			err := tr.Transaction(context.Background(), func(repos repository.Repositories) error {
+				if _, err := repos.Locality.Update(context.Background(), models.Locality{Id: 1, Locality: "Recoleta", ProvinceId: 1}); err != nil {
+					return err
+				}
+				return tt.err
+			})
+			_, _ = rp.FindById(context.Background(), 1)
+
+			// Assert
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.expectedReads, store.reads)
+		})
+	}
+}
+
+func TestTransactor_Transaction_CreateWithNamesInvalidatesPlaces(t *testing.T) {
+	// Arrange
+	store := &localityStore{localities: map[int]models.Locality{}}
+	c := cache.NewLRU(10)
+	tr := NewTransactor(transactor{localities: store}, c)
+	require.NoError(t, c.Set(countriesKey, []byte(`[]`), time.Minute))
+	require.NoError(t, c.Set(provincesKey, []byte(`[]`), time.Minute))
+
+	// Act
+	err := tr.Transaction(context.Background(), func(repos repository.Repositories) error {
+		_, err := repos.Locality.CreateWithNames(context.Background(), models.LocalityDoc{Id: 7, Locality: "Palermo", Province: "Buenos Aires", Country: "Argentina"})
+		return err
+	})
+
+	// Assert
+	require.NoError(t, err)
+	_, countriesCached, _ := c.Get(countriesKey)
+	_, provincesCached, _ := c.Get(provincesKey)
+	require.False(t, countriesCached)
+	require.False(t, provincesCached)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+// CountSessionRepository stores the physical counts of the sections and posts their variances to the stock ledger
+type CountSessionRepository interface {
+	// FindAll retrieves the count sessions along with their lines
+	FindAll(ctx context.Context) ([]models.CountSession, error)
+	// FindById retrieves a count session along with its lines
+	FindById(ctx context.Context, id int) (models.CountSession, error)
+	// Create opens a count session with a line for every batch of its section, expected to hold its current quantity.
+	// It returns ErrCountSessionOpen when the section is already being counted.
+	Create(ctx context.Context, session models.CountSession) (models.CountSession, error)
+	// RecordCount updates the counted quantity of a line of an open session, its variance is taken against the
+	// quantity the batch holds when it is counted
+	RecordCount(ctx context.Context, line models.CountLine) (models.CountLine, error)
+	// Approve closes an open session and posts an adjustment for the variance of every line
+	Approve(ctx context.Context, id int, at time.Time) (models.CountSession, error)
+	// FindWarehouseVariance sums the variances of the approved sessions of the sections of a warehouse
+	FindWarehouseVariance(ctx context.Context, warehouseId int) (models.WarehouseCountVariance, error)
+	// FindAllWarehouseVariances sums the variances of the approved sessions of every warehouse
+	FindAllWarehouseVariances(ctx context.Context) ([]models.WarehouseCountVariance, error)
+	// FindEmployeeVariance sums the variances of the batches counted by an employee in approved sessions
+	FindEmployeeVariance(ctx context.Context, employeeId int) (models.EmployeeCountVariance, error)
+	// FindAllEmployeeVariances sums the variances of the batches counted by every employee in approved sessions
+	FindAllEmployeeVariances(ctx context.Context) ([]models.EmployeeCountVariance, error)
+}
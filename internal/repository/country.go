@@ -0,0 +1,8 @@
+package repository
+
+import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+
+// CountryRepository is an interface that represents a Country repository
+type CountryRepository interface {
+	Repository[int, models.Country]
+}
@@ -0,0 +1,285 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"reflect"
+)
+
+// auditBeforeKey is the statement instance key where the state prior to an update or delete is kept
+const auditBeforeKey = "audit:before"
+
+// unauditedTables holds the technical tables whose mutations are not business changes and are not recorded
+var unauditedTables = map[string]bool{
+	models.AuditLog{}.TableName():            true,
+	models.IdempotencyKey{}.TableName():      true,
+	models.WebhookSubscription{}.TableName(): true,
+	models.WebhookDelivery{}.TableName():     true,
+	models.OutboxEvent{}.TableName():         true,
+}
+
+// AuditRepository stores and searches the audit trail
+type AuditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Create appends a new entry to the audit trail
+func (r *AuditRepository) Create(ctx context.Context, entry models.AuditLog) (models.AuditLog, error) {
+	result := r.db.WithContext(ctx).Create(&entry)
+	if result.Error != nil {
+		return models.AuditLog{}, result.Error
+	}
+	return entry, nil
+}
+
+// FindByFilter returns the entries matching every criteria set in the filter, newest first
+func (r *AuditRepository) FindByFilter(ctx context.Context, filter models.AuditFilter) ([]models.AuditLog, error) {
+	entries := make([]models.AuditLog, 0)
+
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{})
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityId != "" {
+		query = query.Where("entity_id = ?", filter.EntityId)
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.RequestId != "" {
+		query = query.Where("request_id = ?", filter.RequestId)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", *filter.To)
+	}
+
+	result := query.Order("id DESC").Find(&entries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return entries, nil
+}
+
+// AuditPlugin is a GORM plugin that records every create, update and delete executed through the connection in
+// the audit_logs table, within the same transaction as the mutation itself.
+type AuditPlugin struct{}
+
+func NewAuditPlugin() *AuditPlugin {
+	return &AuditPlugin{}
+}
+
+// Name returns the name of the plugin
+func (p *AuditPlugin) Name() string {
+	return "audit"
+}
+
+// Initialize registers the audit callbacks around the default GORM callbacks
+func (p *AuditPlugin) Initialize(db *gorm.DB) error {
+	callbacks := []error{
+		db.Callback().Update().After("gorm:setup_reflect_value").Before("gorm:update").
+			Register("audit:before_update", p.snapshot),
+		db.Callback().Delete().After("gorm:begin_transaction").Before("gorm:delete").
+			Register("audit:before_delete", p.snapshot),
+		db.Callback().Create().After("gorm:after_create").Before("gorm:commit_or_rollback_transaction").
+			Register("audit:after_create", p.record(models.AuditActionCreate)),
+		db.Callback().Update().After("gorm:after_update").Before("gorm:commit_or_rollback_transaction").
+			Register("audit:after_update", p.record(models.AuditActionUpdate)),
+		db.Callback().Delete().After("gorm:after_delete").Before("gorm:commit_or_rollback_transaction").
+			Register("audit:after_delete", p.record(models.AuditActionDelete)),
+	}
+	for _, err := range callbacks {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// audited reports whether the statement targets an entity that must be recorded
+func (p *AuditPlugin) audited(db *gorm.DB) bool {
+	return db.Error == nil &&
+		db.Statement.Schema != nil &&
+		db.Statement.Schema.PrioritizedPrimaryField != nil &&
+		!unauditedTables[db.Statement.Table]
+}
+
+// snapshot loads the rows an update or delete is about to modify
+func (p *AuditPlugin) snapshot(db *gorm.DB) {
+	if !p.audited(db) {
+		return
+	}
+
+	query := p.session(db)
+	if where, ok := db.Statement.Clauses["WHERE"].Expression.(clause.Where); ok {
+		query = query.Clauses(where)
+	}
+	if ids := p.primaryKeys(db); len(ids) > 0 {
+		query = query.Where(clause.IN{Column: clause.PrimaryColumn, Values: ids})
+	}
+
+	var rows []map[string]interface{}
+	if err := query.Find(&rows).Error; err != nil {
+		_ = db.AddError(err)
+		return
+	}
+	db.InstanceSet(auditBeforeKey, rows)
+}
+
+// record builds the audit entries of the executed statement and stores them
+func (p *AuditPlugin) record(action string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		if !p.audited(db) || db.Statement.RowsAffected == 0 {
+			return
+		}
+		pk := db.Statement.Schema.PrioritizedPrimaryField.DBName
+
+		before := make(map[string]map[string]interface{})
+		if value, ok := db.InstanceGet(auditBeforeKey); ok {
+			for _, row := range value.([]map[string]interface{}) {
+				before[fmt.Sprint(normalize(row[pk]))] = row
+			}
+		}
+
+		var ids []interface{}
+		if action == models.AuditActionCreate {
+			ids = p.primaryKeys(db)
+		} else {
+			for _, row := range before {
+				ids = append(ids, row[pk])
+			}
+		}
+
+		after := make(map[string]map[string]interface{})
+		if action != models.AuditActionDelete && len(ids) > 0 {
+			var rows []map[string]interface{}
+			err := p.session(db).Where(clause.IN{Column: clause.PrimaryColumn, Values: ids}).Find(&rows).Error
+			if err != nil {
+				_ = db.AddError(err)
+				return
+			}
+			for _, row := range rows {
+				after[fmt.Sprint(normalize(row[pk]))] = row
+			}
+		}
+
+		md, _ := audit.FromContext(db.Statement.Context)
+		entries := make([]models.AuditLog, 0, len(ids))
+		for _, id := range ids {
+			key := fmt.Sprint(normalize(id))
+			entries = append(entries, models.AuditLog{
+				Actor:      md.Actor,
+				RequestId:  md.RequestId,
+				Action:     action,
+				EntityType: db.Statement.Table,
+				EntityId:   key,
+				Before:     marshalRow(before[key]),
+				After:      marshalRow(after[key]),
+				Diff:       marshalDiff(before[key], after[key]),
+			})
+		}
+		if len(entries) == 0 {
+			return
+		}
+
+		if err := db.Session(&gorm.Session{NewDB: true}).Create(&entries).Error; err != nil {
+			_ = db.AddError(err)
+		}
+	}
+}
+
+// session returns a fresh query over the statement's table that shares its connection, and therefore its transaction
+func (p *AuditPlugin) session(db *gorm.DB) *gorm.DB {
+	model := reflect.New(db.Statement.Schema.ModelType).Interface()
+	return db.Session(&gorm.Session{NewDB: true}).Model(model).Table(db.Statement.Table)
+}
+
+// primaryKeys returns the non-zero primary key values held by the statement's model, either a struct or a slice
+func (p *AuditPlugin) primaryKeys(db *gorm.DB) []interface{} {
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	value := reflect.Indirect(db.Statement.ReflectValue)
+
+	var ids []interface{}
+	switch value.Kind() {
+	case reflect.Struct:
+		if id, zero := field.ValueOf(db.Statement.Context, value); !zero {
+			ids = append(ids, id)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			item := reflect.Indirect(value.Index(i))
+			if item.Kind() != reflect.Struct {
+				continue
+			}
+			if id, zero := field.ValueOf(db.Statement.Context, item); !zero {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// normalize converts the raw values returned by the driver into JSON friendly ones
+func normalize(value interface{}) interface{} {
+	if b, ok := value.([]byte); ok {
+		return string(b)
+	}
+	return value
+}
+
+// marshalRow encodes a row snapshot, a missing row is encoded as null
+func marshalRow(row map[string]interface{}) json.RawMessage {
+	if row == nil {
+		return json.RawMessage("null")
+	}
+	normalized := make(map[string]interface{}, len(row))
+	for column, value := range row {
+		normalized[column] = normalize(value)
+	}
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return data
+}
+
+// marshalDiff encodes the columns whose value changed between both snapshots as {"column": {"from": x, "to": y}}
+func marshalDiff(before, after map[string]interface{}) json.RawMessage {
+	type change struct {
+		From interface{} `json:"from"`
+		To   interface{} `json:"to"`
+	}
+
+	diff := make(map[string]change)
+	for column, value := range before {
+		to := normalize(after[column])
+		if _, ok := after[column]; !ok || fmt.Sprint(normalize(value)) != fmt.Sprint(to) {
+			diff[column] = change{From: normalize(value), To: to}
+		}
+	}
+	for column, value := range after {
+		if _, ok := before[column]; !ok {
+			diff[column] = change{From: nil, To: normalize(value)}
+		}
+	}
+
+	data, err := json.Marshal(diff)
+	if err != nil {
+		return json.RawMessage("{}")
+	}
+	return data
+}
@@ -0,0 +1,227 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// jsonArg captures a JSON argument sent to the database so it can be asserted after the statement runs
+type jsonArg struct {
+	value map[string]interface{}
+}
+
+func (a *jsonArg) Match(v driver.Value) bool {
+	var raw []byte
+	switch value := v.(type) {
+	case []byte:
+		raw = value
+	case string:
+		raw = []byte(value)
+	default:
+		return false
+	}
+	a.value = nil
+	return json.Unmarshal(raw, &a.value) == nil
+}
+
+type AuditTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	repo *AuditRepository
+}
+
+var sectionColumns = []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity",
+	"minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id"}
+
+func (s *AuditTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(gormDB.Use(NewAuditPlugin()))
+
+	s.db = gormDB
+	s.mock = mock
+	s.repo = NewAuditRepository(gormDB)
+}
+
+func (s *AuditTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *AuditTestSuite) TestCreate_RecordsAfterState() {
+	// Arrange
+	section := models.Section{SectionNumber: "S-01", CurrentTemperature: 5, MinimumTemperature: 1, CurrentCapacity: 10,
+		MinimumCapacity: 5, MaximumCapacity: 20, WarehouseId: 1, ProductTypeId: 2}
+	after := &jsonArg{}
+	diff := &jsonArg{}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `sections`")).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows(sectionColumns).AddRow(7, "S-01", 5, 1, 10, 5, 20, 1, 2))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `audit_logs`")).
+		WithArgs("jdoe", "req-1", models.AuditActionCreate, "sections", "7", []byte("null"), after, diff, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	ctx := audit.WithMetadata(context.Background(), audit.Metadata{Actor: "jdoe", RequestId: "req-1"})
+	created, err := NewSectionRepository(s.db).Create(ctx, section)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(7, created.Id)
+	s.Equal("S-01", after.value["section_number"])
+	s.Equal(map[string]interface{}{"from": nil, "to": "S-01"}, diff.value["section_number"])
+}
+
+func (s *AuditTestSuite) TestUpdate_RecordsBeforeAfterDiff() {
+	// Arrange
+	section := models.Section{Id: 5, SectionNumber: "S-05", CurrentTemperature: 8, MinimumTemperature: 1,
+		CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseId: 1, ProductTypeId: 2}
+	before := &jsonArg{}
+	after := &jsonArg{}
+	diff := &jsonArg{}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(sectionColumns).AddRow(5, "S-05", 4, 1, 10, 5, 20, 1, 2))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(sectionColumns).AddRow(5, "S-05", 8, 1, 10, 5, 20, 1, 2))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `audit_logs`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), models.AuditActionUpdate, "sections", "5", before, after, diff, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	_, err := NewSectionRepository(s.db).Update(context.Background(), section)
+
+	// Assert
+	s.NoError(err)
+	s.EqualValues(4, before.value["current_temperature"])
+	s.EqualValues(8, after.value["current_temperature"])
+	s.Equal(map[string]interface{}{"from": float64(4), "to": float64(8)}, diff.value["current_temperature"])
+	s.Len(diff.value, 1)
+}
+
+func (s *AuditTestSuite) TestDelete_RecordsBeforeState() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(sectionColumns).AddRow(5, "S-05", 4, 1, 10, 5, 20, 1, 2))
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `audit_logs`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), models.AuditActionDelete, "sections", "5", sqlmock.AnyArg(), []byte("null"), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	err := NewSectionRepository(s.db).Delete(context.Background(), 5)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *AuditTestSuite) TestDelete_NoRowsAffected() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(99).
+		WillReturnRows(sqlmock.NewRows(sectionColumns))
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(99).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	// Act
+	err := NewSectionRepository(s.db).Delete(context.Background(), 99)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *AuditTestSuite) TestCreate_AuditFailureRollsBack() {
+	// Arrange
+	section := models.Section{SectionNumber: "S-01", WarehouseId: 1, ProductTypeId: 2}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `sections`")).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ?")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows(sectionColumns).AddRow(7, "S-01", 0, 0, 0, 0, 0, 1, 2))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `audit_logs`")).
+		WillReturnError(errors.New("audit storage unavailable"))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := NewSectionRepository(s.db).Create(context.Background(), section)
+
+	// Assert
+	s.Error(err)
+}
+
+func (s *AuditTestSuite) TestFindByFilter_Success() {
+	// Arrange
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	filter := models.AuditFilter{EntityType: "sections", EntityId: "5", Actor: "jdoe", From: &from}
+	createdAt := time.Date(2025, 7, 2, 10, 0, 0, 0, time.UTC)
+
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs` WHERE entity_type = ? AND entity_id = ? AND actor = ? AND created_at >= ? ORDER BY id DESC")).
+		WithArgs("sections", "5", "jdoe", from).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "actor", "request_id", "action", "entity_type", "entity_id", "before", "after", "diff", "created_at"}).
+			AddRow(1, "jdoe", "req-1", "update", "sections", "5", []byte(`{"id":5}`), []byte(`{"id":5}`), []byte(`{}`), createdAt))
+
+	// Act
+	entries, err := s.repo.FindByFilter(context.Background(), filter)
+
+	// Assert
+	s.NoError(err)
+	s.Len(entries, 1)
+	s.Equal("update", entries[0].Action)
+	s.JSONEq(`{"id":5}`, string(entries[0].Before))
+}
+
+func (s *AuditTestSuite) TestFindByFilter_Error() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_logs` ORDER BY id DESC")).
+		WillReturnError(errors.New("db error"))
+
+	// Act
+	entries, err := s.repo.FindByFilter(context.Background(), models.AuditFilter{})
+
+	// Assert
+	s.Error(err)
+	s.Nil(entries)
+}
+
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
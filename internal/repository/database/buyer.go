@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -17,10 +18,10 @@ func NewBuyerRepository(db *gorm.DB) *BuyerRepository {
 	}
 }
 
-func (s *BuyerRepository) FindAll() ([]models.Buyer, error) {
+func (s *BuyerRepository) FindAll(ctx context.Context) ([]models.Buyer, error) {
 	var buyers []models.Buyer
 
-	result := s.db.Find(&buyers)
+	result := s.db.WithContext(ctx).Find(&buyers)
 
 	if result.Error != nil {
 		return nil, result.Error
@@ -29,10 +30,10 @@ func (s *BuyerRepository) FindAll() ([]models.Buyer, error) {
 	return buyers, nil
 }
 
-func (s *BuyerRepository) FindById(id int) (models.Buyer, error) {
+func (s *BuyerRepository) FindById(ctx context.Context, id int) (models.Buyer, error) {
 	var buyer models.Buyer
 
-	result := s.db.First(&buyer, id)
+	result := s.db.WithContext(ctx).First(&buyer, id)
 
 	if result.Error != nil {
 		return models.Buyer{}, result.Error
@@ -41,8 +42,8 @@ func (s *BuyerRepository) FindById(id int) (models.Buyer, error) {
 	return buyer, nil
 }
 
-func (s *BuyerRepository) Create(buyer models.Buyer) (models.Buyer, error) {
-	result := s.db.Create(&buyer)
+func (s *BuyerRepository) Create(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
+	result := s.db.WithContext(ctx).Create(&buyer)
 
 	if result.Error != nil {
 		return models.Buyer{}, result.Error
@@ -51,8 +52,8 @@ func (s *BuyerRepository) Create(buyer models.Buyer) (models.Buyer, error) {
 	return buyer, nil
 }
 
-func (s *BuyerRepository) Update(buyer models.Buyer) (models.Buyer, error) {
-	result := s.db.Save(&buyer)
+func (s *BuyerRepository) Update(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
+	result := s.db.WithContext(ctx).Save(&buyer)
 
 	if result.Error != nil {
 		return models.Buyer{}, result.Error
@@ -61,15 +62,15 @@ func (s *BuyerRepository) Update(buyer models.Buyer) (models.Buyer, error) {
 	return buyer, nil
 }
 
-func (s *BuyerRepository) PartialUpdate(id int, fields map[string]interface{}) (models.Buyer, error) {
+func (s *BuyerRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Buyer, error) {
 	var buyer models.Buyer
 
-	result := s.db.First(&buyer, id)
+	result := s.db.WithContext(ctx).First(&buyer, id)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Buyer{}, repository.ErrEntityNotFound
 	}
 
-	result = s.db.Model(&buyer).Updates(fields)
+	result = s.db.WithContext(ctx).Model(&buyer).Updates(fields)
 	if result.Error != nil {
 		return models.Buyer{}, result.Error
 	}
@@ -77,8 +78,8 @@ func (s *BuyerRepository) PartialUpdate(id int, fields map[string]interface{}) (
 	return buyer, nil
 }
 
-func (s *BuyerRepository) Delete(id int) error {
-	result := s.db.Delete(&models.Buyer{}, id)
+func (s *BuyerRepository) Delete(ctx context.Context, id int) error {
+	result := s.db.WithContext(ctx).Delete(&models.Buyer{}, id)
 
 	if result.RowsAffected < 1 {
 		return repository.ErrEntityNotFound
@@ -86,12 +87,12 @@ func (s *BuyerRepository) Delete(id int) error {
 
 	return nil
 }
-func (r *BuyerRepository) FindByPurchaseOrderReport(id int) ([]models.BuyerReport, error) {
+func (r *BuyerRepository) FindByPurchaseOrderReport(ctx context.Context, id int) ([]models.BuyerReport, error) {
 	var reports []models.BuyerReport
 
 	if id == 0 {
 		// Obtener todos los buyers con su conteo de órdenes
-		err := r.db.
+		err := r.db.WithContext(ctx).
 			Table("buyers").
 			Select("buyers.id, buyers.card_number_id, buyers.first_name, buyers.last_name, COUNT(purchase_orders.id) AS purchase_orders_count").
 			Joins("LEFT JOIN purchase_orders ON purchase_orders.buyer_id = buyers.id").
@@ -102,13 +103,13 @@ func (r *BuyerRepository) FindByPurchaseOrderReport(id int) ([]models.BuyerRepor
 			return nil, err
 		}
 	} else {
-		_, err := r.FindById(id)
+		_, err := r.FindById(ctx, id)
 		if err != nil {
 			return reports, err
 		}
 		// Obtener un solo buyer con su conteo de órdenes
 		var report models.BuyerReport
-		err = r.db.
+		err = r.db.WithContext(ctx).
 			Table("buyers").
 			Select("buyers.id, buyers.card_number_id, buyers.first_name, buyers.last_name,  COUNT(purchase_orders.id) AS purchase_orders_count").
 			Joins("LEFT JOIN purchase_orders ON purchase_orders.buyers_id = buyers.id").
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -71,7 +72,7 @@ func (s *BuyerRepositoryTestSuite) TestFindAll_Success() {
 
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `buyers`")).WillReturnRows(rows)
 
-	buyers, err := s.repo.FindAll()
+	buyers, err := s.repo.FindAll(context.Background())
 
 	s.NoError(err)
 	s.Len(buyers, 2)
@@ -88,7 +89,7 @@ func (s *BuyerRepositoryTestSuite) TestFindAll_DatabaseError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `buyers`")).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	buyers, err := s.repo.FindAll()
+	buyers, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -113,7 +114,7 @@ func (s *BuyerRepositoryTestSuite) TestFindById_Success() {
 		WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	buyer, err := s.repo.FindById(1)
+	buyer, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -129,7 +130,7 @@ func (s *BuyerRepositoryTestSuite) TestFindById_NotFound() {
 		WithArgs(999, 1).WillReturnError(repository.ErrEntityNotFound)
 
 	// Act
-	buyer, err := s.repo.FindById(999)
+	buyer, err := s.repo.FindById(context.Background(), 999)
 
 	// Assert
 	s.Error(err)
@@ -152,7 +153,7 @@ func (s *BuyerRepositoryTestSuite) TestCreate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	createdBuyer, err := s.repo.Create(expectedBuyer)
+	createdBuyer, err := s.repo.Create(context.Background(), expectedBuyer)
 
 	// Assert
 	s.NoError(err)
@@ -179,7 +180,7 @@ func (s *BuyerRepositoryTestSuite) TestCreate_DatabaseError() {
 
 	// Act
 
-	createdBuyer, err := s.repo.Create(expectedBuyer)
+	createdBuyer, err := s.repo.Create(context.Background(), expectedBuyer)
 
 	// Assert
 	s.Error(err)
@@ -203,7 +204,7 @@ func (s *BuyerRepositoryTestSuite) TestUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedBuyer, err := s.repo.Update(existingBuyer)
+	updatedBuyer, err := s.repo.Update(context.Background(), existingBuyer)
 
 	// Assert
 	s.NoError(err)
@@ -230,7 +231,7 @@ func (s *BuyerRepositoryTestSuite) TestUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedBuyer, err := s.repo.Update(existingBuyer)
+	updatedBuyer, err := s.repo.Update(context.Background(), existingBuyer)
 
 	// Assert
 	s.Error(err)
@@ -269,7 +270,7 @@ func (s *BuyerRepositoryTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedBuyer, err := s.repo.PartialUpdate(buyerID, fields)
+	updatedBuyer, err := s.repo.PartialUpdate(context.Background(), buyerID, fields)
 
 	// Assert
 	s.NoError(err)
@@ -290,7 +291,7 @@ func (s *BuyerRepositoryTestSuite) TestPartialUpdate_NotFound() {
 		WithArgs(buyerID, 1).WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	updatedBuyer, err := s.repo.PartialUpdate(buyerID, fields)
+	updatedBuyer, err := s.repo.PartialUpdate(context.Background(), buyerID, fields)
 
 	// Assert
 	s.Error(err)
@@ -328,7 +329,7 @@ func (s *BuyerRepositoryTestSuite) TestPartialUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedBuyer, err := s.repo.PartialUpdate(buyerID, fields)
+	updatedBuyer, err := s.repo.PartialUpdate(context.Background(), buyerID, fields)
 
 	// Assert
 	s.Error(err)
@@ -344,7 +345,7 @@ func (s *BuyerRepositoryTestSuite) TestDelete_Success() {
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 	// Act
-	err := s.repo.Delete(buyerID)
+	err := s.repo.Delete(context.Background(), buyerID)
 	// Assert
 	s.NoError(err)
 }
@@ -360,7 +361,7 @@ func (s *BuyerRepositoryTestSuite) TestDelete_NotFound() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(buyerID)
+	err := s.repo.Delete(context.Background(), buyerID)
 
 	// Assert
 	s.Error(err)
@@ -400,7 +401,7 @@ func (s *BuyerRepositoryTestSuite) TestFindByPurchaseOrderReport_AllBuyers_Succe
 		WillReturnRows(rows)
 
 	// Act
-	result, err := s.repo.FindByPurchaseOrderReport(0)
+	result, err := s.repo.FindByPurchaseOrderReport(context.Background(), 0)
 
 	// Assert
 	s.NoError(err)
@@ -444,7 +445,7 @@ func (s *BuyerRepositoryTestSuite) TestFindByPurchaseOrderReport_SingleBuyer_Suc
 		))
 
 	// Act
-	result, err := s.repo.FindByPurchaseOrderReport(id)
+	result, err := s.repo.FindByPurchaseOrderReport(context.Background(), id)
 
 	// Assert
 	s.NoError(err)
@@ -462,7 +463,7 @@ func (s *BuyerRepositoryTestSuite) TestFindByPurchaseOrderReport_SingleBuyer_Not
 		WillReturnRows(sqlmock.NewRows([]string{})) // sin filas
 
 	// Act
-	result, err := s.repo.FindByPurchaseOrderReport(id)
+	result, err := s.repo.FindByPurchaseOrderReport(context.Background(), id)
 
 	// Assert
 	s.Error(err)
@@ -488,7 +489,7 @@ func (s *BuyerRepositoryTestSuite) TestFindByPurchaseOrderReport_SingleBuyer_Sca
 		WillReturnError(errors.New("scan failed"))
 
 	// Act
-	result, err := s.repo.FindByPurchaseOrderReport(id)
+	result, err := s.repo.FindByPurchaseOrderReport(context.Background(), id)
 
 	// Assert
 	s.Error(err)
@@ -505,7 +506,7 @@ func (s *BuyerRepositoryTestSuite) TestFindByPurchaseOrderReport_AllBuyers_Query
 	)).WillReturnError(errExpected)
 
 	// Act
-	result, err := s.repo.FindByPurchaseOrderReport(0)
+	result, err := s.repo.FindByPurchaseOrderReport(context.Background(), 0)
 
 	// Assert
 	s.Error(err)
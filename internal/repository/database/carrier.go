@@ -1,9 +1,11 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"gorm.io/gorm"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 )
 
@@ -17,28 +19,28 @@ func NewCarrierDB(db *gorm.DB) *CarrierDB {
 	return &CarrierDB{db: db}
 }
 
-func (r *CarrierDB) FindAll() ([]models.Carrier, error) {
+func (r *CarrierDB) FindAll(ctx context.Context) ([]models.Carrier, error) {
 	carriers := make([]models.Carrier, 0)
-	result := r.db.Find(&carriers)
+	result := r.db.WithContext(ctx).Find(&carriers)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return carriers, nil
 }
 
-func (r *CarrierDB) FindById(id int) (models.Carrier, error) {
+func (r *CarrierDB) FindById(ctx context.Context, id int) (models.Carrier, error) {
 	var carrier models.Carrier
-	result := r.db.First(&carrier, id)
+	result := r.db.WithContext(ctx).First(&carrier, id)
 	if result.Error != nil {
 		return models.Carrier{}, result.Error
 	}
 	return carrier, nil
 }
 
-func (r *CarrierDB) Create(carrier models.Carrier) (models.Carrier, error) {
+func (r *CarrierDB) Create(ctx context.Context, carrier models.Carrier) (models.Carrier, error) {
 	// 1- Validate that there is no carrier with this cid already
 	var exists bool
-	err := r.db.Model(&models.Carrier{}).
+	err := r.db.WithContext(ctx).Model(&models.Carrier{}).
 		Select("1").
 		Where("cid = ?", carrier.CId).
 		First(&exists).Error
@@ -56,7 +58,7 @@ func (r *CarrierDB) Create(carrier models.Carrier) (models.Carrier, error) {
 	}
 
 	// 2- Create carrier
-	result := r.db.Create(&carrier)
+	result := r.db.WithContext(ctx).Create(&carrier)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 			return models.Carrier{}, repository.ErrLocalityNotFound
@@ -64,9 +66,9 @@ func (r *CarrierDB) Create(carrier models.Carrier) (models.Carrier, error) {
 	return carrier, result.Error
 }
 
-func (r *CarrierDB) Update(carrier models.Carrier) (models.Carrier, error) {
+func (r *CarrierDB) Update(ctx context.Context, carrier models.Carrier) (models.Carrier, error) {
 	var exists bool
-	err := r.db.Model(&models.Carrier{}).
+	err := r.db.WithContext(ctx).Model(&models.Carrier{}).
 		Select("1").
 		Where("`carriers`.`cid` = ? AND `carriers`.`id` <> ?", carrier.CId, carrier.ID).
 		First(&exists).Error
@@ -83,20 +85,20 @@ func (r *CarrierDB) Update(carrier models.Carrier) (models.Carrier, error) {
 		return models.Carrier{}, repository.ErrEntityAlreadyExists
 	}
 
-	result := r.db.Save(&carrier)
+	result := r.db.WithContext(ctx).Save(&carrier)
 	if result.Error == nil {
 		return carrier, nil
 	}
 	return models.Carrier{}, result.Error
 }
 
-func (r *CarrierDB) PartialUpdate(id int, fields map[string]interface{}) (models.Carrier, error) {
+func (r *CarrierDB) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Carrier, error) {
 	// 1- Validate that there is no carrier with this cid already
-	if val, ok := fields["cid"]; ok {
+	if val, ok := fields["cid"].(string); ok {
 		var exists bool
-		err := r.db.Model(&models.Carrier{}).
+		err := r.db.WithContext(ctx).Model(&models.Carrier{}).
 			Select("1").
-			Where("`carriers`.`cid` = ? AND `carriers`.`id` <> ?", val.(string), id).
+			Where("`carriers`.`cid` = ? AND `carriers`.`id` <> ?", val, id).
 			First(&exists).Error
 
 		if err != nil {
@@ -113,7 +115,7 @@ func (r *CarrierDB) PartialUpdate(id int, fields map[string]interface{}) (models
 	}
 
 	var carrier models.Carrier
-	result := r.db.First(&carrier, id)
+	result := r.db.WithContext(ctx).First(&carrier, id)
 	switch {
 	case errors.Is(result.Error, gorm.ErrRecordNotFound):
 			return models.Carrier{}, repository.ErrEntityNotFound
@@ -121,32 +123,20 @@ func (r *CarrierDB) PartialUpdate(id int, fields map[string]interface{}) (models
 			return models.Carrier{}, result.Error
 	}
 
-	if val, ok := fields["cid"]; ok {
-		carrier.CId = val.(string)
-	}
-	if val, ok := fields["company_name"]; ok {
-		carrier.CompanyName = val.(string)
-	}
-	if val, ok := fields["address"]; ok {
-		carrier.Address = val.(string)
-	}
-	if val, ok := fields["telephone"]; ok {
-		carrier.Telephone = val.(string)
-	}
-	if val, ok := fields["locality_id"]; ok {
-		carrier.LocalityId = int(val.(float64))
+	if err := patch.Apply(&carrier, fields); err != nil {
+		return models.Carrier{}, err
 	}
 
-	result = r.db.Save(&carrier)
+	result = r.db.WithContext(ctx).Save(&carrier)
 	if result.Error != nil {
 		return models.Carrier{}, result.Error
 	}
 	return carrier, nil
 }
 
-func (r *CarrierDB) Delete(id int) error {
+func (r *CarrierDB) Delete(ctx context.Context, id int) error {
 	var carrier models.Carrier
-	result := r.db.Delete(&carrier, id)
+	result := r.db.WithContext(ctx).Delete(&carrier, id)
 	if result.RowsAffected < 1 {
 		return repository.ErrEntityNotFound
 	}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
@@ -93,7 +94,7 @@ func (s *CarrierRepositoryTestSuite) TestFindAll_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `carriers`")).WillReturnRows(rows)
 
 	// Act
-	carriers, err := s.repo.FindAll()
+	carriers, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -109,7 +110,7 @@ func (s *CarrierRepositoryTestSuite) TestFindAll_DatabaseError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `carriers`")).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	carriers, err := s.repo.FindAll()
+	carriers, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -156,7 +157,7 @@ func (s *CarrierRepositoryTestSuite) TestFindById_Success() {
 	)).WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	carrier, err := s.repo.FindById(1)
+	carrier, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -174,7 +175,7 @@ func (s *CarrierRepositoryTestSuite) TestFindById_NotFound() {
 	)).WithArgs(id, 1).
 		WillReturnError(gorm.ErrRecordNotFound)
 
-	result, err := s.repo.FindById(id)
+	result, err := s.repo.FindById(context.Background(), id)
 
 	s.Error(err)
 	s.Equal(gorm.ErrRecordNotFound, err)
@@ -214,7 +215,7 @@ func (s *CarrierRepositoryTestSuite) TestCreate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	carrier, err := s.repo.Create(inputCarrier)
+	carrier, err := s.repo.Create(context.Background(), inputCarrier)
 
 	// Assert
 	s.NoError(err)
@@ -244,7 +245,7 @@ func (s *CarrierRepositoryTestSuite) TestCreate_FailErrorOnCIdValidation() {
 	)).WithArgs(inputCarrier.CId, 1).WillReturnError(gorm.ErrInvalidDB)
 
 	// Act
-	carrier, err := s.repo.Create(inputCarrier)
+	carrier, err := s.repo.Create(context.Background(), inputCarrier)
 
 	// Assert
 	s.Error(err)
@@ -271,7 +272,7 @@ func (s *CarrierRepositoryTestSuite) TestCreate_FailCidAlreadyExists() {
 		WillReturnRows(s.mock.NewRows([]string{"1"}).AddRow(1))
 
 	// Act
-	carrier, err := s.repo.Create(inputCarrier)
+	carrier, err := s.repo.Create(context.Background(), inputCarrier)
 
 	// Assert
 	s.Error(err)
@@ -309,7 +310,7 @@ func (s *CarrierRepositoryTestSuite) TestCreate_FailLocalityNotFound() {
 	s.mock.ExpectRollback()
 
 	// Act
-	carrier, err := s.repo.Create(inputCarrier)
+	carrier, err := s.repo.Create(context.Background(), inputCarrier)
 
 	// Assert
 	s.Error(err)
@@ -351,7 +352,7 @@ func (s *CarrierRepositoryTestSuite) TestUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedCarrier, err := s.repo.Update(existingCarrier)
+	updatedCarrier, err := s.repo.Update(context.Background(), existingCarrier)
 
 	// Assert
 	s.NoError(err)
@@ -377,7 +378,7 @@ func (s *CarrierRepositoryTestSuite) TestUpdate_CIdAlreadyExists() {
 	)).WithArgs("CID#01", 1, 1).
 		WillReturnRows(s.mock.NewRows([]string{"1"}).AddRow(1))
 
-	updatedCarrier, err := s.repo.Update(existingCarrier)
+	updatedCarrier, err := s.repo.Update(context.Background(), existingCarrier)
 
 	// Assert
 	s.Error(err)
@@ -403,7 +404,7 @@ func (s *CarrierRepositoryTestSuite) TestUpdate_ErrorOnCIdValidation() {
 	)).WithArgs("CID#01", 1, 1).
 		WillReturnError(gorm.ErrInvalidDB)
 
-	updatedCarrier, err := s.repo.Update(existingCarrier)
+	updatedCarrier, err := s.repo.Update(context.Background(), existingCarrier)
 
 	// Assert
 	s.Error(err)
@@ -445,7 +446,7 @@ func (s *CarrierRepositoryTestSuite) TestUpdate_ForeignKeyViolation() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedCarrier, err := s.repo.Update(existingCarrier)
+	updatedCarrier, err := s.repo.Update(context.Background(), existingCarrier)
 
 	// Assert
 	s.Error(err)
@@ -528,7 +529,7 @@ func (s *CarrierRepositoryTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.NoError(err)
@@ -559,7 +560,7 @@ func (s *CarrierRepositoryTestSuite) TestPartialUpdate_DatabaseErrorOnFirst() {
 		WillReturnError(gorm.ErrInvalidDB)
 
 	// Act
-	updatedCarrier, err := s.repo.PartialUpdate(id, fields)
+	updatedCarrier, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -599,7 +600,7 @@ func (s *CarrierRepositoryTestSuite) TestPartialUpdate_ErrorNotFound() {
 	)).WithArgs(id, 1).WillReturnRows(s.mock.NewRows(columns))
 
 	// Act
-	updatedCarrier, err := s.repo.PartialUpdate(id, fields)
+	updatedCarrier, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -683,7 +684,7 @@ func (s *CarrierRepositoryTestSuite) TestPartialUpdate_ErrorOnSave() {
 	s.mock.ExpectRollback()
 
 	// Act
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -711,7 +712,7 @@ func (s *CarrierRepositoryTestSuite) TestPartialUpdate_CIdAlreadyExists() {
 		WillReturnRows(s.mock.NewRows([]string{"1"}).AddRow(1))
 
 	// Act
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -738,7 +739,7 @@ func (s *CarrierRepositoryTestSuite) TestPartialUpdate_ErrorOnCIdValidation() {
 		WillReturnError(gorm.ErrInvalidValue)
 
 	// Act
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -759,7 +760,7 @@ func (s *CarrierRepositoryTestSuite) TestDelete_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(carrierID)
+	err := s.repo.Delete(context.Background(), carrierID)
 
 	// Assert
 	s.NoError(err)
@@ -779,7 +780,7 @@ func (s *CarrierRepositoryTestSuite) TestDelete_NotFound() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(carrierID)
+	err := s.repo.Delete(context.Background(), carrierID)
 
 	// Assert
 	s.Error(err)
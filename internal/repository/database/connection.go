@@ -105,5 +105,10 @@ func NewConnection() (*gorm.DB, error) {
 		return nil, err
 	}
 
+	// Record every mutation in the audit trail
+	if err = db.Use(NewAuditPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to register audit plugin: %w", err)
+	}
+
 	return db, nil
 }
@@ -0,0 +1,256 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// countVarianceColumns sums the lines of the approved count sessions joined to the report, the lines not counted yet
+// have no variance and are left out of the mismatches and the sums
+const countVarianceColumns = "COUNT(count_lines.id) AS lines_count, " +
+	"COUNT(NULLIF(count_lines.variance, 0)) AS mismatches_count, " +
+	"COALESCE(SUM(count_lines.variance), 0) AS net_variance, " +
+	"COALESCE(SUM(ABS(count_lines.variance)), 0) AS absolute_variance"
+
+// CountSessionRepository implements the count sessions over the count_sessions and count_lines tables
+type CountSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewCountSessionRepository(db *gorm.DB) *CountSessionRepository {
+	return &CountSessionRepository{db: db}
+}
+
+func (r *CountSessionRepository) FindAll(ctx context.Context) ([]models.CountSession, error) {
+	sessions := make([]models.CountSession, 0)
+	result := r.db.WithContext(ctx).Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).Order("id").Find(&sessions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return sessions, nil
+}
+
+func (r *CountSessionRepository) FindById(ctx context.Context, id int) (models.CountSession, error) {
+	var session models.CountSession
+	result := r.db.WithContext(ctx).Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).Take(&session, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.CountSession{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.CountSession{}, result.Error
+	}
+	return session, nil
+}
+
+// Create snapshots the batches of the section in the lines of the session. The section is locked while the session
+// is opened so two sessions cannot be opened for it at once.
+func (r *CountSessionRepository) Create(ctx context.Context, session models.CountSession) (models.CountSession, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var section models.Section
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&section, session.SectionId).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return repository.ErrEntityNotFound
+		case err != nil:
+			return err
+		}
+
+		var open int64
+		err = tx.Model(&models.CountSession{}).
+			Where("section_id = ? AND status = ?", section.Id, models.CountSessionOpen).
+			Count(&open).Error
+		if err != nil {
+			return err
+		}
+		if open > 0 {
+			return repository.ErrCountSessionOpen
+		}
+
+		var batches []models.ProductBatch
+		if err = tx.Where("section_id = ?", section.Id).Order("id").Find(&batches).Error; err != nil {
+			return err
+		}
+		session.Lines = make([]models.CountLine, 0, len(batches))
+		for _, batch := range batches {
+			session.Lines = append(session.Lines, models.CountLine{ProductBatchId: batch.Id, ExpectedQuantity: batch.CurrentQuantity})
+		}
+		return tx.Create(&session).Error
+	})
+	if err != nil {
+		return models.CountSession{}, err
+	}
+	return session, nil
+}
+
+// RecordCount updates the line while its session is locked, a session approved since it was read is not counted. The
+// expected quantity of the line is the one the batch holds when it is counted, so the stock moved since the session
+// was opened is not taken for a variance.
+func (r *CountSessionRepository) RecordCount(ctx context.Context, line models.CountLine) (models.CountLine, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		session, err := lockOpenCountSession(tx, line.CountSessionId)
+		if err != nil {
+			return err
+		}
+		batch, err := lockProductBatch(tx, line.ProductBatchId)
+		if err != nil {
+			return err
+		}
+		line.ExpectedQuantity = batch.CurrentQuantity
+		line.Count(*line.CountedQuantity, *line.EmployeeId, *line.CountedAt)
+
+		result := tx.Model(&models.CountLine{}).
+			Where("count_session_id = ? AND product_batch_id = ?", session.Id, line.ProductBatchId).
+			Updates(map[string]any{
+				"expected_quantity": line.ExpectedQuantity,
+				"counted_quantity":  line.CountedQuantity,
+				"variance":          line.Variance,
+				"employee_id":       line.EmployeeId,
+				"counted_at":        line.CountedAt,
+			})
+		switch {
+		case result.Error != nil:
+			return result.Error
+		case result.RowsAffected < 1:
+			return repository.ErrEntityNotFound
+		}
+		return tx.Where("count_session_id = ? AND product_batch_id = ?", session.Id, line.ProductBatchId).Take(&line).Error
+	})
+
+	switch {
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return models.CountLine{}, repository.ErrForeignKeyViolation
+	case err != nil:
+		return models.CountLine{}, err
+	}
+	return line, nil
+}
+
+// Approve posts the variance of every line as an adjustment of its batch, counted by the employee of the line. The
+// variance was taken against the quantity of the batch when it was counted and is applied to its current quantity,
+// so the stock moved after the count is kept, and a batch counted empty frees its place in the section.
+func (r *CountSessionRepository) Approve(ctx context.Context, id int, at time.Time) (models.CountSession, error) {
+	var session models.CountSession
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if session, err = lockOpenCountSession(tx, id); err != nil {
+			return err
+		}
+		if err = tx.Where("count_session_id = ?", session.Id).Order("id").Find(&session.Lines).Error; err != nil {
+			return err
+		}
+
+		for _, line := range session.Lines {
+			if line.Variance == nil || *line.Variance == 0 {
+				continue
+			}
+			reasonCode := models.AdjustmentCountOverage
+			if *line.Variance < 0 {
+				reasonCode = models.AdjustmentCountShortage
+			}
+			movement := models.StockMovement{
+				ProductBatchId: line.ProductBatchId,
+				Quantity:       *line.Variance,
+				Reason:         models.StockMovementAdjustment,
+				ReasonCode:     &reasonCode,
+				EmployeeId:     line.EmployeeId,
+			}
+			if err = adjustStock(tx, &movement); err != nil {
+				return err
+			}
+		}
+
+		session.Status = models.CountSessionApproved
+		session.ApprovedAt = &at
+		return tx.Model(&session).Omit(clause.Associations).Updates(map[string]any{
+			"status":      models.CountSessionApproved,
+			"approved_at": at,
+		}).Error
+	})
+	if err != nil {
+		return models.CountSession{}, err
+	}
+	return session, nil
+}
+
+func (r *CountSessionRepository) FindWarehouseVariance(ctx context.Context, warehouseId int) (models.WarehouseCountVariance, error) {
+	var variances []models.WarehouseCountVariance
+	result := r.warehouseVariances(ctx).Where("warehouses.id = ?", warehouseId).Scan(&variances)
+	switch {
+	case result.Error != nil:
+		return models.WarehouseCountVariance{}, result.Error
+	case len(variances) == 0:
+		return models.WarehouseCountVariance{}, repository.ErrEntityNotFound
+	}
+	return variances[0], nil
+}
+
+func (r *CountSessionRepository) FindAllWarehouseVariances(ctx context.Context) ([]models.WarehouseCountVariance, error) {
+	variances := make([]models.WarehouseCountVariance, 0)
+	result := r.warehouseVariances(ctx).Order("warehouses.id").Scan(&variances)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return variances, nil
+}
+
+func (r *CountSessionRepository) FindEmployeeVariance(ctx context.Context, employeeId int) (models.EmployeeCountVariance, error) {
+	var variances []models.EmployeeCountVariance
+	result := r.employeeVariances(ctx).Where("employees.id = ?", employeeId).Scan(&variances)
+	switch {
+	case result.Error != nil:
+		return models.EmployeeCountVariance{}, result.Error
+	case len(variances) == 0:
+		return models.EmployeeCountVariance{}, repository.ErrEntityNotFound
+	}
+	return variances[0], nil
+}
+
+func (r *CountSessionRepository) FindAllEmployeeVariances(ctx context.Context) ([]models.EmployeeCountVariance, error) {
+	variances := make([]models.EmployeeCountVariance, 0)
+	result := r.employeeVariances(ctx).Order("employees.id").Scan(&variances)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return variances, nil
+}
+
+// warehouseVariances joins every warehouse to the lines of the approved sessions of its sections
+func (r *CountSessionRepository) warehouseVariances(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("warehouses").
+		Select("warehouses.id AS warehouse_id, warehouses.warehouse_code, COUNT(DISTINCT count_sessions.id) AS sessions_count, "+countVarianceColumns).
+		Joins("LEFT JOIN sections ON sections.warehouse_id = warehouses.id").
+		Joins("LEFT JOIN count_sessions ON count_sessions.section_id = sections.id AND count_sessions.status = ?", models.CountSessionApproved).
+		Joins("LEFT JOIN count_lines ON count_lines.count_session_id = count_sessions.id").
+		Group("warehouses.id, warehouses.warehouse_code")
+}
+
+// employeeVariances joins every employee to the lines they counted in approved sessions
+func (r *CountSessionRepository) employeeVariances(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("employees").
+		Select("employees.id, employees.card_number_id, employees.first_name, employees.last_name, employees.warehouse_id, "+countVarianceColumns).
+		Joins("LEFT JOIN (count_lines JOIN count_sessions ON count_sessions.id = count_lines.count_session_id AND count_sessions.status = ?) "+
+			"ON count_lines.employee_id = employees.id", models.CountSessionApproved).
+		Group("employees.id, employees.card_number_id, employees.first_name, employees.last_name, employees.warehouse_id")
+}
+
+// lockOpenCountSession reads a count session and locks it until the transaction ends, a session approved since it
+// was read by the caller is stale
+func lockOpenCountSession(tx *gorm.DB, id int) (models.CountSession, error) {
+	var session models.CountSession
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&session, id).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return models.CountSession{}, repository.ErrEntityNotFound
+	case err != nil:
+		return models.CountSession{}, err
+	}
+	if session.Status != models.CountSessionOpen {
+		return models.CountSession{}, repository.ErrStaleEntity
+	}
+	return session, nil
+}
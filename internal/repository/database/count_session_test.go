@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type CountSessionTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo *CountSessionRepository
+}
+
+func (s *CountSessionTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.repo = NewCountSessionRepository(gormDB)
+}
+
+func (s *CountSessionTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *CountSessionTestSuite) expectSection(id int, openSessions int) {
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_id"}).AddRow(id, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `count_sessions` WHERE section_id = ? AND status = ?")).
+		WithArgs(id, models.CountSessionOpen).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(openSessions))
+}
+
+func (s *CountSessionTestSuite) TestCreate_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.expectSection(4, 0)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE section_id = ? ORDER BY id")).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id"}).AddRow(3, 200, 4).AddRow(8, 150, 4))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `count_sessions` (`section_id`,`status`,`created_at`,`approved_at`) VALUES (?,?,?,?)")).
+		WithArgs(4, models.CountSessionOpen, at, nil).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `count_lines` (`count_session_id`,`product_batch_id`,`expected_quantity`,`counted_quantity`,`variance`,`employee_id`,`counted_at`) VALUES (?,?,?,?,?,?,?),(?,?,?,?,?,?,?)")).
+		WithArgs(5, 3, 200, nil, nil, nil, nil, 5, 8, 150, nil, nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	s.mock.ExpectCommit()
+
+	// Act
+	session, err := s.repo.Create(context.Background(), models.CountSession{SectionId: 4, Status: models.CountSessionOpen, CreatedAt: at})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(5, session.Id)
+	s.Len(session.Lines, 2)
+	s.Equal(150, session.Lines[1].ExpectedQuantity)
+}
+
+func (s *CountSessionTestSuite) TestCreate_SectionAlreadyBeingCounted() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectSection(4, 1)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Create(context.Background(), models.CountSession{SectionId: 4, Status: models.CountSessionOpen})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrCountSessionOpen)
+}
+
+func (s *CountSessionTestSuite) TestRecordCount_SessionApproved() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `count_sessions` WHERE `count_sessions`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "section_id", "status"}).AddRow(5, 4, models.CountSessionApproved))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.RecordCount(context.Background(), models.CountLine{CountSessionId: 5, ProductBatchId: 3})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrStaleEntity)
+}
+
+func (s *CountSessionTestSuite) TestRecordCount_StockMovedSinceOpened() {
+	// Arrange
+	at := time.Date(2025, 6, 11, 17, 0, 0, 0, time.UTC)
+	employeeId := 2
+	line := models.CountLine{CountSessionId: 5, ProductBatchId: 3, ExpectedQuantity: 10}
+	line.Count(7, employeeId, at)
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `count_sessions` WHERE `count_sessions`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "section_id", "status"}).AddRow(5, 4, models.CountSessionOpen))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id"}).AddRow(3, 7, 4))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `count_lines` SET `counted_at`=?,`counted_quantity`=?,`employee_id`=?,`expected_quantity`=?,`variance`=? WHERE count_session_id = ? AND product_batch_id = ?")).
+		WithArgs(at, 7, employeeId, 7, 0, 5, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `count_lines` WHERE count_session_id = ? AND product_batch_id = ?")).
+		WithArgs(5, 3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "count_session_id", "product_batch_id", "expected_quantity", "counted_quantity", "variance", "employee_id", "counted_at"}).
+			AddRow(1, 5, 3, 7, 7, 0, employeeId, at))
+	s.mock.ExpectCommit()
+
+	// Act
+	counted, err := s.repo.RecordCount(context.Background(), line)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(7, counted.ExpectedQuantity)
+	s.Equal(0, *counted.Variance)
+}
+
+func (s *CountSessionTestSuite) TestApprove_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 11, 18, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `count_sessions` WHERE `count_sessions`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "section_id", "status"}).AddRow(5, 4, models.CountSessionOpen))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `count_lines` WHERE count_session_id = ? ORDER BY id")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "count_session_id", "product_batch_id", "expected_quantity", "counted_quantity", "variance", "employee_id"}).
+			AddRow(1, 5, 3, 200, 194, -6, 2).
+			AddRow(2, 5, 8, 150, 150, 0, 2))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id"}).AddRow(3, 180, 4))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(174, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(3, 4, -6, models.StockMovementAdjustment, models.AdjustmentCountShortage, 2, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(20, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `count_sessions` SET `approved_at`=?,`status`=? WHERE `id` = ?")).
+		WithArgs(at, models.CountSessionApproved, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	session, err := s.repo.Approve(context.Background(), 5, at)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.CountSessionApproved, session.Status)
+	s.Equal(at, *session.ApprovedAt)
+}
+
+func (s *CountSessionTestSuite) TestFindWarehouseVariance_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `warehouses` LEFT JOIN sections")).
+		WithArgs(models.CountSessionApproved, 9).
+		WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}))
+
+	// Act
+	_, err := s.repo.FindWarehouseVariance(context.Background(), 9)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *CountSessionTestSuite) TestFindAllEmployeeVariances_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `employees` LEFT JOIN (count_lines JOIN count_sessions")).
+		WithArgs(models.CountSessionApproved).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "warehouse_id", "lines_count", "mismatches_count", "net_variance", "absolute_variance"}).
+			AddRow(2, "Ana", 1, 12, 3, -4, 10))
+
+	// Act
+	variances, err := s.repo.FindAllEmployeeVariances(context.Background())
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.EmployeeCountVariance{{
+		Employee:      models.Employee{Id: 2, FirstName: "Ana", WarehouseId: 1},
+		CountVariance: models.CountVariance{LinesCount: 12, MismatchesCount: 3, NetVariance: -4, AbsoluteVariance: 10},
+	}}, variances)
+}
+
+func TestCountSessionTestSuite(t *testing.T) {
+	suite.Run(t, new(CountSessionTestSuite))
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+)
+
+type CountryRepository struct {
+	db *gorm.DB
+}
+
+func NewCountryRepository(db *gorm.DB) *CountryRepository {
+	return &CountryRepository{db: db}
+}
+
+func (r *CountryRepository) FindAll(ctx context.Context) ([]models.Country, error) {
+	var countries []models.Country
+	result := r.db.WithContext(ctx).Order("id").Find(&countries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return countries, nil
+}
+
+func (r *CountryRepository) FindById(ctx context.Context, id int) (models.Country, error) {
+	var country models.Country
+	result := r.db.WithContext(ctx).First(&country, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.Country{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.Country{}, result.Error
+	}
+	return country, nil
+}
+
+func (r *CountryRepository) Create(ctx context.Context, country models.Country) (models.Country, error) {
+	result := r.db.WithContext(ctx).Create(&country)
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		return models.Country{}, repository.ErrEntityAlreadyExists
+	case result.Error != nil:
+		return models.Country{}, result.Error
+	}
+	return country, nil
+}
+
+func (r *CountryRepository) Update(ctx context.Context, country models.Country) (models.Country, error) {
+	if _, err := r.FindById(ctx, country.Id); err != nil {
+		return models.Country{}, err
+	}
+	result := r.db.WithContext(ctx).Save(&country)
+	if result.Error != nil {
+		return models.Country{}, result.Error
+	}
+	return country, nil
+}
+
+func (r *CountryRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Country, error) {
+	country, err := r.FindById(ctx, id)
+	if err != nil {
+		return models.Country{}, err
+	}
+	result := r.db.WithContext(ctx).Model(&country).Updates(fields)
+	if result.Error != nil {
+		return models.Country{}, result.Error
+	}
+	return country, nil
+}
+
+// Delete removes a country, it returns ErrForeignKeyViolation when a province still references it
+func (r *CountryRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.Country{}, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return result.Error
+	case result.RowsAffected < 1:
+		return repository.ErrEntityNotFound
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -17,9 +18,9 @@ func NewEmployeeRepository(db *gorm.DB) *EmployeeRepository {
 	}
 }
 
-func (e EmployeeRepository) FindAll() ([]models.Employee, error) {
+func (e EmployeeRepository) FindAll(ctx context.Context) ([]models.Employee, error) {
 	var employees []models.Employee
-	result := e.db.Find(&employees)
+	result := e.db.WithContext(ctx).Find(&employees)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -27,9 +28,9 @@ func (e EmployeeRepository) FindAll() ([]models.Employee, error) {
 	return employees, nil
 }
 
-func (e EmployeeRepository) FindById(id int) (models.Employee, error) {
+func (e EmployeeRepository) FindById(ctx context.Context, id int) (models.Employee, error) {
 	var employee models.Employee
-	result := e.db.First(&employee, id)
+	result := e.db.WithContext(ctx).First(&employee, id)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Employee{}, repository.ErrEntityNotFound
 	}
@@ -40,8 +41,8 @@ func (e EmployeeRepository) FindById(id int) (models.Employee, error) {
 	return employee, nil
 }
 
-func (e EmployeeRepository) Create(employee models.Employee) (models.Employee, error) {
-	result := e.db.Create(&employee)
+func (e EmployeeRepository) Create(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	result := e.db.WithContext(ctx).Create(&employee)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.Employee{}, repository.ErrForeignKeyViolation
@@ -51,8 +52,8 @@ func (e EmployeeRepository) Create(employee models.Employee) (models.Employee, e
 	return employee, nil
 }
 
-func (e EmployeeRepository) Update(employee models.Employee) (models.Employee, error) {
-	result := e.db.Save(&employee)
+func (e EmployeeRepository) Update(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	result := e.db.WithContext(ctx).Save(&employee)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.Employee{}, repository.ErrForeignKeyViolation
@@ -62,16 +63,16 @@ func (e EmployeeRepository) Update(employee models.Employee) (models.Employee, e
 	return employee, nil
 }
 
-func (e EmployeeRepository) PartialUpdate(id int, fields map[string]interface{}) (models.Employee, error) {
+func (e EmployeeRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Employee, error) {
 	var employee models.Employee
-	result := e.db.First(&employee, id)
+	result := e.db.WithContext(ctx).First(&employee, id)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Employee{}, repository.ErrEntityNotFound
 	}
 	if result.Error != nil {
 		return models.Employee{}, result.Error
 	}
-	result = e.db.Model(&employee).Updates(fields)
+	result = e.db.WithContext(ctx).Model(&employee).Updates(fields)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.Employee{}, repository.ErrForeignKeyViolation
@@ -81,8 +82,8 @@ func (e EmployeeRepository) PartialUpdate(id int, fields map[string]interface{})
 	return employee, nil
 }
 
-func (e EmployeeRepository) Delete(id int) error {
-	result := e.db.Delete(&models.Employee{}, id)
+func (e EmployeeRepository) Delete(ctx context.Context, id int) error {
+	result := e.db.WithContext(ctx).Delete(&models.Employee{}, id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -93,10 +94,10 @@ func (e EmployeeRepository) Delete(id int) error {
 }
 
 // InboundOrdersReport  returns inbound orders count for all employees
-func (e EmployeeRepository) InboundOrdersReport() ([]models.EmployeeInboundOrdersReport, error) {
+func (e EmployeeRepository) InboundOrdersReport(ctx context.Context) ([]models.EmployeeInboundOrdersReport, error) {
 	var reports []models.EmployeeInboundOrdersReport
 
-	result := e.db.Table("employees e").
+	result := e.db.WithContext(ctx).Table("employees e").
 		Select(`
             e.id,
             e.card_number_id,
@@ -119,10 +120,10 @@ func (e EmployeeRepository) InboundOrdersReport() ([]models.EmployeeInboundOrder
 }
 
 // InboundOrdersReportById returns inbound orders count for a specific employee
-func (e EmployeeRepository) InboundOrdersReportById(id int) (models.EmployeeInboundOrdersReport, error) {
+func (e EmployeeRepository) InboundOrdersReportById(ctx context.Context, id int) (models.EmployeeInboundOrdersReport, error) {
 	var report models.EmployeeInboundOrdersReport
 
-	result := e.db.Table("employees e").
+	result := e.db.WithContext(ctx).Table("employees e").
 		Select(`
             e.id,
             e.card_number_id,
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
@@ -46,7 +47,7 @@ func (s *EmployeeRepositoryTestSuite) TestFindAll_DatabaseError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `employees`")).
 		WillReturnError(sql.ErrConnDone)
 	// Act
-	es, err := s.repo.FindAll()
+	es, err := s.repo.FindAll(context.Background())
 	// Asserts
 	s.Error(err)
 	s.Equal(sql.ErrConnDone, err)
@@ -80,7 +81,7 @@ func (s *EmployeeRepositoryTestSuite) TestFindAll_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `employees`")).
 		WillReturnRows(rows)
 	// Act
-	employees, err := s.repo.FindAll()
+	employees, err := s.repo.FindAll(context.Background())
 	// Asserts
 	s.NoError(err) // espera NO haya error
 	s.Equal(employeesExpected, employees)
@@ -92,7 +93,7 @@ func (s *EmployeeRepositoryTestSuite) TestFindAll_EmptyList() {
 	rows := sqlmock.NewRows([]string{"id", "card_number_id", "first_name", "last_name", "warehouse_id"})
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `employees`")).WillReturnRows(rows)
 	// Act
-	employees, err := s.repo.FindAll()
+	employees, err := s.repo.FindAll(context.Background())
 	// Asserts
 	s.NoError(err)
 	s.Empty(employees)
@@ -105,7 +106,7 @@ func (s *EmployeeRepositoryTestSuite) TestFindById_DatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	employee, err := s.repo.FindById(1)
+	employee, err := s.repo.FindById(context.Background(), 1)
 
 	// Asserts
 	s.Error(err)
@@ -131,7 +132,7 @@ func (s *EmployeeRepositoryTestSuite) TestFindById_Success() {
 		WillReturnRows(rows)
 
 	// Act
-	employee, err := s.repo.FindById(1)
+	employee, err := s.repo.FindById(context.Background(), 1)
 
 	// Asserts
 	s.NoError(err)
@@ -148,7 +149,7 @@ func (s *EmployeeRepositoryTestSuite) TestFindById_NotFound() {
 		WillReturnRows(rows)
 
 	// Act
-	employee, err := s.repo.FindById(999)
+	employee, err := s.repo.FindById(context.Background(), 999)
 
 	// Asserts
 	s.Error(err)
@@ -172,7 +173,7 @@ func (s *EmployeeRepositoryTestSuite) TestCreate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	createdEmployee, err := s.repo.Create(newEmployee)
+	createdEmployee, err := s.repo.Create(context.Background(), newEmployee)
 
 	// Asserts
 	s.NoError(err)
@@ -199,7 +200,7 @@ func (s *EmployeeRepositoryTestSuite) TestCreate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdEmployee, err := s.repo.Create(newEmployee)
+	createdEmployee, err := s.repo.Create(context.Background(), newEmployee)
 
 	// Asserts
 	s.Error(err)
@@ -223,7 +224,7 @@ func (s *EmployeeRepositoryTestSuite) TestCreate_ForeignKeyViolation() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdEmployee, err := s.repo.Create(newEmployee)
+	createdEmployee, err := s.repo.Create(context.Background(), newEmployee)
 
 	// Asserts
 	s.Error(err)
@@ -248,7 +249,7 @@ func (s *EmployeeRepositoryTestSuite) TestUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedEmployee, err := s.repo.Update(ep)
+	updatedEmployee, err := s.repo.Update(context.Background(), ep)
 
 	// Asserts
 	s.NoError(err)
@@ -276,7 +277,7 @@ func (s *EmployeeRepositoryTestSuite) TestUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedEmployee, err := s.repo.Update(existingEmployee)
+	updatedEmployee, err := s.repo.Update(context.Background(), existingEmployee)
 
 	// Asserts
 	s.Error(err)
@@ -301,7 +302,7 @@ func (s *EmployeeRepositoryTestSuite) TestUpdate_ForeignKeyViolation() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedEmployee, err := s.repo.Update(existingEmployee)
+	updatedEmployee, err := s.repo.Update(context.Background(), existingEmployee)
 
 	// Asserts
 	s.Error(err)
@@ -340,7 +341,7 @@ func (s *EmployeeRepositoryTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedEmployee, err := s.repo.PartialUpdate(employeeId, fields)
+	updatedEmployee, err := s.repo.PartialUpdate(context.Background(), employeeId, fields)
 
 	// Asserts
 	s.NoError(err)
@@ -362,7 +363,7 @@ func (s *EmployeeRepositoryTestSuite) TestPartialUpdate_NotFound() {
 		WillReturnRows(rows)
 
 	// Act
-	updatedEmployee, err := s.repo.PartialUpdate(employeeId, fields)
+	updatedEmployee, err := s.repo.PartialUpdate(context.Background(), employeeId, fields)
 
 	// Asserts
 	s.Error(err)
@@ -384,7 +385,7 @@ func (s *EmployeeRepositoryTestSuite) TestPartialUpdate_FindDatabaseError() {
 		WillReturnError(sql.ErrConnDone) // Error genérico de BD, NO gorm.ErrRecordNotFound
 
 	// Act
-	updatedEmployee, err := s.repo.PartialUpdate(employeeId, fields)
+	updatedEmployee, err := s.repo.PartialUpdate(context.Background(), employeeId, fields)
 
 	// Asserts
 	s.Error(err)
@@ -424,7 +425,7 @@ func (s *EmployeeRepositoryTestSuite) TestPartialUpdate_ForeignKeyViolation() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedEmployee, err := s.repo.PartialUpdate(employeeId, fields)
+	updatedEmployee, err := s.repo.PartialUpdate(context.Background(), employeeId, fields)
 
 	// Asserts
 	s.Error(err)
@@ -442,7 +443,7 @@ func (s *EmployeeRepositoryTestSuite) TestDelete_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(employeeID)
+	err := s.repo.Delete(context.Background(), employeeID)
 
 	// Asserts
 	s.NoError(err)
@@ -458,7 +459,7 @@ func (s *EmployeeRepositoryTestSuite) TestDelete_NotFound() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(employeeID)
+	err := s.repo.Delete(context.Background(), employeeID)
 
 	// Asserts
 	s.Error(err)
@@ -475,7 +476,7 @@ func (s *EmployeeRepositoryTestSuite) TestDelete_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	err := s.repo.Delete(employeeID)
+	err := s.repo.Delete(context.Background(), employeeID)
 
 	// Asserts
 	s.Error(err)
@@ -512,7 +513,7 @@ func (s *EmployeeRepositoryTestSuite) TestPartialUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedEmployee, err := s.repo.PartialUpdate(employeeId, fields)
+	updatedEmployee, err := s.repo.PartialUpdate(context.Background(), employeeId, fields)
 
 	// Asserts
 	s.Error(err)
@@ -563,7 +564,7 @@ func (s *EmployeeRepositoryTestSuite) TestInboundOrdersReport_Success() {
 		WillReturnRows(rows)
 
 	// Act
-	reports, err := s.repo.InboundOrdersReport()
+	reports, err := s.repo.InboundOrdersReport(context.Background())
 
 	// Asserts
 	s.NoError(err)
@@ -586,7 +587,7 @@ func (s *EmployeeRepositoryTestSuite) TestInboundOrdersReport_DatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	reports, err := s.repo.InboundOrdersReport()
+	reports, err := s.repo.InboundOrdersReport(context.Background())
 
 	// Asserts
 	s.Error(err)
@@ -612,7 +613,7 @@ func (s *EmployeeRepositoryTestSuite) TestInboundOrdersReport_EmptyResult() {
 		WillReturnRows(rows)
 
 	// Act
-	reports, err := s.repo.InboundOrdersReport()
+	reports, err := s.repo.InboundOrdersReport(context.Background())
 
 	// Asserts
 	s.NoError(err)
@@ -651,7 +652,7 @@ func (s *EmployeeRepositoryTestSuite) TestInboundOrdersReportById_Success() {
 		WillReturnRows(rows)
 
 	// Act
-	report, err := s.repo.InboundOrdersReportById(employeeId)
+	report, err := s.repo.InboundOrdersReportById(context.Background(), employeeId)
 
 	// Asserts
 	s.NoError(err)
@@ -676,7 +677,7 @@ func (s *EmployeeRepositoryTestSuite) TestInboundOrdersReportById_DatabaseError(
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	report, err := s.repo.InboundOrdersReportById(employeeId)
+	report, err := s.repo.InboundOrdersReportById(context.Background(), employeeId)
 
 	// Asserts
 	s.Error(err)
@@ -704,7 +705,7 @@ func (s *EmployeeRepositoryTestSuite) TestInboundOrdersReportById_NotFound() {
 		WillReturnRows(rows)
 
 	// Act
-	report, err := s.repo.InboundOrdersReportById(employeeId)
+	report, err := s.repo.InboundOrdersReportById(context.Background(), employeeId)
 
 	// Asserts
 	s.Error(err) // The method doesn't return error for empty results, just empty struct
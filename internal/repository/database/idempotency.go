@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+)
+
+type IdempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// FindByKey retrieves the record stored for an idempotency key
+func (r *IdempotencyRepository) FindByKey(ctx context.Context, key string) (models.IdempotencyKey, error) {
+	var record models.IdempotencyKey
+	result := r.db.WithContext(ctx).Where("idempotency_key = ?", key).First(&record)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.IdempotencyKey{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.IdempotencyKey{}, result.Error
+	}
+	return record, nil
+}
+
+// Create inserts a new record, the unique index over the key guarantees only one request can claim it
+func (r *IdempotencyRepository) Create(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, error) {
+	result := r.db.WithContext(ctx).Create(&record)
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		return models.IdempotencyKey{}, repository.ErrEntityAlreadyExists
+	case result.Error != nil:
+		return models.IdempotencyKey{}, result.Error
+	}
+	return record, nil
+}
+
+// Update stores the response of a completed request
+func (r *IdempotencyRepository) Update(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, error) {
+	result := r.db.WithContext(ctx).Save(&record)
+	if result.Error != nil {
+		return models.IdempotencyKey{}, result.Error
+	}
+	return record, nil
+}
+
+// Delete releases a key so it can be used again
+func (r *IdempotencyRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.IdempotencyKey{}, id)
+	switch {
+	case result.Error != nil:
+		return result.Error
+	case result.RowsAffected < 1:
+		return repository.ErrEntityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type IdempotencyTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo *IdempotencyRepository
+}
+
+var idempotencyColumns = []string{"id", "idempotency_key", "method", "path", "request_hash", "status_code", "content_type", "response", "created_at"}
+
+func (s *IdempotencyTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.repo = NewIdempotencyRepository(gormDB)
+}
+
+func (s *IdempotencyTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *IdempotencyTestSuite) TestFindByKey_Success() {
+	// Arrange
+	createdAt := time.Now()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `idempotency_keys` WHERE idempotency_key = ? ORDER BY `idempotency_keys`.`id` LIMIT ?")).
+		WithArgs("key-1", 1).
+		WillReturnRows(sqlmock.NewRows(idempotencyColumns).
+			AddRow(1, "key-1", "POST", "/api/v1/purchaseOrders", "hash", 201, "application/json", []byte(`{"data":{}}`), createdAt))
+
+	// Act
+	record, err := s.repo.FindByKey(context.Background(), "key-1")
+
+	// Assert
+	s.NoError(err)
+	s.Equal(201, record.StatusCode)
+	s.True(record.Completed())
+	s.Equal([]byte(`{"data":{}}`), record.Response)
+}
+
+func (s *IdempotencyTestSuite) TestFindByKey_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `idempotency_keys` WHERE idempotency_key = ?")).
+		WithArgs("key-1", 1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	// Act
+	_, err := s.repo.FindByKey(context.Background(), "key-1")
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *IdempotencyTestSuite) TestCreate_Success() {
+	// Arrange
+	record := models.IdempotencyKey{Key: "key-1", Method: "POST", Path: "/api/v1/inbound-orders", RequestHash: "hash", CreatedAt: time.Now()}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_keys`")).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	created, err := s.repo.Create(context.Background(), record)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(3, created.Id)
+	s.False(created.Completed())
+}
+
+func (s *IdempotencyTestSuite) TestCreate_Duplicated() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_keys`")).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Create(context.Background(), models.IdempotencyKey{Key: "key-1"})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityAlreadyExists)
+}
+
+func (s *IdempotencyTestSuite) TestUpdate_Error() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `idempotency_keys` SET")).
+		WillReturnError(errors.New("db error"))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Update(context.Background(), models.IdempotencyKey{Id: 1, Key: "key-1", StatusCode: 201})
+
+	// Assert
+	s.Error(err)
+}
+
+func (s *IdempotencyTestSuite) TestDelete_NotFound() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_keys` WHERE `idempotency_keys`.`id` = ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	// Act
+	err := s.repo.Delete(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func TestIdempotencyTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
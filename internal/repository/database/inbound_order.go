@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -15,10 +16,10 @@ func NewInboundOrderRepository(db *gorm.DB) *InboundOrderRepository {
 	return &InboundOrderRepository{db: db}
 }
 
-func (i *InboundOrderRepository) FindAll() ([]models.InboundOrder, error) {
+func (i *InboundOrderRepository) FindAll(ctx context.Context) ([]models.InboundOrder, error) {
 	var inboundOrders []models.InboundOrder
 
-	result := i.db.Find(&inboundOrders)
+	result := i.db.WithContext(ctx).Find(&inboundOrders)
 
 	if result.Error != nil {
 		return nil, result.Error
@@ -27,10 +28,10 @@ func (i *InboundOrderRepository) FindAll() ([]models.InboundOrder, error) {
 	return inboundOrders, nil
 }
 
-func (i *InboundOrderRepository) FindById(id int) (models.InboundOrder, error) {
+func (i *InboundOrderRepository) FindById(ctx context.Context, id int) (models.InboundOrder, error) {
 	var inboundOrder models.InboundOrder
 
-	result := i.db.First(&inboundOrder, id)
+	result := i.db.WithContext(ctx).First(&inboundOrder, id)
 
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.InboundOrder{}, repository.ErrEntityNotFound
@@ -42,23 +43,60 @@ func (i *InboundOrderRepository) FindById(id int) (models.InboundOrder, error) {
 	return inboundOrder, nil
 }
 
-func (i *InboundOrderRepository) Create(inboundOrder models.InboundOrder) (models.InboundOrder, error) {
-	result := i.db.Create(&inboundOrder)
+// Create inserts the inbound order along with its inbound_order.created event in the outbox, and the receipt of its
+// batch in the stock ledger
+func (i *InboundOrderRepository) Create(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error) {
+	err := i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&inboundOrder).Error; err != nil {
+			return err
+		}
+		if err := receiveProductBatch(tx, inboundOrder); err != nil {
+			return err
+		}
+		return appendOutbox(tx, models.OutboxAggregateInboundOrder, inboundOrder.Id, models.OutboxEventInboundOrderCreated, inboundOrder)
+	})
 
 	switch {
-	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
 		return models.InboundOrder{}, repository.ErrForeignKeyViolation
-	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+	case errors.Is(err, gorm.ErrDuplicatedKey):
 		return models.InboundOrder{}, errors.New("inbound order number already exists, must be unique")
-	case result.Error != nil:
-		return models.InboundOrder{}, result.Error
+	case err != nil:
+		return models.InboundOrder{}, err
 	}
 
 	return inboundOrder, nil
 }
 
-func (i *InboundOrderRepository) Update(inboundOrder models.InboundOrder) (models.InboundOrder, error) {
-	result := i.db.Save(&inboundOrder)
+// receiveProductBatch records the stock of the batch of an inbound order as received by its employee, for the
+// batches that hold stock without a receipt because they were loaded before the ledger posted it on creation. A batch
+// is received once, the inbound orders registered for it afterwards do not add stock.
+func receiveProductBatch(tx *gorm.DB, inboundOrder models.InboundOrder) error {
+	batch, err := lockProductBatch(tx, inboundOrder.ProductBatchId)
+	if err != nil {
+		return err
+	}
+
+	var receipts int64
+	err = tx.Model(&models.StockMovement{}).
+		Where("product_batch_id = ? AND reason = ?", batch.Id, models.StockMovementReceipt).
+		Count(&receipts).Error
+	if err != nil || receipts > 0 || batch.CurrentQuantity == 0 {
+		return err
+	}
+
+	employeeId := inboundOrder.EmployeeId
+	return appendStockMovement(tx, &models.StockMovement{
+		ProductBatchId: batch.Id,
+		SectionId:      batch.SectionId,
+		Quantity:       batch.CurrentQuantity,
+		Reason:         models.StockMovementReceipt,
+		EmployeeId:     &employeeId,
+	})
+}
+
+func (i *InboundOrderRepository) Update(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error) {
+	result := i.db.WithContext(ctx).Save(&inboundOrder)
 
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
@@ -72,18 +110,18 @@ func (i *InboundOrderRepository) Update(inboundOrder models.InboundOrder) (model
 	return inboundOrder, nil
 }
 
-func (i *InboundOrderRepository) PartialUpdate(id int, fields map[string]interface{}) (models.InboundOrder, error) {
+func (i *InboundOrderRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.InboundOrder, error) {
 	var inboundOrder models.InboundOrder
 
 	// First, find the seller to update
-	result := i.db.First(&inboundOrder, id)
+	result := i.db.WithContext(ctx).First(&inboundOrder, id)
 
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.InboundOrder{}, repository.ErrEntityNotFound
 	}
 
 	// Update only the specified fields
-	result = i.db.Model(&inboundOrder).Updates(fields)
+	result = i.db.WithContext(ctx).Model(&inboundOrder).Updates(fields)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.InboundOrder{}, repository.ErrForeignKeyViolation
@@ -96,8 +134,8 @@ func (i *InboundOrderRepository) PartialUpdate(id int, fields map[string]interfa
 	return inboundOrder, nil
 }
 
-func (i *InboundOrderRepository) Delete(id int) error {
-	result := i.db.Delete(&models.InboundOrder{}, id)
+func (i *InboundOrderRepository) Delete(ctx context.Context, id int) error {
+	result := i.db.WithContext(ctx).Delete(&models.InboundOrder{}, id)
 
 	if result.RowsAffected < 1 {
 		return repository.ErrEntityNotFound
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
@@ -79,7 +80,7 @@ func (s *InboundOrderRepositoryTestSuite) TestFindAll_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `inbound_orders`")).WillReturnRows(rows)
 
 	// Act
-	orders, err := s.repo.FindAll()
+	orders, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -93,7 +94,7 @@ func (s *InboundOrderRepositoryTestSuite) TestFindAll_DatabaseError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `inbound_orders`")).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	orders, err := s.repo.FindAll()
+	orders, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -121,7 +122,7 @@ func (s *InboundOrderRepositoryTestSuite) TestFindById_Success() {
 		WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	order, err := s.repo.FindById(1)
+	order, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -135,7 +136,7 @@ func (s *InboundOrderRepositoryTestSuite) TestFindById_NotFound() {
 		WithArgs(999, 1).WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	order, err := s.repo.FindById(999)
+	order, err := s.repo.FindById(context.Background(), 999)
 
 	// Assert
 	s.Error(err)
@@ -149,7 +150,7 @@ func (s *InboundOrderRepositoryTestSuite) TestFindById_DatabaseError() {
 		WithArgs(1, 1).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	order, err := s.repo.FindById(1)
+	order, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.Error(err)
@@ -172,10 +173,22 @@ func (s *InboundOrderRepositoryTestSuite) TestCreate_Success() {
 	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `inbound_orders` (`order_number`,`order_date`,`employee_id`,`product_batch_id`,`warehouse_id`) VALUES (?,?,?,?,?)")).
 		WithArgs(newOrder.OrderNumber, newOrder.OrderDate, newOrder.EmployeeId, newOrder.ProductBatchId, newOrder.WarehouseId).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id"}).AddRow(3, 200, 4, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `stock_movements` WHERE product_batch_id = ? AND reason = ?")).
+		WithArgs(3, models.StockMovementReceipt).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements` (`product_batch_id`,`section_id`,`quantity`,`reason`,`reason_code`,`employee_id`,`actor`,`created_at`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs(3, 4, 200, models.StockMovementReceipt, nil, 3, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox` (`aggregate_type`,`aggregate_id`,`event_type`,`payload`,`status`,`attempts`,`last_error`,`next_attempt_at`,`dispatched_at`,`created_at`) VALUES (?,?,?,?,?,?,?,?,?,?)")).
+		WithArgs(models.OutboxAggregateInboundOrder, 1, models.OutboxEventInboundOrderCreated, sqlmock.AnyArg(), models.OutboxPending, 0, "", nil, nil, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
 	// Act
-	createdOrder, err := s.repo.Create(newOrder)
+	createdOrder, err := s.repo.Create(context.Background(), newOrder)
 
 	// Assert
 	s.NoError(err)
@@ -183,6 +196,36 @@ func (s *InboundOrderRepositoryTestSuite) TestCreate_Success() {
 	s.Equal(1, createdOrder.Id)
 }
 
+func (s *InboundOrderRepositoryTestSuite) TestCreate_BatchAlreadyReceived() {
+	// Arrange
+	newOrder := models.InboundOrder{
+		OrderNumber:    "ORD-004",
+		OrderDate:      time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC),
+		EmployeeId:     3,
+		ProductBatchId: 3,
+		WarehouseId:    3,
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `inbound_orders`")).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id"}).AddRow(3, 150, 4, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `stock_movements`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox`")).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	createdOrder, err := s.repo.Create(context.Background(), newOrder)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(2, createdOrder.Id)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
 func (s *InboundOrderRepositoryTestSuite) TestCreate_ForeignKeyViolated() {
 	// Arrange
 	orderDate := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
@@ -201,7 +244,7 @@ func (s *InboundOrderRepositoryTestSuite) TestCreate_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdOrder, err := s.repo.Create(newOrder)
+	createdOrder, err := s.repo.Create(context.Background(), newOrder)
 
 	// Assert
 	s.Error(err)
@@ -227,7 +270,7 @@ func (s *InboundOrderRepositoryTestSuite) TestCreate_DuplicatedKey() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdOrder, err := s.repo.Create(newOrder)
+	createdOrder, err := s.repo.Create(context.Background(), newOrder)
 
 	// Assert
 	s.Error(err)
@@ -253,7 +296,7 @@ func (s *InboundOrderRepositoryTestSuite) TestCreate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdOrder, err := s.repo.Create(newOrder)
+	createdOrder, err := s.repo.Create(context.Background(), newOrder)
 
 	// Assert
 	s.Error(err)
@@ -280,7 +323,7 @@ func (s *InboundOrderRepositoryTestSuite) TestUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedOrder, err := s.repo.Update(existingOrder)
+	updatedOrder, err := s.repo.Update(context.Background(), existingOrder)
 
 	// Assert
 	s.NoError(err)
@@ -307,7 +350,7 @@ func (s *InboundOrderRepositoryTestSuite) TestUpdate_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedOrder, err := s.repo.Update(existingOrder)
+	updatedOrder, err := s.repo.Update(context.Background(), existingOrder)
 
 	// Assert
 	s.Error(err)
@@ -334,7 +377,7 @@ func (s *InboundOrderRepositoryTestSuite) TestUpdate_DuplicatedKey() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedOrder, err := s.repo.Update(existingOrder)
+	updatedOrder, err := s.repo.Update(context.Background(), existingOrder)
 
 	// Assert
 	s.Error(err)
@@ -361,7 +404,7 @@ func (s *InboundOrderRepositoryTestSuite) TestUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedOrder, err := s.repo.Update(existingOrder)
+	updatedOrder, err := s.repo.Update(context.Background(), existingOrder)
 
 	// Assert
 	s.Error(err)
@@ -402,7 +445,7 @@ func (s *InboundOrderRepositoryTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedOrder, err := s.repo.PartialUpdate(orderID, fields)
+	updatedOrder, err := s.repo.PartialUpdate(context.Background(), orderID, fields)
 
 	// Assert
 	s.NoError(err)
@@ -420,7 +463,7 @@ func (s *InboundOrderRepositoryTestSuite) TestPartialUpdate_NotFound() {
 		WithArgs(orderID, 1).WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	updatedOrder, err := s.repo.PartialUpdate(orderID, fields)
+	updatedOrder, err := s.repo.PartialUpdate(context.Background(), orderID, fields)
 
 	// Assert
 	s.Error(err)
@@ -460,7 +503,7 @@ func (s *InboundOrderRepositoryTestSuite) TestPartialUpdate_ForeignKeyViolated()
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedOrder, err := s.repo.PartialUpdate(orderID, fields)
+	updatedOrder, err := s.repo.PartialUpdate(context.Background(), orderID, fields)
 
 	// Assert
 	s.Error(err)
@@ -500,7 +543,7 @@ func (s *InboundOrderRepositoryTestSuite) TestPartialUpdate_DuplicatedKey() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedOrder, err := s.repo.PartialUpdate(orderID, fields)
+	updatedOrder, err := s.repo.PartialUpdate(context.Background(), orderID, fields)
 
 	// Assert
 	s.Error(err)
@@ -540,7 +583,7 @@ func (s *InboundOrderRepositoryTestSuite) TestPartialUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedOrder, err := s.repo.PartialUpdate(orderID, fields)
+	updatedOrder, err := s.repo.PartialUpdate(context.Background(), orderID, fields)
 
 	// Assert
 	s.Error(err)
@@ -558,7 +601,7 @@ func (s *InboundOrderRepositoryTestSuite) TestDelete_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(orderID)
+	err := s.repo.Delete(context.Background(), orderID)
 
 	// Assert
 	s.NoError(err)
@@ -575,7 +618,7 @@ func (s *InboundOrderRepositoryTestSuite) TestDelete_NotFound() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(orderID)
+	err := s.repo.Delete(context.Background(), orderID)
 
 	// Assert
 	s.Error(err)
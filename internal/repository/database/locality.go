@@ -1,12 +1,17 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"gorm.io/gorm"
+	"strings"
 )
 
+// likeEscaper escapes the wildcards of a LIKE pattern, so that a search matches them literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 type LocalityRepository struct {
 	db *gorm.DB
 }
@@ -15,10 +20,10 @@ func NewLocalityRepository(db *gorm.DB) *LocalityRepository {
 	return &LocalityRepository{db: db}
 }
 
-func (l LocalityRepository) FindLocalityBySeller(id int) (models.LocalitySellerCount, error) {
+func (l LocalityRepository) FindLocalityBySeller(ctx context.Context, id int) (models.LocalitySellerCount, error) {
 	var locality models.LocalitySellerCount
 
-	result := l.db.Model(&models.Locality{}).
+	result := l.db.WithContext(ctx).Model(&models.Locality{}).
 		Select("localities.id as id, localities.locality as locality, p.province as province, c.country as country, COUNT(DISTINCT s.id) as seller_count").
 		Joins("JOIN provinces p ON localities.province_id = p.id").
 		Joins("JOIN countries c ON p.country_id = c.id").
@@ -39,10 +44,10 @@ func (l LocalityRepository) FindLocalityBySeller(id int) (models.LocalitySellerC
 	return locality, nil
 }
 
-func (l LocalityRepository) FindAllLocality() ([]models.LocalitySellerCount, error) {
+func (l LocalityRepository) FindAllLocality(ctx context.Context) ([]models.LocalitySellerCount, error) {
 	var localitiesSellers []models.LocalitySellerCount
 
-	result := l.db.Model(&models.Locality{}).
+	result := l.db.WithContext(ctx).Model(&models.Locality{}).
 		Select("localities.id as id, localities.locality as locality, p.province as province, c.country as country, COUNT(DISTINCT s.id) as seller_count").
 		Joins("JOIN provinces p ON localities.province_id = p.id").
 		Joins("JOIN countries c ON p.country_id = c.id").
@@ -57,18 +62,18 @@ func (l LocalityRepository) FindAllLocality() ([]models.LocalitySellerCount, err
 	return localitiesSellers, nil
 }
 
-func (l LocalityRepository) FindAll() ([]models.Locality, error) {
+func (l LocalityRepository) FindAll(ctx context.Context) ([]models.Locality, error) {
 	var localities []models.Locality
-	result := l.db.Find(&localities)
+	result := l.db.WithContext(ctx).Find(&localities)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return localities, nil
 }
 
-func (l LocalityRepository) FindById(id int) (models.Locality, error) {
+func (l LocalityRepository) FindById(ctx context.Context, id int) (models.Locality, error) {
 	var locality models.Locality
-	result := l.db.First(&locality, id)
+	result := l.db.WithContext(ctx).First(&locality, id)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Locality{}, repository.ErrEntityNotFound
 	}
@@ -78,9 +83,9 @@ func (l LocalityRepository) FindById(id int) (models.Locality, error) {
 	return locality, nil
 }
 
-func (l LocalityRepository) Create(locality models.Locality) (models.Locality, error) {
+func (l LocalityRepository) Create(ctx context.Context, locality models.Locality) (models.Locality, error) {
 	var province models.Province
-	result := l.db.First(&province, locality.ProvinceId)
+	result := l.db.WithContext(ctx).First(&province, locality.ProvinceId)
 
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Locality{}, repository.ErrForeignKeyViolation
@@ -89,7 +94,7 @@ func (l LocalityRepository) Create(locality models.Locality) (models.Locality, e
 		return models.Locality{}, result.Error
 	}
 
-	result = l.db.Create(&locality)
+	result = l.db.WithContext(ctx).Create(&locality)
 	switch {
 	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
 		return models.Locality{}, repository.ErrEntityAlreadyExists
@@ -102,8 +107,8 @@ func (l LocalityRepository) Create(locality models.Locality) (models.Locality, e
 	return locality, nil
 }
 
-func (l LocalityRepository) Update(locality models.Locality) (models.Locality, error) {
-	result := l.db.Save(&locality)
+func (l LocalityRepository) Update(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	result := l.db.WithContext(ctx).Save(&locality)
 	if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
 		return models.Locality{}, repository.ErrForeignKeyViolation
 	}
@@ -113,9 +118,9 @@ func (l LocalityRepository) Update(locality models.Locality) (models.Locality, e
 	return locality, nil
 }
 
-func (l LocalityRepository) PartialUpdate(id int, fields map[string]interface{}) (models.Locality, error) {
+func (l LocalityRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Locality, error) {
 	var locality models.Locality
-	result := l.db.First(&locality, id)
+	result := l.db.WithContext(ctx).First(&locality, id)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Locality{}, repository.ErrEntityNotFound
 	}
@@ -123,7 +128,7 @@ func (l LocalityRepository) PartialUpdate(id int, fields map[string]interface{})
 		return models.Locality{}, result.Error
 	}
 
-	result = l.db.Model(&locality).Updates(fields)
+	result = l.db.WithContext(ctx).Model(&locality).Updates(fields)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.Locality{}, repository.ErrForeignKeyViolation
@@ -133,9 +138,13 @@ func (l LocalityRepository) PartialUpdate(id int, fields map[string]interface{})
 	return locality, nil
 }
 
-func (l LocalityRepository) Delete(id int) error {
-	result := l.db.Delete(&models.Locality{}, id)
+// Delete removes a locality, it returns ErrForeignKeyViolation when a seller, a carrier or a warehouse still
+// references it
+func (l LocalityRepository) Delete(ctx context.Context, id int) error {
+	result := l.db.WithContext(ctx).Delete(&models.Locality{}, id)
 	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return repository.ErrForeignKeyViolation
 	case result.Error != nil:
 		return result.Error
 	case result.RowsAffected < 1:
@@ -144,10 +153,10 @@ func (l LocalityRepository) Delete(id int) error {
 	return nil
 }
 
-func (l LocalityRepository) FindAllCarriers() ([]models.LocalityCarrierCount, error) {
+func (l LocalityRepository) FindAllCarriers(ctx context.Context) ([]models.LocalityCarrierCount, error) {
 
 	var carriers []models.LocalityCarrierCount
-	err := l.db.Model(&models.Locality{}).
+	err := l.db.WithContext(ctx).Model(&models.Locality{}).
 		Select("localities.id as 'locality_id', localities.locality as 'locality_name', COUNT(carriers.id) 'total_carriers'").
 		Joins("LEFT JOIN carriers ON localities.id = carriers.locality_id").
 		Group("localities.id").
@@ -164,10 +173,10 @@ func (l LocalityRepository) FindAllCarriers() ([]models.LocalityCarrierCount, er
 	return carriers, nil
 }
 
-func (l LocalityRepository) FindCarriersByLocality(id int) ([]models.LocalityCarrierCount, error) {
+func (l LocalityRepository) FindCarriersByLocality(ctx context.Context, id int) ([]models.LocalityCarrierCount, error) {
 
 	var carriers []models.LocalityCarrierCount
-	err := l.db.Model(&models.Locality{}).
+	err := l.db.WithContext(ctx).Model(&models.Locality{}).
 		Select("localities.id as 'locality_id', localities.locality as 'locality_name', COUNT(carriers.id) 'total_carriers'").
 		Joins("LEFT JOIN carriers ON localities.id = carriers.locality_id").
 		Where("localities.id = ?", id).
@@ -185,9 +194,9 @@ func (l LocalityRepository) FindCarriersByLocality(id int) ([]models.LocalityCar
 	return carriers, nil
 }
 
-func (l LocalityRepository) CreateWithNames(locality models.LocalityDoc) (models.LocalityDoc, error) {
+func (l LocalityRepository) CreateWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error) {
 	var province models.Province
-	result := l.db.Joins("INNER JOIN countries ON countries.id = provinces.country_id").
+	result := l.db.WithContext(ctx).Joins("INNER JOIN countries ON countries.id = provinces.country_id").
 		Where("provinces.province = ? AND countries.country = ?", locality.Province, locality.Country).
 		First(&province)
 
@@ -205,7 +214,7 @@ func (l LocalityRepository) CreateWithNames(locality models.LocalityDoc) (models
 		ProvinceId: province.Id,
 	}
 
-	result = l.db.Create(&localityCreated)
+	result = l.db.WithContext(ctx).Create(&localityCreated)
 	switch {
 	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
 		return models.LocalityDoc{}, repository.ErrEntityAlreadyExists
@@ -217,3 +226,28 @@ func (l LocalityRepository) CreateWithNames(locality models.LocalityDoc) (models
 
 	return locality, nil
 }
+
+func (l LocalityRepository) FindByProvince(ctx context.Context, provinceId int) ([]models.Locality, error) {
+	var localities []models.Locality
+	result := l.db.WithContext(ctx).Where("province_id = ?", provinceId).Order("id").Find(&localities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return localities, nil
+}
+
+// SearchByName matches the name with an accent and case insensitive collation, so that "cordoba" finds "Córdoba"
+func (l LocalityRepository) SearchByName(ctx context.Context, name string) ([]models.LocalityDoc, error) {
+	var localities []models.LocalityDoc
+	result := l.db.WithContext(ctx).Model(&models.Locality{}).
+		Select("localities.id as id, localities.locality as locality, p.province as province, c.country as country").
+		Joins("JOIN provinces p ON localities.province_id = p.id").
+		Joins("JOIN countries c ON p.country_id = c.id").
+		Where("localities.locality COLLATE utf8mb4_0900_ai_ci LIKE ?", "%"+likeEscaper.Replace(name)+"%").
+		Order("localities.locality, localities.id").
+		Find(&localities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return localities, nil
+}
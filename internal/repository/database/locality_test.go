@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -62,7 +63,7 @@ func (s *LocalityRepositoryTestSuite) TestCreate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	createdLocality, err := s.repo.Create(newLocality)
+	createdLocality, err := s.repo.Create(context.Background(), newLocality)
 
 	// Asserts
 	s.NoError(err)
@@ -84,7 +85,7 @@ func (s *LocalityRepositoryTestSuite) TestCreate_ProvinceNotFound() {
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	createdLocality, err := s.repo.Create(newLocality)
+	createdLocality, err := s.repo.Create(context.Background(), newLocality)
 
 	// Asserts
 	s.Error(err)
@@ -106,7 +107,7 @@ func (s *LocalityRepositoryTestSuite) TestCreate_ProvinceFindDatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	createdLocality, err := s.repo.Create(newLocality)
+	createdLocality, err := s.repo.Create(context.Background(), newLocality)
 
 	// Asserts
 	s.Error(err)
@@ -134,7 +135,7 @@ func (s *LocalityRepositoryTestSuite) TestCreate_DuplicatedKey() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdLocality, err := s.repo.Create(newLocality)
+	createdLocality, err := s.repo.Create(context.Background(), newLocality)
 
 	// Asserts
 	s.Error(err)
@@ -162,7 +163,7 @@ func (s *LocalityRepositoryTestSuite) TestCreate_ForeignKeyViolation() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdLocality, err := s.repo.Create(newLocality)
+	createdLocality, err := s.repo.Create(context.Background(), newLocality)
 
 	// Asserts
 	s.Error(err)
@@ -190,7 +191,7 @@ func (s *LocalityRepositoryTestSuite) TestCreate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdLocality, err := s.repo.Create(newLocality)
+	createdLocality, err := s.repo.Create(context.Background(), newLocality)
 
 	// Asserts
 	s.Error(err)
@@ -215,7 +216,7 @@ func (s *LocalityRepositoryTestSuite) TestFindById_Success() {
 		WillReturnRows(localityRows)
 
 	// Act
-	locality, err := s.repo.FindById(1)
+	locality, err := s.repo.FindById(context.Background(), 1)
 
 	// Asserts
 	s.NoError(err)
@@ -232,7 +233,7 @@ func (s *LocalityRepositoryTestSuite) TestFindById_EntityNotFound() {
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	locality, err := s.repo.FindById(999)
+	locality, err := s.repo.FindById(context.Background(), 999)
 
 	// Asserts
 	s.Error(err)
@@ -248,7 +249,7 @@ func (s *LocalityRepositoryTestSuite) TestFindById_DatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	locality, err := s.repo.FindById(1)
+	locality, err := s.repo.FindById(context.Background(), 1)
 
 	// Asserts
 	s.Error(err)
@@ -280,7 +281,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAll_Success() {
 		WillReturnRows(localityRows)
 
 	// Act
-	localities, err := s.repo.FindAll()
+	localities, err := s.repo.FindAll(context.Background())
 
 	// Asserts
 	s.NoError(err)
@@ -300,7 +301,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAll_SuccessEmptyResult() {
 		WillReturnRows(localityRows)
 
 	// Act
-	localities, err := s.repo.FindAll()
+	localities, err := s.repo.FindAll(context.Background())
 
 	// Asserts
 	s.NoError(err)
@@ -315,7 +316,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAll_DatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	localities, err := s.repo.FindAll()
+	localities, err := s.repo.FindAll(context.Background())
 
 	// Asserts
 	s.Error(err)
@@ -355,7 +356,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllLocality_Success() {
 		WillReturnRows(localityRows)
 
 	// Act
-	localities, err := s.repo.FindAllLocality()
+	localities, err := s.repo.FindAllLocality(context.Background())
 
 	// Asserts
 	s.NoError(err)
@@ -378,7 +379,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllLocality_SuccessEmptyResult() {
 		WillReturnRows(localityRows)
 
 	// Act
-	localities, err := s.repo.FindAllLocality()
+	localities, err := s.repo.FindAllLocality(context.Background())
 
 	// Asserts
 	s.NoError(err)
@@ -393,7 +394,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllLocality_DatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	localities, err := s.repo.FindAllLocality()
+	localities, err := s.repo.FindAllLocality(context.Background())
 
 	// Asserts
 	s.Error(err)
@@ -423,7 +424,7 @@ func (s *LocalityRepositoryTestSuite) TestFindLocalityBySeller_Success() {
 		WillReturnRows(localityRows)
 
 	// Act
-	locality, err := s.repo.FindLocalityBySeller(localityId)
+	locality, err := s.repo.FindLocalityBySeller(context.Background(), localityId)
 
 	// Asserts
 	s.NoError(err)
@@ -446,7 +447,7 @@ func (s *LocalityRepositoryTestSuite) TestFindLocalityBySeller_EntityNotFound()
 		WillReturnRows(localityRows)
 
 	// Act
-	locality, err := s.repo.FindLocalityBySeller(localityId)
+	locality, err := s.repo.FindLocalityBySeller(context.Background(), localityId)
 
 	// Asserts
 	s.Error(err)
@@ -464,7 +465,7 @@ func (s *LocalityRepositoryTestSuite) TestFindLocalityBySeller_DatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	locality, err := s.repo.FindLocalityBySeller(localityId)
+	locality, err := s.repo.FindLocalityBySeller(context.Background(), localityId)
 
 	// Asserts
 	s.Error(err)
@@ -482,7 +483,7 @@ func (s *LocalityRepositoryTestSuite) TestFindLocalityBySeller_GormRecordNotFoun
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	locality, err := s.repo.FindLocalityBySeller(localityId)
+	locality, err := s.repo.FindLocalityBySeller(context.Background(), localityId)
 
 	// Asserts
 	s.Error(err)
@@ -506,7 +507,7 @@ func (s *LocalityRepositoryTestSuite) TestUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedLocality, err := s.repo.Update(localityToUpdate)
+	updatedLocality, err := s.repo.Update(context.Background(), localityToUpdate)
 
 	// Asserts
 	s.NoError(err)
@@ -531,7 +532,7 @@ func (s *LocalityRepositoryTestSuite) TestUpdate_ForeignKeyViolation() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedLocality, err := s.repo.Update(localityToUpdate)
+	updatedLocality, err := s.repo.Update(context.Background(), localityToUpdate)
 
 	// Asserts
 	s.Error(err)
@@ -555,7 +556,7 @@ func (s *LocalityRepositoryTestSuite) TestUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedLocality, err := s.repo.Update(localityToUpdate)
+	updatedLocality, err := s.repo.Update(context.Background(), localityToUpdate)
 
 	// Asserts
 	s.Error(err)
@@ -591,7 +592,7 @@ func (s *LocalityRepositoryTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedLocality, err := s.repo.PartialUpdate(localityId, fieldsToUpdate)
+	updatedLocality, err := s.repo.PartialUpdate(context.Background(), localityId, fieldsToUpdate)
 
 	// Asserts
 	s.NoError(err)
@@ -613,7 +614,7 @@ func (s *LocalityRepositoryTestSuite) TestPartialUpdate_EntityNotFound() {
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	updatedLocality, err := s.repo.PartialUpdate(localityId, fieldsToUpdate)
+	updatedLocality, err := s.repo.PartialUpdate(context.Background(), localityId, fieldsToUpdate)
 
 	// Asserts
 	s.Error(err)
@@ -634,7 +635,7 @@ func (s *LocalityRepositoryTestSuite) TestPartialUpdate_FindDatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	updatedLocality, err := s.repo.PartialUpdate(localityId, fieldsToUpdate)
+	updatedLocality, err := s.repo.PartialUpdate(context.Background(), localityId, fieldsToUpdate)
 
 	// Asserts
 	s.Error(err)
@@ -670,7 +671,7 @@ func (s *LocalityRepositoryTestSuite) TestPartialUpdate_UpdateForeignKeyViolatio
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedLocality, err := s.repo.PartialUpdate(localityId, fieldsToUpdate)
+	updatedLocality, err := s.repo.PartialUpdate(context.Background(), localityId, fieldsToUpdate)
 
 	// Asserts
 	s.Error(err)
@@ -706,7 +707,7 @@ func (s *LocalityRepositoryTestSuite) TestPartialUpdate_UpdateDatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedLocality, err := s.repo.PartialUpdate(localityId, fieldsToUpdate)
+	updatedLocality, err := s.repo.PartialUpdate(context.Background(), localityId, fieldsToUpdate)
 
 	// Asserts
 	s.Error(err)
@@ -726,7 +727,7 @@ func (s *LocalityRepositoryTestSuite) TestDelete_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(localityId)
+	err := s.repo.Delete(context.Background(), localityId)
 
 	// Asserts
 	s.NoError(err)
@@ -744,7 +745,7 @@ func (s *LocalityRepositoryTestSuite) TestDelete_EntityNotFound() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(localityId)
+	err := s.repo.Delete(context.Background(), localityId)
 
 	// Asserts
 	s.Error(err)
@@ -763,7 +764,7 @@ func (s *LocalityRepositoryTestSuite) TestDelete_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	err := s.repo.Delete(localityId)
+	err := s.repo.Delete(context.Background(), localityId)
 
 	// Asserts
 	s.Error(err)
@@ -796,7 +797,7 @@ func (s *LocalityRepositoryTestSuite) TestCreateWithNames_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	createdLocality, err := s.repo.CreateWithNames(newLocalityDoc)
+	createdLocality, err := s.repo.CreateWithNames(context.Background(), newLocalityDoc)
 
 	// Asserts
 	s.NoError(err)
@@ -822,7 +823,7 @@ func (s *LocalityRepositoryTestSuite) TestCreateWithNames_ProvinceNotFound() {
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	createdLocality, err := s.repo.CreateWithNames(newLocalityDoc)
+	createdLocality, err := s.repo.CreateWithNames(context.Background(), newLocalityDoc)
 
 	// Asserts
 	s.Error(err)
@@ -846,7 +847,7 @@ func (s *LocalityRepositoryTestSuite) TestCreateWithNames_DatabaseErrorFindingPr
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	createdLocality, err := s.repo.CreateWithNames(newLocalityDoc)
+	createdLocality, err := s.repo.CreateWithNames(context.Background(), newLocalityDoc)
 
 	// Asserts
 	s.Error(err)
@@ -880,7 +881,7 @@ func (s *LocalityRepositoryTestSuite) TestCreateWithNames_DuplicatedKeyError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdLocality, err := s.repo.CreateWithNames(newLocalityDoc)
+	createdLocality, err := s.repo.CreateWithNames(context.Background(), newLocalityDoc)
 
 	// Asserts
 	s.Error(err)
@@ -914,7 +915,7 @@ func (s *LocalityRepositoryTestSuite) TestCreateWithNames_ForeignKeyViolation()
 	s.mock.ExpectRollback()
 
 	// Act
-	createdLocality, err := s.repo.CreateWithNames(newLocalityDoc)
+	createdLocality, err := s.repo.CreateWithNames(context.Background(), newLocalityDoc)
 
 	// Asserts
 	s.Error(err)
@@ -948,7 +949,7 @@ func (s *LocalityRepositoryTestSuite) TestCreateWithNames_OtherDatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdLocality, err := s.repo.CreateWithNames(newLocalityDoc)
+	createdLocality, err := s.repo.CreateWithNames(context.Background(), newLocalityDoc)
 
 	// Asserts
 	s.Error(err)
@@ -992,7 +993,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllCarriers_Success() {
 	)).WillReturnRows(rows)
 
 	// Act
-	localities, err := s.repo.FindAllCarriers()
+	localities, err := s.repo.FindAllCarriers(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -1018,7 +1019,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllCarriers_NoRecordsFound() {
 	)).WillReturnRows(rows)
 
 	// Act
-	localities, err := s.repo.FindAllCarriers()
+	localities, err := s.repo.FindAllCarriers(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -1035,7 +1036,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllCarriers_DatabaseError() {
 	)).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	localities, err := s.repo.FindAllCarriers()
+	localities, err := s.repo.FindAllCarriers(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -1076,7 +1077,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllCarriersByLocality_Success() {
 	)).WithArgs(id).WillReturnRows(rows)
 
 	// Act
-	localities, err := s.repo.FindCarriersByLocality(id)
+	localities, err := s.repo.FindCarriersByLocality(context.Background(), id)
 
 	// Assert
 	s.NoError(err)
@@ -1095,7 +1096,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllCarriersByLocality_DatabasError
 	)).WithArgs(id).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	localities, err := s.repo.FindCarriersByLocality(id)
+	localities, err := s.repo.FindCarriersByLocality(context.Background(), id)
 
 	// Assert
 	s.Error(err)
@@ -1121,7 +1122,7 @@ func (s *LocalityRepositoryTestSuite) TestFindAllCarriersByLocality_NoRecordsFou
 	)).WithArgs(id).WillReturnRows(rows)
 
 	// Act
-	localities, err := s.repo.FindCarriersByLocality(id)
+	localities, err := s.repo.FindCarriersByLocality(context.Background(), id)
 
 	// Assert
 	s.Error(err)
@@ -1131,6 +1132,53 @@ func (s *LocalityRepositoryTestSuite) TestFindAllCarriersByLocality_NoRecordsFou
 	s.NoError(err)
 }
 
+func (s *LocalityRepositoryTestSuite) TestSearchByName_EscapesWildcards() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("WHERE localities.locality COLLATE utf8mb4_0900_ai_ci LIKE ?")).
+		WithArgs(`%100\%%`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "locality", "province", "country"}))
+
+	// Act
+	localities, err := s.repo.SearchByName(context.Background(), "100%")
+
+	// Assert
+	s.NoError(err)
+	s.Empty(localities)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *LocalityRepositoryTestSuite) TestSearchByName_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT localities.id as id, localities.locality as locality, p.province as province, c.country as country FROM `localities`")).
+		WithArgs("%cordoba%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "locality", "province", "country"}).
+			AddRow(2, "Córdoba", "Córdoba", "Argentina"))
+
+	// Act
+	localities, err := s.repo.SearchByName(context.Background(), "cordoba")
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.LocalityDoc{{Id: 2, Locality: "Córdoba", Province: "Córdoba", Country: "Argentina"}}, localities)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *LocalityRepositoryTestSuite) TestDelete_StillReferenced() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `localities` WHERE `localities`.`id` = ?")).
+		WithArgs(1).
+		WillReturnError(gorm.ErrForeignKeyViolated)
+	s.mock.ExpectRollback()
+
+	// Act
+	err := s.repo.Delete(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrForeignKeyViolation)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
 func TestLocalityRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(LocalityRepositoryTestSuite))
 }
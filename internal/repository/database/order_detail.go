@@ -1,8 +1,13 @@
 package database
 
 import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type OrderDetailRepository struct {
@@ -14,79 +19,177 @@ func NewOrderDetailRepository(db *gorm.DB) *OrderDetailRepository {
 }
 
 // FindAll retrieves all order details
-func (r *OrderDetailRepository) FindAll() ([]models.OrderDetail, error) {
+func (r *OrderDetailRepository) FindAll(ctx context.Context) ([]models.OrderDetail, error) {
 	orderDetails := make([]models.OrderDetail, 0)
-	result := r.db.Find(&orderDetails)
+	result := r.db.WithContext(ctx).Find(&orderDetails)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return orderDetails, nil
 }
 
+// FindByPurchaseOrders retrieves the lines of the given purchase orders
+func (r *OrderDetailRepository) FindByPurchaseOrders(ctx context.Context, purchaseOrderIds []int) ([]models.OrderDetail, error) {
+	return findIn[models.OrderDetail](r.db.WithContext(ctx), "purchase_order_id", purchaseOrderIds)
+}
+
 // FindById retrieves a specific order detail by ID
-func (r *OrderDetailRepository) FindById(id int) (models.OrderDetail, error) {
+func (r *OrderDetailRepository) FindById(ctx context.Context, id int) (models.OrderDetail, error) {
 	var od models.OrderDetail
-	result := r.db.First(&od, id)
-	if result.Error != nil {
+	result := r.db.WithContext(ctx).First(&od, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.OrderDetail{}, repository.ErrEntityNotFound
+	case result.Error != nil:
 		return models.OrderDetail{}, result.Error
 	}
 	return od, nil
 }
 
 // Create inserts a new order detail
-func (r *OrderDetailRepository) Create(od models.OrderDetail) (models.OrderDetail, error) {
-	result := r.db.Create(&od)
-	if result.Error != nil {
+func (r *OrderDetailRepository) Create(ctx context.Context, od models.OrderDetail) (models.OrderDetail, error) {
+	result := r.db.WithContext(ctx).Create(&od)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.OrderDetail{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
 		return models.OrderDetail{}, result.Error
 	}
 	return od, nil
 }
 
 // Update updates an entire order detail
-func (r *OrderDetailRepository) Update(od models.OrderDetail) (models.OrderDetail, error) {
-	result := r.db.Save(&od)
-	if result.Error != nil {
+func (r *OrderDetailRepository) Update(ctx context.Context, od models.OrderDetail) (models.OrderDetail, error) {
+	result := r.db.WithContext(ctx).Save(&od)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.OrderDetail{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
 		return models.OrderDetail{}, result.Error
 	}
 	return od, nil
 }
 
 // PartialUpdate modifies only specific fields
-func (r *OrderDetailRepository) PartialUpdate(id int, fields map[string]interface{}) (models.OrderDetail, error) {
+func (r *OrderDetailRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.OrderDetail, error) {
 	var od models.OrderDetail
-	result := r.db.First(&od, id)
+	result := r.db.WithContext(ctx).First(&od, id)
+	if result.Error != nil {
+		return models.OrderDetail{}, result.Error
+	}
+
+	// Apply each field, converted to the type of the model field
+	if err := patch.Apply(&od, fields); err != nil {
+		return models.OrderDetail{}, err
+	}
+
+	result = r.db.WithContext(ctx).Save(&od)
 	if result.Error != nil {
 		return models.OrderDetail{}, result.Error
 	}
+	return od, nil
+}
 
-	if val, ok := fields["quantity"]; ok {
-		q := int(val.(float64))
-		od.Quantity = q
+// Delete removes an order detail by ID
+func (r *OrderDetailRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.OrderDetail{}, id)
+	if result.Error != nil {
+		return result.Error
 	}
-	if val, ok := fields["clean_lines_status"]; ok {
-		status := val.(string)
-		od.CleanLinesStatus = status
+	if result.RowsAffected < 1 {
+		return repository.ErrEntityNotFound
 	}
-	if val, ok := fields["temperature"]; ok {
-		t := val.(float64)
-		od.Temperature = t
+	return nil
+}
+
+// FindByPurchaseOrder retrieves the lines of a purchase order, ordered by their ID
+func (r *OrderDetailRepository) FindByPurchaseOrder(ctx context.Context, purchaseOrderId int) ([]models.OrderDetail, error) {
+	orderDetails := make([]models.OrderDetail, 0)
+	result := r.db.WithContext(ctx).Where("purchase_order_id = ?", purchaseOrderId).Order("id").Find(&orderDetails)
+	if result.Error != nil {
+		return nil, result.Error
 	}
-	if val, ok := fields["product_record_id"]; ok {
-		od.ProductRecordID = int(val.(float64))
+	return orderDetails, nil
+}
+
+// FindTotals computes the totals of a purchase order from its priced lines, the amount is rounded line by line as
+// the order is priced
+func (r *OrderDetailRepository) FindTotals(ctx context.Context, purchaseOrderId int) (models.PurchaseOrderTotals, error) {
+	details, err := r.FindPricedByPurchaseOrder(ctx, purchaseOrderId)
+	if err != nil {
+		return models.PurchaseOrderTotals{}, err
 	}
-	if val, ok := fields["purchase_order_id"]; ok {
-		od.PurchaseOrderID = int(val.(float64))
+	return models.NewPurchaseOrderTotals(purchaseOrderId, details), nil
+}
+
+// FindPricedByPurchaseOrder retrieves the lines of a purchase order with the sale price of their product records,
+// ordered by their ID
+func (r *OrderDetailRepository) FindPricedByPurchaseOrder(ctx context.Context, purchaseOrderId int) ([]models.PricedOrderDetail, error) {
+	details := make([]models.PricedOrderDetail, 0)
+	result := r.db.WithContext(ctx).Table("order_details").
+		Select("order_details.*, product_records.sale_price AS unit_price").
+		Joins("JOIN product_records ON product_records.id = order_details.product_record_id").
+		Where("order_details.purchase_order_id = ?", purchaseOrderId).
+		Order("order_details.id").
+		Scan(&details)
+	if result.Error != nil {
+		return nil, result.Error
 	}
+	return details, nil
+}
 
-	result = r.db.Save(&od)
+// FindPicks retrieves the picks of a line in the order they were picked
+func (r *OrderDetailRepository) FindPicks(ctx context.Context, orderDetailId int) ([]models.OrderDetailPick, error) {
+	picks := make([]models.OrderDetailPick, 0)
+	result := r.db.WithContext(ctx).Where("order_detail_id = ?", orderDetailId).Order("picked_at, id").Find(&picks)
 	if result.Error != nil {
-		return models.OrderDetail{}, result.Error
+		return nil, result.Error
 	}
-	return od, nil
+	return picks, nil
 }
 
-// Delete removes an order detail by ID
-func (r *OrderDetailRepository) Delete(id int) error {
-	result := r.db.Delete(&models.OrderDetail{}, id)
-	return result.Error
+// CreatePick posts the pick to the stock ledger and records the batch it was taken from. The line is locked while
+// its picks are summed so concurrent picks cannot exceed its quantity, and a batch emptied by the pick frees its
+// place in its section. Only the stock of an available batch can be picked.
+func (r *OrderDetailRepository) CreatePick(ctx context.Context, pick models.OrderDetailPick) (models.OrderDetailPick, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var detail models.OrderDetail
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&detail, pick.OrderDetailId).Error; err != nil {
+			return err
+		}
+		var picked int
+		err := tx.Model(&models.OrderDetailPick{}).Select("COALESCE(SUM(quantity), 0)").
+			Where("order_detail_id = ?", detail.Id).Scan(&picked).Error
+		if err != nil {
+			return err
+		}
+		if picked+pick.Quantity > detail.Quantity {
+			return repository.ErrOrderDetailOverPicked
+		}
+
+		movement := models.StockMovement{
+			ProductBatchId: pick.ProductBatchId,
+			Quantity:       -pick.Quantity,
+			Reason:         models.StockMovementPick,
+			EmployeeId:     pick.EmployeeId,
+		}
+		if err = adjustStock(tx, &movement); err != nil {
+			return err
+		}
+
+		pick.Id = 0
+		pick.StockMovementId = movement.Id
+		pick.PickedAt = movement.CreatedAt
+		return tx.Create(&pick).Error
+	})
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return models.OrderDetailPick{}, repository.ErrEntityNotFound
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return models.OrderDetailPick{}, repository.ErrForeignKeyViolation
+	case err != nil:
+		return models.OrderDetailPick{}, err
+	}
+	return pick, nil
 }
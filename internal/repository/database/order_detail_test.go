@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -101,7 +102,7 @@ func (s *OrderDetailTestSuite) TestFindAll_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `order_details`")).WillReturnRows(rows)
 
 	// Act
-	od, err := s.repo.FindAll()
+	od, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -114,7 +115,7 @@ func (s *OrderDetailTestSuite) TestFindAll_DatabaseError() {
 	// Arrange
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `order_details`")).WillReturnError(sql.ErrConnDone)
 	// Act
-	orderDetail, err := s.repo.FindAll()
+	orderDetail, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -147,7 +148,7 @@ func (s *OrderDetailTestSuite) TestFindById_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `order_details` WHERE `order_details`.`id` = ? ORDER BY `order_details`.`id` LIMIT ?")).
 		WithArgs(1, 1).WillReturnRows(rows)
 
-	od, err := s.repo.FindById(orderDetail.Id)
+	od, err := s.repo.FindById(context.Background(), orderDetail.Id)
 	s.NoError(err)
 	s.Equal(orderDetail, od)
 	s.Equal(orderDetail.Quantity, od.Quantity)
@@ -159,7 +160,7 @@ func (s *OrderDetailTestSuite) TestFindById_NotFound() {
 		"SELECT * FROM `order_details` WHERE `order_details`.`id` = ? ORDER BY `order_details`.`id` LIMIT ?",
 	)).WithArgs(999, 1).WillReturnError(repository.ErrEntityNotFound)
 	// Act
-	od, err := s.repo.FindById(999)
+	od, err := s.repo.FindById(context.Background(), 999)
 
 	// Assert
 	s.Error(err)
@@ -190,7 +191,7 @@ func (s *OrderDetailTestSuite) TestUpdate_Success() {
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
-	result, err := s.repo.Update(od)
+	result, err := s.repo.Update(context.Background(), od)
 
 	s.NoError(err)
 	s.Equal(od, result)
@@ -219,7 +220,7 @@ func (s *OrderDetailTestSuite) TestUpdate_Error() {
 	).WillReturnError(errors.New("update failed"))
 	s.mock.ExpectRollback()
 
-	result, err := s.repo.Update(od)
+	result, err := s.repo.Update(context.Background(), od)
 
 	s.Error(err)
 	s.Contains(err.Error(), "update failed")
@@ -271,7 +272,7 @@ func (s *OrderDetailTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Ejecutar
-	result, err := s.repo.PartialUpdate(id, updatedFields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, updatedFields)
 
 	// Validar
 	s.NoError(err)
@@ -294,7 +295,7 @@ func (s *OrderDetailTestSuite) TestPartialUpdate_NotFound() {
 	)).WithArgs(id, 1).
 		WillReturnRows(sqlmock.NewRows([]string{})) // sin filas
 
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	s.Error(err)
 	s.Equal(models.OrderDetail{}, result)
@@ -342,7 +343,7 @@ func (s *OrderDetailTestSuite) TestPartialUpdate_SaveError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -360,7 +361,7 @@ func (s *OrderDetailTestSuite) TestDelete_Success() {
 		WillReturnResult(sqlmock.NewResult(0, 1)) // 1 row affected
 	s.mock.ExpectCommit()
 
-	err := s.repo.Delete(id)
+	err := s.repo.Delete(context.Background(), id)
 
 	s.NoError(err)
 	s.NoError(s.mock.ExpectationsWereMet())
@@ -376,13 +377,224 @@ func (s *OrderDetailTestSuite) TestDelete_Error() {
 		WillReturnError(errors.New("delete failed"))
 	s.mock.ExpectRollback()
 
-	err := s.repo.Delete(id)
+	err := s.repo.Delete(context.Background(), id)
 
 	s.Error(err)
 	s.EqualError(err, "delete failed")
 	s.NoError(s.mock.ExpectationsWereMet())
 }
 
+func (s *OrderDetailTestSuite) TestDelete_NotFound() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta(
+		"DELETE FROM `order_details` WHERE `order_details`.`id` = ?",
+	)).WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	err := s.repo.Delete(context.Background(), 9)
+
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *OrderDetailTestSuite) TestCreate_ForeignKeyViolated() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `order_details`")).
+		WillReturnError(gorm.ErrForeignKeyViolated)
+	s.mock.ExpectRollback()
+
+	result, err := s.repo.Create(context.Background(), models.OrderDetail{Quantity: 1, ProductRecordID: 99, PurchaseOrderID: 1})
+
+	s.ErrorIs(err, repository.ErrForeignKeyViolation)
+	s.Equal(models.OrderDetail{}, result)
+}
+
+func (s *OrderDetailTestSuite) TestFindByPurchaseOrder_Success() {
+	rows := sqlmock.NewRows([]string{"id", "quantity", "clean_lines_status", "temperature", "product_record_id", "purchase_order_id"}).
+		AddRow(1, 3, "OK", 4.5, 10, 2).
+		AddRow(2, 5, "OK", 4.0, 11, 2)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `order_details` WHERE purchase_order_id = ? ORDER BY id")).
+		WithArgs(2).WillReturnRows(rows)
+
+	details, err := s.repo.FindByPurchaseOrder(context.Background(), 2)
+
+	s.NoError(err)
+	s.Len(details, 2)
+	s.Equal(2, details[1].Id)
+}
+
+func (s *OrderDetailTestSuite) TestFindTotals_Success() {
+	// every line amounts to 1.01, rounding only their sum would give 2.01
+	rows := sqlmock.NewRows([]string{"id", "quantity", "product_record_id", "purchase_order_id", "unit_price"}).
+		AddRow(1, 3, 4, 2, 0.3355).
+		AddRow(2, 3, 5, 2, 0.3355)
+	s.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT order_details.*, product_records.sale_price AS unit_price FROM `order_details` JOIN product_records ON product_records.id = order_details.product_record_id WHERE order_details.purchase_order_id = ? ORDER BY order_details.id",
+	)).WithArgs(2).WillReturnRows(rows)
+
+	totals, err := s.repo.FindTotals(context.Background(), 2)
+
+	s.NoError(err)
+	s.Equal(models.PurchaseOrderTotals{PurchaseOrderId: 2, LinesCount: 2, TotalQuantity: 6, TotalAmount: 2.02}, totals)
+}
+
+func (s *OrderDetailTestSuite) TestFindTotals_DatabaseError() {
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `order_details`")).WillReturnError(sql.ErrConnDone)
+
+	totals, err := s.repo.FindTotals(context.Background(), 2)
+
+	s.ErrorIs(err, sql.ErrConnDone)
+	s.Equal(models.PurchaseOrderTotals{}, totals)
+}
+
+func (s *OrderDetailTestSuite) TestFindPricedByPurchaseOrder_Success() {
+	rows := sqlmock.NewRows([]string{"id", "quantity", "clean_lines_status", "temperature", "product_record_id", "purchase_order_id", "unit_price"}).
+		AddRow(1, 3, "OK", 2.5, 4, 2, 29.99).
+		AddRow(2, 10, "OK", 3.0, 5, 2, 2.79)
+	s.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT order_details.*, product_records.sale_price AS unit_price FROM `order_details` JOIN product_records ON product_records.id = order_details.product_record_id WHERE order_details.purchase_order_id = ? ORDER BY order_details.id",
+	)).WithArgs(2).WillReturnRows(rows)
+
+	details, err := s.repo.FindPricedByPurchaseOrder(context.Background(), 2)
+
+	s.NoError(err)
+	s.Len(details, 2)
+	s.Equal(models.OrderDetail{Id: 1, Quantity: 3, CleanLinesStatus: "OK", Temperature: 2.5, ProductRecordID: 4, PurchaseOrderID: 2}, details[0].OrderDetail)
+	s.Equal(29.99, details[0].UnitPrice)
+	s.Equal(2.79, details[1].UnitPrice)
+}
+
+func (s *OrderDetailTestSuite) TestFindPricedByPurchaseOrder_DatabaseError() {
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `order_details`")).WillReturnError(sql.ErrConnDone)
+
+	details, err := s.repo.FindPricedByPurchaseOrder(context.Background(), 2)
+
+	s.ErrorIs(err, sql.ErrConnDone)
+	s.Nil(details)
+}
+
+func (s *OrderDetailTestSuite) TestFindPicks_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `order_detail_picks` WHERE order_detail_id = ? ORDER BY picked_at, id")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_detail_id", "product_batch_id", "quantity", "stock_movement_id"}).
+			AddRow(1, 3, 2, 4, 9))
+
+	// Act
+	picks, err := s.repo.FindPicks(context.Background(), 3)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.OrderDetailPick{{Id: 1, OrderDetailId: 3, ProductBatchId: 2, Quantity: 4, StockMovementId: 9}}, picks)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// expectPickedLine expects the line to be locked and its picks summed
+func (s *OrderDetailTestSuite) expectPickedLine(id int, quantity int, picked int) {
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `order_details` WHERE `order_details`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "purchase_order_id"}).AddRow(id, quantity, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(SUM(quantity), 0) FROM `order_detail_picks` WHERE order_detail_id = ?")).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"picked"}).AddRow(picked))
+}
+
+func (s *OrderDetailTestSuite) TestCreatePick_EmptiesBatch() {
+	// Arrange
+	employeeId := 2
+	s.mock.ExpectBegin()
+	s.expectPickedLine(3, 10, 6)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id", "status"}).AddRow(2, 4, 5, 1, models.ProductBatchAvailable))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(0, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(2, 5, -4, models.StockMovementPick, nil, employeeId, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity - 1 WHERE id = ?")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `order_detail_picks` (`order_detail_id`,`product_batch_id`,`quantity`,`employee_id`,`stock_movement_id`,`picked_at`) VALUES (?,?,?,?,?,?)")).
+		WithArgs(3, 2, 4, employeeId, 9, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	pick, err := s.repo.CreatePick(context.Background(), models.OrderDetailPick{OrderDetailId: 3, ProductBatchId: 2, Quantity: 4, EmployeeId: &employeeId})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(1, pick.Id)
+	s.Equal(9, pick.StockMovementId)
+	s.False(pick.PickedAt.IsZero())
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *OrderDetailTestSuite) TestCreatePick_BatchRecalled() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectPickedLine(3, 10, 0)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id", "status"}).AddRow(2, 40, 5, 1, models.ProductBatchRecalled))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.CreatePick(context.Background(), models.OrderDetailPick{OrderDetailId: 3, ProductBatchId: 2, Quantity: 4})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrProductBatchRecalled)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *OrderDetailTestSuite) TestCreatePick_BatchQuarantined() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectPickedLine(3, 10, 0)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id", "status"}).AddRow(2, 40, 5, 1, models.ProductBatchQuarantined))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.CreatePick(context.Background(), models.OrderDetailPick{OrderDetailId: 3, ProductBatchId: 2, Quantity: 4})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrProductBatchNotAvailable)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *OrderDetailTestSuite) TestCreatePick_OverPicked() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectPickedLine(3, 10, 8)
+	s.mock.ExpectRollback()
+
+	// Act
+	pick, err := s.repo.CreatePick(context.Background(), models.OrderDetailPick{OrderDetailId: 3, ProductBatchId: 2, Quantity: 4})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrOrderDetailOverPicked)
+	s.Equal(models.OrderDetailPick{}, pick)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *OrderDetailTestSuite) TestCreatePick_LineNotFound() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `order_details`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "quantity"}))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.CreatePick(context.Background(), models.OrderDetailPick{OrderDetailId: 9, ProductBatchId: 2, Quantity: 4})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
 // Run the test suite
 func TestOrderDetailRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(OrderDetailTestSuite))
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+)
+
+type OrderStatusRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderStatusRepository(db *gorm.DB) *OrderStatusRepository {
+	return &OrderStatusRepository{db: db}
+}
+
+func (r *OrderStatusRepository) FindAll(ctx context.Context) ([]models.OrderStatus, error) {
+	var statuses []models.OrderStatus
+	result := r.db.WithContext(ctx).Order("id").Find(&statuses)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return statuses, nil
+}
+
+func (r *OrderStatusRepository) FindById(ctx context.Context, id int) (models.OrderStatus, error) {
+	var status models.OrderStatus
+	result := r.db.WithContext(ctx).First(&status, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.OrderStatus{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.OrderStatus{}, result.Error
+	}
+	return status, nil
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+func (r *OutboxRepository) FindPending(ctx context.Context, now time.Time, limit int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	result := r.db.WithContext(ctx).
+		Where("status = ?", models.OutboxPending).
+		// skip the events waiting for their next attempt, along with the later events of their aggregate
+		Where("NOT EXISTS (SELECT 1 FROM outbox AS waiting WHERE waiting.status = ? AND waiting.aggregate_type = outbox.aggregate_type "+
+			"AND waiting.aggregate_id = outbox.aggregate_id AND waiting.id <= outbox.id AND waiting.next_attempt_at > ?)", models.OutboxPending, now).
+		Order("id").
+		Limit(limit).
+		Find(&events)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return events, nil
+}
+
+func (r *OutboxRepository) Update(ctx context.Context, event models.OutboxEvent) (models.OutboxEvent, error) {
+	result := r.db.WithContext(ctx).Save(&event)
+	if result.Error != nil {
+		return models.OutboxEvent{}, result.Error
+	}
+	return event, nil
+}
+
+// appendOutbox records an event about aggregate in the outbox. It must be called with the transaction of the change,
+// so that the event is committed or rolled back along with it.
+func appendOutbox(tx *gorm.DB, aggregateType string, aggregateId int, eventType string, aggregate any) error {
+	payload, err := json.Marshal(aggregate)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&models.OutboxEvent{
+		AggregateType: aggregateType,
+		AggregateId:   aggregateId,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        models.OutboxPending,
+		CreatedAt:     time.Now(),
+	}).Error
+}
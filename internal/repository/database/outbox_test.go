@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type OutboxTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo *OutboxRepository
+}
+
+func (s *OutboxTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.repo = NewOutboxRepository(gormDB)
+}
+
+func (s *OutboxTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *OutboxTestSuite) TestFindPending_Success() {
+	// Arrange
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `outbox` WHERE status = ? AND (NOT EXISTS (SELECT 1 FROM outbox AS waiting WHERE waiting.status = ? AND waiting.aggregate_type = outbox.aggregate_type "+
+		"AND waiting.aggregate_id = outbox.aggregate_id AND waiting.id <= outbox.id AND waiting.next_attempt_at > ?)) ORDER BY id LIMIT ?")).
+		WithArgs(models.OutboxPending, models.OutboxPending, now, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "aggregate_type", "aggregate_id", "event_type", "payload", "status", "created_at"}).
+			AddRow(7, models.OutboxAggregatePurchaseOrder, 3, models.OutboxEventPurchaseOrderCreated, []byte(`{"id":3}`), models.OutboxPending, time.Now()))
+
+	// Act
+	events, err := s.repo.FindPending(context.Background(), now, 50)
+
+	// Assert
+	s.NoError(err)
+	s.Len(events, 1)
+	s.Equal(3, events[0].AggregateId)
+	s.Equal(json.RawMessage(`{"id":3}`), events[0].Payload)
+}
+
+func (s *OutboxTestSuite) TestUpdate_Success() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `outbox` SET")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	_, err := s.repo.Update(context.Background(), models.OutboxEvent{Id: 7, Status: models.OutboxDispatched, Payload: json.RawMessage(`{}`)})
+
+	// Assert
+	s.NoError(err)
+}
+
+func TestOutboxTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxTestSuite))
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -22,20 +23,25 @@ func NewProductRepository(db *gorm.DB) *ProductRepository {
 
 // FindAll returns a copy of all products currently stored in the repository.
 // It returns an error if the operation fails, which is nil in this implementation.
-func (r *ProductRepository) FindAll() ([]models.Product, error) {
+func (r *ProductRepository) FindAll(ctx context.Context) ([]models.Product, error) {
 	var products []models.Product
 	// GORM genera: SELECT * FROM products;
-	result := r.db.Find(&products)
+	result := r.db.WithContext(ctx).Find(&products)
 	if result.Error != nil {
 		return nil, repository.ErrEmptyEntity
 	}
 	return products, nil
 }
 
+// FindByIds retrieves the products with the given ids
+func (r *ProductRepository) FindByIds(ctx context.Context, ids []int) ([]models.Product, error) {
+	return findIn[models.Product](r.db.WithContext(ctx), "id", ids)
+}
+
 // Create adds a new product to the repository.
 // It returns an error if a product with the same ID already exists.
-func (r *ProductRepository) Create(body models.Product) (models.Product, error) {
-	result := r.db.Create(&body)
+func (r *ProductRepository) Create(ctx context.Context, body models.Product) (models.Product, error) {
+	result := r.db.WithContext(ctx).Create(&body)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.Product{}, repository.ErrForeignKeyViolation
@@ -44,8 +50,8 @@ func (r *ProductRepository) Create(body models.Product) (models.Product, error)
 	}
 	return body, nil
 }
-func (r *ProductRepository) Update(body models.Product) (models.Product, error) {
-	result := r.db.Save(&body)
+func (r *ProductRepository) Update(ctx context.Context, body models.Product) (models.Product, error) {
+	result := r.db.WithContext(ctx).Save(&body)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.Product{}, repository.ErrForeignKeyViolation
@@ -58,9 +64,9 @@ func (r *ProductRepository) Update(body models.Product) (models.Product, error)
 
 // FindById searches for a product by its unique ID.
 // It returns the found product or an error if the product does not exist.
-func (r *ProductRepository) FindById(id int) (models.Product, error) {
+func (r *ProductRepository) FindById(ctx context.Context, id int) (models.Product, error) {
 	var product models.Product
-	result := r.db.First(&product, id)
+	result := r.db.WithContext(ctx).First(&product, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return models.Product{}, repository.ErrProductNotFound
@@ -71,16 +77,16 @@ func (r *ProductRepository) FindById(id int) (models.Product, error) {
 }
 
 // PartialUpdate updates specific fields of an existing product.
-func (r *ProductRepository) PartialUpdate(id int, fields map[string]interface{}) (models.Product, error) {
+func (r *ProductRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Product, error) {
 	var product models.Product
 	// Search if the prodcut exists
-	if err := r.db.First(&product, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&product, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return models.Product{}, repository.ErrProductNotFound
 		}
 	}
 	// Updates the product
-	if err := r.db.Model(&product).Updates(fields).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&product).Updates(fields).Error; err != nil {
 		return models.Product{}, err
 	}
 
@@ -88,17 +94,17 @@ func (r *ProductRepository) PartialUpdate(id int, fields map[string]interface{})
 }
 
 // Delete elimina un producto por su ID.
-func (r *ProductRepository) Delete(id int) error {
-	result := r.db.Delete(&models.Product{}, id)
+func (r *ProductRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.Product{}, id)
 	if result.RowsAffected == 0 {
 		return repository.ErrProductNotFound
 	}
 	return nil
 }
 
-func (r *ProductRepository) FindRecordsCountByProductId(id int) (models.ProductReport, error) {
+func (r *ProductRepository) FindRecordsCountByProductId(ctx context.Context, id int) (models.ProductReport, error) {
 	reports := models.ProductReport{}
-	err := r.db.
+	err := r.db.WithContext(ctx).
 		Table("products").
 		Select("products.id, products.description, COUNT(product_records.id) as records_count").
 		Joins("inner join  product_records on product_records.product_id = products.id").
@@ -113,9 +119,9 @@ func (r *ProductRepository) FindRecordsCountByProductId(id int) (models.ProductR
 	return reports, nil
 }
 
-func (r *ProductRepository) FindRecordsCount() ([]models.ProductReport, error) {
+func (r *ProductRepository) FindRecordsCount(ctx context.Context) ([]models.ProductReport, error) {
 	var reports []models.ProductReport
-	err := r.db.
+	err := r.db.WithContext(ctx).
 		Table("products").
 		Select("products.id, products.description, COUNT(product_records.id) as records_count").
 		Joins("inner join  product_records on product_records.products_id = products.id").
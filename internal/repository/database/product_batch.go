@@ -1,12 +1,21 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
+// expiryReason is the reason recorded for the batches expired because they are past their due date
+const expiryReason = "past its due date"
+
+// sellableStatuses are the statuses of the batches whose stock may still be sold, the ones that expire
+var sellableStatuses = []string{models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable}
+
 // ProductMap implements a product repository using an in-memory map.
 // The key of the map is the product ID.
 type ProductBatchRepository struct {
@@ -20,38 +29,359 @@ func NewProductBatchRepository(db *gorm.DB) *ProductBatchRepository {
 	return &ProductBatchRepository{db: db}
 }
 
-// FindAll not specified in the User Story
-func (r *ProductBatchRepository) FindAll() ([]models.ProductBatch, error) {
-	panic("method FindAll not implemented for ProductBatchRepository")
+// FindAll retrieves every product batch
+func (r *ProductBatchRepository) FindAll(ctx context.Context) ([]models.ProductBatch, error) {
+	batches := make([]models.ProductBatch, 0)
+	if err := r.db.WithContext(ctx).Find(&batches).Error; err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
+
+// FindBySections retrieves the batches stored in the given sections
+func (r *ProductBatchRepository) FindBySections(ctx context.Context, sectionIds []int) ([]models.ProductBatch, error) {
+	return findIn[models.ProductBatch](r.db.WithContext(ctx), "section_id", sectionIds)
 }
 
-// Create adds a new product to the repository.
-// It returns an error if a product with the same ID already exists.
-func (r *ProductBatchRepository) Create(body models.ProductBatch) (models.ProductBatch, error) {
-	result := r.db.Create(&body)
+// Create adds a new product batch along with the receipt of its stock in the ledger, so the stock on hand of the
+// ledger matches the current quantities of the batches. A batch with stock takes its place in its section, it returns
+// ErrSectionCapacityExceeded when the section is full and an error if a batch with the same number already exists.
+func (r *ProductBatchRepository) Create(ctx context.Context, body models.ProductBatch) (models.ProductBatch, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&body).Error; err != nil {
+			return err
+		}
+		if body.CurrentQuantity == 0 {
+			return nil
+		}
+		if err := occupySection(tx, body.SectionId); err != nil {
+			return err
+		}
+		return appendStockMovement(tx, &models.StockMovement{
+			ProductBatchId: body.Id,
+			SectionId:      body.SectionId,
+			Quantity:       body.CurrentQuantity,
+			Reason:         models.StockMovementReceipt,
+		})
+	})
 	switch {
-	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return models.ProductBatch{}, repository.ErrProductBatchAlreadyExists
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
 		return models.ProductBatch{}, repository.ErrForeignKeyViolation
-	case result.Error != nil:
-		return models.ProductBatch{}, result.Error
+	case err != nil:
+		return models.ProductBatch{}, err
 	}
 	return body, nil
 }
-func (r *ProductBatchRepository) Update(body models.ProductBatch) (models.ProductBatch, error) {
+func (r *ProductBatchRepository) Update(ctx context.Context, body models.ProductBatch) (models.ProductBatch, error) {
 	panic("method Update not implemented for ProductBatchRepository")
 }
 
-// FindById not specified in the User Story
-func (r *ProductBatchRepository) FindById(id int) (models.ProductBatch, error) {
-	panic("method FindById not implemented for ProductBatchRepository")
+// FindById retrieves a product batch, it returns ErrEntityNotFound when it does not exist
+func (r *ProductBatchRepository) FindById(ctx context.Context, id int) (models.ProductBatch, error) {
+	var batch models.ProductBatch
+	result := r.db.WithContext(ctx).Take(&batch, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.ProductBatch{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.ProductBatch{}, result.Error
+	}
+	return batch, nil
 }
 
 // PartialUpdate not specified in the User Story
-func (r *ProductBatchRepository) PartialUpdate(id int, fields map[string]interface{}) (models.ProductBatch, error) {
+func (r *ProductBatchRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.ProductBatch, error) {
 	panic("method PartialUpdate not implemented for ProductBatchRepository")
 }
 
 // Delete not specified in the User Story
-func (r *ProductBatchRepository) Delete(id int) error {
+func (r *ProductBatchRepository) Delete(ctx context.Context, id int) error {
 	panic("method Delete not implemented for ProductBatchRepository")
 }
+
+func (r *ProductBatchRepository) FindStatusChanges(ctx context.Context, id int) ([]models.ProductBatchStatusChange, error) {
+	changes := make([]models.ProductBatchStatusChange, 0)
+	result := r.db.WithContext(ctx).Where("product_batch_id = ?", id).Order("changed_at, id").Find(&changes)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return changes, nil
+}
+
+// ChangeStatus changes the status of the batch while it is locked. A batch under an open recall, even once
+// quarantined, is moved by its recall only, and a batch whose status changed since it was read is stale. The stock of a batch disposed of is
+// posted to the ledger as a disposal by the employee of the change.
+func (r *ProductBatchRepository) ChangeStatus(ctx context.Context, change models.ProductBatchStatusChange) (models.ProductBatch, error) {
+	var batch models.ProductBatch
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if batch, err = lockProductBatch(tx, change.ProductBatchId); err != nil {
+			return err
+		}
+		if batch.Status == models.ProductBatchRecalled {
+			return repository.ErrProductBatchRecalled
+		}
+		if batch.Status != change.FromStatus {
+			return repository.ErrStaleEntity
+		}
+		recalled, err := countOpenRecalls(tx, []int{batch.Id})
+		if err != nil {
+			return err
+		}
+		if recalled > 0 {
+			return repository.ErrProductBatchRecalled
+		}
+
+		if change.ToStatus == models.ProductBatchDisposed && batch.CurrentQuantity > 0 {
+			movement := models.StockMovement{
+				ProductBatchId: batch.Id,
+				Quantity:       -batch.CurrentQuantity,
+				Reason:         models.StockMovementDisposal,
+				ReasonCode:     change.ReasonCode,
+				EmployeeId:     change.EmployeeId,
+			}
+			if err = adjustStock(tx, &movement); err != nil {
+				return err
+			}
+			batch.CurrentQuantity = 0
+		}
+		return setProductBatchStatus(tx, &batch, change)
+	})
+
+	switch {
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return models.ProductBatch{}, repository.ErrForeignKeyViolation
+	case err != nil:
+		return models.ProductBatch{}, err
+	}
+	return batch, nil
+}
+
+// InOpenRecall reports whether the batch is part of a recall not closed yet
+func (r *ProductBatchRepository) InOpenRecall(ctx context.Context, id int) (bool, error) {
+	recalled, err := countOpenRecalls(r.db.WithContext(ctx), []int{id})
+	if err != nil {
+		return false, err
+	}
+	return recalled > 0, nil
+}
+
+// FindExpiring retrieves the batches with stock left that may still be sold and whose due date is on or before until,
+// soonest first
+func (r *ProductBatchRepository) FindExpiring(ctx context.Context, until string) ([]models.ProductBatch, error) {
+	batches := make([]models.ProductBatch, 0)
+	err := r.db.WithContext(ctx).
+		Where("current_quantity > 0 AND status IN ? AND due_date <= ?", sellableStatuses, until).
+		Order("due_date, id").
+		Find(&batches).Error
+	if err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
+
+// Expire locks the batches past their due date while they are expired, so they cannot be picked in the meantime. The
+// batches set apart, recalled or disposed keep their status.
+func (r *ProductBatchRepository) Expire(ctx context.Context, today string, at time.Time) ([]models.ProductBatch, error) {
+	batches := make([]models.ProductBatch, 0)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status IN ? AND due_date < ?", sellableStatuses, today).
+			Order("id").
+			Find(&batches).Error
+		if err != nil {
+			return err
+		}
+		for i := range batches {
+			change := models.ProductBatchStatusChange{ToStatus: models.ProductBatchExpired, Reason: expiryReason, ChangedAt: at}
+			if err = setProductBatchStatus(tx, &batches[i], change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
+
+// setProductBatchStatus moves a batch locked by the caller to the status of the change and records the change
+func setProductBatchStatus(tx *gorm.DB, batch *models.ProductBatch, change models.ProductBatchStatusChange) error {
+	err := tx.Model(&models.ProductBatch{}).Where("id = ?", batch.Id).Update("status", change.ToStatus).Error
+	if err != nil {
+		return err
+	}
+
+	change.Id = 0
+	change.ProductBatchId = batch.Id
+	change.FromStatus = batch.Status
+	batch.Status = change.ToStatus
+	return tx.Create(&change).Error
+}
+
+// batchPick is a pick of a traced batch along with the purchase order of its line
+type batchPick struct {
+	models.OrderDetailPick
+	PurchaseOrderId int
+}
+
+// FindTrace follows a batch upstream to its product, seller and the inbound orders it was received with, and
+// downstream to the purchase orders it was picked for, with their buyers and carriers
+func (r *ProductBatchRepository) FindTrace(ctx context.Context, batchNumber string) (models.BatchTrace, error) {
+	var trace models.BatchTrace
+	err := r.db.WithContext(ctx).Where("batch_number = ?", batchNumber).Take(&trace.ProductBatch).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return models.BatchTrace{}, repository.ErrEntityNotFound
+	case err != nil:
+		return models.BatchTrace{}, err
+	}
+	batch := trace.ProductBatch
+
+	if err = r.db.WithContext(ctx).Take(&trace.Product, batch.ProductId).Error; err != nil {
+		return models.BatchTrace{}, err
+	}
+	if trace.Product.SellerId != nil {
+		var seller models.Seller
+		if err = r.db.WithContext(ctx).Take(&seller, *trace.Product.SellerId).Error; err != nil {
+			return models.BatchTrace{}, err
+		}
+		trace.Seller = &seller
+	}
+	if err = r.db.WithContext(ctx).Take(&trace.Section, batch.SectionId).Error; err != nil {
+		return models.BatchTrace{}, err
+	}
+	if err = r.db.WithContext(ctx).Take(&trace.Warehouse, trace.Section.WarehouseId).Error; err != nil {
+		return models.BatchTrace{}, err
+	}
+
+	if trace.Receipts, err = r.findReceipts(ctx, batch.Id); err != nil {
+		return models.BatchTrace{}, err
+	}
+	if trace.Shipments, err = r.findShipments(ctx, batch.Id); err != nil {
+		return models.BatchTrace{}, err
+	}
+	return trace, nil
+}
+
+// findReceipts retrieves the inbound orders of a batch in the order they were placed, with their employees and
+// warehouses
+func (r *ProductBatchRepository) findReceipts(ctx context.Context, productBatchId int) ([]models.BatchReceipt, error) {
+	var orders []models.InboundOrder
+	err := r.db.WithContext(ctx).Where("product_batch_id = ?", productBatchId).Order("order_date, id").Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	employeeIds := make([]int, len(orders))
+	warehouseIds := make([]int, len(orders))
+	for i, order := range orders {
+		employeeIds[i] = order.EmployeeId
+		warehouseIds[i] = order.WarehouseId
+	}
+	employees, err := findByIds(r.db.WithContext(ctx), employeeIds, func(e models.Employee) int { return e.Id })
+	if err != nil {
+		return nil, err
+	}
+	warehouses, err := findByIds(r.db.WithContext(ctx), warehouseIds, func(w models.Warehouse) int { return w.Id })
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]models.BatchReceipt, len(orders))
+	for i, order := range orders {
+		receipts[i] = models.BatchReceipt{
+			InboundOrder: order,
+			Employee:     employees[order.EmployeeId],
+			Warehouse:    warehouses[order.WarehouseId],
+		}
+	}
+	return receipts, nil
+}
+
+// findShipments groups the picks of a batch by purchase order, the orders in the order they were first picked for
+func (r *ProductBatchRepository) findShipments(ctx context.Context, productBatchId int) ([]models.BatchShipment, error) {
+	var picks []batchPick
+	err := r.db.WithContext(ctx).Table("order_detail_picks").
+		Select("order_detail_picks.*, order_details.purchase_order_id").
+		Joins("JOIN order_details ON order_details.id = order_detail_picks.order_detail_id").
+		Where("order_detail_picks.product_batch_id = ?", productBatchId).
+		Order("order_detail_picks.picked_at, order_detail_picks.id").
+		Scan(&picks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	orderIds := make([]int, len(picks))
+	for i, pick := range picks {
+		orderIds[i] = pick.PurchaseOrderId
+	}
+	orders, err := findByIds(r.db.WithContext(ctx), orderIds, func(o models.PurchaseOrder) int { return o.Id })
+	if err != nil {
+		return nil, err
+	}
+	buyerIds := make([]int, 0, len(orders))
+	carrierIds := make([]int, 0, len(orders))
+	for _, order := range orders {
+		buyerIds = append(buyerIds, order.BuyerID)
+		carrierIds = append(carrierIds, order.CarrierID)
+	}
+	buyers, err := findByIds(r.db.WithContext(ctx), buyerIds, func(b models.Buyer) int { return b.Id })
+	if err != nil {
+		return nil, err
+	}
+	carriers, err := findByIds(r.db.WithContext(ctx), carrierIds, func(c models.Carrier) int { return c.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	shipments := make([]models.BatchShipment, 0, len(orders))
+	positions := make(map[int]int, len(orders))
+	for _, pick := range picks {
+		position, ok := positions[pick.PurchaseOrderId]
+		if !ok {
+			order := orders[pick.PurchaseOrderId]
+			position = len(shipments)
+			positions[pick.PurchaseOrderId] = position
+			shipments = append(shipments, models.BatchShipment{
+				PurchaseOrder: order,
+				Buyer:         buyers[order.BuyerID],
+				Carrier:       carriers[order.CarrierID],
+				Picks:         make([]models.OrderDetailPick, 0),
+			})
+		}
+		shipments[position].PickedQuantity += pick.Quantity
+		shipments[position].Picks = append(shipments[position].Picks, pick.OrderDetailPick)
+	}
+	return shipments, nil
+}
+
+// findIn retrieves the rows whose column holds one of the values, ordered by id
+func findIn[T any](db *gorm.DB, column string, values []int) ([]T, error) {
+	rows := make([]T, 0, len(values))
+	if len(values) == 0 {
+		return rows, nil
+	}
+	if err := db.Where(column+" IN ?", values).Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// findByIds retrieves the rows with the given ids keyed by their id, the ids may repeat
+func findByIds[T any](db *gorm.DB, ids []int, id func(T) int) (map[int]T, error) {
+	rows := make([]T, 0, len(ids))
+	if len(ids) > 0 {
+		if err := db.Where("id IN ?", ids).Find(&rows).Error; err != nil {
+			return nil, err
+		}
+	}
+	byId := make(map[int]T, len(rows))
+	for _, row := range rows {
+		byId[id(row)] = row
+	}
+	return byId, nil
+}
@@ -1,14 +1,17 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"regexp"
 	"testing"
+	"time"
 )
 
 type ProductBatchRepositoryTestSuite struct {
@@ -60,13 +63,22 @@ func (p *ProductBatchRepositoryTestSuite) TestCreate_Success() {
 	}
 
 	p.mock.ExpectBegin()
-	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches` (`batch_number`,`current_quantity`,`current_temperature`,`due_date`,`initial_quantity`,`manufacturing_date`,`manufacturing_hour`,`minimum_temperature`,`section_id`,`product_id`) VALUES (?,?,?,?,?,?,?,?,?,?)")).
-		WithArgs(newBatch.BatchNumber, newBatch.CurrentQuantity, newBatch.CurrentTemperature, newBatch.DueDate, newBatch.InitialQuantity, newBatch.ManufacturingDate, newBatch.ManufacturingHour, newBatch.MinimumTemperature, newBatch.SectionId, newBatch.ProductId).
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches` (`batch_number`,`current_quantity`,`current_temperature`,`due_date`,`initial_quantity`,`manufacturing_date`,`manufacturing_hour`,`minimum_temperature`,`section_id`,`product_id`,`status`) VALUES (?,?,?,?,?,?,?,?,?,?,?)")).
+		WithArgs(newBatch.BatchNumber, newBatch.CurrentQuantity, newBatch.CurrentTemperature, newBatch.DueDate, newBatch.InitialQuantity, newBatch.ManufacturingDate, newBatch.ManufacturingHour, newBatch.MinimumTemperature, newBatch.SectionId, newBatch.ProductId, newBatch.Status).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(newBatch.SectionId, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_capacity", "maximum_capacity"}).AddRow(1, 10, 50))
+	p.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity + 1 WHERE `id` = ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements` (`product_batch_id`,`section_id`,`quantity`,`reason`,`reason_code`,`employee_id`,`actor`,`created_at`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs(1, newBatch.SectionId, newBatch.CurrentQuantity, models.StockMovementReceipt, nil, nil, "", sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	p.mock.ExpectCommit()
 
 	// Act
-	createdBatch, err := p.repo.Create(newBatch)
+	createdBatch, err := p.repo.Create(context.Background(), newBatch)
 
 	// Assert
 	p.NoError(err)
@@ -74,6 +86,27 @@ func (p *ProductBatchRepositoryTestSuite) TestCreate_Success() {
 	p.Equal(1, createdBatch.Id)
 }
 
+func (p *ProductBatchRepositoryTestSuite) TestCreate_SectionFull() {
+	// Arrange
+	newBatch := models.ProductBatch{BatchNumber: 40, CurrentQuantity: 200, InitialQuantity: 200, SectionId: 1, ProductId: 1}
+
+	p.mock.ExpectBegin()
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(newBatch.SectionId, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_capacity", "maximum_capacity"}).AddRow(1, 50, 50))
+	p.mock.ExpectRollback()
+
+	// Act
+	createdBatch, err := p.repo.Create(context.Background(), newBatch)
+
+	// Assert
+	p.ErrorIs(err, repository.ErrSectionCapacityExceeded)
+	p.Equal(models.ProductBatch{}, createdBatch)
+	p.NoError(p.mock.ExpectationsWereMet())
+}
+
 func (p *ProductBatchRepositoryTestSuite) TestCreate_ForeignKeyViolated() {
 	// Arrange
 	newBatch := models.ProductBatch{
@@ -90,19 +123,47 @@ func (p *ProductBatchRepositoryTestSuite) TestCreate_ForeignKeyViolated() {
 	}
 
 	p.mock.ExpectBegin()
-	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches` (`batch_number`,`current_quantity`,`current_temperature`,`due_date`,`initial_quantity`,`manufacturing_date`,`manufacturing_hour`,`minimum_temperature`,`section_id`,`product_id`) VALUES (?,?,?,?,?,?,?,?,?,?)")).
-		WithArgs(newBatch.BatchNumber, newBatch.CurrentQuantity, newBatch.CurrentTemperature, newBatch.DueDate, newBatch.InitialQuantity, newBatch.ManufacturingDate, newBatch.ManufacturingHour, newBatch.MinimumTemperature, newBatch.SectionId, newBatch.ProductId).
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches` (`batch_number`,`current_quantity`,`current_temperature`,`due_date`,`initial_quantity`,`manufacturing_date`,`manufacturing_hour`,`minimum_temperature`,`section_id`,`product_id`,`status`) VALUES (?,?,?,?,?,?,?,?,?,?,?)")).
+		WithArgs(newBatch.BatchNumber, newBatch.CurrentQuantity, newBatch.CurrentTemperature, newBatch.DueDate, newBatch.InitialQuantity, newBatch.ManufacturingDate, newBatch.ManufacturingHour, newBatch.MinimumTemperature, newBatch.SectionId, newBatch.ProductId, newBatch.Status).
 		WillReturnError(gorm.ErrForeignKeyViolated)
 	p.mock.ExpectRollback()
 
 	// Act
-	createdBatch, err := p.repo.Create(newBatch)
+	createdBatch, err := p.repo.Create(context.Background(), newBatch)
 
 	// Assert
 	p.Error(err)
 	p.Equal(models.ProductBatch{}, createdBatch)
 }
 
+func (p *ProductBatchRepositoryTestSuite) TestCreate_DuplicatedBatchNumber() {
+	// Arrange
+	newBatch := models.ProductBatch{
+		BatchNumber:        40,
+		CurrentQuantity:    200,
+		CurrentTemperature: 20,
+		DueDate:            "2022-04-04",
+		InitialQuantity:    10,
+		ManufacturingDate:  "2020-04-04",
+		ManufacturingHour:  10,
+		MinimumTemperature: 5,
+		SectionId:          1,
+		ProductId:          1,
+	}
+
+	p.mock.ExpectBegin()
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches`")).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	p.mock.ExpectRollback()
+
+	// Act
+	createdBatch, err := p.repo.Create(context.Background(), newBatch)
+
+	// Assert
+	p.ErrorIs(err, repository.ErrProductBatchAlreadyExists)
+	p.Equal(models.ProductBatch{}, createdBatch)
+}
+
 func (p *ProductBatchRepositoryTestSuite) TestCreate_GenericDataBaseError() {
 	// Arrange
 	newBatch := models.ProductBatch{
@@ -119,47 +180,389 @@ func (p *ProductBatchRepositoryTestSuite) TestCreate_GenericDataBaseError() {
 	}
 
 	p.mock.ExpectBegin()
-	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches` (`batch_number`,`current_quantity`,`current_temperature`,`due_date`,`initial_quantity`,`manufacturing_date`,`manufacturing_hour`,`minimum_temperature`,`section_id`,`product_id`) VALUES (?,?,?,?,?,?,?,?,?,?)")).
-		WithArgs(newBatch.BatchNumber, newBatch.CurrentQuantity, newBatch.CurrentTemperature, newBatch.DueDate, newBatch.InitialQuantity, newBatch.ManufacturingDate, newBatch.ManufacturingHour, newBatch.MinimumTemperature, newBatch.SectionId, newBatch.ProductId).
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batches` (`batch_number`,`current_quantity`,`current_temperature`,`due_date`,`initial_quantity`,`manufacturing_date`,`manufacturing_hour`,`minimum_temperature`,`section_id`,`product_id`,`status`) VALUES (?,?,?,?,?,?,?,?,?,?,?)")).
+		WithArgs(newBatch.BatchNumber, newBatch.CurrentQuantity, newBatch.CurrentTemperature, newBatch.DueDate, newBatch.InitialQuantity, newBatch.ManufacturingDate, newBatch.ManufacturingHour, newBatch.MinimumTemperature, newBatch.SectionId, newBatch.ProductId, newBatch.Status).
 		WillReturnError(gorm.ErrInvalidValue)
 	p.mock.ExpectRollback()
 
 	// Act
-	createdBatch, err := p.repo.Create(newBatch)
+	createdBatch, err := p.repo.Create(context.Background(), newBatch)
 
 	// Assert
 	p.Error(err)
 	p.Equal(models.ProductBatch{}, createdBatch)
 }
-func (p *ProductBatchRepositoryTestSuite) TestFindAll_PanicsWhenCalled() {
+func (p *ProductBatchRepositoryTestSuite) TestFindAll_Success() {
+	// Arrange
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "section_id", "product_id"}).AddRow(1, 40, 3, 1).AddRow(2, 41, 4, 1))
+
+	// Act
+	batches, err := p.repo.FindAll(context.Background())
+
+	// Assert
+	p.NoError(err)
+	p.Equal([]models.ProductBatch{{Id: 1, BatchNumber: 40, SectionId: 3, ProductId: 1}, {Id: 2, BatchNumber: 41, SectionId: 4, ProductId: 1}}, batches)
+}
+func (p *ProductBatchRepositoryTestSuite) TestFindBySections_Success() {
+	// Arrange
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE section_id IN (?,?) ORDER BY id")).
+		WithArgs(3, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "section_id", "product_id"}).AddRow(1, 40, 3, 1))
+
+	// Act
+	batches, err := p.repo.FindBySections(context.Background(), []int{3, 4})
+
 	// Assert
-	p.PanicsWithValue("method FindAll not implemented for ProductBatchRepository", func() {
-		_, _ = p.repo.FindAll()
-	})
+	p.NoError(err)
+	p.Equal([]models.ProductBatch{{Id: 1, BatchNumber: 40, SectionId: 3, ProductId: 1}}, batches)
+}
+func (p *ProductBatchRepositoryTestSuite) TestFindBySections_NoSections() {
+	// Act
+	batches, err := p.repo.FindBySections(context.Background(), nil)
+
+	// Assert
+	p.NoError(err)
+	p.Empty(batches)
+	p.NoError(p.mock.ExpectationsWereMet())
 }
 func (p *ProductBatchRepositoryTestSuite) TestUpdate_PanicsWhenCalled() {
 	// Assert
 	p.PanicsWithValue("method Update not implemented for ProductBatchRepository", func() {
-		_, _ = p.repo.Update(models.ProductBatch{})
+		_, _ = p.repo.Update(context.Background(), models.ProductBatch{})
 	})
 }
-func (p *ProductBatchRepositoryTestSuite) TestFindById_PanicsWhenCalled() {
+func (p *ProductBatchRepositoryTestSuite) TestFindById_Success() {
+	// Arrange
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "current_quantity", "section_id", "product_id"}).AddRow(1, 40, 200, 3, 1))
+
+	// Act
+	batch, err := p.repo.FindById(context.Background(), 1)
+
 	// Assert
-	p.PanicsWithValue("method FindById not implemented for ProductBatchRepository", func() {
-		_, _ = p.repo.FindById(1)
-	})
+	p.NoError(err)
+	p.Equal(models.ProductBatch{Id: 1, BatchNumber: 40, CurrentQuantity: 200, SectionId: 3, ProductId: 1}, batch)
+}
+func (p *ProductBatchRepositoryTestSuite) TestFindById_NotFound() {
+	// Arrange
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Act
+	batch, err := p.repo.FindById(context.Background(), 9)
+
+	// Assert
+	p.ErrorIs(err, repository.ErrEntityNotFound)
+	p.Equal(models.ProductBatch{}, batch)
 }
 func (p *ProductBatchRepositoryTestSuite) TestPartialUpdate_PanicsWhenCalled() {
 	// Assert
 	p.PanicsWithValue("method PartialUpdate not implemented for ProductBatchRepository", func() {
-		_, _ = p.repo.PartialUpdate(1, map[string]interface{}{})
+		_, _ = p.repo.PartialUpdate(context.Background(), 1, map[string]interface{}{})
 	})
 }
 func (p *ProductBatchRepositoryTestSuite) TestDelete_PanicsWhenCalled() {
 	// Assert
 	p.PanicsWithValue("method Delete not implemented for ProductBatchRepository", func() {
-		_ = p.repo.Delete(1)
+		_ = p.repo.Delete(context.Background(), 1)
+	})
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestFindTrace_Success() {
+	// Arrange
+	pickedAt := time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE batch_number = ? LIMIT ?")).
+		WithArgs("40", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "section_id", "product_id"}).AddRow(3, 40, 2, 1))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `products` WHERE `products`.`id` = ? LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "description", "seller_id"}).AddRow(1, "Apple", 5))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sellers` WHERE `sellers`.`id` = ? LIMIT ?")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(5, "Acme"))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ?")).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_id"}).AddRow(2, 1))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `warehouses` WHERE `warehouses`.`id` = ? LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_code"}).AddRow(1, "WH-1"))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `inbound_orders` WHERE product_batch_id = ? ORDER BY order_date, id")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_number", "employee_id", "product_batch_id", "warehouse_id"}).AddRow(4, "IO-4", 2, 3, 1))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `employees` WHERE id IN (?)")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "warehouse_id"}).AddRow(2, "Ana", 1))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `warehouses` WHERE id IN (?)")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_code"}).AddRow(1, "WH-1"))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT order_detail_picks.*, order_details.purchase_order_id FROM `order_detail_picks` JOIN order_details ON order_details.id = order_detail_picks.order_detail_id WHERE order_detail_picks.product_batch_id = ? ORDER BY order_detail_picks.picked_at, order_detail_picks.id")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_detail_id", "product_batch_id", "quantity", "stock_movement_id", "picked_at", "purchase_order_id"}).
+			AddRow(1, 7, 3, 4, 9, pickedAt, 6).
+			AddRow(2, 8, 3, 5, 10, pickedAt, 6))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `purchase_orders` WHERE id IN (?,?)")).
+		WithArgs(6, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_number", "buyer_id", "carrier_id"}).AddRow(6, "PO-6", 1, 2))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `buyers` WHERE id IN (?)")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name"}).AddRow(1, "Luis"))
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `carriers` WHERE id IN (?)")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "FastCo"))
+
+	// Act
+	trace, err := p.repo.FindTrace(context.Background(), "40")
+
+	// Assert
+	p.NoError(err)
+	p.NoError(p.mock.ExpectationsWereMet())
+	p.Equal(40, trace.ProductBatch.BatchNumber)
+	p.Equal("Acme", trace.Seller.Name)
+	p.Equal("WH-1", trace.Warehouse.WarehouseCode)
+	p.Equal([]models.BatchReceipt{{
+		InboundOrder: models.InboundOrder{Id: 4, OrderNumber: "IO-4", EmployeeId: 2, ProductBatchId: 3, WarehouseId: 1},
+		Employee:     models.Employee{Id: 2, FirstName: "Ana", WarehouseId: 1},
+		Warehouse:    models.Warehouse{Id: 1, WarehouseCode: "WH-1"},
+	}}, trace.Receipts)
+	p.Equal([]models.BatchShipment{{
+		PurchaseOrder:  models.PurchaseOrder{Id: 6, OrderNumber: "PO-6", BuyerID: 1, CarrierID: 2},
+		Buyer:          models.Buyer{Id: 1, FirstName: "Luis"},
+		Carrier:        models.Carrier{ID: 2, CompanyName: "FastCo"},
+		PickedQuantity: 9,
+		Picks: []models.OrderDetailPick{
+			{Id: 1, OrderDetailId: 7, ProductBatchId: 3, Quantity: 4, StockMovementId: 9, PickedAt: pickedAt},
+			{Id: 2, OrderDetailId: 8, ProductBatchId: 3, Quantity: 5, StockMovementId: 10, PickedAt: pickedAt},
+		},
+	}}, trace.Shipments)
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestFindTrace_NotFound() {
+	// Arrange
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE batch_number = ?")).
+		WithArgs("99", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Act
+	trace, err := p.repo.FindTrace(context.Background(), "99")
+
+	// Assert
+	p.ErrorIs(err, repository.ErrEntityNotFound)
+	p.Equal(models.BatchTrace{}, trace)
+}
+
+// expectLockedBatch expects the batch to be locked
+func (p *ProductBatchRepositoryTestSuite) expectLockedBatch(id int, quantity int, status string) {
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "status"}).AddRow(id, quantity, 3, status))
+}
+
+// expectOpenRecalls expects the open recalls of a batch to be counted
+func (p *ProductBatchRepositoryTestSuite) expectOpenRecalls(id int, count int) {
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `recall_batches` JOIN recalls ON recalls.id = recall_batches.recall_id WHERE recall_batches.product_batch_id IN (?) AND recalls.status = ?")).
+		WithArgs(id, models.RecallOpen).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+// expectStatusChange expects a batch to be moved to a status and the change to be recorded
+func (p *ProductBatchRepositoryTestSuite) expectStatusChange(id int, from string, to string, reason string, reasonCode any, employeeId any) {
+	p.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `status`=? WHERE id = ?")).
+		WithArgs(to, id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batch_status_changes` (`product_batch_id`,`from_status`,`to_status`,`reason`,`reason_code`,`employee_id`,`changed_at`) VALUES (?,?,?,?,?,?,?)")).
+		WithArgs(id, from, to, reason, reasonCode, employeeId, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestChangeStatus_Success() {
+	// Arrange
+	employeeId := 2
+	p.mock.ExpectBegin()
+	p.expectLockedBatch(1, 200, models.ProductBatchAvailable)
+	p.expectOpenRecalls(1, 0)
+	p.expectStatusChange(1, models.ProductBatchAvailable, models.ProductBatchQuarantined, "Damaged packaging", nil, employeeId)
+	p.mock.ExpectCommit()
+
+	// Act
+	batch, err := p.repo.ChangeStatus(context.Background(), models.ProductBatchStatusChange{
+		ProductBatchId: 1,
+		FromStatus:     models.ProductBatchAvailable,
+		ToStatus:       models.ProductBatchQuarantined,
+		Reason:         "Damaged packaging",
+		EmployeeId:     &employeeId,
+		ChangedAt:      time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
+	})
+
+	// Assert
+	p.NoError(err)
+	p.Equal(models.ProductBatchQuarantined, batch.Status)
+	p.Equal(200, batch.CurrentQuantity)
+	p.NoError(p.mock.ExpectationsWereMet())
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestChangeStatus_DisposalEmptiesBatch() {
+	// Arrange
+	employeeId := 2
+	reasonCode := models.AdjustmentExpired
+	p.mock.ExpectBegin()
+	p.expectLockedBatch(1, 180, models.ProductBatchExpired)
+	p.expectOpenRecalls(1, 0)
+	p.expectLockedBatch(1, 180, models.ProductBatchExpired)
+	p.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(0, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	p.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(1, 3, -180, models.StockMovementDisposal, reasonCode, employeeId, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	p.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity - 1 WHERE id = ?")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	p.expectStatusChange(1, models.ProductBatchExpired, models.ProductBatchDisposed, "Past its due date", reasonCode, employeeId)
+	p.mock.ExpectCommit()
+
+	// Act
+	batch, err := p.repo.ChangeStatus(context.Background(), models.ProductBatchStatusChange{
+		ProductBatchId: 1,
+		FromStatus:     models.ProductBatchExpired,
+		ToStatus:       models.ProductBatchDisposed,
+		Reason:         "Past its due date",
+		ReasonCode:     &reasonCode,
+		EmployeeId:     &employeeId,
+	})
+
+	// Assert
+	p.NoError(err)
+	p.Equal(models.ProductBatchDisposed, batch.Status)
+	p.Equal(0, batch.CurrentQuantity)
+	p.NoError(p.mock.ExpectationsWereMet())
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestChangeStatus_Errors() {
+	tests := []struct {
+		title         string
+		status        string
+		expectedError error
+	}{
+		{title: "Stale", status: models.ProductBatchQuarantined, expectedError: repository.ErrStaleEntity},
+		{title: "Recalled", status: models.ProductBatchRecalled, expectedError: repository.ErrProductBatchRecalled},
+	}
+
+	for _, tt := range tests {
+		p.Run(tt.title, func() {
+			// Arrange
+			p.mock.ExpectBegin()
+			p.expectLockedBatch(1, 200, tt.status)
+			p.mock.ExpectRollback()
+
+			// Act
+			_, err := p.repo.ChangeStatus(context.Background(), models.ProductBatchStatusChange{
+				ProductBatchId: 1,
+				FromStatus:     models.ProductBatchAvailable,
+				ToStatus:       models.ProductBatchUnderInspection,
+				Reason:         "Temperature excursion",
+			})
+
+			// Assert
+			p.ErrorIs(err, tt.expectedError)
+			p.NoError(p.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestChangeStatus_QuarantinedByOpenRecall() {
+	// Arrange
+	p.mock.ExpectBegin()
+	p.expectLockedBatch(1, 200, models.ProductBatchQuarantined)
+	p.expectOpenRecalls(1, 1)
+	p.mock.ExpectRollback()
+
+	// Act
+	_, err := p.repo.ChangeStatus(context.Background(), models.ProductBatchStatusChange{
+		ProductBatchId: 1,
+		FromStatus:     models.ProductBatchQuarantined,
+		ToStatus:       models.ProductBatchAvailable,
+		Reason:         "Checked",
 	})
+
+	// Assert
+	p.ErrorIs(err, repository.ErrProductBatchRecalled)
+	p.NoError(p.mock.ExpectationsWereMet())
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestInOpenRecall_Success() {
+	// Arrange
+	p.expectOpenRecalls(1, 1)
+
+	// Act
+	recalled, err := p.repo.InOpenRecall(context.Background(), 1)
+
+	// Assert
+	p.NoError(err)
+	p.True(recalled)
+	p.NoError(p.mock.ExpectationsWereMet())
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestExpire_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 10, 0, 5, 0, 0, time.UTC)
+	p.mock.ExpectBegin()
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE status IN (?,?,?) AND due_date < ? ORDER BY id FOR UPDATE")).
+		WithArgs(models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable, "2025-06-10").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "due_date", "status"}).
+			AddRow(1, "2025-06-09", models.ProductBatchAvailable).
+			AddRow(4, "2025-05-30", models.ProductBatchReceived))
+	p.expectStatusChange(1, models.ProductBatchAvailable, models.ProductBatchExpired, "past its due date", nil, nil)
+	p.expectStatusChange(4, models.ProductBatchReceived, models.ProductBatchExpired, "past its due date", nil, nil)
+	p.mock.ExpectCommit()
+
+	// Act
+	batches, err := p.repo.Expire(context.Background(), "2025-06-10", at)
+
+	// Assert
+	p.NoError(err)
+	p.Len(batches, 2)
+	p.Equal(models.ProductBatchExpired, batches[0].Status)
+	p.Equal(models.ProductBatchExpired, batches[1].Status)
+	p.NoError(p.mock.ExpectationsWereMet())
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestFindExpiring_Success() {
+	// Arrange
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE current_quantity > 0 AND status IN (?,?,?) AND due_date <= ? ORDER BY due_date, id")).
+		WithArgs(models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable, "2025-06-13").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "due_date", "status"}).
+			AddRow(4, 5, "2025-06-11", models.ProductBatchReceived))
+
+	// Act
+	batches, err := p.repo.FindExpiring(context.Background(), "2025-06-13")
+
+	// Assert
+	p.NoError(err)
+	p.Equal([]models.ProductBatch{{Id: 4, CurrentQuantity: 5, DueDate: "2025-06-11", Status: models.ProductBatchReceived}}, batches)
+	p.NoError(p.mock.ExpectationsWereMet())
+}
+
+func (p *ProductBatchRepositoryTestSuite) TestFindStatusChanges_Success() {
+	// Arrange
+	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batch_status_changes` WHERE product_batch_id = ? ORDER BY changed_at, id")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_batch_id", "from_status", "to_status", "reason"}).
+			AddRow(1, 1, models.ProductBatchReceived, models.ProductBatchAvailable, "Inspection passed"))
+
+	// Act
+	changes, err := p.repo.FindStatusChanges(context.Background(), 1)
+
+	// Assert
+	p.NoError(err)
+	p.Equal([]models.ProductBatchStatusChange{{
+		Id:             1,
+		ProductBatchId: 1,
+		FromStatus:     models.ProductBatchReceived,
+		ToStatus:       models.ProductBatchAvailable,
+		Reason:         "Inspection passed",
+	}}, changes)
 }
 
 // Run the test suite
@@ -1,9 +1,12 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"gorm.io/gorm"
+	"time"
 )
 
 type ProductRecordRepository struct {
@@ -16,10 +19,10 @@ func NewProductRecordRepository(db *gorm.DB) *ProductRecordRepository {
 	}
 }
 
-func (s *ProductRecordRepository) FindAll() ([]models.ProductRecord, error) {
+func (s *ProductRecordRepository) FindAll(ctx context.Context) ([]models.ProductRecord, error) {
 	var productRecords []models.ProductRecord
 
-	result := s.db.Find(&productRecords)
+	result := s.db.WithContext(ctx).Find(&productRecords)
 
 	if result.Error != nil {
 		return nil, result.Error
@@ -28,60 +31,67 @@ func (s *ProductRecordRepository) FindAll() ([]models.ProductRecord, error) {
 	return productRecords, nil
 }
 
-func (s *ProductRecordRepository) FindById(id int) (models.ProductRecord, error) {
-	var productRecord models.ProductRecord
-
-	result := s.db.First(&productRecord, id)
-
-	if result.Error != nil {
-		return models.ProductRecord{}, result.Error
-	}
-
-	return productRecord, nil
+func (s *ProductRecordRepository) FindByIds(ctx context.Context, ids []int) ([]models.ProductRecord, error) {
+	return findIn[models.ProductRecord](s.db.WithContext(ctx), "id", ids)
 }
 
-func (s *ProductRecordRepository) Create(productRecord models.ProductRecord) (models.ProductRecord, error) {
-	result := s.db.Create(&productRecord)
+func (s *ProductRecordRepository) FindById(ctx context.Context, id int) (models.ProductRecord, error) {
+	var productRecord models.ProductRecord
 
-	if result.Error != nil {
+	result := s.db.WithContext(ctx).First(&productRecord, id)
+
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.ProductRecord{}, repository.ErrEntityNotFound
+	case result.Error != nil:
 		return models.ProductRecord{}, result.Error
 	}
 
 	return productRecord, nil
 }
 
-func (s *ProductRecordRepository) Update(productRecord models.ProductRecord) (models.ProductRecord, error) {
-	result := s.db.Save(&productRecord)
+func (s *ProductRecordRepository) Create(ctx context.Context, productRecord models.ProductRecord) (models.ProductRecord, error) {
+	result := s.db.WithContext(ctx).Create(&productRecord)
 
-	if result.Error != nil {
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.ProductRecord{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
 		return models.ProductRecord{}, result.Error
 	}
 
 	return productRecord, nil
 }
 
-func (s *ProductRecordRepository) PartialUpdate(id int, fields map[string]interface{}) (models.ProductRecord, error) {
+// FindAt retrieves the latest record of the product not after the instant, records made at the same instant are
+// ordered by their ID
+func (s *ProductRecordRepository) FindAt(ctx context.Context, productId int, at time.Time) (models.ProductRecord, error) {
 	var productRecord models.ProductRecord
 
-	result := s.db.First(&productRecord, id)
-	if result.Error != nil {
-		return models.ProductRecord{}, result.Error
-	}
+	result := s.db.WithContext(ctx).Where("product_id = ? AND last_update <= ?", productId, at).
+		Order("last_update DESC, id DESC").
+		Take(&productRecord)
 
-	result = s.db.Model(&productRecord).Updates(fields)
-	if result.Error != nil {
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.ProductRecord{}, repository.ErrEntityNotFound
+	case result.Error != nil:
 		return models.ProductRecord{}, result.Error
 	}
 
 	return productRecord, nil
 }
 
-func (s *ProductRecordRepository) Delete(id int) error {
-	result := s.db.Delete(&models.ProductRecord{}, id)
+func (s *ProductRecordRepository) FindBetween(ctx context.Context, productId int, from time.Time, to time.Time) ([]models.ProductRecord, error) {
+	productRecords := make([]models.ProductRecord, 0)
+
+	result := s.db.WithContext(ctx).Where("product_id = ? AND last_update BETWEEN ? AND ?", productId, from, to).
+		Order("last_update, id").
+		Find(&productRecords)
 
-	if result.RowsAffected < 1 {
-		return repository.ErrEntityNotFound
+	if result.Error != nil {
+		return nil, result.Error
 	}
 
-	return nil
+	return productRecords, nil
 }
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
@@ -10,6 +11,7 @@ import (
 	"gorm.io/gorm"
 	"regexp"
 	"testing"
+	"time"
 )
 
 type ProductRecordRepositoryTestSuite struct {
@@ -50,14 +52,14 @@ func (s *ProductRecordRepositoryTestSuite) TestFindAll_Success() {
 	expectedProductRecords := []models.ProductRecord{
 		{
 			Id:            1,
-			LastUpdate:    "2022-22-10",
+			LastUpdate:    time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC),
 			PurchasePrice: 4.99,
 			SalePrice:     5.99,
 			ProductId:     1,
 		},
 		{
 			Id:            2,
-			LastUpdate:    "2022-22-11",
+			LastUpdate:    time.Date(2022, 11, 22, 10, 0, 0, 0, time.UTC),
 			PurchasePrice: 4.99,
 			SalePrice:     6.99,
 			ProductId:     1,
@@ -72,7 +74,7 @@ func (s *ProductRecordRepositoryTestSuite) TestFindAll_Success() {
 
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_records`")).WillReturnRows(rows)
 
-	productRecord, err := s.repo.FindAll()
+	productRecord, err := s.repo.FindAll(context.Background())
 
 	s.NoError(err)
 	s.Len(productRecord, 2)
@@ -91,7 +93,7 @@ func (s *ProductRecordRepositoryTestSuite) TestFindAll_DatabaseError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_records`")).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	productRecord, err := s.repo.FindAll()
+	productRecord, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -103,7 +105,7 @@ func (s *ProductRecordRepositoryTestSuite) TestFindById_Success() {
 	// Arrange
 	expectedProductRecord := models.ProductRecord{
 		Id:            1,
-		LastUpdate:    "2022-22-10",
+		LastUpdate:    time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC),
 		PurchasePrice: 4.99,
 		SalePrice:     5.99,
 		ProductId:     1,
@@ -117,7 +119,7 @@ func (s *ProductRecordRepositoryTestSuite) TestFindById_Success() {
 		WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	productRecord, err := s.repo.FindById(1)
+	productRecord, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -133,7 +135,7 @@ func (s *ProductRecordRepositoryTestSuite) TestFindById_NotFound() {
 		WithArgs(999, 1).WillReturnError(repository.ErrEntityNotFound)
 
 	// Act
-	productRecord, err := s.repo.FindById(999)
+	productRecord, err := s.repo.FindById(context.Background(), 999)
 
 	// Assert
 	s.Error(err)
@@ -144,7 +146,7 @@ func (s *ProductRecordRepositoryTestSuite) TestFindById_NotFound() {
 func (s *ProductRecordRepositoryTestSuite) TestCreate_Success() {
 	// Arrange
 	expectedProductRecord := models.ProductRecord{
-		LastUpdate:    "2022-22-10",
+		LastUpdate:    time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC),
 		PurchasePrice: 4.99,
 		SalePrice:     5.99,
 		ProductId:     1,
@@ -159,7 +161,7 @@ func (s *ProductRecordRepositoryTestSuite) TestCreate_Success() {
 
 	// Act
 
-	createdProductRecord, err := s.repo.Create(expectedProductRecord)
+	createdProductRecord, err := s.repo.Create(context.Background(), expectedProductRecord)
 
 	// Assert
 	s.NoError(err)
@@ -172,7 +174,7 @@ func (s *ProductRecordRepositoryTestSuite) TestCreate_Success() {
 func (s *ProductRecordRepositoryTestSuite) TestCreate_DatabaseError() {
 	// Arrange
 	expectedProductRecord := models.ProductRecord{
-		LastUpdate:    "2022-22-10",
+		LastUpdate:    time.Date(2022, 10, 22, 10, 0, 0, 0, time.UTC),
 		PurchasePrice: 4.99,
 		SalePrice:     5.99,
 		ProductId:     1,
@@ -187,7 +189,7 @@ func (s *ProductRecordRepositoryTestSuite) TestCreate_DatabaseError() {
 
 	// Act
 
-	createdProductRecord, err := s.repo.Create(expectedProductRecord)
+	createdProductRecord, err := s.repo.Create(context.Background(), expectedProductRecord)
 
 	// Assert
 	s.Error(err)
@@ -195,195 +197,70 @@ func (s *ProductRecordRepositoryTestSuite) TestCreate_DatabaseError() {
 	s.Equal(sql.ErrConnDone, err)
 }
 
-func (s *ProductRecordRepositoryTestSuite) TestUpdate_Success() {
+func (s *ProductRecordRepositoryTestSuite) TestCreate_ForeignKeyViolated() {
 	// Arrange
-	expectedProductRecord := models.ProductRecord{
-		Id:            1,
-		LastUpdate:    "2022-22-10",
-		PurchasePrice: 4.99,
-		SalePrice:     5.99,
-		ProductId:     1,
-	}
-
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec(regexp.QuoteMeta(
-		"UPDATE `product_records` SET `last_update`=?,`purchase_price`=?,`sale_price`=?,`product_id`=? WHERE `id` = ?")).
-		WithArgs(
-			expectedProductRecord.LastUpdate,
-			expectedProductRecord.PurchasePrice,
-			expectedProductRecord.SalePrice,
-			expectedProductRecord.ProductId,
-			1).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	s.mock.ExpectCommit()
-
-	// Act
-	updatedProductRecord, err := s.repo.Update(expectedProductRecord)
-
-	// Assert
-
-	s.NoError(err)
-	s.Equal(expectedProductRecord.Id, updatedProductRecord.Id)
-	s.Equal(expectedProductRecord.LastUpdate, updatedProductRecord.LastUpdate)
-	s.Equal(expectedProductRecord.PurchasePrice, updatedProductRecord.PurchasePrice)
-	s.Equal(expectedProductRecord.SalePrice, updatedProductRecord.SalePrice)
-	s.Equal(expectedProductRecord.ProductId, updatedProductRecord.ProductId)
-
-}
-
-func (s *ProductRecordRepositoryTestSuite) TestUpdate_DatabaseError() {
-	// Arrange
-
-	expectedProductRecord := models.ProductRecord{
-		Id:            1,
-		LastUpdate:    "2022-22-10",
-		PurchasePrice: 4.99,
-		SalePrice:     5.99,
-		ProductId:     1,
-	}
-
-	s.mock.ExpectBegin()
-	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_records` SET `last_update`=?,`purchase_price`=?,`sale_price`=?,`product_id`=? WHERE `id` = ?")).
-		WithArgs(expectedProductRecord.LastUpdate, expectedProductRecord.PurchasePrice,
-			expectedProductRecord.SalePrice, expectedProductRecord.ProductId, 1).
-		WillReturnError(sql.ErrConnDone)
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_records`")).
+		WillReturnError(gorm.ErrForeignKeyViolated)
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedProductRecord, err := s.repo.Update(expectedProductRecord)
+	createdProductRecord, err := s.repo.Create(context.Background(), models.ProductRecord{LastUpdate: time.Now(), ProductId: 999})
 
 	// Assert
-	s.Error(err)
-	s.Equal(models.ProductRecord{}, updatedProductRecord)
-	s.Equal(sql.ErrConnDone, err)
-
+	s.ErrorIs(err, repository.ErrForeignKeyViolation)
+	s.Equal(models.ProductRecord{}, createdProductRecord)
 }
 
-func (s *ProductRecordRepositoryTestSuite) TestPartialUpdate_Success() {
-	// Arrange}
-	productRecordID := 1
-	fields := map[string]interface{}{
-		"last_update":    "2022-22-10",
-		"purchase_price": 4.99,
-	}
-
-	expectedProductRecord := models.ProductRecord{
-		LastUpdate:    "2022-22-10",
-		PurchasePrice: 4.99,
-		SalePrice:     5.99,
-		ProductId:     1,
-	}
-
+func (s *ProductRecordRepositoryTestSuite) TestFindAt_Success() {
+	// Arrange
+	at := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	lastUpdate := time.Date(2024, 6, 11, 16, 45, 0, 0, time.UTC)
 	rows := s.mock.NewRows([]string{"id", "last_update", "purchase_price", "sale_price", "product_id"}).
-		AddRow(productRecordID, expectedProductRecord.LastUpdate, expectedProductRecord.PurchasePrice, expectedProductRecord.SalePrice, expectedProductRecord.ProductId)
-
-	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_records` WHERE `product_records`.`id` = ? ORDER BY `product_records`.`id` LIMIT ?")).
-		WithArgs(productRecordID, 1).WillReturnRows(rows)
-
-	// Update query
-	s.mock.ExpectBegin()
-	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_records` SET `last_update`=?,`purchase_price`=? WHERE `id` = ?")).
-		WithArgs(fields["last_update"], fields["purchase_price"], productRecordID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	s.mock.ExpectCommit()
+		AddRow(1, lastUpdate, 3.99, 29.99, 1)
+	s.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `product_records` WHERE product_id = ? AND last_update <= ? ORDER BY last_update DESC, id DESC LIMIT ?")).
+		WithArgs(1, at, 1).WillReturnRows(rows)
 
 	// Act
-	updatedProductRecord, err := s.repo.PartialUpdate(productRecordID, fields)
+	productRecord, err := s.repo.FindAt(context.Background(), 1, at)
 
 	// Assert
 	s.NoError(err)
-	s.Equal(productRecordID, updatedProductRecord.Id)
-	s.Equal(fields["last_update"], updatedProductRecord.LastUpdate)
-	s.Equal(fields["purchase_price"], updatedProductRecord.PurchasePrice)
+	s.Equal(models.ProductRecord{Id: 1, LastUpdate: lastUpdate, PurchasePrice: 3.99, SalePrice: 29.99, ProductId: 1}, productRecord)
 }
 
-func (s *ProductRecordRepositoryTestSuite) TestPartialUpdate_NotFound() {
+func (s *ProductRecordRepositoryTestSuite) TestFindAt_NoRecordBefore() {
 	// Arrange
-	productRecordID := 999
-	fields := map[string]interface{}{
-		"last_update":    "2022-22-10",
-		"purchase_price": 4.99,
-	}
-
-	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_records` WHERE `product_records`.`id` = ? ORDER BY `product_records`.`id` LIMIT ?")).
-		WithArgs(productRecordID, 1).WillReturnError(gorm.ErrRecordNotFound)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_records` WHERE product_id = ? AND last_update <= ?")).
+		WillReturnRows(s.mock.NewRows([]string{"id"}))
 
 	// Act
-	updatedProductRecord, err := s.repo.PartialUpdate(productRecordID, fields)
+	productRecord, err := s.repo.FindAt(context.Background(), 1, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
 
 	// Assert
-	s.Error(err)
-	s.Equal(gorm.ErrRecordNotFound, err)
-	s.Equal(models.ProductRecord{}, updatedProductRecord)
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+	s.Equal(models.ProductRecord{}, productRecord)
 }
 
-func (s *ProductRecordRepositoryTestSuite) TestPartialUpdate_DatabaseError() {
+func (s *ProductRecordRepositoryTestSuite) TestFindBetween_Success() {
 	// Arrange
-	productRecordID := 1
-	fields := map[string]interface{}{
-		"last_update":    "2022-22-10",
-		"purchase_price": 4.99,
-	}
-
-	expectedProductRecord := models.ProductRecord{
-		Id:            productRecordID,
-		LastUpdate:    "2022-22-10",
-		PurchasePrice: 4.99,
-		SalePrice:     5.99,
-		ProductId:     1,
-	}
-
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
 	rows := s.mock.NewRows([]string{"id", "last_update", "purchase_price", "sale_price", "product_id"}).
-		AddRow(expectedProductRecord.Id, expectedProductRecord.LastUpdate, expectedProductRecord.PurchasePrice, expectedProductRecord.SalePrice, expectedProductRecord.ProductId)
-
-	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_records` WHERE `product_records`.`id` = ? ORDER BY `product_records`.`id` LIMIT ?")).
-		WithArgs(productRecordID, 1).WillReturnRows(rows)
-
-	// Update query with database error
-	s.mock.ExpectBegin()
-	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_records` SET `last_update`=?,`purchase_price`=? WHERE `id` = ?")).
-		WithArgs(fields["last_update"], fields["purchase_price"], productRecordID).
-		WillReturnError(sql.ErrConnDone)
-	s.mock.ExpectRollback()
+		AddRow(1, time.Date(2024, 6, 11, 16, 45, 0, 0, time.UTC), 3.99, 29.99, 1).
+		AddRow(7, time.Date(2024, 6, 17, 16, 45, 0, 0, time.UTC), 5.49, 29.99, 1)
+	s.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `product_records` WHERE product_id = ? AND last_update BETWEEN ? AND ? ORDER BY last_update, id")).
+		WithArgs(1, from, to).WillReturnRows(rows)
 
 	// Act
-	updatedProductRecord, err := s.repo.PartialUpdate(productRecordID, fields)
+	productRecords, err := s.repo.FindBetween(context.Background(), 1, from, to)
 
-	// Assert
-	s.Error(err)
-	s.Equal(sql.ErrConnDone, err)
-	s.Equal(models.ProductRecord{}, updatedProductRecord)
-}
-func (s *ProductRecordRepositoryTestSuite) TestDelete_Success() {
-	// Arrange
-	productRecordID := 1
-	s.mock.ExpectBegin()
-	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `product_records` WHERE `product_records`.`id` = ?")).
-		WithArgs(productRecordID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	s.mock.ExpectCommit()
-	// Act
-	err := s.repo.Delete(productRecordID)
 	// Assert
 	s.NoError(err)
-}
-
-func (s *ProductRecordRepositoryTestSuite) TestDelete_NotFound() {
-	// Arrange
-	productRecordID := 999
-
-	s.mock.ExpectBegin()
-	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `product_records` WHERE `product_records`.`id` = ?")).
-		WithArgs(productRecordID).
-		WillReturnResult(sqlmock.NewResult(1, 0)) // 0 rows affected
-	s.mock.ExpectCommit()
-
-	// Act
-	err := s.repo.Delete(productRecordID)
-
-	// Assert
-	s.Error(err)
-	s.Equal(repository.ErrEntityNotFound, err)
+	s.Len(productRecords, 2)
+	s.Equal(7, productRecords[1].Id)
 }
 
 // Run the test suite
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
@@ -88,7 +89,7 @@ func (p *ProductRepositoryTestSuite) TestFindAll_Success() {
 	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `products`")).WillReturnRows(rows)
 
 	// Act
-	products, err := p.repo.FindAll()
+	products, err := p.repo.FindAll(context.Background())
 
 	// Assert
 	p.NoError(err)
@@ -102,7 +103,7 @@ func (p *ProductRepositoryTestSuite) TestFindAll_DatabaseError() {
 	p.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `products`")).WillReturnError(repository.ErrEmptyEntity)
 
 	// Act
-	products, err := p.repo.FindAll()
+	products, err := p.repo.FindAll(context.Background())
 
 	// Assert
 	p.Error(err)
@@ -137,7 +138,7 @@ func (p *ProductRepositoryTestSuite) TestFindById_Success() {
 		WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	product, err := p.repo.FindById(1)
+	product, err := p.repo.FindById(context.Background(), 1)
 
 	// Assert
 	p.NoError(err)
@@ -153,7 +154,7 @@ func (p *ProductRepositoryTestSuite) TestFindById_DataBaseError() {
 		WithArgs(99, 1).WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	product, err := p.repo.FindById(99)
+	product, err := p.repo.FindById(context.Background(), 99)
 
 	// Assert
 	p.Error(err)
@@ -168,7 +169,7 @@ func (p *ProductRepositoryTestSuite) TestFindById_GenericDatabaseError() {
 		WillReturnError(sql.ErrConnDone)
 
 	// Act
-	product, err := p.repo.FindById(99)
+	product, err := p.repo.FindById(context.Background(), 99)
 
 	// Assert
 	p.Error(err)
@@ -204,7 +205,7 @@ func (p *ProductRepositoryTestSuite) TestCreate_Success() {
 	p.mock.ExpectCommit()
 
 	// Act
-	createdProduct, err := p.repo.Create(newProduct[0])
+	createdProduct, err := p.repo.Create(context.Background(), newProduct[0])
 
 	// Assert
 	p.NoError(err)
@@ -236,7 +237,7 @@ func (p *ProductRepositoryTestSuite) TestCreate_GenericDatabaseError() {
 	p.mock.ExpectRollback()
 
 	// Act
-	createdProduct, err := p.repo.Create(newProduct)
+	createdProduct, err := p.repo.Create(context.Background(), newProduct)
 
 	// Assert
 	p.Error(err)
@@ -268,7 +269,7 @@ func (p *ProductRepositoryTestSuite) TestCreate_DatabaseError() {
 	p.mock.ExpectRollback()
 
 	// Act
-	createdProduct, err := p.repo.Create(newProduct)
+	createdProduct, err := p.repo.Create(context.Background(), newProduct)
 
 	// Assert
 	p.Error(err)
@@ -301,7 +302,7 @@ func (p *ProductRepositoryTestSuite) TestUpdate_Success() {
 	p.mock.ExpectCommit()
 
 	// Act
-	updatedProduct, err := p.repo.Update(update)
+	updatedProduct, err := p.repo.Update(context.Background(), update)
 
 	// Assert
 	p.NoError(err)
@@ -334,7 +335,7 @@ func (p *ProductRepositoryTestSuite) TestUpdate_DatabaseError() {
 	p.mock.ExpectRollback()
 
 	// Act
-	createdProduct, err := p.repo.Update(update)
+	createdProduct, err := p.repo.Update(context.Background(), update)
 
 	// Assert
 	p.Error(err)
@@ -367,7 +368,7 @@ func (p *ProductRepositoryTestSuite) TestUpdate_GenericDatabaseError() {
 	p.mock.ExpectRollback()
 
 	// Act
-	createdProduct, err := p.repo.Update(update)
+	createdProduct, err := p.repo.Update(context.Background(), update)
 
 	// Assert
 	p.Error(err)
@@ -414,7 +415,7 @@ func (p *ProductRepositoryTestSuite) TestPartialUpdate_Success() {
 	p.mock.ExpectCommit()
 
 	// Act
-	updatedProduct, err := p.repo.PartialUpdate(productId, fields)
+	updatedProduct, err := p.repo.PartialUpdate(context.Background(), productId, fields)
 
 	// Assert
 	p.NoError(err)
@@ -430,7 +431,7 @@ func (p *ProductRepositoryTestSuite) TestPartialUpdate_NotFound() {
 		WithArgs(productID, 1).WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	updatedProduct, err := p.repo.PartialUpdate(productID, fields)
+	updatedProduct, err := p.repo.PartialUpdate(context.Background(), productID, fields)
 
 	// Assert
 	p.Error(err)
@@ -476,7 +477,7 @@ func (p *ProductRepositoryTestSuite) TestPartialUpdate_DatabaseError() {
 	p.mock.ExpectRollback()
 
 	// Act
-	updatedProduct, err := p.repo.PartialUpdate(productId, fields)
+	updatedProduct, err := p.repo.PartialUpdate(context.Background(), productId, fields)
 
 	// Assert
 	p.Error(err)
@@ -493,7 +494,7 @@ func (p *ProductRepositoryTestSuite) TestDelete_Success() {
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	p.mock.ExpectCommit()
 	// Act
-	err := p.repo.Delete(productID)
+	err := p.repo.Delete(context.Background(), productID)
 	// Assert
 	p.NoError(err)
 }
@@ -509,7 +510,7 @@ func (p *ProductRepositoryTestSuite) TestDelete_NotFound() {
 	p.mock.ExpectCommit()
 
 	// Act
-	err := p.repo.Delete(productID)
+	err := p.repo.Delete(context.Background(), productID)
 
 	// Assert
 	p.Error(err)
@@ -533,7 +534,7 @@ func (s *ProductRepositoryTestSuite) TestFindRecordsCountByProductId_Success() {
 		WithArgs(id).
 		WillReturnRows(rows)
 
-	report, err := s.repo.FindRecordsCountByProductId(id)
+	report, err := s.repo.FindRecordsCountByProductId(context.Background(), id)
 	s.NoError(err)
 	s.Equal(expected, report)
 }
@@ -546,7 +547,7 @@ func (s *ProductRepositoryTestSuite) TestFindRecordsCountByProductId_NotFound()
 		WithArgs(id).
 		WillReturnError(gorm.ErrRecordNotFound)
 
-	report, err := s.repo.FindRecordsCountByProductId(id)
+	report, err := s.repo.FindRecordsCountByProductId(context.Background(), id)
 
 	s.Error(err)
 	s.Equal(repository.ErrProductReportNotFound, err)
@@ -576,7 +577,7 @@ func (s *ProductRepositoryTestSuite) TestFindRecordsCount_Success() {
 		"SELECT products.id, products.description, COUNT(product_records.id) as records_count FROM `products` inner join product_records on product_records.products_id = products.id GROUP BY `products`.`id`")).
 		WillReturnRows(rows)
 
-	report, err := s.repo.FindRecordsCount()
+	report, err := s.repo.FindRecordsCount(context.Background())
 
 	s.NoError(err)
 	s.Equal(expected, report)
@@ -588,7 +589,7 @@ func (s *ProductRepositoryTestSuite) TestFindRecordsCount_NotFound() {
 		"SELECT products.id, products.description, COUNT(product_records.id) as records_count FROM `products` inner join product_records on product_records.products_id = products.id GROUP BY `products`.`id`")).
 		WillReturnError(gorm.ErrRecordNotFound)
 
-	report, err := s.repo.FindRecordsCount()
+	report, err := s.repo.FindRecordsCount(context.Background())
 
 	s.Error(err)
 	s.Equal(repository.ErrProductReportNotFound, err)
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+)
+
+// productTypeUsageColumns counts the references with subqueries, joining both tables would multiply the counts
+const productTypeUsageColumns = "product_type.id AS product_type_id, product_type.name, " +
+	"(SELECT COUNT(*) FROM products WHERE products.product_type_id = product_type.id) AS products_count, " +
+	"(SELECT COUNT(*) FROM sections WHERE sections.product_type_id = product_type.id) AS sections_count"
+
+type ProductTypeRepository struct {
+	db *gorm.DB
+}
+
+func NewProductTypeRepository(db *gorm.DB) *ProductTypeRepository {
+	return &ProductTypeRepository{db: db}
+}
+
+func (r *ProductTypeRepository) FindAll(ctx context.Context) ([]models.ProductType, error) {
+	var productTypes []models.ProductType
+	result := r.db.WithContext(ctx).Find(&productTypes)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return productTypes, nil
+}
+
+func (r *ProductTypeRepository) FindById(ctx context.Context, id int) (models.ProductType, error) {
+	var productType models.ProductType
+	result := r.db.WithContext(ctx).First(&productType, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.ProductType{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.ProductType{}, result.Error
+	}
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) Create(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	result := r.db.WithContext(ctx).Create(&productType)
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		return models.ProductType{}, repository.ErrEntityAlreadyExists
+	case result.Error != nil:
+		return models.ProductType{}, result.Error
+	}
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) Update(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	if _, err := r.FindById(ctx, productType.Id); err != nil {
+		return models.ProductType{}, err
+	}
+	result := r.db.WithContext(ctx).Save(&productType)
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		return models.ProductType{}, repository.ErrEntityAlreadyExists
+	case result.Error != nil:
+		return models.ProductType{}, result.Error
+	}
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.ProductType, error) {
+	productType, err := r.FindById(ctx, id)
+	if err != nil {
+		return models.ProductType{}, err
+	}
+	result := r.db.WithContext(ctx).Model(&productType).Updates(fields)
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		return models.ProductType{}, repository.ErrEntityAlreadyExists
+	case result.Error != nil:
+		return models.ProductType{}, result.Error
+	}
+	return productType, nil
+}
+
+// Delete removes a product type, it returns ErrForeignKeyViolation when a product or a section still references it
+func (r *ProductTypeRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.ProductType{}, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return result.Error
+	case result.RowsAffected < 1:
+		return repository.ErrEntityNotFound
+	}
+	return nil
+}
+
+func (r *ProductTypeRepository) FindUsage(ctx context.Context, id int) (models.ProductTypeUsage, error) {
+	var usages []models.ProductTypeUsage
+	result := r.db.WithContext(ctx).Table("product_type").Select(productTypeUsageColumns).Where("product_type.id = ?", id).Scan(&usages)
+	switch {
+	case result.Error != nil:
+		return models.ProductTypeUsage{}, result.Error
+	case len(usages) == 0:
+		return models.ProductTypeUsage{}, repository.ErrEntityNotFound
+	}
+	return usages[0], nil
+}
+
+func (r *ProductTypeRepository) FindAllUsage(ctx context.Context) ([]models.ProductTypeUsage, error) {
+	var usages []models.ProductTypeUsage
+	result := r.db.WithContext(ctx).Table("product_type").Select(productTypeUsageColumns).Order("product_type.id").Scan(&usages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return usages, nil
+}
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+)
+
+type ProductTypeTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	rp   *ProductTypeRepository
+}
+
+var productTypeUsageRows = []string{"product_type_id", "name", "products_count", "sections_count"}
+
+func (s *ProductTypeTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.rp = NewProductTypeRepository(gormDB)
+}
+
+func (s *ProductTypeTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *ProductTypeTestSuite) TestFindById_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_type` WHERE `product_type`.`id` = ?")).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description"}).AddRow(1, "Fruits", "Fresh fruits"))
+
+	// Act
+	productType, err := s.rp.FindById(context.Background(), 1)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.ProductType{Id: 1, Name: "Fruits", Description: "Fresh fruits"}, productType)
+}
+
+func (s *ProductTypeTestSuite) TestFindById_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_type`")).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	// Act
+	_, err := s.rp.FindById(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *ProductTypeTestSuite) TestCreate_DuplicatedName() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_type`")).
+		WithArgs("Fruits", "Fresh fruits").
+		WillReturnError(gorm.ErrDuplicatedKey)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.rp.Create(context.Background(), models.ProductType{Name: "Fruits", Description: "Fresh fruits"})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityAlreadyExists)
+}
+
+func (s *ProductTypeTestSuite) TestDelete_StillReferenced() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `product_type` WHERE `product_type`.`id` = ?")).
+		WithArgs(1).
+		WillReturnError(gorm.ErrForeignKeyViolated)
+	s.mock.ExpectRollback()
+
+	// Act
+	err := s.rp.Delete(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrForeignKeyViolation)
+}
+
+func (s *ProductTypeTestSuite) TestDelete_NotFound() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `product_type` WHERE `product_type`.`id` = ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	// Act
+	err := s.rp.Delete(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *ProductTypeTestSuite) TestFindUsage_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT product_type.id AS product_type_id, product_type.name, (SELECT COUNT(*) FROM products")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(productTypeUsageRows).AddRow(1, "Fruits", 4, 2))
+
+	// Act
+	usage, err := s.rp.FindUsage(context.Background(), 1)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.ProductTypeUsage{ProductTypeId: 1, Name: "Fruits", ProductsCount: 4, SectionsCount: 2}, usage)
+}
+
+func (s *ProductTypeTestSuite) TestFindUsage_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `product_type` WHERE product_type.id = ?")).
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows(productTypeUsageRows))
+
+	// Act
+	_, err := s.rp.FindUsage(context.Background(), 9)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func TestProductTypeTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductTypeTestSuite))
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+)
+
+type ProvinceRepository struct {
+	db *gorm.DB
+}
+
+func NewProvinceRepository(db *gorm.DB) *ProvinceRepository {
+	return &ProvinceRepository{db: db}
+}
+
+func (r *ProvinceRepository) FindAll(ctx context.Context) ([]models.Province, error) {
+	var provinces []models.Province
+	result := r.db.WithContext(ctx).Order("id").Find(&provinces)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return provinces, nil
+}
+
+func (r *ProvinceRepository) FindById(ctx context.Context, id int) (models.Province, error) {
+	var province models.Province
+	result := r.db.WithContext(ctx).First(&province, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.Province{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.Province{}, result.Error
+	}
+	return province, nil
+}
+
+func (r *ProvinceRepository) FindByCountry(ctx context.Context, countryId int) ([]models.Province, error) {
+	var provinces []models.Province
+	result := r.db.WithContext(ctx).Where("country_id = ?", countryId).Order("id").Find(&provinces)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return provinces, nil
+}
+
+// Create inserts a province, it returns ErrForeignKeyViolation when its country does not exist
+func (r *ProvinceRepository) Create(ctx context.Context, province models.Province) (models.Province, error) {
+	result := r.db.WithContext(ctx).Create(&province)
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		return models.Province{}, repository.ErrEntityAlreadyExists
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.Province{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return models.Province{}, result.Error
+	}
+	return province, nil
+}
+
+func (r *ProvinceRepository) Update(ctx context.Context, province models.Province) (models.Province, error) {
+	if _, err := r.FindById(ctx, province.Id); err != nil {
+		return models.Province{}, err
+	}
+	result := r.db.WithContext(ctx).Save(&province)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.Province{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return models.Province{}, result.Error
+	}
+	return province, nil
+}
+
+func (r *ProvinceRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Province, error) {
+	province, err := r.FindById(ctx, id)
+	if err != nil {
+		return models.Province{}, err
+	}
+	result := r.db.WithContext(ctx).Model(&province).Updates(fields)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.Province{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return models.Province{}, result.Error
+	}
+	return province, nil
+}
+
+// Delete removes a province, it returns ErrForeignKeyViolation when a locality still references it
+func (r *ProvinceRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.Province{}, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return result.Error
+	case result.RowsAffected < 1:
+		return repository.ErrEntityNotFound
+	}
+	return nil
+}
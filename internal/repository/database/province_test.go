@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+)
+
+type ProvinceTestSuite struct {
+	suite.Suite
+	mock      sqlmock.Sqlmock
+	countries *CountryRepository
+	provinces *ProvinceRepository
+}
+
+func (s *ProvinceTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.countries = NewCountryRepository(gormDB)
+	s.provinces = NewProvinceRepository(gormDB)
+}
+
+func (s *ProvinceTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *ProvinceTestSuite) TestFindByCountry_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `provinces` WHERE country_id = ? ORDER BY id")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "province", "country_id"}).
+			AddRow(1, "Buenos Aires", 1).
+			AddRow(2, "Córdoba", 1))
+
+	// Act
+	provinces, err := s.provinces.FindByCountry(context.Background(), 1)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.Province{{Id: 1, Province: "Buenos Aires", CountryId: 1}, {Id: 2, Province: "Córdoba", CountryId: 1}}, provinces)
+}
+
+func (s *ProvinceTestSuite) TestCreateProvince_MissingCountry() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `provinces`")).
+		WithArgs("Córdoba", 9).
+		WillReturnError(gorm.ErrForeignKeyViolated)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.provinces.Create(context.Background(), models.Province{Province: "Córdoba", CountryId: 9})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrForeignKeyViolation)
+}
+
+func (s *ProvinceTestSuite) TestDeleteCountry_StillReferenced() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `countries` WHERE `countries`.`id` = ?")).
+		WithArgs(1).
+		WillReturnError(gorm.ErrForeignKeyViolated)
+	s.mock.ExpectRollback()
+
+	// Act
+	err := s.countries.Delete(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrForeignKeyViolation)
+}
+
+func (s *ProvinceTestSuite) TestFindCountryById_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `countries` WHERE `countries`.`id` = ?")).
+		WithArgs(9, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "country"}))
+
+	// Act
+	_, err := s.countries.FindById(context.Background(), 9)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func TestProvinceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProvinceTestSuite))
+}
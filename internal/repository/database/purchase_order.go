@@ -1,10 +1,12 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"gorm.io/gorm"
-	"time"
 )
 
 type PurchaseOrderRepository struct {
@@ -16,9 +18,9 @@ func NewPurchaseOrderRepository(db *gorm.DB) *PurchaseOrderRepository {
 }
 
 // FindAll retrieves all purchase orders
-func (r *PurchaseOrderRepository) FindAll() ([]models.PurchaseOrder, error) {
+func (r *PurchaseOrderRepository) FindAll(ctx context.Context) ([]models.PurchaseOrder, error) {
 	purchaseOrders := make([]models.PurchaseOrder, 0)
-	result := r.db.Find(&purchaseOrders)
+	result := r.db.WithContext(ctx).Find(&purchaseOrders)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -26,18 +28,21 @@ func (r *PurchaseOrderRepository) FindAll() ([]models.PurchaseOrder, error) {
 }
 
 // FindById retrieves a purchase order by its ID
-func (r *PurchaseOrderRepository) FindById(id int) (models.PurchaseOrder, error) {
+func (r *PurchaseOrderRepository) FindById(ctx context.Context, id int) (models.PurchaseOrder, error) {
 	var purchaseOrder models.PurchaseOrder
-	result := r.db.First(&purchaseOrder, id)
-	if result.Error != nil {
+	result := r.db.WithContext(ctx).First(&purchaseOrder, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.PurchaseOrder{}, repository.ErrEntityNotFound
+	case result.Error != nil:
 		return models.PurchaseOrder{}, result.Error
 	}
 	return purchaseOrder, nil
 }
 
 // Create inserts a new purchase order
-func (r *PurchaseOrderRepository) Create(po models.PurchaseOrder) (models.PurchaseOrder, error) {
-	tx := r.db.Begin()
+func (r *PurchaseOrderRepository) Create(ctx context.Context, po models.PurchaseOrder) (models.PurchaseOrder, error) {
+	tx := r.db.WithContext(ctx).Begin()
 	if tx.Error != nil {
 		return models.PurchaseOrder{}, tx.Error
 	}
@@ -45,7 +50,14 @@ func (r *PurchaseOrderRepository) Create(po models.PurchaseOrder) (models.Purcha
 
 	po.OrderDetails = nil
 	result := tx.Create(&po)
-	if result.Error != nil {
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		tx.Rollback()
+		return models.PurchaseOrder{}, repository.ErrEntityAlreadyExists
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		tx.Rollback()
+		return models.PurchaseOrder{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
 		tx.Rollback()
 		return models.PurchaseOrder{}, result.Error
 	}
@@ -61,13 +73,19 @@ func (r *PurchaseOrderRepository) Create(po models.PurchaseOrder) (models.Purcha
 		d := detail
 		d.Id = 0
 		d.PurchaseOrderID = po.Id
-		_, err := odr.Create(d)
+		_, err := odr.Create(ctx, d)
 		if err != nil {
 			tx.Rollback()
 			return models.PurchaseOrder{}, err
 		}
 	}
 
+	// the event is committed along with the order, so it is never lost nor published for a rolled back order
+	if err := appendOutbox(tx, models.OutboxAggregatePurchaseOrder, po.Id, models.OutboxEventPurchaseOrderCreated, po); err != nil {
+		tx.Rollback()
+		return models.PurchaseOrder{}, err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
 		return models.PurchaseOrder{}, err
@@ -77,8 +95,8 @@ func (r *PurchaseOrderRepository) Create(po models.PurchaseOrder) (models.Purcha
 }
 
 // Update replaces an existing purchase order by its struct
-func (r *PurchaseOrderRepository) Update(po models.PurchaseOrder) (models.PurchaseOrder, error) {
-	result := r.db.Save(&po)
+func (r *PurchaseOrderRepository) Update(ctx context.Context, po models.PurchaseOrder) (models.PurchaseOrder, error) {
+	result := r.db.WithContext(ctx).Save(&po)
 	if result.Error != nil {
 		return models.PurchaseOrder{}, result.Error
 	}
@@ -86,37 +104,19 @@ func (r *PurchaseOrderRepository) Update(po models.PurchaseOrder) (models.Purcha
 }
 
 // PartialUpdate updates only the provided fields
-func (r *PurchaseOrderRepository) PartialUpdate(id int, fields map[string]interface{}) (models.PurchaseOrder, error) {
+func (r *PurchaseOrderRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.PurchaseOrder, error) {
 	var po models.PurchaseOrder
-	result := r.db.First(&po, id)
+	result := r.db.WithContext(ctx).First(&po, id)
 	if result.Error != nil {
 		return models.PurchaseOrder{}, result.Error
 	}
 
-	// Apply each field conditionally
-	if val, ok := fields["order_number"]; ok {
-		po.OrderNumber = val.(string)
-	}
-	if val, ok := fields["order_date"]; ok {
-		po.OrderDate = val.(time.Time)
-	}
-	if val, ok := fields["tracing_code"]; ok {
-		po.TracingCode = val.(string)
-	}
-	if val, ok := fields["buyer_id"]; ok {
-		po.BuyerID = int(val.(float64))
-	}
-	if val, ok := fields["warehouse_id"]; ok {
-		po.WarehouseID = int(val.(float64))
-	}
-	if val, ok := fields["carrier_id"]; ok {
-		po.CarrierID = int(val.(float64))
-	}
-	if val, ok := fields["order_status_id"]; ok {
-		po.OrderStatusID = int(val.(float64))
+	// Apply each field, converted to the type of the model field
+	if err := patch.Apply(&po, fields); err != nil {
+		return models.PurchaseOrder{}, err
 	}
 
-	result = r.db.Save(&po)
+	result = r.db.WithContext(ctx).Save(&po)
 	if result.Error != nil {
 		return models.PurchaseOrder{}, result.Error
 	}
@@ -124,14 +124,14 @@ func (r *PurchaseOrderRepository) PartialUpdate(id int, fields map[string]interf
 }
 
 // Delete removes a purchase order by ID
-func (r *PurchaseOrderRepository) Delete(id int) error {
-	result := r.db.Delete(&models.PurchaseOrder{}, id)
+func (r *PurchaseOrderRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.PurchaseOrder{}, id)
 	return result.Error
 }
 
-func (r *PurchaseOrderRepository) FindByBuyerId(id int) ([]models.PurchaseOrder, error) {
+func (r *PurchaseOrderRepository) FindByBuyerId(ctx context.Context, id int) ([]models.PurchaseOrder, error) {
 	var orders []models.PurchaseOrder
-	result := r.db.Where("buyer_id = ?", id).Find(&orders)
+	result := r.db.WithContext(ctx).Where("buyer_id = ?", id).Find(&orders)
 	if result.Error != nil {
 		return []models.PurchaseOrder{}, result.Error
 	}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -115,7 +116,7 @@ func (s *PurchaseOrderTestSuite) TestFindAll_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `purchase_orders`")).WillReturnRows(rows)
 
 	// Act
-	po, err := s.repo.FindAll()
+	po, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -128,7 +129,7 @@ func (s *PurchaseOrderTestSuite) TestFindAll_DatabaseError() {
 	// Arrange
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `purchase_orders`")).WillReturnError(sql.ErrConnDone)
 	// Act
-	purchaseOrder, err := s.repo.FindAll()
+	purchaseOrder, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -168,7 +169,7 @@ func (s *PurchaseOrderTestSuite) TestFindById_Success() {
 		WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	po, err := s.repo.FindById(1)
+	po, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -183,7 +184,7 @@ func (s *PurchaseOrderTestSuite) TestFindById_NotFound() {
 		"SELECT * FROM `purchase_orders` WHERE `purchase_orders`.`id` = ? ORDER BY `purchase_orders`.`id` LIMIT ?",
 	)).WithArgs(999, 1).WillReturnError(repository.ErrEntityNotFound)
 	// Act
-	po, err := s.repo.FindById(999)
+	po, err := s.repo.FindById(context.Background(), 999)
 
 	// Assert
 	s.Error(err)
@@ -228,9 +229,13 @@ func (s *PurchaseOrderTestSuite) TestCreate_Success() {
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox`")).
+		WithArgs(models.OutboxAggregatePurchaseOrder, 1, models.OutboxEventPurchaseOrderCreated, sqlmock.AnyArg(), models.OutboxPending, 0, "", nil, nil, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
 	s.mock.ExpectCommit()
 
-	createdPO, err := s.repo.Create(purchaseOrder)
+	createdPO, err := s.repo.Create(context.Background(), purchaseOrder)
 
 	s.NoError(err)
 	s.Equal(purchaseOrder.OrderNumber, createdPO.OrderNumber)
@@ -260,7 +265,7 @@ func (s *PurchaseOrderTestSuite) TestCreate_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdPO, err := s.repo.Create(purchaseOrder)
+	createdPO, err := s.repo.Create(context.Background(), purchaseOrder)
 
 	// Assert
 	s.Error(err)
@@ -268,6 +273,33 @@ func (s *PurchaseOrderTestSuite) TestCreate_ForeignKeyViolated() {
 
 }
 
+func (s *PurchaseOrderTestSuite) TestCreate_DuplicatedOrderNumber() {
+	// Arrange
+	orderDetail := []models.OrderDetail{{Quantity: 1, ProductRecordID: 1}}
+	purchaseOrder := models.PurchaseOrder{
+		OrderNumber:   "PO-20250715-020",
+		OrderDate:     time.Now(),
+		TracingCode:   "TRC004",
+		BuyerID:       1,
+		WarehouseID:   2,
+		CarrierID:     1,
+		OrderStatusID: 1,
+		OrderDetails:  &orderDetail,
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `purchase_orders`")).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	s.mock.ExpectRollback()
+
+	// Act
+	createdPO, err := s.repo.Create(context.Background(), purchaseOrder)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityAlreadyExists)
+	s.Equal(models.PurchaseOrder{}, createdPO)
+}
+
 func (s *PurchaseOrderTestSuite) TestCreate_BeginTransactionFails() {
 	// Arrange
 	orderDetail := []models.OrderDetail{}
@@ -284,7 +316,7 @@ func (s *PurchaseOrderTestSuite) TestCreate_BeginTransactionFails() {
 	s.mock.ExpectBegin().WillReturnError(errors.New("begin failed"))
 
 	// Act
-	createdPO, err := s.repo.Create(purchaseOrder)
+	createdPO, err := s.repo.Create(context.Background(), purchaseOrder)
 
 	// Assert
 	s.Error(err)
@@ -333,7 +365,7 @@ func (s *PurchaseOrderTestSuite) TestCreate_OrderDetailFails() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdPO, err := s.repo.Create(purchaseOrder)
+	createdPO, err := s.repo.Create(context.Background(), purchaseOrder)
 
 	// Assert
 	s.Error(err)
@@ -369,7 +401,7 @@ func (s *PurchaseOrderTestSuite) TestCreate_OrderDetailsNil() {
 	s.mock.ExpectRollback() // Porque el rollback SÍ ocurre si ordersDetails == nil
 
 	// Act
-	createdPO, err := s.repo.Create(purchaseOrder)
+	createdPO, err := s.repo.Create(context.Background(), purchaseOrder)
 
 	// Assert
 	s.Error(err)
@@ -398,7 +430,7 @@ func (s *PurchaseOrderTestSuite) TestUpdate_Success() {
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
-	result, err := s.repo.Update(po)
+	result, err := s.repo.Update(context.Background(), po)
 	s.NoError(err)
 	s.Equal(po, result)
 }
@@ -430,16 +462,20 @@ func (s *PurchaseOrderTestSuite) TestCreate_CommitError() {
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	// 5. Espera un Commit que FALLE
+	// 5. Espera el INSERT del evento en el outbox, dentro de la misma transacción
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `outbox`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// 6. Espera un Commit que FALLE
 	s.mock.ExpectCommit().WillReturnError(errors.New("commit failed"))
 
-	// 6. Espera el Rollback que hace tu código al fallar el commit
+	// 7. Espera el Rollback que hace tu código al fallar el commit
 	s.mock.ExpectRollback()
 
-	// 7. Ejecuta el método
-	_, err := s.repo.Create(po)
+	// 8. Ejecuta el método
+	_, err := s.repo.Create(context.Background(), po)
 
-	// 8. Asegúrate que el error es correcto
+	// 9. Asegúrate que el error es correcto
 	s.Error(err)
 	s.Contains(err.Error(), "commit failed")
 
@@ -462,7 +498,7 @@ func (s *PurchaseOrderTestSuite) TestUpdate_Error() {
 	s.mock.ExpectRollback()
 
 	// Ejecutar
-	result, err := s.repo.Update(po)
+	result, err := s.repo.Update(context.Background(), po)
 
 	// Verificar
 	s.Error(err)
@@ -513,7 +549,7 @@ func (s *PurchaseOrderTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Ejecutar función
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Validaciones
 	s.NoError(err)
@@ -556,7 +592,7 @@ func (s *PurchaseOrderTestSuite) TestPartialUpdate_SaveError() {
 	s.mock.ExpectRollback()
 
 	// Ejecuta
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Verifica
 	s.Error(err)
@@ -574,7 +610,7 @@ func (s *PurchaseOrderTestSuite) TestPartialUpdate_FirstFails() {
 	)).WithArgs(id, sqlmock.AnyArg()).
 		WillReturnError(errors.New("record not found"))
 
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	s.Error(err)
 	s.EqualError(err, "record not found")
@@ -590,7 +626,7 @@ func (s *PurchaseOrderTestSuite) TestDelete_Success() {
 	)).WithArgs(id).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
-	err := s.repo.Delete(id)
+	err := s.repo.Delete(context.Background(), id)
 	s.NoError(err)
 }
 
@@ -604,7 +640,7 @@ func (s *PurchaseOrderTestSuite) TestFindByBuyerId_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `purchase_orders` WHERE buyer_id = ?")).
 		WithArgs(buyerID).WillReturnRows(rows)
 
-	result, err := s.repo.FindByBuyerId(buyerID)
+	result, err := s.repo.FindByBuyerId(context.Background(), buyerID)
 	s.NoError(err)
 	s.Len(result, 2)
 	s.Equal("ORD-001", result[0].OrderNumber)
@@ -617,7 +653,7 @@ func (s *PurchaseOrderTestSuite) TestFindByBuyerId_Fail() {
 		WithArgs(buyerID).
 		WillReturnError(repository.ErrEntityNotFound)
 	// Act
-	po, err := s.repo.FindByBuyerId(buyerID)
+	po, err := s.repo.FindByBuyerId(context.Background(), buyerID)
 
 	// Assert
 	s.Error(err)
@@ -0,0 +1,339 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// RecallRepository implements the recalls over the recalls and recall_batches tables
+type RecallRepository struct {
+	db *gorm.DB
+}
+
+func NewRecallRepository(db *gorm.DB) *RecallRepository {
+	return &RecallRepository{db: db}
+}
+
+func (r *RecallRepository) FindAll(ctx context.Context) ([]models.Recall, error) {
+	recalls := make([]models.Recall, 0)
+	result := r.db.WithContext(ctx).Preload("Batches", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).Order("id").Find(&recalls)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return recalls, nil
+}
+
+func (r *RecallRepository) FindById(ctx context.Context, id int) (models.Recall, error) {
+	var recall models.Recall
+	result := r.db.WithContext(ctx).Preload("Batches", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).Take(&recall, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.Recall{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.Recall{}, result.Error
+	}
+	return recall, nil
+}
+
+// Create locks the recalled batches while the recall is opened, so they cannot be picked or recalled by another
+// request until it is stored, and moves them to recalled. The disposed batches hold no stock and are not recalled.
+func (r *RecallRepository) Create(ctx context.Context, recall models.Recall, batchNumbers []string) (models.Recall, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("status <> ?", models.ProductBatchDisposed).Order("id")
+		if recall.ProductId != nil {
+			query = query.Where("product_id = ?", *recall.ProductId)
+		} else {
+			query = query.Where("batch_number IN ?", batchNumbers)
+		}
+		var batches []models.ProductBatch
+		if err := query.Find(&batches).Error; err != nil {
+			return err
+		}
+		if len(batches) == 0 || (recall.ProductId == nil && len(batches) != len(batchNumbers)) {
+			return repository.ErrEntityNotFound
+		}
+
+		ids := make([]int, len(batches))
+		for i, batch := range batches {
+			ids[i] = batch.Id
+		}
+		recalled, err := countOpenRecalls(tx, ids)
+		if err != nil {
+			return err
+		}
+		if recalled > 0 {
+			return repository.ErrProductBatchRecalled
+		}
+
+		recall.Batches = make([]models.RecallBatch, len(batches))
+		for i, batch := range batches {
+			recall.Batches[i] = models.RecallBatch{
+				ProductBatchId:   batch.Id,
+				Status:           models.RecallBatchRecalled,
+				RecalledQuantity: batch.CurrentQuantity,
+			}
+		}
+		if err = tx.Create(&recall).Error; err != nil {
+			return err
+		}
+
+		for i := range batches {
+			change := models.ProductBatchStatusChange{
+				ToStatus:  models.ProductBatchRecalled,
+				Reason:    fmt.Sprintf("recall %d: %s", recall.Id, recall.Reason),
+				ChangedAt: recall.CreatedAt,
+			}
+			if err = setProductBatchStatus(tx, &batches[i], change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return models.Recall{}, repository.ErrForeignKeyViolation
+	case err != nil:
+		return models.Recall{}, err
+	}
+	return recall, nil
+}
+
+// Quarantine records the stock the batch holds as set apart and moves the batch to quarantined, a batch quarantined
+// since it was read is stale
+func (r *RecallRepository) Quarantine(ctx context.Context, id int, productBatchId int, at time.Time) (models.RecallBatch, error) {
+	var line models.RecallBatch
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		recall, err := lockOpenRecall(tx, id)
+		if err != nil {
+			return err
+		}
+		batch, err := lockProductBatch(tx, productBatchId)
+		if err != nil {
+			return err
+		}
+
+		err = moveRecallBatch(tx, recall.Id, batch.Id, models.RecallBatchRecalled, map[string]any{
+			"status":               models.RecallBatchQuarantined,
+			"quarantined_quantity": batch.CurrentQuantity,
+			"quarantined_at":       at,
+		})
+		if err != nil {
+			return err
+		}
+		change := models.ProductBatchStatusChange{
+			ToStatus:  models.ProductBatchQuarantined,
+			Reason:    fmt.Sprintf("quarantined by recall %d", recall.Id),
+			ChangedAt: at,
+		}
+		if err = setProductBatchStatus(tx, &batch, change); err != nil {
+			return err
+		}
+		return tx.Where("recall_id = ? AND product_batch_id = ?", recall.Id, batch.Id).Take(&line).Error
+	})
+	if err != nil {
+		return models.RecallBatch{}, err
+	}
+	return line, nil
+}
+
+// Dispose takes the stock the batch holds out of it as a disposal by the employee and moves the batch to disposed, a
+// batch emptied by it frees its place in its section. A batch disposed since it was read is stale.
+func (r *RecallRepository) Dispose(ctx context.Context, id int, productBatchId int, employeeId *int, at time.Time) (models.RecallBatch, error) {
+	var line models.RecallBatch
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		recall, err := lockOpenRecall(tx, id)
+		if err != nil {
+			return err
+		}
+		batch, err := lockProductBatch(tx, productBatchId)
+		if err != nil {
+			return err
+		}
+
+		if batch.CurrentQuantity > 0 {
+			reasonCode := models.AdjustmentRecalled
+			movement := models.StockMovement{
+				ProductBatchId: batch.Id,
+				Quantity:       -batch.CurrentQuantity,
+				Reason:         models.StockMovementDisposal,
+				ReasonCode:     &reasonCode,
+				EmployeeId:     employeeId,
+			}
+			if err = adjustStock(tx, &movement); err != nil {
+				return err
+			}
+		}
+
+		err = moveRecallBatch(tx, recall.Id, batch.Id, models.RecallBatchQuarantined, map[string]any{
+			"status":            models.RecallBatchDisposed,
+			"disposed_quantity": batch.CurrentQuantity,
+			"disposed_at":       at,
+		})
+		if err != nil {
+			return err
+		}
+		change := models.ProductBatchStatusChange{
+			ToStatus:   models.ProductBatchDisposed,
+			Reason:     fmt.Sprintf("disposed by recall %d", recall.Id),
+			EmployeeId: employeeId,
+			ChangedAt:  at,
+		}
+		if err = setProductBatchStatus(tx, &batch, change); err != nil {
+			return err
+		}
+		return tx.Where("recall_id = ? AND product_batch_id = ?", recall.Id, batch.Id).Take(&line).Error
+	})
+
+	switch {
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return models.RecallBatch{}, repository.ErrForeignKeyViolation
+	case err != nil:
+		return models.RecallBatch{}, err
+	}
+	return line, nil
+}
+
+// countOpenRecalls counts the lines of the open recalls the batches are part of, whatever the stage of their batch
+func countOpenRecalls(tx *gorm.DB, productBatchIds []int) (int64, error) {
+	var recalled int64
+	err := tx.Model(&models.RecallBatch{}).
+		Joins("JOIN recalls ON recalls.id = recall_batches.recall_id").
+		Where("recall_batches.product_batch_id IN ? AND recalls.status = ?", productBatchIds, models.RecallOpen).
+		Count(&recalled).Error
+	return recalled, err
+}
+
+// Close closes the recall while it is locked, a recall with a batch not disposed since it was read is stale
+func (r *RecallRepository) Close(ctx context.Context, id int, at time.Time) (models.Recall, error) {
+	var recall models.Recall
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if recall, err = lockOpenRecall(tx, id); err != nil {
+			return err
+		}
+		if err = tx.Where("recall_id = ?", recall.Id).Order("id").Find(&recall.Batches).Error; err != nil {
+			return err
+		}
+		for _, line := range recall.Batches {
+			if line.Status != models.RecallBatchDisposed {
+				return repository.ErrStaleEntity
+			}
+		}
+
+		recall.Status = models.RecallClosed
+		recall.ClosedAt = &at
+		return tx.Model(&recall).Omit(clause.Associations).Updates(map[string]any{
+			"status":    models.RecallClosed,
+			"closed_at": at,
+		}).Error
+	})
+	if err != nil {
+		return models.Recall{}, err
+	}
+	return recall, nil
+}
+
+func (r *RecallRepository) FindStock(ctx context.Context, id int) ([]models.RecallStock, error) {
+	stock := make([]models.RecallStock, 0)
+	result := r.db.WithContext(ctx).Table("recall_batches").
+		Select("warehouses.id AS warehouse_id, warehouses.warehouse_code, COUNT(recall_batches.id) AS batches_count, "+
+			"COUNT(CASE WHEN recall_batches.status = ? THEN 1 END) AS pending_batches_count, "+
+			"COALESCE(SUM(product_batches.current_quantity), 0) AS quantity", models.RecallBatchRecalled).
+		Joins("JOIN product_batches ON product_batches.id = recall_batches.product_batch_id").
+		Joins("JOIN sections ON sections.id = product_batches.section_id").
+		Joins("JOIN warehouses ON warehouses.id = sections.warehouse_id").
+		Where("recall_batches.recall_id = ?", id).
+		Group("warehouses.id, warehouses.warehouse_code").
+		Order("warehouses.id").
+		Scan(&stock)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return stock, nil
+}
+
+// recallOrderRow is a purchase order the batches of a recall were picked for, along with its buyer
+type recallOrderRow struct {
+	models.Buyer
+	models.RecallPurchaseOrder
+}
+
+// FindOrders follows the picks of the batches of the recall to their purchase orders, the buyers and their orders
+// are ordered by id
+func (r *RecallRepository) FindOrders(ctx context.Context, id int) ([]models.RecallOrders, error) {
+	var rows []recallOrderRow
+	result := r.db.WithContext(ctx).Table("recall_batches").
+		Select("buyers.id, buyers.card_number_id, buyers.first_name, buyers.last_name, "+
+			"purchase_orders.id AS purchase_order_id, purchase_orders.order_number, purchase_orders.order_status_id, "+
+			"SUM(order_detail_picks.quantity) AS picked_quantity").
+		Joins("JOIN order_detail_picks ON order_detail_picks.product_batch_id = recall_batches.product_batch_id").
+		Joins("JOIN order_details ON order_details.id = order_detail_picks.order_detail_id").
+		Joins("JOIN purchase_orders ON purchase_orders.id = order_details.purchase_order_id").
+		Joins("JOIN buyers ON buyers.id = purchase_orders.buyer_id").
+		Where("recall_batches.recall_id = ?", id).
+		Group("buyers.id, buyers.card_number_id, buyers.first_name, buyers.last_name, " +
+			"purchase_orders.id, purchase_orders.order_number, purchase_orders.order_status_id").
+		Order("buyers.id, purchase_orders.id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	orders := make([]models.RecallOrders, 0)
+	for _, row := range rows {
+		if len(orders) == 0 || orders[len(orders)-1].Id != row.Buyer.Id {
+			orders = append(orders, models.RecallOrders{Buyer: row.Buyer, PurchaseOrders: make([]models.RecallPurchaseOrder, 0)})
+		}
+		last := &orders[len(orders)-1]
+		last.PurchaseOrders = append(last.PurchaseOrders, row.RecallPurchaseOrder)
+	}
+	return orders, nil
+}
+
+// lockOpenRecall reads a recall and locks it until the transaction ends, a recall closed since it was read by the
+// caller is stale
+func lockOpenRecall(tx *gorm.DB, id int) (models.Recall, error) {
+	var recall models.Recall
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&recall, id).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return models.Recall{}, repository.ErrEntityNotFound
+	case err != nil:
+		return models.Recall{}, err
+	}
+	if recall.Status != models.RecallOpen {
+		return models.Recall{}, repository.ErrStaleEntity
+	}
+	return recall, nil
+}
+
+// moveRecallBatch moves a batch of a recall out of the given status, a batch that is not in it anymore is stale and
+// a batch that is not part of the recall is not found
+func moveRecallBatch(tx *gorm.DB, recallId int, productBatchId int, from string, fields map[string]any) error {
+	result := tx.Model(&models.RecallBatch{}).
+		Where("recall_id = ? AND product_batch_id = ? AND status = ?", recallId, productBatchId, from).
+		Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	err := tx.Model(&models.RecallBatch{}).Where("recall_id = ? AND product_batch_id = ?", recallId, productBatchId).Count(&count).Error
+	switch {
+	case err != nil:
+		return err
+	case count == 0:
+		return repository.ErrEntityNotFound
+	}
+	return repository.ErrStaleEntity
+}
@@ -0,0 +1,286 @@
+package database
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type RecallTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo *RecallRepository
+}
+
+func (s *RecallTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.repo = NewRecallRepository(gormDB)
+}
+
+func (s *RecallTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// expectRecallBatches expects the batches with the given numbers to be locked
+func (s *RecallTestSuite) expectRecallBatches(rows *sqlmock.Rows, first string, second string) {
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE status <> ? AND batch_number IN (?,?) ORDER BY id FOR UPDATE")).
+		WithArgs(models.ProductBatchDisposed, first, second).
+		WillReturnRows(rows)
+}
+
+// expectStatusChange expects a batch to be moved to a status and the change to be recorded
+func (s *RecallTestSuite) expectStatusChange(productBatchId int, from string, to string, reason string) {
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `status`=? WHERE id = ?")).
+		WithArgs(to, productBatchId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `product_batch_status_changes` (`product_batch_id`,`from_status`,`to_status`,`reason`,`reason_code`,`employee_id`,`changed_at`) VALUES (?,?,?,?,?,?,?)")).
+		WithArgs(productBatchId, from, to, reason, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+// expectOpenRecall expects the recall to be locked
+func (s *RecallTestSuite) expectOpenRecall(id int, status string) {
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recalls` WHERE `recalls`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(id, status))
+}
+
+func (s *RecallTestSuite) TestCreate_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.expectRecallBatches(sqlmock.NewRows([]string{"id", "current_quantity", "status"}).
+		AddRow(6, 200, models.ProductBatchAvailable).
+		AddRow(7, 150, models.ProductBatchQuarantined), "6", "7")
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `recall_batches` JOIN recalls ON recalls.id = recall_batches.recall_id WHERE recall_batches.product_batch_id IN (?,?) AND recalls.status = ?")).
+		WithArgs(6, 7, models.RecallOpen).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `recalls` (`product_id`,`severity`,`reason`,`status`,`created_at`,`closed_at`) VALUES (?,?,?,?,?,?)")).
+		WithArgs(nil, models.RecallSeverityHigh, "Listeria", models.RecallOpen, at, nil).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `recall_batches` (`recall_id`,`product_batch_id`,`status`,`recalled_quantity`,`quarantined_quantity`,`quarantined_at`,`disposed_quantity`,`disposed_at`) VALUES (?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?)")).
+		WithArgs(2, 6, models.RecallBatchRecalled, 200, nil, nil, nil, nil, 2, 7, models.RecallBatchRecalled, 150, nil, nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	s.expectStatusChange(6, models.ProductBatchAvailable, models.ProductBatchRecalled, "recall 2: Listeria")
+	s.expectStatusChange(7, models.ProductBatchQuarantined, models.ProductBatchRecalled, "recall 2: Listeria")
+	s.mock.ExpectCommit()
+
+	// Act
+	recall, err := s.repo.Create(context.Background(), models.Recall{Severity: models.RecallSeverityHigh, Reason: "Listeria", Status: models.RecallOpen, CreatedAt: at}, []string{"6", "7"})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(2, recall.Id)
+	s.Len(recall.Batches, 2)
+	s.Equal(150, recall.Batches[1].RecalledQuantity)
+}
+
+func (s *RecallTestSuite) TestCreate_BatchNotFound() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectRecallBatches(sqlmock.NewRows([]string{"id", "current_quantity", "status"}).AddRow(6, 200, models.ProductBatchAvailable), "6", "99")
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Create(context.Background(), models.Recall{Severity: models.RecallSeverityLow, Reason: "Damaged packaging"}, []string{"6", "99"})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *RecallTestSuite) TestCreate_BatchAlreadyRecalled() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectRecallBatches(sqlmock.NewRows([]string{"id", "current_quantity", "status"}).
+		AddRow(6, 200, models.ProductBatchRecalled).
+		AddRow(7, 150, models.ProductBatchAvailable), "6", "7")
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `recall_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Create(context.Background(), models.Recall{Severity: models.RecallSeverityLow, Reason: "Damaged packaging"}, []string{"6", "7"})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrProductBatchRecalled)
+}
+
+func (s *RecallTestSuite) TestQuarantine_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 11, 9, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.expectOpenRecall(2, models.RecallOpen)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(6, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "status"}).AddRow(6, 180, 3, models.ProductBatchRecalled))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `recall_batches` SET `quarantined_at`=?,`quarantined_quantity`=?,`status`=? WHERE recall_id = ? AND product_batch_id = ? AND status = ?")).
+		WithArgs(at, 180, models.RecallBatchQuarantined, 2, 6, models.RecallBatchRecalled).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectStatusChange(6, models.ProductBatchRecalled, models.ProductBatchQuarantined, "quarantined by recall 2")
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recall_batches` WHERE recall_id = ? AND product_batch_id = ? LIMIT ?")).
+		WithArgs(2, 6, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "recall_id", "product_batch_id", "status", "recalled_quantity", "quarantined_quantity"}).
+			AddRow(4, 2, 6, models.RecallBatchQuarantined, 200, 180))
+	s.mock.ExpectCommit()
+
+	// Act
+	line, err := s.repo.Quarantine(context.Background(), 2, 6, at)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.RecallBatchQuarantined, line.Status)
+	s.Equal(180, *line.QuarantinedQuantity)
+}
+
+func (s *RecallTestSuite) TestQuarantine_Stale() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectOpenRecall(2, models.RecallOpen)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id"}).AddRow(6, 180, 3))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `recall_batches`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `recall_batches` WHERE recall_id = ? AND product_batch_id = ?")).
+		WithArgs(2, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Quarantine(context.Background(), 2, 6, time.Now())
+
+	// Assert
+	s.ErrorIs(err, repository.ErrStaleEntity)
+}
+
+func (s *RecallTestSuite) TestDispose_EmptiesBatch() {
+	// Arrange
+	at := time.Date(2025, 6, 12, 9, 0, 0, 0, time.UTC)
+	employeeId := 1
+	s.mock.ExpectBegin()
+	s.expectOpenRecall(2, models.RecallOpen)
+	for range 2 {
+		s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+			WithArgs(6, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "status"}).AddRow(6, 180, 3, models.ProductBatchQuarantined))
+	}
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(0, 6).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(6, 3, -180, models.StockMovementDisposal, models.AdjustmentRecalled, employeeId, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity - 1 WHERE id = ?")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `recall_batches` SET `disposed_at`=?,`disposed_quantity`=?,`status`=? WHERE recall_id = ? AND product_batch_id = ? AND status = ?")).
+		WithArgs(at, 180, models.RecallBatchDisposed, 2, 6, models.RecallBatchQuarantined).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectStatusChange(6, models.ProductBatchQuarantined, models.ProductBatchDisposed, "disposed by recall 2")
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recall_batches` WHERE recall_id = ? AND product_batch_id = ? LIMIT ?")).
+		WithArgs(2, 6, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "recall_id", "product_batch_id", "status", "disposed_quantity"}).
+			AddRow(4, 2, 6, models.RecallBatchDisposed, 180))
+	s.mock.ExpectCommit()
+
+	// Act
+	line, err := s.repo.Dispose(context.Background(), 2, 6, &employeeId, at)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.RecallBatchDisposed, line.Status)
+	s.Equal(180, *line.DisposedQuantity)
+}
+
+func (s *RecallTestSuite) TestDispose_RecallClosed() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectOpenRecall(2, models.RecallClosed)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Dispose(context.Background(), 2, 6, nil, time.Now())
+
+	// Assert
+	s.ErrorIs(err, repository.ErrStaleEntity)
+}
+
+func (s *RecallTestSuite) TestClose_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 13, 9, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.expectOpenRecall(2, models.RecallOpen)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recall_batches` WHERE recall_id = ? ORDER BY id")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "recall_id", "status"}).AddRow(4, 2, models.RecallBatchDisposed))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `recalls` SET `closed_at`=?,`status`=? WHERE `id` = ?")).
+		WithArgs(at, models.RecallClosed, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	recall, err := s.repo.Close(context.Background(), 2, at)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.RecallClosed, recall.Status)
+	s.Equal(at, *recall.ClosedAt)
+}
+
+func (s *RecallTestSuite) TestClose_BatchNotDisposed() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectOpenRecall(2, models.RecallOpen)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `recall_batches` WHERE recall_id = ? ORDER BY id")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "recall_id", "status"}).
+			AddRow(4, 2, models.RecallBatchDisposed).
+			AddRow(5, 2, models.RecallBatchQuarantined))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Close(context.Background(), 2, time.Now())
+
+	// Assert
+	s.ErrorIs(err, repository.ErrStaleEntity)
+}
+
+func (s *RecallTestSuite) TestFindOrders_GroupsByBuyer() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `recall_batches` JOIN order_detail_picks")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "card_number_id", "first_name", "last_name", "purchase_order_id", "order_number", "order_status_id", "picked_quantity"}).
+			AddRow(1, "B-1", "Ana", "Diaz", 1, "PO-1", 1, 20).
+			AddRow(1, "B-1", "Ana", "Diaz", 3, "PO-3", 2, 5).
+			AddRow(2, "B-2", "Luis", "Mora", 2, "PO-2", 1, 8))
+
+	// Act
+	orders, err := s.repo.FindOrders(context.Background(), 2)
+
+	// Assert
+	s.NoError(err)
+	s.Len(orders, 2)
+	s.Len(orders[0].PurchaseOrders, 2)
+	s.Equal(5, orders[0].PurchaseOrders[1].PickedQuantity)
+	s.Equal(2, orders[1].Id)
+}
+
+func TestRecallTestSuite(t *testing.T) {
+	suite.Run(t, new(RecallTestSuite))
+}
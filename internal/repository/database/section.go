@@ -1,10 +1,13 @@
 package database
 
 import (
+	"context"
+	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"gorm.io/gorm"
 	//"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 )
 
 type SectionRepository struct {
@@ -17,34 +20,45 @@ func NewSectionRepository(db *gorm.DB) *SectionRepository {
 	}
 }
 
-func (r *SectionRepository) FindAll() ([]models.Section, error) {
+func (r *SectionRepository) FindAll(ctx context.Context) ([]models.Section, error) {
 	sections := make([]models.Section, 0)
-	result := r.db.Find(&sections)
+	result := r.db.WithContext(ctx).Find(&sections)
 	if result.Error != nil {
 		return make([]models.Section, 0), result.Error
 	}
 	return sections, nil
 }
 
-func (r *SectionRepository) FindById(id int) (models.Section, error) {
+func (r *SectionRepository) FindByIds(ctx context.Context, ids []int) ([]models.Section, error) {
+	return findIn[models.Section](r.db.WithContext(ctx), "id", ids)
+}
+
+func (r *SectionRepository) FindByWarehouses(ctx context.Context, warehouseIds []int) ([]models.Section, error) {
+	return findIn[models.Section](r.db.WithContext(ctx), "warehouse_id", warehouseIds)
+}
+
+func (r *SectionRepository) FindById(ctx context.Context, id int) (models.Section, error) {
 	var section models.Section
-	result := r.db.First(&section, id)
+	result := r.db.WithContext(ctx).First(&section, id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return models.Section{}, repository.ErrEntityNotFound
+	}
 	if result.Error != nil {
 		return models.Section{}, result.Error
 	}
 	return section, nil
 }
 
-func (r *SectionRepository) Create(section models.Section) (models.Section, error) {
-	result := r.db.Create(&section)
+func (r *SectionRepository) Create(ctx context.Context, section models.Section) (models.Section, error) {
+	result := r.db.WithContext(ctx).Create(&section)
 	if result.Error != nil {
 		return models.Section{}, result.Error
 	}
 	return section, nil
 }
 
-func (s *SectionRepository) Update(section models.Section) (models.Section, error) {
-	result := s.db.Save(&section)
+func (s *SectionRepository) Update(ctx context.Context, section models.Section) (models.Section, error) {
+	result := s.db.WithContext(ctx).Save(&section)
 
 	if result.Error != nil {
 		return models.Section{}, result.Error
@@ -52,67 +66,46 @@ func (s *SectionRepository) Update(section models.Section) (models.Section, erro
 
 	return section, nil
 }
-func (r *SectionRepository) PartialUpdate(id int, fields map[string]interface{}) (models.Section, error) {
+func (r *SectionRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Section, error) {
 	var section models.Section
-	result := r.db.First(&section, id)
+	result := r.db.WithContext(ctx).First(&section, id)
 	if result.Error != nil {
 		return models.Section{}, result.Error
 	}
 
-	if val, ok := fields["section_number"]; ok {
-		section.SectionNumber = val.(string)
-	}
-	if val, ok := fields["current_temperature"]; ok {
-		section.CurrentTemperature = val.(float64)
-	}
-	if val, ok := fields["minimum_temperature"]; ok {
-		section.MinimumTemperature = val.(float64)
-	}
-	if val, ok := fields["current_capacity"]; ok {
-		section.CurrentCapacity = int(val.(float64))
-	}
-	if val, ok := fields["minimum_capacity"]; ok {
-		section.MinimumCapacity = int(val.(float64))
-	}
-	if val, ok := fields["maximum_capacity"]; ok {
-		section.MaximumCapacity = int(val.(float64))
-	}
-	if val, ok := fields["warehouses_id"]; ok {
-		section.WarehouseId = int(val.(float64))
-	}
-	if val, ok := fields["product_type_id"]; ok {
-		section.ProductTypeId = int(val.(float64))
+	if err := patch.Apply(&section, fields); err != nil {
+		return models.Section{}, err
 	}
 
-	result = r.db.Save(&section)
+	result = r.db.WithContext(ctx).Save(&section)
 	if result.Error != nil {
 		return models.Section{}, result.Error
 	}
 	return section, nil
 }
 
-func (r *SectionRepository) Delete(id int) error {
+func (r *SectionRepository) Delete(ctx context.Context, id int) error {
 	var section models.Section
-	result := r.db.Delete(&section, id)
+	result := r.db.WithContext(ctx).Delete(&section, id)
 	return result.Error
 }
 
-func (r *SectionRepository) FindSectionReport(id int) (models.SectionReport, error) {
+func (r *SectionRepository) FindSectionReport(ctx context.Context, id int) (models.SectionReport, error) {
 	var report models.SectionReport
 	var section models.Section
-	exists := r.db.First(&section, id)
+	exists := r.db.WithContext(ctx).First(&section, id)
 
 	if exists.Error != nil {
 		return models.SectionReport{}, repository.ErrSectionNotFound
 	}
 
-	// gorm query requierment 3
-	result := r.db.Table("sections as s"). // alias for table sections
-						Select("s.id as section_id, s.section_number, COUNT(p.id) as products_count"). // map data found to struct
-						Joins("INNER JOIN product_batches as p ON s.id = p.section_id").               // join product_batches
-						Where("s.id = ?", id).                                                         // filter by id given
-						Group("s.id, s.section_number").                                               // group to make COUNT() work proppertly
-						Scan(&report)                                                                  // scan the result into the model variable
+	// gorm query requierment 3, only the batches whose stock may still be sold are counted
+	result := r.db.WithContext(ctx).Table("sections as s"). // alias for table sections
+								Select("s.id as section_id, s.section_number, COUNT(p.id) as products_count").                       // map data found to struct
+								Joins("INNER JOIN product_batches as p ON s.id = p.section_id AND p.status IN ?", sellableStatuses). // join product_batches
+								Where("s.id = ?", id).                                                                               // filter by id given
+								Group("s.id, s.section_number").                                                                     // group to make COUNT() work proppertly
+								Scan(&report)                                                                                        // scan the result into the model variable
 
 	if result.Error != nil {
 		return models.SectionReport{}, result.Error
@@ -122,13 +115,13 @@ func (r *SectionRepository) FindSectionReport(id int) (models.SectionReport, err
 }
 
 // GetAllSectionReports obtiene el reporte de productos para TODAS las secciones.
-func (r *SectionRepository) FindAllSectionReports() ([]models.SectionReport, error) {
+func (r *SectionRepository) FindAllSectionReports(ctx context.Context) ([]models.SectionReport, error) {
 	var reports []models.SectionReport
 
 	// La consulta es casi idéntica, pero sin el .Where() y escaneando en un slice.
-	result := r.db.Table("sections as s").
+	result := r.db.WithContext(ctx).Table("sections as s").
 		Select("s.id as section_id, s.section_number, COUNT(p.id) as products_count").
-		Joins("INNER JOIN product_batches as p ON s.id = p.section_id").
+		Joins("INNER JOIN product_batches as p ON s.id = p.section_id AND p.status IN ?", sellableStatuses).
 		Group("s.id, s.section_number").
 		Scan(&reports)
 
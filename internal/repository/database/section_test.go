@@ -1,11 +1,13 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -104,7 +106,7 @@ func (s *SectionTestSuite) TestFindAllSection_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections`")).WillReturnRows(rows)
 
 	// Act
-	po, err := s.repo.FindAll()
+	po, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -118,7 +120,7 @@ func (s *SectionTestSuite) TestFindAllSection_DatabaseError() {
 	// Arrange
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections`")).WillReturnError(sql.ErrConnDone)
 	// Act
-	sections, err := s.repo.FindAll()
+	sections, err := s.repo.FindAll(context.Background())
 	// Assert
 	s.Error(err)
 	s.Equal([]models.Section{}, sections)
@@ -150,7 +152,7 @@ func (s *SectionTestSuite) TestFindByIdSection_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? ORDER BY `sections`.`id` LIMIT ?")).
 		WithArgs(1, 1).WillReturnRows(rows)
 	// Act
-	sec, err := s.repo.FindById(1)
+	sec, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -164,7 +166,7 @@ func (s *SectionTestSuite) TestFindById_NotFound() {
 		"SELECT * FROM `sections` WHERE `sections`.`id` = ? ORDER BY `sections`.`id` LIMIT ?",
 	)).WithArgs(999, 1).WillReturnError(repository.ErrEntityNotFound)
 	// Act
-	sec, err := s.repo.FindById(999)
+	sec, err := s.repo.FindById(context.Background(), 999)
 
 	// Assert
 	s.Error(err)
@@ -197,7 +199,7 @@ func (s *SectionTestSuite) TestCreate_Success() {
 		sections.ProductTypeId,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
-	sec, err := s.repo.Create(sections)
+	sec, err := s.repo.Create(context.Background(), sections)
 
 	s.NoError(err)
 	s.Equal(sections.SectionNumber, sec.SectionNumber)
@@ -233,7 +235,7 @@ func (s *SectionTestSuite) TestCreateSection_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	sec, err := s.repo.Create(sections)
+	sec, err := s.repo.Create(context.Background(), sections)
 
 	// Assert
 	s.Error(err)
@@ -271,7 +273,7 @@ func (s *SectionTestSuite) TestUpdateSection_Success() {
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
-	updated, err := s.repo.Update(section)
+	updated, err := s.repo.Update(context.Background(), section)
 
 	s.NoError(err)
 	s.Equal(section.SectionNumber, updated.SectionNumber)
@@ -308,7 +310,7 @@ func (s *SectionTestSuite) TestUpdateSection_Error() {
 		WillReturnError(errors.New("database update failed"))
 	s.mock.ExpectRollback()
 
-	updated, err := s.repo.Update(section)
+	updated, err := s.repo.Update(context.Background(), section)
 
 	s.Error(err)
 	s.EqualError(err, "database update failed")
@@ -324,7 +326,7 @@ func (s *SectionTestSuite) TestPartialUpdateSection_Success() {
 		"current_capacity":    8.0,
 		"minimum_capacity":    4.0,
 		"maximum_capacity":    10.0,
-		"warehouses_id":       1.0, // corregido nombre y tipo int
+		"warehouse_id":        1.0, // corregido nombre y tipo int
 		"product_type_id":     2.0, // corregido nombre y tipo int
 	}
 
@@ -360,7 +362,7 @@ func (s *SectionTestSuite) TestPartialUpdateSection_Success() {
 			id, "XY-99", 7.5, 3.2, 8, 4, 10, 1, 2,
 		))
 
-	updated, err := s.repo.PartialUpdate(id, fields)
+	updated, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	s.NoError(err)
 	s.Equal("XY-99", updated.SectionNumber)
@@ -382,7 +384,7 @@ func (s *SectionTestSuite) TestPartialUpdate_FailOnFirstQuery() {
 		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnError(errors.New("db error on select"))
 
-	updated, err := s.repo.PartialUpdate(id, map[string]interface{}{})
+	updated, err := s.repo.PartialUpdate(context.Background(), id, map[string]interface{}{})
 
 	s.Error(err)
 	s.EqualError(err, "db error on select")
@@ -400,7 +402,7 @@ func (s *SectionTestSuite) TestPartialUpdate_NoRowsFound() {
 		WithArgs(id).
 		WillReturnRows(sqlmock.NewRows([]string{})) // sin filas
 
-	updated, err := s.repo.PartialUpdate(id, map[string]interface{}{})
+	updated, err := s.repo.PartialUpdate(context.Background(), id, map[string]interface{}{})
 
 	s.Error(err)
 	s.Equal(models.Section{}, updated)
@@ -429,13 +431,35 @@ func (s *SectionTestSuite) TestPartialUpdate_FailOnSave() {
 	).WillReturnError(errors.New("save failed"))
 	s.mock.ExpectRollback()
 
-	updated, err := s.repo.PartialUpdate(id, fields)
+	updated, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	s.Error(err)
 	s.EqualError(err, "save failed")
 	s.Equal(models.Section{}, updated)
 }
 
+func (s *SectionTestSuite) TestPartialUpdate_InvalidFieldType() {
+	id := 1
+	fields := map[string]interface{}{
+		"current_capacity": "full",
+	}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `sections` WHERE `sections`.`id` = ? ORDER BY `sections`.`id` LIMIT ?",
+	)).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "section_number", "current_temperature", "minimum_temperature",
+			"current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id",
+		}).AddRow(id, "A", 1.1, 1.1, 1, 1, 1, 1, 1))
+
+	updated, err := s.repo.PartialUpdate(context.Background(), id, fields)
+
+	var validationErr *patch.ValidationError
+	s.ErrorAs(err, &validationErr)
+	s.Equal(models.Section{}, updated)
+}
+
 func (s *SectionTestSuite) TestDelete_Success() {
 	id := 1
 
@@ -445,7 +469,7 @@ func (s *SectionTestSuite) TestDelete_Success() {
 	)).WithArgs(id).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 
-	err := s.repo.Delete(id)
+	err := s.repo.Delete(context.Background(), id)
 	s.NoError(err)
 }
 
@@ -456,7 +480,7 @@ func (s *SectionTestSuite) TestFindSectionReport_SectionNotFound() {
 	).WithArgs(id, 1).
 		WillReturnError(errors.New("some db error"))
 
-	_, err := s.repo.FindSectionReport(id)
+	_, err := s.repo.FindSectionReport(context.Background(), id)
 	s.ErrorIs(err, repository.ErrSectionNotFound)
 }
 
@@ -475,11 +499,11 @@ func (s *SectionTestSuite) TestFindSectionReport_QueryError() {
 
 	// Mock error en el Scan de la segunda query (JOIN, GROUP BY, etc.)
 	s.mock.ExpectQuery(regexp.QuoteMeta(
-		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id WHERE s.id = ? GROUP BY s.id, s.section_number")).
-		WithArgs(id).
+		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id AND p.status IN (?,?,?) WHERE s.id = ? GROUP BY s.id, s.section_number")).
+		WithArgs(models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable, id).
 		WillReturnError(errors.New("query error"))
 
-	_, err := s.repo.FindSectionReport(id)
+	_, err := s.repo.FindSectionReport(context.Background(), id)
 	s.Error(err)
 	s.Contains(err.Error(), "query error")
 }
@@ -498,13 +522,13 @@ func (s *SectionTestSuite) TestFindSectionReport_Success() {
 		))
 	// Mock JOIN query exitosa
 	s.mock.ExpectQuery(regexp.QuoteMeta(
-		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id WHERE s.id = ? GROUP BY s.id, s.section_number")).
-		WithArgs(id).
+		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id AND p.status IN (?,?,?) WHERE s.id = ? GROUP BY s.id, s.section_number")).
+		WithArgs(models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable, id).
 		WillReturnRows(sqlmock.NewRows([]string{"section_id", "section_number", "products_count"}).
 			AddRow(id, "SEC123", 3), // <--- products_count 3!
 		)
 
-	rep, err := s.repo.FindSectionReport(id)
+	rep, err := s.repo.FindSectionReport(context.Background(), id)
 	s.NoError(err)
 	s.Equal(id, rep.SectionId)
 	s.Equal("SEC123", rep.SectionNumber)
@@ -514,13 +538,14 @@ func (s *SectionTestSuite) TestFindSectionReport_Success() {
 func (s *SectionTestSuite) TestFindAllSectionReports_Success() {
 	// Simula dos resultados para la consulta
 	s.mock.ExpectQuery(regexp.QuoteMeta(
-		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id GROUP BY s.id, s.section_number")).
+		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id AND p.status IN (?,?,?) GROUP BY s.id, s.section_number")).
+		WithArgs(models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable).
 		WillReturnRows(sqlmock.NewRows([]string{"section_id", "section_number", "products_count"}).
 			AddRow(1, "S-001", 4).
 			AddRow(2, "S-002", 2),
 		)
 
-	result, err := s.repo.FindAllSectionReports()
+	result, err := s.repo.FindAllSectionReports(context.Background())
 
 	s.NoError(err)
 	s.Len(result, 2)
@@ -534,10 +559,11 @@ func (s *SectionTestSuite) TestFindAllSectionReports_Success() {
 
 func (s *SectionTestSuite) TestFindAllSectionReports_DBError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta(
-		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id GROUP BY s.id, s.section_number")).
+		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id AND p.status IN (?,?,?) GROUP BY s.id, s.section_number")).
+		WithArgs(models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable).
 		WillReturnError(errors.New("db explosion"))
 
-	result, err := s.repo.FindAllSectionReports()
+	result, err := s.repo.FindAllSectionReports(context.Background())
 
 	s.Error(err)
 	s.EqualError(err, "db explosion")
@@ -546,10 +572,11 @@ func (s *SectionTestSuite) TestFindAllSectionReports_DBError() {
 
 func (s *SectionTestSuite) TestFindAllSectionReports_Empty() {
 	s.mock.ExpectQuery(regexp.QuoteMeta(
-		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id GROUP BY s.id, s.section_number")).
+		"SELECT s.id as section_id, s.section_number, COUNT(p.id) as products_count FROM sections as s INNER JOIN product_batches as p ON s.id = p.section_id AND p.status IN (?,?,?) GROUP BY s.id, s.section_number")).
+		WithArgs(models.ProductBatchReceived, models.ProductBatchUnderInspection, models.ProductBatchAvailable).
 		WillReturnRows(sqlmock.NewRows([]string{"section_id", "section_number", "products_count"}))
 
-	result, err := s.repo.FindAllSectionReports()
+	result, err := s.repo.FindAllSectionReports(context.Background())
 
 	s.NoError(err)
 	s.Equal(0, len(result))
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -17,10 +18,10 @@ func NewSellerRepository(db *gorm.DB) *SellerRepository {
 	}
 }
 
-func (s *SellerRepository) FindAll() ([]models.Seller, error) {
+func (s *SellerRepository) FindAll(ctx context.Context) ([]models.Seller, error) {
 	var sellers []models.Seller
 
-	result := s.db.Find(&sellers)
+	result := s.db.WithContext(ctx).Find(&sellers)
 
 	if result.Error != nil {
 		return nil, result.Error
@@ -29,10 +30,14 @@ func (s *SellerRepository) FindAll() ([]models.Seller, error) {
 	return sellers, nil
 }
 
-func (s *SellerRepository) FindById(id int) (models.Seller, error) {
+func (s *SellerRepository) FindByIds(ctx context.Context, ids []int) ([]models.Seller, error) {
+	return findIn[models.Seller](s.db.WithContext(ctx), "id", ids)
+}
+
+func (s *SellerRepository) FindById(ctx context.Context, id int) (models.Seller, error) {
 	var seller models.Seller
 
-	result := s.db.First(&seller, id)
+	result := s.db.WithContext(ctx).First(&seller, id)
 
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Seller{}, repository.ErrEntityNotFound
@@ -44,8 +49,8 @@ func (s *SellerRepository) FindById(id int) (models.Seller, error) {
 	return seller, nil
 }
 
-func (s *SellerRepository) Create(seller models.Seller) (models.Seller, error) {
-	result := s.db.Create(&seller)
+func (s *SellerRepository) Create(ctx context.Context, seller models.Seller) (models.Seller, error) {
+	result := s.db.WithContext(ctx).Create(&seller)
 
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
@@ -57,8 +62,8 @@ func (s *SellerRepository) Create(seller models.Seller) (models.Seller, error) {
 	return seller, nil
 }
 
-func (s *SellerRepository) Update(seller models.Seller) (models.Seller, error) {
-	result := s.db.Save(&seller)
+func (s *SellerRepository) Update(ctx context.Context, seller models.Seller) (models.Seller, error) {
+	result := s.db.WithContext(ctx).Save(&seller)
 
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
@@ -70,17 +75,17 @@ func (s *SellerRepository) Update(seller models.Seller) (models.Seller, error) {
 	return seller, nil
 }
 
-func (s *SellerRepository) PartialUpdate(id int, fields map[string]interface{}) (models.Seller, error) {
+func (s *SellerRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Seller, error) {
 	var seller models.Seller
 
 	// First, find the seller to update
-	result := s.db.First(&seller, id)
+	result := s.db.WithContext(ctx).First(&seller, id)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return models.Seller{}, repository.ErrEntityNotFound
 	}
 
 	// Update only the specified fields
-	result = s.db.Model(&seller).Updates(fields)
+	result = s.db.WithContext(ctx).Model(&seller).Updates(fields)
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
 		return models.Seller{}, repository.ErrForeignKeyViolation
@@ -91,8 +96,8 @@ func (s *SellerRepository) PartialUpdate(id int, fields map[string]interface{})
 	return seller, nil
 }
 
-func (s *SellerRepository) Delete(id int) error {
-	result := s.db.Delete(&models.Seller{}, id)
+func (s *SellerRepository) Delete(ctx context.Context, id int) error {
+	result := s.db.WithContext(ctx).Delete(&models.Seller{}, id)
 
 	if result.RowsAffected < 1 {
 		return repository.ErrEntityNotFound
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
@@ -73,7 +74,7 @@ func (s *SellerRepositoryTestSuite) TestFindAll_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sellers`")).WillReturnRows(rows)
 
 	// Act
-	sellers, err := s.repo.FindAll()
+	sellers, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -87,7 +88,7 @@ func (s *SellerRepositoryTestSuite) TestFindAll_DatabaseError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sellers`")).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	sellers, err := s.repo.FindAll()
+	sellers, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -113,7 +114,7 @@ func (s *SellerRepositoryTestSuite) TestFindById_Success() {
 		WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	seller, err := s.repo.FindById(1)
+	seller, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -127,7 +128,7 @@ func (s *SellerRepositoryTestSuite) TestFindById_NotFound() {
 		WithArgs(999, 1).WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	seller, err := s.repo.FindById(999)
+	seller, err := s.repo.FindById(context.Background(), 999)
 
 	// Assert
 	s.Error(err)
@@ -141,7 +142,7 @@ func (s *SellerRepositoryTestSuite) TestFindById_DatabaseError() {
 		WithArgs(1, 1).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	seller, err := s.repo.FindById(1)
+	seller, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.Error(err)
@@ -165,7 +166,7 @@ func (s *SellerRepositoryTestSuite) TestCreate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	createdSeller, err := s.repo.Create(newSeller)
+	createdSeller, err := s.repo.Create(context.Background(), newSeller)
 
 	// Assert
 	s.NoError(err)
@@ -189,7 +190,7 @@ func (s *SellerRepositoryTestSuite) TestCreate_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdSeller, err := s.repo.Create(newSeller)
+	createdSeller, err := s.repo.Create(context.Background(), newSeller)
 
 	// Assert
 	s.Error(err)
@@ -213,7 +214,7 @@ func (s *SellerRepositoryTestSuite) TestCreate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdSeller, err := s.repo.Create(newSeller)
+	createdSeller, err := s.repo.Create(context.Background(), newSeller)
 
 	// Assert
 	s.Error(err)
@@ -238,7 +239,7 @@ func (s *SellerRepositoryTestSuite) TestUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedSeller, err := s.repo.Update(existingSeller)
+	updatedSeller, err := s.repo.Update(context.Background(), existingSeller)
 
 	// Assert
 	s.NoError(err)
@@ -263,7 +264,7 @@ func (s *SellerRepositoryTestSuite) TestUpdate_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedSeller, err := s.repo.Update(existingSeller)
+	updatedSeller, err := s.repo.Update(context.Background(), existingSeller)
 
 	// Assert
 	s.Error(err)
@@ -288,7 +289,7 @@ func (s *SellerRepositoryTestSuite) TestUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedSeller, err := s.repo.Update(existingSeller)
+	updatedSeller, err := s.repo.Update(context.Background(), existingSeller)
 
 	// Assert
 	s.Error(err)
@@ -327,7 +328,7 @@ func (s *SellerRepositoryTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedSeller, err := s.repo.PartialUpdate(sellerID, fields)
+	updatedSeller, err := s.repo.PartialUpdate(context.Background(), sellerID, fields)
 
 	// Assert
 	s.NoError(err)
@@ -345,7 +346,7 @@ func (s *SellerRepositoryTestSuite) TestPartialUpdate_NotFound() {
 		WithArgs(sellerID, 1).WillReturnError(gorm.ErrRecordNotFound)
 
 	// Act
-	updatedSeller, err := s.repo.PartialUpdate(sellerID, fields)
+	updatedSeller, err := s.repo.PartialUpdate(context.Background(), sellerID, fields)
 
 	// Assert
 	s.Error(err)
@@ -383,7 +384,7 @@ func (s *SellerRepositoryTestSuite) TestPartialUpdate_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedSeller, err := s.repo.PartialUpdate(sellerID, fields)
+	updatedSeller, err := s.repo.PartialUpdate(context.Background(), sellerID, fields)
 
 	// Assert
 	s.Error(err)
@@ -421,7 +422,7 @@ func (s *SellerRepositoryTestSuite) TestPartialUpdate_DatabaseError() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedSeller, err := s.repo.PartialUpdate(sellerID, fields)
+	updatedSeller, err := s.repo.PartialUpdate(context.Background(), sellerID, fields)
 
 	// Assert
 	s.Error(err)
@@ -437,7 +438,7 @@ func (s *SellerRepositoryTestSuite) TestDelete_Success() {
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
 	// Act
-	err := s.repo.Delete(sellerID)
+	err := s.repo.Delete(context.Background(), sellerID)
 	// Assert
 	s.NoError(err)
 }
@@ -453,7 +454,7 @@ func (s *SellerRepositoryTestSuite) TestDelete_NotFound() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(sellerID)
+	err := s.repo.Delete(context.Background(), sellerID)
 
 	// Assert
 	s.Error(err)
@@ -0,0 +1,255 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"time"
+)
+
+// StockMovementRepository implements the stock ledger over the stock_movements table
+type StockMovementRepository struct {
+	db *gorm.DB
+}
+
+func NewStockMovementRepository(db *gorm.DB) *StockMovementRepository {
+	return &StockMovementRepository{db: db}
+}
+
+func (r *StockMovementRepository) FindByProductBatch(ctx context.Context, productBatchId int) ([]models.StockMovement, error) {
+	movements := make([]models.StockMovement, 0)
+	result := r.db.WithContext(ctx).Where("product_batch_id = ?", productBatchId).Order("created_at, id").Find(&movements)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return movements, nil
+}
+
+func (r *StockMovementRepository) Adjust(ctx context.Context, movement models.StockMovement) (models.StockMovement, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return adjustStock(tx, &movement)
+	})
+
+	switch {
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return models.StockMovement{}, repository.ErrForeignKeyViolation
+	case err != nil:
+		return models.StockMovement{}, err
+	}
+	return movement, nil
+}
+
+// FindWaste sums the adjustments and disposals that removed spoiled, damaged or recalled stock per product and
+// warehouse, the warehouse is the one of the section the stock was removed from
+func (r *StockMovementRepository) FindWaste(ctx context.Context, productId int, sellerId int, warehouseId int) ([]models.WasteReport, error) {
+	reports := make([]models.WasteReport, 0)
+	query := r.db.WithContext(ctx).Table("stock_movements").
+		Select("products.id AS product_id, products.description AS product_description, "+
+			"sellers.id AS seller_id, sellers.name AS seller_name, "+
+			"sections.warehouse_id, warehouses.warehouse_code, "+
+			"-SUM(CASE WHEN stock_movements.reason_code = ? THEN stock_movements.quantity ELSE 0 END) AS expired_quantity, "+
+			"-SUM(CASE WHEN stock_movements.reason_code = ? THEN stock_movements.quantity ELSE 0 END) AS damaged_quantity, "+
+			"-SUM(CASE WHEN stock_movements.reason_code = ? THEN stock_movements.quantity ELSE 0 END) AS temperature_excursion_quantity, "+
+			"-SUM(CASE WHEN stock_movements.reason_code = ? THEN stock_movements.quantity ELSE 0 END) AS recalled_quantity, "+
+			"-SUM(stock_movements.quantity) AS total_quantity",
+			models.AdjustmentExpired, models.AdjustmentDamaged, models.AdjustmentTemperatureExcursion, models.AdjustmentRecalled).
+		Joins("JOIN product_batches ON product_batches.id = stock_movements.product_batch_id").
+		Joins("JOIN products ON products.id = product_batches.product_id").
+		Joins("LEFT JOIN sellers ON sellers.id = products.seller_id").
+		Joins("JOIN sections ON sections.id = stock_movements.section_id").
+		Joins("JOIN warehouses ON warehouses.id = sections.warehouse_id").
+		Where("stock_movements.reason IN ? AND stock_movements.reason_code IN ?",
+			[]string{models.StockMovementAdjustment, models.StockMovementDisposal},
+			[]string{models.AdjustmentExpired, models.AdjustmentDamaged, models.AdjustmentTemperatureExcursion, models.AdjustmentRecalled})
+	if productId != 0 {
+		query = query.Where("products.id = ?", productId)
+	}
+	if sellerId != 0 {
+		query = query.Where("products.seller_id = ?", sellerId)
+	}
+	if warehouseId != 0 {
+		query = query.Where("sections.warehouse_id = ?", warehouseId)
+	}
+
+	result := query.
+		Group("products.id, products.description, sellers.id, sellers.name, sections.warehouse_id, warehouses.warehouse_code").
+		Order("products.id, sections.warehouse_id").
+		Scan(&reports)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return reports, nil
+}
+
+// FindBalances sums the ledger per product and warehouse, then subtracts the stock of the batches that are not
+// available and what the pending purchase orders reserved. A line of a pending order reserves what is left to pick,
+// the stock picked for it already left the ledger.
+func (r *StockMovementRepository) FindBalances(ctx context.Context, productId int, warehouseId int) ([]models.StockBalance, error) {
+	var onHand []models.StockBalance
+	query := r.db.WithContext(ctx).Table("stock_movements").
+		Select("product_batches.product_id, sections.warehouse_id, SUM(stock_movements.quantity) AS on_hand, "+
+			"SUM(CASE WHEN product_batches.status <> ? THEN stock_movements.quantity ELSE 0 END) AS blocked",
+			models.ProductBatchAvailable).
+		Joins("JOIN product_batches ON product_batches.id = stock_movements.product_batch_id").
+		Joins("JOIN sections ON sections.id = stock_movements.section_id")
+	if productId != 0 {
+		query = query.Where("product_batches.product_id = ?", productId)
+	}
+	if warehouseId != 0 {
+		query = query.Where("sections.warehouse_id = ?", warehouseId)
+	}
+	if err := query.Group("product_batches.product_id, sections.warehouse_id").Scan(&onHand).Error; err != nil {
+		return nil, err
+	}
+
+	var reserved []models.StockBalance
+	query = r.db.WithContext(ctx).Table("order_details").
+		Select("product_records.product_id, purchase_orders.warehouse_id, "+
+			"SUM(order_details.quantity - COALESCE(picks.quantity, 0)) AS reserved").
+		Joins("JOIN purchase_orders ON purchase_orders.id = order_details.purchase_order_id").
+		Joins("LEFT JOIN (SELECT order_detail_id, SUM(quantity) AS quantity FROM order_detail_picks GROUP BY order_detail_id) AS picks "+
+			"ON picks.order_detail_id = order_details.id").
+		Joins("JOIN product_records ON product_records.id = order_details.product_record_id").
+		Where("purchase_orders.order_status_id = ?", models.OrderStatusPending)
+	if productId != 0 {
+		query = query.Where("product_records.product_id = ?", productId)
+	}
+	if warehouseId != 0 {
+		query = query.Where("purchase_orders.warehouse_id = ?", warehouseId)
+	}
+	if err := query.Group("product_records.product_id, purchase_orders.warehouse_id").Scan(&reserved).Error; err != nil {
+		return nil, err
+	}
+
+	return mergeStockBalances(onHand, reserved), nil
+}
+
+// mergeStockBalances joins the stock on hand and the reserved stock of the same product and warehouse, ordered by
+// product and warehouse
+func mergeStockBalances(onHand []models.StockBalance, reserved []models.StockBalance) []models.StockBalance {
+	type key struct{ productId, warehouseId int }
+	merged := make(map[key]*models.StockBalance)
+	balances := make([]models.StockBalance, 0, len(onHand)+len(reserved))
+	for _, rows := range [][]models.StockBalance{onHand, reserved} {
+		for _, row := range rows {
+			k := key{row.ProductId, row.WarehouseId}
+			balance, ok := merged[k]
+			if !ok {
+				balance = &models.StockBalance{ProductId: row.ProductId, WarehouseId: row.WarehouseId}
+				merged[k] = balance
+			}
+			balance.OnHand += row.OnHand
+			balance.Blocked += row.Blocked
+			balance.Reserved += row.Reserved
+		}
+	}
+	for _, balance := range merged {
+		balance.Available = balance.OnHand - balance.Blocked - balance.Reserved
+		balances = append(balances, *balance)
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].ProductId != balances[j].ProductId {
+			return balances[i].ProductId < balances[j].ProductId
+		}
+		return balances[i].WarehouseId < balances[j].WarehouseId
+	})
+	return balances
+}
+
+// adjustStock posts an adjustment, a disposal or a pick to its batch and keeps the capacity of the section of the batch in sync.
+// The capacity of a section counts the batches holding stock, so a batch emptied by the movement frees its place and
+// an empty batch refilled takes it back if the section has room. It must be called within a transaction.
+func adjustStock(tx *gorm.DB, movement *models.StockMovement) error {
+	batch, err := lockProductBatch(tx, movement.ProductBatchId)
+	if err != nil {
+		return err
+	}
+	wasEmpty := batch.CurrentQuantity == 0
+	if err = applyStockMovement(tx, &batch, movement); err != nil {
+		return err
+	}
+
+	switch isEmpty := batch.CurrentQuantity == 0; {
+	case !wasEmpty && isEmpty:
+		return tx.Model(&models.Section{}).Where("id = ?", batch.SectionId).
+			Update("current_capacity", gorm.Expr("current_capacity - 1")).Error
+	case wasEmpty && !isEmpty:
+		return occupySection(tx, batch.SectionId)
+	}
+	return nil
+}
+
+// occupySection takes the place of a batch that holds stock in its section, the section is locked until the
+// transaction ends so concurrent batches cannot exceed its maximum capacity
+func occupySection(tx *gorm.DB, sectionId int) error {
+	var section models.Section
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&section, sectionId).Error; err != nil {
+		return err
+	}
+	if section.CurrentCapacity >= section.MaximumCapacity {
+		return repository.ErrSectionCapacityExceeded
+	}
+	return tx.Model(&section).Update("current_capacity", gorm.Expr("current_capacity + 1")).Error
+}
+
+// lockProductBatch reads a batch and locks it until the transaction ends
+func lockProductBatch(tx *gorm.DB, id int) (models.ProductBatch, error) {
+	var batch models.ProductBatch
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&batch, id).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return models.ProductBatch{}, repository.ErrEntityNotFound
+	case err != nil:
+		return models.ProductBatch{}, err
+	}
+	return batch, nil
+}
+
+// applyStockMovement applies a movement to a batch locked by lockProductBatch and records it in the ledger
+func applyStockMovement(tx *gorm.DB, batch *models.ProductBatch, movement *models.StockMovement) error {
+	if err := checkStockMovement(*batch, *movement); err != nil {
+		return err
+	}
+	quantity := batch.CurrentQuantity + movement.Quantity
+	if quantity < 0 {
+		return repository.ErrInsufficientStock
+	}
+	err := tx.Model(&models.ProductBatch{}).Where("id = ?", batch.Id).Update("current_quantity", quantity).Error
+	if err != nil {
+		return err
+	}
+	batch.CurrentQuantity = quantity
+
+	movement.SectionId = batch.SectionId
+	return appendStockMovement(tx, movement)
+}
+
+// checkStockMovement returns an error when the status of a batch does not allow the movement. Stock is picked and
+// transferred out of available batches only, and a disposed batch holds no stock to move.
+func checkStockMovement(batch models.ProductBatch, movement models.StockMovement) error {
+	outbound := movement.Reason == models.StockMovementPick || (movement.Reason == models.StockMovementTransfer && movement.Quantity < 0)
+	switch {
+	case batch.Status == models.ProductBatchDisposed:
+		return repository.ErrProductBatchNotAvailable
+	case outbound && batch.Status == models.ProductBatchRecalled:
+		return repository.ErrProductBatchRecalled
+	case outbound && batch.Status != models.ProductBatchAvailable:
+		return repository.ErrProductBatchNotAvailable
+	}
+	return nil
+}
+
+// appendStockMovement records a movement in the ledger without changing its batch, for the stock a batch already
+// holds. The actor is the one of the request being served.
+func appendStockMovement(tx *gorm.DB, movement *models.StockMovement) error {
+	movement.Id = 0
+	md, _ := audit.FromContext(tx.Statement.Context)
+	movement.Actor = md.Actor
+	movement.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	return tx.Create(movement).Error
+}
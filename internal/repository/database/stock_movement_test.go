@@ -0,0 +1,229 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+)
+
+type StockMovementTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo *StockMovementRepository
+}
+
+func (s *StockMovementTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.repo = NewStockMovementRepository(gormDB)
+}
+
+func (s *StockMovementTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *StockMovementTestSuite) expectBatch(id int, quantity int, sectionId int) {
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id", "status"}).
+			AddRow(id, quantity, sectionId, 1, models.ProductBatchAvailable))
+}
+
+func (s *StockMovementTestSuite) TestAdjust_Success() {
+	// Arrange
+	employeeId := 2
+	reasonCode := models.AdjustmentDamaged
+	s.mock.ExpectBegin()
+	s.expectBatch(3, 200, 4)
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(185, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements` (`product_batch_id`,`section_id`,`quantity`,`reason`,`reason_code`,`employee_id`,`actor`,`created_at`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs(3, 4, -15, models.StockMovementDisposal, reasonCode, employeeId, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	movement, err := s.repo.Adjust(context.Background(), models.StockMovement{ProductBatchId: 3, Quantity: -15, Reason: models.StockMovementDisposal, ReasonCode: &reasonCode, EmployeeId: &employeeId})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(7, movement.Id)
+	s.Equal(4, movement.SectionId)
+	s.False(movement.CreatedAt.IsZero())
+}
+
+func (s *StockMovementTestSuite) TestAdjust_InsufficientStock() {
+	// Arrange
+	reasonCode := models.AdjustmentTheft
+	s.mock.ExpectBegin()
+	s.expectBatch(3, 10, 4)
+	s.mock.ExpectRollback()
+
+	// Act
+	movement, err := s.repo.Adjust(context.Background(), models.StockMovement{ProductBatchId: 3, Quantity: -11, Reason: models.StockMovementAdjustment, ReasonCode: &reasonCode})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrInsufficientStock)
+	s.Equal(models.StockMovement{}, movement)
+}
+
+func (s *StockMovementTestSuite) TestAdjust_BatchNotFound() {
+	// Arrange
+	reasonCode := models.AdjustmentFound
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id"}))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Adjust(context.Background(), models.StockMovement{ProductBatchId: 9, Quantity: 5, Reason: models.StockMovementAdjustment, ReasonCode: &reasonCode})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *StockMovementTestSuite) TestAdjust_EmptiesBatch() {
+	// Arrange
+	employeeId := 2
+	reasonCode := models.AdjustmentExpired
+	s.mock.ExpectBegin()
+	s.expectBatch(3, 12, 4)
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(0, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(3, 4, -12, models.StockMovementAdjustment, reasonCode, employeeId, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(8, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity - 1 WHERE id = ?")).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	movement, err := s.repo.Adjust(context.Background(), models.StockMovement{ProductBatchId: 3, Quantity: -12, Reason: models.StockMovementAdjustment, ReasonCode: &reasonCode, EmployeeId: &employeeId})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(8, movement.Id)
+}
+
+func (s *StockMovementTestSuite) TestAdjust_RefillsBatchInFullSection() {
+	// Arrange
+	reasonCode := models.AdjustmentFound
+	s.mock.ExpectBegin()
+	s.expectBatch(3, 0, 4)
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(5, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(4, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_capacity", "maximum_capacity"}).AddRow(4, 50, 50))
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Adjust(context.Background(), models.StockMovement{ProductBatchId: 3, Quantity: 5, Reason: models.StockMovementAdjustment, ReasonCode: &reasonCode})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrSectionCapacityExceeded)
+}
+
+func (s *StockMovementTestSuite) TestFindWaste_Success() {
+	// Arrange
+	sellerId := 2
+	sellerName := "Frutas del Sur"
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `stock_movements` JOIN product_batches ON product_batches.id = stock_movements.product_batch_id JOIN products ON products.id = product_batches.product_id LEFT JOIN sellers ON sellers.id = products.seller_id")).
+		WithArgs(models.AdjustmentExpired, models.AdjustmentDamaged, models.AdjustmentTemperatureExcursion, models.AdjustmentRecalled,
+			models.StockMovementAdjustment, models.StockMovementDisposal,
+			models.AdjustmentExpired, models.AdjustmentDamaged, models.AdjustmentTemperatureExcursion, models.AdjustmentRecalled, sellerId).
+		WillReturnRows(sqlmock.NewRows([]string{"product_id", "product_description", "seller_id", "seller_name", "warehouse_id", "warehouse_code", "expired_quantity", "damaged_quantity", "temperature_excursion_quantity", "recalled_quantity", "total_quantity"}).
+			AddRow(1, "Yogur", sellerId, sellerName, 1, "W1", 12, 3, 0, 40, 55))
+
+	// Act
+	reports, err := s.repo.FindWaste(context.Background(), 0, sellerId, 0)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.WasteReport{{
+		ProductId: 1, ProductDescription: "Yogur", SellerId: &sellerId, SellerName: &sellerName, WarehouseId: 1, WarehouseCode: "W1",
+		ExpiredQuantity: 12, DamagedQuantity: 3, RecalledQuantity: 40, TotalQuantity: 55,
+	}}, reports)
+}
+
+func (s *StockMovementTestSuite) TestFindByProductBatch_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `stock_movements` WHERE product_batch_id = ? ORDER BY created_at, id")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_batch_id", "section_id", "quantity", "reason", "employee_id", "actor"}).
+			AddRow(1, 3, 4, 200, models.StockMovementReceipt, 2, "seed").
+			AddRow(2, 3, 4, -15, models.StockMovementDisposal, nil, "ana"))
+
+	// Act
+	movements, err := s.repo.FindByProductBatch(context.Background(), 3)
+
+	// Assert
+	s.NoError(err)
+	s.Len(movements, 2)
+	s.Equal(2, *movements[0].EmployeeId)
+	s.Nil(movements[1].EmployeeId)
+	s.Equal(-15, movements[1].Quantity)
+}
+
+func (s *StockMovementTestSuite) TestFindBalances_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT product_batches.product_id, sections.warehouse_id, SUM(stock_movements.quantity) AS on_hand, SUM(CASE WHEN product_batches.status <> ? THEN stock_movements.quantity ELSE 0 END) AS blocked FROM `stock_movements` JOIN product_batches ON product_batches.id = stock_movements.product_batch_id JOIN sections ON sections.id = stock_movements.section_id WHERE product_batches.product_id = ? GROUP BY product_batches.product_id, sections.warehouse_id",
+	)).WithArgs(models.ProductBatchAvailable, 1).WillReturnRows(sqlmock.NewRows([]string{"product_id", "warehouse_id", "on_hand", "blocked"}).
+		AddRow(1, 2, 300, 100).
+		AddRow(1, 1, 120, 0))
+	s.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT product_records.product_id, purchase_orders.warehouse_id, SUM(order_details.quantity - COALESCE(picks.quantity, 0)) AS reserved FROM `order_details` JOIN purchase_orders ON purchase_orders.id = order_details.purchase_order_id LEFT JOIN (SELECT order_detail_id, SUM(quantity) AS quantity FROM order_detail_picks GROUP BY order_detail_id) AS picks ON picks.order_detail_id = order_details.id JOIN product_records ON product_records.id = order_details.product_record_id WHERE purchase_orders.order_status_id = ? AND product_records.product_id = ? GROUP BY product_records.product_id, purchase_orders.warehouse_id",
+	)).WithArgs(models.OrderStatusPending, 1).WillReturnRows(sqlmock.NewRows([]string{"product_id", "warehouse_id", "reserved"}).
+		AddRow(1, 1, 20).
+		AddRow(1, 3, 5))
+
+	// Act
+	balances, err := s.repo.FindBalances(context.Background(), 1, 0)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.StockBalance{
+		{ProductId: 1, WarehouseId: 1, OnHand: 120, Reserved: 20, Available: 100},
+		{ProductId: 1, WarehouseId: 2, OnHand: 300, Blocked: 100, Reserved: 0, Available: 200},
+		{ProductId: 1, WarehouseId: 3, OnHand: 0, Reserved: 5, Available: -5},
+	}, balances)
+}
+
+func (s *StockMovementTestSuite) TestFindBalances_DatabaseError() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `stock_movements`")).WillReturnError(sql.ErrConnDone)
+
+	// Act
+	balances, err := s.repo.FindBalances(context.Background(), 0, 0)
+
+	// Assert
+	s.ErrorIs(err, sql.ErrConnDone)
+	s.Nil(balances)
+}
+
+func TestStockMovementRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(StockMovementTestSuite))
+}
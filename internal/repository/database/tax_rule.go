@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+)
+
+type TaxRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxRuleRepository(db *gorm.DB) *TaxRuleRepository {
+	return &TaxRuleRepository{db: db}
+}
+
+func (r *TaxRuleRepository) FindByCountry(ctx context.Context, countryId int) ([]models.TaxRule, error) {
+	rules := make([]models.TaxRule, 0)
+	result := r.db.WithContext(ctx).Where("country_id = ?", countryId).Order("id").Find(&rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rules, nil
+}
+
+func (r *TaxRuleRepository) FindById(ctx context.Context, id int) (models.TaxRule, error) {
+	var rule models.TaxRule
+	result := r.db.WithContext(ctx).First(&rule, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.TaxRule{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.TaxRule{}, result.Error
+	}
+	return rule, nil
+}
+
+func (r *TaxRuleRepository) Create(ctx context.Context, rule models.TaxRule) (models.TaxRule, error) {
+	result := r.db.WithContext(ctx).Create(&rule)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.TaxRule{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return models.TaxRule{}, result.Error
+	}
+	return rule, nil
+}
+
+func (r *TaxRuleRepository) Update(ctx context.Context, rule models.TaxRule) (models.TaxRule, error) {
+	if _, err := r.FindById(ctx, rule.Id); err != nil {
+		return models.TaxRule{}, err
+	}
+	result := r.db.WithContext(ctx).Save(&rule)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.TaxRule{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return models.TaxRule{}, result.Error
+	}
+	return rule, nil
+}
+
+func (r *TaxRuleRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.TaxRule{}, id)
+	switch {
+	case result.Error != nil:
+		return result.Error
+	case result.RowsAffected < 1:
+		return repository.ErrEntityNotFound
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+)
+
+type TaxRuleTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo *TaxRuleRepository
+}
+
+func (s *TaxRuleTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.repo = NewTaxRuleRepository(gormDB)
+}
+
+func (s *TaxRuleTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *TaxRuleTestSuite) TestFindByCountry_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tax_rules` WHERE country_id = ? ORDER BY id")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "country_id", "name", "rate"}).
+			AddRow(3, 3, "IVA", 16).
+			AddRow(4, 3, "IEPS", 8))
+
+	// Act
+	rules, err := s.repo.FindByCountry(context.Background(), 3)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.TaxRule{{Id: 3, CountryId: 3, Name: "IVA", Rate: 16}, {Id: 4, CountryId: 3, Name: "IEPS", Rate: 8}}, rules)
+}
+
+func (s *TaxRuleTestSuite) TestFindByCountry_NoRules() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tax_rules` WHERE country_id = ? ORDER BY id")).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "country_id", "name", "rate"}))
+
+	// Act
+	rules, err := s.repo.FindByCountry(context.Background(), 4)
+
+	// Assert
+	s.NoError(err)
+	s.Empty(rules)
+}
+
+func (s *TaxRuleTestSuite) TestFindByCountry_DatabaseError() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("FROM `tax_rules`")).WillReturnError(sql.ErrConnDone)
+
+	// Act
+	rules, err := s.repo.FindByCountry(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, sql.ErrConnDone)
+	s.Nil(rules)
+}
+
+func (s *TaxRuleTestSuite) TestFindById_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tax_rules` WHERE `tax_rules`.`id` = ?")).
+		WithArgs(9, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "country_id", "name", "rate"}))
+
+	// Act
+	_, err := s.repo.FindById(context.Background(), 9)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *TaxRuleTestSuite) TestCreate_Success() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `tax_rules` (`country_id`,`name`,`rate`) VALUES (?,?,?)")).
+		WithArgs(3, "IVA", 16.0).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	rule, err := s.repo.Create(context.Background(), models.TaxRule{CountryId: 3, Name: "IVA", Rate: 16})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.TaxRule{Id: 5, CountryId: 3, Name: "IVA", Rate: 16}, rule)
+}
+
+func (s *TaxRuleTestSuite) TestCreate_MissingCountry() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `tax_rules`")).WillReturnError(gorm.ErrForeignKeyViolated)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Create(context.Background(), models.TaxRule{CountryId: 9, Name: "IVA", Rate: 16})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrForeignKeyViolation)
+}
+
+func (s *TaxRuleTestSuite) TestUpdate_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tax_rules` WHERE `tax_rules`.`id` = ?")).
+		WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "country_id", "name", "rate"}).AddRow(3, 3, "IVA", 16))
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `tax_rules` SET `country_id`=?,`name`=?,`rate`=? WHERE `id` = ?")).
+		WithArgs(3, "IVA", 15.0, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	rule, err := s.repo.Update(context.Background(), models.TaxRule{Id: 3, CountryId: 3, Name: "IVA", Rate: 15})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.TaxRule{Id: 3, CountryId: 3, Name: "IVA", Rate: 15}, rule)
+}
+
+func (s *TaxRuleTestSuite) TestDelete_NotFound() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `tax_rules` WHERE `tax_rules`.`id` = ?")).
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	// Act
+	err := s.repo.Delete(context.Background(), 9)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func TestTaxRuleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TaxRuleTestSuite))
+}
@@ -0,0 +1,28 @@
+package database
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Transactor runs units of work over GORM transactions
+type Transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+// Transaction calls fn with repositories bound to a new transaction
+func (t *Transactor) Transaction(ctx context.Context, fn func(repos repository.Repositories) error) error {
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(repository.Repositories{
+			Product:      NewProductRepository(tx),
+			Seller:       NewSellerRepository(tx),
+			Locality:     NewLocalityRepository(tx),
+			ProductBatch: NewProductBatchRepository(tx),
+		})
+	})
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+)
+
+type TransactorTestSuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	transactor *Transactor
+}
+
+func (s *TransactorTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.transactor = NewTransactor(gormDB)
+}
+
+func (s *TransactorTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *TransactorTestSuite) TestTransaction_Commit() {
+	// Arrange
+	seller := models.Seller{Name: "Acme", Address: "Street 1", Telephone: "555", LocalityId: 1}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `sellers`")).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	var created models.Seller
+	err := s.transactor.Transaction(context.Background(), func(repos repository.Repositories) (err error) {
+		created, err = repos.Seller.Create(context.Background(), seller)
+		return err
+	})
+
+	// Assert
+	s.NoError(err)
+	s.Equal(3, created.Id)
+}
+
+func (s *TransactorTestSuite) TestTransaction_Rollback() {
+	// Arrange
+	seller := models.Seller{Name: "Acme", Address: "Street 1", Telephone: "555", LocalityId: 1}
+	failure := errors.New("second row failed")
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `sellers`")).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	s.mock.ExpectRollback()
+
+	// Act
+	err := s.transactor.Transaction(context.Background(), func(repos repository.Repositories) error {
+		if _, err := repos.Seller.Create(context.Background(), seller); err != nil {
+			return err
+		}
+		return failure
+	})
+
+	// Assert
+	s.ErrorIs(err, failure)
+}
+
+func TestTransactorTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactorTestSuite))
+}
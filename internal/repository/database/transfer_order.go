@@ -0,0 +1,202 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// TransferOrderRepository implements the transfer orders over the transfer_orders and transfer_order_lines tables
+type TransferOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferOrderRepository(db *gorm.DB) *TransferOrderRepository {
+	return &TransferOrderRepository{db: db}
+}
+
+func (r *TransferOrderRepository) FindAll(ctx context.Context) ([]models.TransferOrder, error) {
+	orders := make([]models.TransferOrder, 0)
+	result := r.db.WithContext(ctx).Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).Order("id").Find(&orders)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return orders, nil
+}
+
+func (r *TransferOrderRepository) FindById(ctx context.Context, id int) (models.TransferOrder, error) {
+	var order models.TransferOrder
+	result := r.db.WithContext(ctx).Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).Take(&order, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.TransferOrder{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.TransferOrder{}, result.Error
+	}
+	return order, nil
+}
+
+func (r *TransferOrderRepository) FindOpenByProductBatch(ctx context.Context, productBatchId int) ([]models.TransferOrder, error) {
+	orders := make([]models.TransferOrder, 0)
+	result := r.db.WithContext(ctx).Select("transfer_orders.*").
+		Joins("JOIN transfer_order_lines ON transfer_order_lines.transfer_order_id = transfer_orders.id").
+		Where("transfer_order_lines.product_batch_id = ? AND transfer_orders.status <> ?", productBatchId, models.TransferOrderReceived).
+		Order("transfer_orders.id").
+		Find(&orders)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return orders, nil
+}
+
+// Create inserts the transfer order and its lines in a single transaction
+func (r *TransferOrderRepository) Create(ctx context.Context, order models.TransferOrder) (models.TransferOrder, error) {
+	result := r.db.WithContext(ctx).Create(&order)
+	switch {
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.TransferOrder{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return models.TransferOrder{}, result.Error
+	}
+	return order, nil
+}
+
+// Dispatch empties the batches of the order into the ledger and frees their place in the source section. A batch
+// that left the source section or was emptied since the order was drafted, by another transfer order among others,
+// cannot be dispatched.
+func (r *TransferOrderRepository) Dispatch(ctx context.Context, id int, at time.Time) (models.TransferOrder, error) {
+	return r.move(ctx, id, models.TransferOrderDispatched, func(tx *gorm.DB, order *models.TransferOrder) error {
+		for i := range order.Lines {
+			line := &order.Lines[i]
+			batch, err := lockProductBatch(tx, line.ProductBatchId)
+			if err != nil {
+				return err
+			}
+			if batch.SectionId != order.SourceSectionId || batch.CurrentQuantity == 0 {
+				return repository.ErrStaleEntity
+			}
+
+			line.Quantity = batch.CurrentQuantity
+			if err = tx.Model(line).Update("quantity", line.Quantity).Error; err != nil {
+				return err
+			}
+			movement := models.StockMovement{ProductBatchId: batch.Id, Quantity: -line.Quantity, Reason: models.StockMovementTransfer}
+			if err = applyStockMovement(tx, &batch, &movement); err != nil {
+				return err
+			}
+		}
+
+		// every batch dispatched holds stock, and it is emptied into the ledger
+		err := tx.Model(&models.Section{}).Where("id = ?", order.SourceSectionId).
+			Update("current_capacity", gorm.Expr("current_capacity - ?", len(order.Lines))).Error
+		if err != nil {
+			return err
+		}
+		order.DispatchedAt = &at
+		return tx.Model(order).Omit(clause.Associations).Updates(map[string]any{"status": models.TransferOrderDispatched, "dispatched_at": at}).Error
+	})
+}
+
+func (r *TransferOrderRepository) MarkInTransit(ctx context.Context, id int, carrierId *int, at time.Time) (models.TransferOrder, error) {
+	return r.move(ctx, id, models.TransferOrderInTransit, func(tx *gorm.DB, order *models.TransferOrder) error {
+		if carrierId != nil {
+			order.CarrierId = carrierId
+		}
+		order.InTransitAt = &at
+		return tx.Model(order).Omit(clause.Associations).Updates(map[string]any{
+			"status":        models.TransferOrderInTransit,
+			"carrier_id":    order.CarrierId,
+			"in_transit_at": at,
+		}).Error
+	})
+}
+
+// Receive relocates the batches of the order to the destination section and puts their stock back in the ledger.
+// Like the adjustments, a batch takes a place in the destination section when it holds stock once received, and
+// frees the place it took back in the source section if it was refilled there while in transit.
+func (r *TransferOrderRepository) Receive(ctx context.Context, id int, at time.Time) (models.TransferOrder, error) {
+	return r.move(ctx, id, models.TransferOrderReceived, func(tx *gorm.DB, order *models.TransferOrder) error {
+		var section models.Section
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&section, order.DestinationSectionId).Error; err != nil {
+			return err
+		}
+
+		var filled, freed int
+		for _, line := range order.Lines {
+			batch, err := lockProductBatch(tx, line.ProductBatchId)
+			if err != nil {
+				return err
+			}
+			if batch.CurrentQuantity > 0 {
+				freed++
+			}
+			batch.SectionId = section.Id
+			if err = tx.Model(&models.ProductBatch{}).Where("id = ?", batch.Id).Update("section_id", section.Id).Error; err != nil {
+				return err
+			}
+			movement := models.StockMovement{ProductBatchId: batch.Id, Quantity: line.Quantity, Reason: models.StockMovementTransfer}
+			if err = applyStockMovement(tx, &batch, &movement); err != nil {
+				return err
+			}
+			if batch.CurrentQuantity > 0 {
+				filled++
+			}
+			if section.CurrentCapacity+filled > section.MaximumCapacity {
+				return repository.ErrSectionCapacityExceeded
+			}
+		}
+
+		err := tx.Model(&section).Update("current_capacity", gorm.Expr("current_capacity + ?", filled)).Error
+		if err != nil {
+			return err
+		}
+		if freed > 0 {
+			err = tx.Model(&models.Section{}).Where("id = ?", order.SourceSectionId).
+				Update("current_capacity", gorm.Expr("current_capacity - ?", freed)).Error
+			if err != nil {
+				return err
+			}
+		}
+		order.ReceivedAt = &at
+		return tx.Model(order).Omit(clause.Associations).Updates(map[string]any{"status": models.TransferOrderReceived, "received_at": at}).Error
+	})
+}
+
+// move locks a transfer order along with its lines and applies a status change to it in a single transaction. The
+// order must be in the status that precedes the new one, otherwise it was moved by another request since it was read.
+func (r *TransferOrderRepository) move(ctx context.Context, id int, status string, apply func(tx *gorm.DB, order *models.TransferOrder) error) (models.TransferOrder, error) {
+	var order models.TransferOrder
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&order, id).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return repository.ErrEntityNotFound
+		case err != nil:
+			return err
+		}
+		if !order.CanMoveTo(status) {
+			return repository.ErrStaleEntity
+		}
+		if err = tx.Where("transfer_order_id = ?", order.Id).Order("id").Find(&order.Lines).Error; err != nil {
+			return err
+		}
+
+		if err = apply(tx, &order); err != nil {
+			return err
+		}
+		order.Status = status
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return models.TransferOrder{}, repository.ErrForeignKeyViolation
+	case err != nil:
+		return models.TransferOrder{}, err
+	}
+	return order, nil
+}
@@ -0,0 +1,268 @@
+package database
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type TransferOrderTestSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo *TransferOrderRepository
+}
+
+func (s *TransferOrderTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.repo = NewTransferOrderRepository(gormDB)
+}
+
+func (s *TransferOrderTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// expectOrder expects the transfer order to be locked in the status along with its lines, one per batch
+func (s *TransferOrderTestSuite) expectOrder(id int, status string, productBatchIds ...int) {
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `transfer_orders` WHERE `transfer_orders`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "source_warehouse_id", "source_section_id", "destination_warehouse_id", "destination_section_id", "status"}).
+			AddRow(id, 1, 4, 2, 5, status))
+	if status == models.TransferOrderReceived {
+		return
+	}
+	lines := sqlmock.NewRows([]string{"id", "transfer_order_id", "product_batch_id", "quantity"})
+	for i, productBatchId := range productBatchIds {
+		lines.AddRow(i+1, id, productBatchId, 200)
+	}
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `transfer_order_lines` WHERE transfer_order_id = ? ORDER BY id")).
+		WithArgs(id).
+		WillReturnRows(lines)
+}
+
+func (s *TransferOrderTestSuite) expectBatch(id int, quantity int, sectionId int) {
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `product_batches` WHERE `product_batches`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(id, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity", "section_id", "product_id", "status"}).
+			AddRow(id, quantity, sectionId, 1, models.ProductBatchAvailable))
+}
+
+func (s *TransferOrderTestSuite) TestFindById_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `transfer_orders` WHERE `transfer_orders`.`id` = ? LIMIT ?")).
+		WithArgs(9, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Act
+	order, err := s.repo.FindById(context.Background(), 9)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+	s.Equal(models.TransferOrder{}, order)
+}
+
+func (s *TransferOrderTestSuite) TestDispatch_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.expectOrder(7, models.TransferOrderDraft, 3)
+	s.expectBatch(3, 185, 4)
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `transfer_order_lines` SET `quantity`=? WHERE `id` = ?")).
+		WithArgs(185, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(0, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(3, 4, -185, models.StockMovementTransfer, nil, nil, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(11, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity - ? WHERE id = ?")).
+		WithArgs(1, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `transfer_orders` SET `dispatched_at`=?,`status`=? WHERE `id` = ?")).
+		WithArgs(at, models.TransferOrderDispatched, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	order, err := s.repo.Dispatch(context.Background(), 7, at)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.TransferOrderDispatched, order.Status)
+	s.Equal(at, *order.DispatchedAt)
+	s.Equal(185, order.Lines[0].Quantity)
+}
+
+func (s *TransferOrderTestSuite) TestFindOpenByProductBatch_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT transfer_orders.* FROM `transfer_orders` JOIN transfer_order_lines ON transfer_order_lines.transfer_order_id = transfer_orders.id WHERE transfer_order_lines.product_batch_id = ? AND transfer_orders.status <> ? ORDER BY transfer_orders.id")).
+		WithArgs(3, models.TransferOrderReceived).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(7, models.TransferOrderDraft))
+
+	// Act
+	orders, err := s.repo.FindOpenByProductBatch(context.Background(), 3)
+
+	// Assert
+	s.NoError(err)
+	s.Equal([]models.TransferOrder{{Id: 7, Status: models.TransferOrderDraft}}, orders)
+}
+
+func (s *TransferOrderTestSuite) TestDispatch_BatchEmptied() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectOrder(7, models.TransferOrderDraft, 3)
+	s.expectBatch(3, 0, 4)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Dispatch(context.Background(), 7, time.Now())
+
+	// Assert
+	s.ErrorIs(err, repository.ErrStaleEntity)
+}
+
+func (s *TransferOrderTestSuite) TestDispatch_BatchLeftSection() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectOrder(7, models.TransferOrderDraft, 3)
+	s.expectBatch(3, 185, 6)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Dispatch(context.Background(), 7, time.Now())
+
+	// Assert
+	s.ErrorIs(err, repository.ErrStaleEntity)
+}
+
+func (s *TransferOrderTestSuite) TestMarkInTransit_AlreadyMoved() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectOrder(7, models.TransferOrderReceived)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.MarkInTransit(context.Background(), 7, nil, time.Now())
+
+	// Assert
+	s.ErrorIs(err, repository.ErrStaleEntity)
+}
+
+func (s *TransferOrderTestSuite) TestReceive_Success() {
+	// Arrange
+	at := time.Date(2025, 6, 12, 15, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.expectOrder(7, models.TransferOrderInTransit, 3)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_capacity", "maximum_capacity", "warehouse_id"}).AddRow(5, 40, 41, 2))
+	s.expectBatch(3, 0, 4)
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `section_id`=? WHERE id = ?")).
+		WithArgs(5, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(200, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(3, 5, 200, models.StockMovementTransfer, nil, nil, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity + ? WHERE `id` = ?")).
+		WithArgs(1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `transfer_orders` SET `received_at`=?,`status`=? WHERE `id` = ?")).
+		WithArgs(at, models.TransferOrderReceived, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	order, err := s.repo.Receive(context.Background(), 7, at)
+
+	// Assert
+	s.NoError(err)
+	s.Equal(models.TransferOrderReceived, order.Status)
+	s.Equal(at, *order.ReceivedAt)
+}
+
+func (s *TransferOrderTestSuite) TestReceive_RefilledInTransit() {
+	// Arrange
+	at := time.Date(2025, 6, 12, 15, 0, 0, 0, time.UTC)
+	s.mock.ExpectBegin()
+	s.expectOrder(7, models.TransferOrderInTransit, 3)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_capacity", "maximum_capacity", "warehouse_id"}).AddRow(5, 40, 41, 2))
+	s.expectBatch(3, 10, 4)
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `section_id`=? WHERE id = ?")).
+		WithArgs(5, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+		WithArgs(210, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+		WithArgs(3, 5, 200, models.StockMovementTransfer, nil, nil, "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity + ? WHERE `id` = ?")).
+		WithArgs(1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `sections` SET `current_capacity`=current_capacity - ? WHERE id = ?")).
+		WithArgs(1, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `transfer_orders` SET `received_at`=?,`status`=? WHERE `id` = ?")).
+		WithArgs(at, models.TransferOrderReceived, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	// Act
+	_, err := s.repo.Receive(context.Background(), 7, at)
+
+	// Assert
+	s.NoError(err)
+}
+
+func (s *TransferOrderTestSuite) TestReceive_CapacityExceeded() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.expectOrder(7, models.TransferOrderInTransit, 3, 8)
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sections` WHERE `sections`.`id` = ? LIMIT ? FOR UPDATE")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "current_capacity", "maximum_capacity", "warehouse_id"}).AddRow(5, 40, 41, 2))
+	for _, id := range []int{3, 8} {
+		s.expectBatch(id, 0, 4)
+		s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `section_id`=? WHERE id = ?")).
+			WithArgs(5, id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_batches` SET `current_quantity`=? WHERE id = ?")).
+			WithArgs(200, id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `stock_movements`")).
+			WillReturnResult(sqlmock.NewResult(12, 1))
+	}
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.repo.Receive(context.Background(), 7, time.Now())
+
+	// Assert
+	s.ErrorIs(err, repository.ErrSectionCapacityExceeded)
+}
+
+func TestTransferOrderTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferOrderTestSuite))
+}
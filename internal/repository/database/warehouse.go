@@ -1,9 +1,11 @@
 package database
 
 import (
+	"context"
 	"errors"
 	"gorm.io/gorm"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 )
 
@@ -17,26 +19,30 @@ func NewWarehouseDB(db *gorm.DB) *WarehouseDB {
 	return &WarehouseDB{db: db}
 }
 
-func (r *WarehouseDB) FindAll() ([]models.Warehouse, error) {
+func (r *WarehouseDB) FindAll(ctx context.Context) ([]models.Warehouse, error) {
 	warehouses := make([]models.Warehouse, 0)
-	result := r.db.Find(&warehouses)
+	result := r.db.WithContext(ctx).Find(&warehouses)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return warehouses, nil
 }
 
-func (r *WarehouseDB) FindById(id int) (models.Warehouse, error) {
+func (r *WarehouseDB) FindByIds(ctx context.Context, ids []int) ([]models.Warehouse, error) {
+	return findIn[models.Warehouse](r.db.WithContext(ctx), "id", ids)
+}
+
+func (r *WarehouseDB) FindById(ctx context.Context, id int) (models.Warehouse, error) {
 	var warehouse models.Warehouse
-	result := r.db.First(&warehouse, id)
+	result := r.db.WithContext(ctx).First(&warehouse, id)
 	if result.Error != nil {
 		return models.Warehouse{}, result.Error
 	}
 	return warehouse, nil
 }
 
-func (r *WarehouseDB) Create(warehouse models.Warehouse) (models.Warehouse, error) {
-	result := r.db.Create(&warehouse)
+func (r *WarehouseDB) Create(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
+	result := r.db.WithContext(ctx).Create(&warehouse)
 
 	switch {
 	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
@@ -48,8 +54,8 @@ func (r *WarehouseDB) Create(warehouse models.Warehouse) (models.Warehouse, erro
 	return warehouse, nil
 }
 
-func (r *WarehouseDB) Update(warehouse models.Warehouse) (models.Warehouse, error) {
-	result := r.db.Save(&warehouse)
+func (r *WarehouseDB) Update(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
+	result := r.db.WithContext(ctx).Save(&warehouse)
 	if result.Error == nil {
 		return warehouse, nil
 	}
@@ -57,9 +63,9 @@ func (r *WarehouseDB) Update(warehouse models.Warehouse) (models.Warehouse, erro
 	return models.Warehouse{}, result.Error
 }
 
-func (r *WarehouseDB) PartialUpdate(id int, fields map[string]interface{}) (models.Warehouse, error) {
+func (r *WarehouseDB) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.Warehouse, error) {
 	var warehouse models.Warehouse
-	result := r.db.First(&warehouse, id)
+	result := r.db.WithContext(ctx).First(&warehouse, id)
 	switch {
 	case errors.Is(result.Error, gorm.ErrRecordNotFound):
 			return models.Warehouse{}, repository.ErrEntityNotFound
@@ -67,35 +73,20 @@ func (r *WarehouseDB) PartialUpdate(id int, fields map[string]interface{}) (mode
 			return models.Warehouse{}, result.Error
 	}
 
-	if val, ok := fields["code"]; ok {
-		warehouse.WarehouseCode = val.(string)
-	}
-	if val, ok := fields["address"]; ok {
-		warehouse.Address = val.(string)
-	}
-	if val, ok := fields["telephone"]; ok {
-		warehouse.Telephone = val.(string)
-	}
-	if val, ok := fields["minimum_capacity"]; ok {
-		warehouse.MinimumCapacity = int(val.(float64))
-	}
-	if val, ok := fields["minimum_temperature"]; ok {
-		warehouse.MinimumTemperature = int(val.(float64))
-	}
-	if val, ok := fields["locality_id"]; ok {
-		warehouse.LocalityId = int(val.(float64))
+	if err := patch.Apply(&warehouse, fields); err != nil {
+		return models.Warehouse{}, err
 	}
 
-	result = r.db.Save(&warehouse)
+	result = r.db.WithContext(ctx).Save(&warehouse)
 	if result.Error != nil {
 		return models.Warehouse{}, result.Error
 	}
 	return warehouse, nil
 }
 
-func (r *WarehouseDB) Delete(id int) error {
+func (r *WarehouseDB) Delete(ctx context.Context, id int) error {
 	var warehouse models.Warehouse
-	result := r.db.Delete(&warehouse, id)
+	result := r.db.WithContext(ctx).Delete(&warehouse, id)
 	if result.RowsAffected < 1 {
 		return repository.ErrEntityNotFound
 	}
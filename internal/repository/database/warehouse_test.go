@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
@@ -97,7 +98,7 @@ func (s *WarehouseRepositoryTestSuite) TestFindAll_Success() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `warehouses`")).WillReturnRows(rows)
 
 	// Act
-	warehouses, err := s.repo.FindAll()
+	warehouses, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.NoError(err)
@@ -113,7 +114,7 @@ func (s *WarehouseRepositoryTestSuite) TestFindAll_DatabaseError() {
 	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `warehouses`")).WillReturnError(sql.ErrConnDone)
 
 	// Act
-	warehouses, err := s.repo.FindAll()
+	warehouses, err := s.repo.FindAll(context.Background())
 
 	// Assert
 	s.Error(err)
@@ -162,7 +163,7 @@ func (s *WarehouseRepositoryTestSuite) TestFindById_Success() {
 	)).  WithArgs(1, 1).WillReturnRows(rows)
 
 	// Act
-	warehouse, err := s.repo.FindById(1)
+	warehouse, err := s.repo.FindById(context.Background(), 1)
 
 	// Assert
 	s.NoError(err)
@@ -181,7 +182,7 @@ func (s *WarehouseRepositoryTestSuite) TestFindById_NotFound() {
 		WithArgs(id, 1).
 		WillReturnError(gorm.ErrRecordNotFound)
 
-	result, err := s.repo.FindById(id)
+	result, err := s.repo.FindById(context.Background(), id)
 
 	s.Error(err)
 	s.Equal(gorm.ErrRecordNotFound, err)
@@ -210,7 +211,7 @@ func (s *WarehouseRepositoryTestSuite) TestCreate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	createdWarehouse, err := s.repo.Create(newWarehouse)
+	createdWarehouse, err := s.repo.Create(context.Background(), newWarehouse)
 
 	// Assert
 	s.NoError(err)
@@ -244,7 +245,7 @@ func (s *WarehouseRepositoryTestSuite) TestCreate_ForeignKeyViolated() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdWarehouse, err := s.repo.Create(newWarehouse)
+	createdWarehouse, err := s.repo.Create(context.Background(), newWarehouse)
 
 	// Assert
 	s.Error(err)
@@ -274,7 +275,7 @@ func (s *WarehouseRepositoryTestSuite) TestCreate_ErrorOnSave() {
 	s.mock.ExpectRollback()
 
 	// Act
-	createdWarehouse, err := s.repo.Create(newWarehouse)
+	createdWarehouse, err := s.repo.Create(context.Background(), newWarehouse)
 
 	// Assert
 	s.Error(err)
@@ -314,7 +315,7 @@ func (s *WarehouseRepositoryTestSuite) TestUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	updatedWarehouse, err := s.repo.Update(existingWarehouse)
+	updatedWarehouse, err := s.repo.Update(context.Background(), existingWarehouse)
 
 	// Assert
 	s.NoError(err)
@@ -353,7 +354,7 @@ func (s *WarehouseRepositoryTestSuite) TestUpdate_ForeignKeyViolation() {
 	s.mock.ExpectRollback()
 
 	// Act
-	updatedWarehouse, err := s.repo.Update(existingWarehouse)
+	updatedWarehouse, err := s.repo.Update(context.Background(), existingWarehouse)
 
 	// Assert
 	s.Error(err)
@@ -377,7 +378,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_Success() {
 	}
 
 	fields := map[string]interface{}{
-		"code":					"NEW-CODE",
+		"warehouse_code":		"NEW-CODE",
 		"address":				"New Address",
 		"telephone":			"123-321",
 		"minimum_capacity":		float64(99),
@@ -436,7 +437,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	s.NoError(err)
 	s.Equal(expectedUpdatedWarehouse, result)
@@ -449,7 +450,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_DatabaseErrorOnFirst()
 	// Arrange
 	id := 1
 	fields := map[string]any{
-		"code":					"CID#01",
+		"warehouse_code":		"CID#01",
 		"address":				"Boulevard plaza",
 		"telephone":			"123-456789",
 		"minimum_capacity":		float64(200),
@@ -463,7 +464,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_DatabaseErrorOnFirst()
 		WillReturnError(gorm.ErrInvalidDB)
 
 	// Act
-	updatedWarehouse, err := s.repo.PartialUpdate(id, fields)
+	updatedWarehouse, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -477,7 +478,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_ErrorNotFound() {
 	// Arrange
 	id := 1111
 	fields := map[string]any{
-		"code":					"CID#01",
+		"warehouse_code":		"CID#01",
 		"address":				"Boulevard plaza",
 		"telephone":			"123-456789",
 		"minimum_capacity":		float64(200),
@@ -500,7 +501,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_ErrorNotFound() {
 	)).WithArgs(id, 1).WillReturnRows(s.mock.NewRows(columns))
 
 	// Act
-	updatedWarehouse, err := s.repo.PartialUpdate(id, fields)
+	updatedWarehouse, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -524,7 +525,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_ErrorOnSave() {
 	}
 
 	fields := map[string]interface{}{
-		"code":					"NEW-CODE",
+		"warehouse_code":		"NEW-CODE",
 		"address":				"New Address",
 		"telephone":			"123-321",
 		"minimum_capacity":		float64(99),
@@ -583,7 +584,7 @@ func (s *WarehouseRepositoryTestSuite) TestPartialUpdate_ErrorOnSave() {
 	s.mock.ExpectRollback()
 
 	// Act
-	result, err := s.repo.PartialUpdate(id, fields)
+	result, err := s.repo.PartialUpdate(context.Background(), id, fields)
 
 	// Assert
 	s.Error(err)
@@ -603,7 +604,7 @@ func (s *WarehouseRepositoryTestSuite) TestDelete_Success() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(warehouseID)
+	err := s.repo.Delete(context.Background(), warehouseID)
 
 	// Assert
 	s.NoError(err)
@@ -623,7 +624,7 @@ func (s *WarehouseRepositoryTestSuite) TestDelete_NotFound() {
 	s.mock.ExpectCommit()
 
 	// Act
-	err := s.repo.Delete(warehouseID)
+	err := s.repo.Delete(context.Background(), warehouseID)
 
 	// Assert
 	s.Error(err)
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+type WebhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{db: db}
+}
+
+func (r *WebhookSubscriptionRepository) FindAll(ctx context.Context) ([]models.WebhookSubscription, error) {
+	var subscriptions []models.WebhookSubscription
+	result := r.db.WithContext(ctx).Find(&subscriptions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return subscriptions, nil
+}
+
+func (r *WebhookSubscriptionRepository) FindById(ctx context.Context, id int) (models.WebhookSubscription, error) {
+	var subscription models.WebhookSubscription
+	result := r.db.WithContext(ctx).First(&subscription, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.WebhookSubscription{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.WebhookSubscription{}, result.Error
+	}
+	return subscription, nil
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	result := r.db.WithContext(ctx).Create(&subscription)
+	if result.Error != nil {
+		return models.WebhookSubscription{}, result.Error
+	}
+	return subscription, nil
+}
+
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	result := r.db.WithContext(ctx).Save(&subscription)
+	if result.Error != nil {
+		return models.WebhookSubscription{}, result.Error
+	}
+	return subscription, nil
+}
+
+func (r *WebhookSubscriptionRepository) PartialUpdate(ctx context.Context, id int, fields map[string]interface{}) (models.WebhookSubscription, error) {
+	subscription, err := r.FindById(ctx, id)
+	if err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	result := r.db.WithContext(ctx).Model(&subscription).Updates(fields)
+	if result.Error != nil {
+		return models.WebhookSubscription{}, result.Error
+	}
+	return subscription, nil
+}
+
+// Delete removes a subscription, its deliveries are deleted along with it
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Delete(&models.WebhookSubscription{}, id)
+	switch {
+	case result.Error != nil:
+		return result.Error
+	case result.RowsAffected < 1:
+		return repository.ErrEntityNotFound
+	}
+	return nil
+}
+
+type WebhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: db}
+}
+
+func (r *WebhookDeliveryRepository) FindById(ctx context.Context, id int) (models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	result := r.db.WithContext(ctx).First(&delivery, id)
+	switch {
+	case errors.Is(result.Error, gorm.ErrRecordNotFound):
+		return models.WebhookDelivery{}, repository.ErrEntityNotFound
+	case result.Error != nil:
+		return models.WebhookDelivery{}, result.Error
+	}
+	return delivery, nil
+}
+
+func (r *WebhookDeliveryRepository) FindBySubscription(ctx context.Context, subscriptionId int) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	result := r.db.WithContext(ctx).Where("subscription_id = ?", subscriptionId).Order("id DESC").Find(&deliveries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookDeliveryRepository) FindByStatus(ctx context.Context, status string) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	result := r.db.WithContext(ctx).Where("status = ?", status).Order("id DESC").Find(&deliveries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookDeliveryRepository) FindDue(ctx context.Context, at time.Time, limit int) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	result := r.db.WithContext(ctx).Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, at).
+		Order("next_attempt_at, id").Limit(limit).Find(&deliveries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return deliveries, nil
+}
+
+// Create inserts a delivery, the unique index over the subscription and the event keeps an event from being
+// delivered twice
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	result := r.db.WithContext(ctx).Create(&delivery)
+	switch {
+	case errors.Is(result.Error, gorm.ErrDuplicatedKey):
+		return models.WebhookDelivery{}, repository.ErrEntityAlreadyExists
+	case errors.Is(result.Error, gorm.ErrForeignKeyViolated):
+		return models.WebhookDelivery{}, repository.ErrForeignKeyViolation
+	case result.Error != nil:
+		return models.WebhookDelivery{}, result.Error
+	}
+	return delivery, nil
+}
+
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	result := r.db.WithContext(ctx).Save(&delivery)
+	if result.Error != nil {
+		return models.WebhookDelivery{}, result.Error
+	}
+	return delivery, nil
+}
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type WebhookTestSuite struct {
+	suite.Suite
+	mock          sqlmock.Sqlmock
+	subscriptions *WebhookSubscriptionRepository
+	deliveries    *WebhookDeliveryRepository
+}
+
+var webhookSubscriptionColumns = []string{"id", "url", "secret", "event_types", "active", "created_at"}
+
+func (s *WebhookTestSuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		TranslateError: true,
+	})
+	s.Require().NoError(err)
+
+	s.mock = mock
+	s.subscriptions = NewWebhookSubscriptionRepository(gormDB)
+	s.deliveries = NewWebhookDeliveryRepository(gormDB)
+}
+
+func (s *WebhookTestSuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *WebhookTestSuite) TestFindSubscriptionById_Success() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `webhook_subscriptions` WHERE `webhook_subscriptions`.`id` = ?")).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows(webhookSubscriptionColumns).
+			AddRow(1, "https://example.com/hooks", "secret", `["purchase_order.created"]`, true, time.Now()))
+
+	// Act
+	subscription, err := s.subscriptions.FindById(context.Background(), 1)
+
+	// Assert
+	s.NoError(err)
+	s.Equal("secret", subscription.Secret)
+	s.Equal([]string{models.WebhookEventPurchaseOrderCreated}, subscription.EventTypes)
+}
+
+func (s *WebhookTestSuite) TestFindSubscriptionById_NotFound() {
+	// Arrange
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `webhook_subscriptions`")).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	// Act
+	_, err := s.subscriptions.FindById(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *WebhookTestSuite) TestDeleteSubscription_NotFound() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `webhook_subscriptions` WHERE `webhook_subscriptions`.`id` = ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	// Act
+	err := s.subscriptions.Delete(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *WebhookTestSuite) TestFindDue_Success() {
+	// Arrange
+	now := time.Now()
+	s.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `webhook_deliveries` WHERE status = ? AND next_attempt_at <= ? ORDER BY next_attempt_at, id LIMIT ?")).
+		WithArgs(models.WebhookDeliveryPending, now, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subscription_id", "event_id", "status", "attempts"}).
+			AddRow(4, 1, "evt-1", models.WebhookDeliveryPending, 2))
+
+	// Act
+	deliveries, err := s.deliveries.FindDue(context.Background(), now, 10)
+
+	// Assert
+	s.NoError(err)
+	s.Len(deliveries, 1)
+	s.Equal(2, deliveries[0].Attempts)
+}
+
+func (s *WebhookTestSuite) TestCreateDelivery_Duplicated() {
+	// Arrange
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `webhook_deliveries`")).
+		WillReturnError(gorm.ErrDuplicatedKey)
+	s.mock.ExpectRollback()
+
+	// Act
+	_, err := s.deliveries.Create(context.Background(), models.WebhookDelivery{SubscriptionId: 1, EventId: "evt-1"})
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityAlreadyExists)
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
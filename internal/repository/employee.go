@@ -1,9 +1,12 @@
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type EmployeeRepository interface {
 	Repository[int, models.Employee]
-	InboundOrdersReport() ([]models.EmployeeInboundOrdersReport, error)
-	InboundOrdersReportById(id int) (models.EmployeeInboundOrdersReport, error)
+	InboundOrdersReport(ctx context.Context) ([]models.EmployeeInboundOrdersReport, error)
+	InboundOrdersReportById(ctx context.Context, id int) (models.EmployeeInboundOrdersReport, error)
 }
@@ -40,4 +40,26 @@ var (
 	ErrSQLQueryExecution = errors.New("SQL query execution failed")
 	// ErrForeignKeyViolation is returned when a foreign key does not exist
 	ErrForeignKeyViolation = errors.New("foreign key does not exist")
+
+	// ErrInsufficientStock is returned when a stock movement would leave a batch with a negative quantity
+	ErrInsufficientStock = errors.New("insufficient stock in the product batch")
+
+	// ErrSectionCapacityExceeded is returned when a section would hold more batches than its maximum capacity
+	ErrSectionCapacityExceeded = errors.New("the section cannot hold more product batches")
+
+	// ErrCountSessionOpen is returned when a count session is opened for a section that is already being counted
+	ErrCountSessionOpen = errors.New("the section already has an open count session")
+
+	// ErrOrderDetailOverPicked is returned when more stock is picked for a purchase order line than it ordered
+	ErrOrderDetailOverPicked = errors.New("the picked quantity exceeds the quantity of the order line")
+
+	// ErrProductBatchRecalled is returned when a batch under an open recall is picked or recalled again
+	ErrProductBatchRecalled = errors.New("the product batch is under an open recall")
+
+	// ErrProductBatchNotAvailable is returned when stock is picked or transferred out of a batch that is not available,
+	// or moved in or out of a disposed batch
+	ErrProductBatchNotAvailable = errors.New("the product batch is not available")
+
+	// ErrStaleEntity is returned when an entity changed after it was read, and the change can no longer be applied
+	ErrStaleEntity = errors.New("the entity was changed by another request")
 )
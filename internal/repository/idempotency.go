@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+type IdempotencyRepository interface {
+	FindByKey(ctx context.Context, key string) (models.IdempotencyKey, error)
+	Create(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, error)
+	Update(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, error)
+	Delete(ctx context.Context, id int) error
+}
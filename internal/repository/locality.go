@@ -1,12 +1,19 @@
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type LocalityRepository interface {
 	Repository[int, models.Locality]
-	FindLocalityBySeller(id int) (models.LocalitySellerCount, error)
-	FindAllLocality() ([]models.LocalitySellerCount, error)
-	FindAllCarriers() ([]models.LocalityCarrierCount, error)
-	FindCarriersByLocality(id int) ([]models.LocalityCarrierCount, error)
-	CreateWithNames(locality models.LocalityDoc) (models.LocalityDoc, error)
+	FindLocalityBySeller(ctx context.Context, id int) (models.LocalitySellerCount, error)
+	FindAllLocality(ctx context.Context) ([]models.LocalitySellerCount, error)
+	FindAllCarriers(ctx context.Context) ([]models.LocalityCarrierCount, error)
+	FindCarriersByLocality(ctx context.Context, id int) ([]models.LocalityCarrierCount, error)
+	CreateWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error)
+	// FindByProvince returns the localities of a province
+	FindByProvince(ctx context.Context, provinceId int) ([]models.Locality, error)
+	// SearchByName returns the localities whose name contains name, ignoring case and accents
+	SearchByName(ctx context.Context, name string) ([]models.LocalityDoc, error)
 }
@@ -1,7 +1,24 @@
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type OrderDetailRepository interface {
 	Repository[int, models.OrderDetail]
+	// FindByPurchaseOrder retrieves the lines of a purchase order
+	FindByPurchaseOrder(ctx context.Context, purchaseOrderId int) ([]models.OrderDetail, error)
+	// FindByPurchaseOrders retrieves the lines of the given purchase orders
+	FindByPurchaseOrders(ctx context.Context, purchaseOrderIds []int) ([]models.OrderDetail, error)
+	// FindTotals computes the totals of a purchase order from its lines, priced as FindPricedByPurchaseOrder prices them
+	FindTotals(ctx context.Context, purchaseOrderId int) (models.PurchaseOrderTotals, error)
+	// FindPricedByPurchaseOrder retrieves the lines of a purchase order at the sale price of their product records
+	FindPricedByPurchaseOrder(ctx context.Context, purchaseOrderId int) ([]models.PricedOrderDetail, error)
+	// FindPicks retrieves the product batches picked for a line
+	FindPicks(ctx context.Context, orderDetailId int) ([]models.OrderDetailPick, error)
+	// CreatePick takes the picked stock out of its batch and records the batch as picked for the line, it returns
+	// ErrOrderDetailOverPicked when the line would be picked beyond its quantity, ErrProductBatchRecalled when the
+	// batch is under an open recall and ErrProductBatchNotAvailable when it is not available otherwise
+	CreatePick(ctx context.Context, pick models.OrderDetailPick) (models.OrderDetailPick, error)
 }
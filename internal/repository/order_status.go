@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// OrderStatusRepository is an interface that represents an OrderStatus repository, the statuses are seeded with the
+// database and read only
+type OrderStatusRepository interface {
+	FindAll(ctx context.Context) ([]models.OrderStatus, error)
+	FindById(ctx context.Context, id int) (models.OrderStatus, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+type OutboxRepository interface {
+	// FindPending returns up to limit events not dispatched yet that are due at now, in the order they were written.
+	// The events of an aggregate are held back while one of its earlier events waits for its next attempt.
+	FindPending(ctx context.Context, now time.Time, limit int) ([]models.OutboxEvent, error)
+	Update(ctx context.Context, event models.OutboxEvent) (models.OutboxEvent, error)
+}
@@ -1,10 +1,30 @@
 // Package repository defines the interfaces and implementations for product data access.
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
 
 // ProductRepository Inherits the basic CRUD methods from the Generic Repository[int, models.Product]
 type ProductBatchRepository interface {
 	// Repository is a generic repository interface for CRUD operations
 	Repository[int, models.ProductBatch]
+	// FindBySections retrieves the batches stored in the given sections
+	FindBySections(ctx context.Context, sectionIds []int) ([]models.ProductBatch, error)
+	// FindTrace follows the batch with the given number from its seller to the buyers it was shipped to
+	FindTrace(ctx context.Context, batchNumber string) (models.BatchTrace, error)
+	// FindStatusChanges retrieves the status changes of a batch, oldest first
+	FindStatusChanges(ctx context.Context, id int) ([]models.ProductBatchStatusChange, error)
+	// ChangeStatus moves a batch from the status the change comes from to its new status and records the change, the
+	// stock of a batch disposed of is taken out of it. It returns ErrProductBatchRecalled when the batch is part of an
+	// open recall.
+	ChangeStatus(ctx context.Context, change models.ProductBatchStatusChange) (models.ProductBatch, error)
+	// InOpenRecall reports whether the batch is part of a recall not closed yet
+	InOpenRecall(ctx context.Context, id int) (bool, error)
+	// FindExpiring retrieves the batches with stock left that may still be sold and whose due date is on or before until
+	FindExpiring(ctx context.Context, until string) ([]models.ProductBatch, error)
+	// Expire moves the batches received, under inspection or available whose due date is before today to expired
+	Expire(ctx context.Context, today string, at time.Time) ([]models.ProductBatch, error)
 }
@@ -1,7 +1,20 @@
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
 
+// ProductRecordRepository stores the price history of the products, records are only appended
 type ProductRecordRepository interface {
-	Repository[int, models.ProductRecord]
+	FindAll(ctx context.Context) ([]models.ProductRecord, error)
+	FindById(ctx context.Context, id int) (models.ProductRecord, error)
+	// FindByIds retrieves the records with the given ids, the ids that do not exist are skipped
+	FindByIds(ctx context.Context, ids []int) ([]models.ProductRecord, error)
+	Create(ctx context.Context, productRecord models.ProductRecord) (models.ProductRecord, error)
+	// FindAt retrieves the record of the product in effect at the instant, the latest one not after it
+	FindAt(ctx context.Context, productId int, at time.Time) (models.ProductRecord, error)
+	// FindBetween retrieves the records of the product made within the range, ordered by time
+	FindBetween(ctx context.Context, productId int, from time.Time, to time.Time) ([]models.ProductRecord, error)
 }
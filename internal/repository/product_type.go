@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// ProductTypeRepository is an interface that represents a ProductType repository
+type ProductTypeRepository interface {
+	Repository[int, models.ProductType]
+	// FindUsage counts the products and the sections of a product type
+	FindUsage(ctx context.Context, id int) (models.ProductTypeUsage, error)
+	// FindAllUsage counts the products and the sections of every product type
+	FindAllUsage(ctx context.Context) ([]models.ProductTypeUsage, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// ProvinceRepository is an interface that represents a Province repository
+type ProvinceRepository interface {
+	Repository[int, models.Province]
+	// FindByCountry returns the provinces of a country
+	FindByCountry(ctx context.Context, countryId int) ([]models.Province, error)
+}
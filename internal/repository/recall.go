@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+// RecallRepository stores the recall campaigns and the quarantine and disposal of their batches
+type RecallRepository interface {
+	// FindAll retrieves the recalls along with their batches
+	FindAll(ctx context.Context) ([]models.Recall, error)
+	// FindById retrieves a recall along with its batches
+	FindById(ctx context.Context, id int) (models.Recall, error)
+	// Create opens a recall of every batch of its product, or of the batches with the given numbers, each recalled
+	// with the stock it holds. It returns ErrEntityNotFound when a batch does not exist or the product has none, and
+	// ErrProductBatchRecalled when a batch is already under an open recall.
+	Create(ctx context.Context, recall models.Recall, batchNumbers []string) (models.Recall, error)
+	// Quarantine sets apart the stock of a recalled batch of an open recall
+	Quarantine(ctx context.Context, id int, productBatchId int, at time.Time) (models.RecallBatch, error)
+	// Dispose posts the disposal of the stock of a quarantined batch of an open recall to the stock ledger
+	Dispose(ctx context.Context, id int, productBatchId int, employeeId *int, at time.Time) (models.RecallBatch, error)
+	// Close closes an open recall whose batches were all disposed
+	Close(ctx context.Context, id int, at time.Time) (models.Recall, error)
+	// FindStock sums the stock of the batches of a recall per warehouse
+	FindStock(ctx context.Context, id int) ([]models.RecallStock, error)
+	// FindOrders retrieves the purchase orders the batches of a recall were picked for, per buyer
+	FindOrders(ctx context.Context, id int) ([]models.RecallOrders, error)
+}
@@ -1,9 +1,16 @@
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type SectionRepository interface {
 	Repository[int, models.Section]
-	FindSectionReport(id int) (models.SectionReport, error)
-	FindAllSectionReports() ([]models.SectionReport, error)
+	// FindByIds retrieves the sections with the given ids, the ids that do not exist are skipped
+	FindByIds(ctx context.Context, ids []int) ([]models.Section, error)
+	// FindByWarehouses retrieves the sections of the given warehouses
+	FindByWarehouses(ctx context.Context, warehouseIds []int) ([]models.Section, error)
+	FindSectionReport(ctx context.Context, id int) (models.SectionReport, error)
+	FindAllSectionReports(ctx context.Context) ([]models.SectionReport, error)
 }
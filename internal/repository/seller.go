@@ -1,9 +1,14 @@
 package repository
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 // SellerRepository defines the interface for seller data operations
 type SellerRepository interface {
 	Repository[int, models.Seller]
+	// FindByIds retrieves the sellers with the given ids, the ids that do not exist are skipped
+	FindByIds(ctx context.Context, ids []int) ([]models.Seller, error)
 	// More methods specific to seller data operations can be added here...
 }
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// StockMovementRepository is the append-only ledger of the stock of the product batches, its movements are never
+// updated nor deleted
+type StockMovementRepository interface {
+	// FindByProductBatch retrieves the movements of a batch, oldest first
+	FindByProductBatch(ctx context.Context, productBatchId int) ([]models.StockMovement, error)
+	// Adjust posts an adjustment or a disposal, applying its quantity to the current quantity of the batch in the
+	// same transaction, and keeps the capacity of the section of the batch in sync: a batch emptied by the movement
+	// frees its place in the section and an empty batch refilled takes it back. It returns ErrEntityNotFound when the
+	// batch does not exist, ErrInsufficientStock when the batch would be left with a negative quantity and
+	// ErrSectionCapacityExceeded when the section is full.
+	Adjust(ctx context.Context, movement models.StockMovement) (models.StockMovement, error)
+	// FindWaste retrieves the stock removed because it expired, was damaged, was kept out of its temperature range or
+	// was recalled, a zero product, seller or warehouse ID matches all of them
+	FindWaste(ctx context.Context, productId int, sellerId int, warehouseId int) ([]models.WasteReport, error)
+	// FindBalances retrieves the stock of every product in every warehouse, a zero product or warehouse ID matches
+	// all of them
+	FindBalances(ctx context.Context, productId int, warehouseId int) ([]models.StockBalance, error)
+}
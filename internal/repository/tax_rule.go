@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// TaxRuleRepository stores the tax rules configured for the countries
+type TaxRuleRepository interface {
+	// FindByCountry retrieves the tax rules of a country, ordered by their ID
+	FindByCountry(ctx context.Context, countryId int) ([]models.TaxRule, error)
+	FindById(ctx context.Context, id int) (models.TaxRule, error)
+	// Create adds a tax rule, it returns ErrForeignKeyViolation when its country does not exist
+	Create(ctx context.Context, rule models.TaxRule) (models.TaxRule, error)
+	Update(ctx context.Context, rule models.TaxRule) (models.TaxRule, error)
+	Delete(ctx context.Context, id int) error
+}
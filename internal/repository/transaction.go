@@ -0,0 +1,18 @@
+package repository
+
+import "context"
+
+// Repositories groups the repositories that can take part in a unit of work
+type Repositories struct {
+	Product      ProductRepository
+	Seller       SellerRepository
+	Locality     LocalityRepository
+	ProductBatch ProductBatchRepository
+}
+
+// Transactor runs units of work that are committed or rolled back as a whole
+type Transactor interface {
+	// Transaction calls fn with repositories bound to a new transaction, which is rolled back when fn returns an error
+	// and committed otherwise
+	Transaction(ctx context.Context, fn func(repos Repositories) error) error
+}
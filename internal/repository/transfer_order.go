@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+// TransferOrderRepository stores the transfer orders and moves their batches. Every move is atomic: the status of
+// the order, the location and stock of its batches and the capacity of the sections change together.
+type TransferOrderRepository interface {
+	// FindAll retrieves the transfer orders along with their lines
+	FindAll(ctx context.Context) ([]models.TransferOrder, error)
+	// FindById retrieves a transfer order along with its lines
+	FindById(ctx context.Context, id int) (models.TransferOrder, error)
+	// FindOpenByProductBatch retrieves the transfer orders not received yet that move the batch, without their lines
+	FindOpenByProductBatch(ctx context.Context, productBatchId int) ([]models.TransferOrder, error)
+	// Create inserts a transfer order along with its lines
+	Create(ctx context.Context, order models.TransferOrder) (models.TransferOrder, error)
+	// Dispatch takes the batches of a draft order out of the source section
+	Dispatch(ctx context.Context, id int, at time.Time) (models.TransferOrder, error)
+	// MarkInTransit hands a dispatched order to its carrier, the carrier of the order is replaced when one is given
+	MarkInTransit(ctx context.Context, id int, carrierId *int, at time.Time) (models.TransferOrder, error)
+	// Receive stores the batches of an order in transit in the destination section. It returns
+	// ErrSectionCapacityExceeded when the section cannot hold them.
+	Receive(ctx context.Context, id int, at time.Time) (models.TransferOrder, error)
+}
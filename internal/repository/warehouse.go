@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
 
 type WarehouseRepository interface {
 	Repository[int, models.Warehouse]
+	// FindByIds retrieves the warehouses with the given ids, the ids that do not exist are skipped
+	FindByIds(ctx context.Context, ids []int) ([]models.Warehouse, error)
 }
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+type WebhookSubscriptionRepository interface {
+	Repository[int, models.WebhookSubscription]
+}
+
+type WebhookDeliveryRepository interface {
+	FindById(ctx context.Context, id int) (models.WebhookDelivery, error)
+	// FindBySubscription returns the deliveries of a subscription, the latest first
+	FindBySubscription(ctx context.Context, subscriptionId int) ([]models.WebhookDelivery, error)
+	// FindByStatus returns the deliveries with the given status, the latest first
+	FindByStatus(ctx context.Context, status string) ([]models.WebhookDelivery, error)
+	// FindDue returns up to limit pending deliveries whose next attempt is due at the given time, the oldest first
+	FindDue(ctx context.Context, at time.Time, limit int) ([]models.WebhookDelivery, error)
+	// Create inserts a delivery, it returns ErrEntityAlreadyExists when the event was already delivered to the
+	// subscription
+	Create(ctx context.Context, delivery models.WebhookDelivery) (models.WebhookDelivery, error)
+	Update(ctx context.Context, delivery models.WebhookDelivery) (models.WebhookDelivery, error)
+}
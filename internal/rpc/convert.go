@@ -0,0 +1,28 @@
+package rpc
+
+import (
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// mapSlice converts every model of a list into its message
+func mapSlice[T any, M any](items []T, convert func(T) M) []M {
+	messages := make([]M, len(items))
+	for i, item := range items {
+		messages[i] = convert(item)
+	}
+	return messages
+}
+
+// patchFields returns the fields of a patch request, validated against the schema of the model as the REST API
+// validates a JSON Merge Patch
+func patchFields(fields *structpb.Struct, schema *patch.Schema) (map[string]any, error) {
+	if len(fields.GetFields()) == 0 {
+		return nil, ErrMissingFields
+	}
+	values := fields.AsMap()
+	if err := schema.Validate(values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
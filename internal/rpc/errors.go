@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrInvalidId is returned when the ID is not a positive integer greater than zero
+	ErrInvalidId = status.Error(codes.InvalidArgument, "invalid ID, must be a positive integer greater than zero")
+	// ErrMissingEntity is returned when a create or update request does not carry the entity
+	ErrMissingEntity = status.Error(codes.InvalidArgument, "the entity to create or update is required")
+	// ErrMissingFields is returned when a patch request does not carry the fields to update
+	ErrMissingFields = status.Error(codes.InvalidArgument, "the fields to update are required")
+	// ErrProductBatchReadOnly is returned when a product batch is updated or deleted, its stock changes through the
+	// stock ledger and its status through status changes
+	ErrProductBatchReadOnly = status.Error(codes.Unimplemented, "product batches cannot be updated nor deleted")
+)
+
+// toStatus maps an error returned by the services to the gRPC status with the closest meaning, as the REST handlers
+// map them to HTTP status codes
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validationErr *patch.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(validationErr.Errors))
+		for i, fieldErr := range validationErr.Errors {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: fieldErr.Pointer, Description: fieldErr.Message}
+		}
+		st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+		if detailErr != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return st.Err()
+	case errors.Is(err, repository.ErrEntityNotFound), errors.Is(err, repository.ErrProductNotFound),
+		errors.Is(err, repository.ErrSectionNotFound), errors.Is(err, repository.ErrLocalityNotFound),
+		errors.Is(err, repository.ErrProvinceNotFound), errors.Is(err, service.ErrEntityNotFound),
+		errors.Is(err, service.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrEntityAlreadyExists), errors.Is(err, repository.ErrProductAlreadyExists),
+		errors.Is(err, repository.ErrProductBatchAlreadyExists), errors.Is(err, service.ErrEntityAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrInvalidEntity), errors.Is(err, repository.ErrEmptyEntity),
+		errors.Is(err, repository.ErrIDInvalid), errors.Is(err, service.ErrInvalidEntity),
+		errors.Is(err, service.ErrEmptyEntity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrForeignKeyViolation), errors.Is(err, repository.ErrSectionCapacityExceeded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
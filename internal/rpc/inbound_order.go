@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	pb "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+var inboundOrderPatch = patch.NewSchema(models.InboundOrder{})
+
+// InboundOrderServer serves the inbound orders over gRPC
+type InboundOrderServer struct {
+	pb.UnimplementedInboundOrderServiceServer
+	sv service.InboundOrderService
+}
+
+func (s *InboundOrderServer) ListInboundOrders(ctx context.Context, _ *pb.ListInboundOrdersRequest) (*pb.ListInboundOrdersResponse, error) {
+	orders, err := s.sv.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListInboundOrdersResponse{InboundOrders: mapSlice(orders, inboundOrderToProto)}, nil
+}
+
+func (s *InboundOrderServer) GetInboundOrder(ctx context.Context, req *pb.GetInboundOrderRequest) (*pb.InboundOrder, error) {
+	if req.GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	order, err := s.sv.Retrieve(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return inboundOrderToProto(order), nil
+}
+
+func (s *InboundOrderServer) CreateInboundOrder(ctx context.Context, req *pb.CreateInboundOrderRequest) (*pb.InboundOrder, error) {
+	if req.GetInboundOrder() == nil {
+		return nil, ErrMissingEntity
+	}
+	order := inboundOrderFromProto(req.GetInboundOrder())
+	order.Id = 0
+	created, err := s.sv.Register(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	return inboundOrderToProto(created), nil
+}
+
+func (s *InboundOrderServer) UpdateInboundOrder(ctx context.Context, req *pb.UpdateInboundOrderRequest) (*pb.InboundOrder, error) {
+	if req.GetInboundOrder() == nil {
+		return nil, ErrMissingEntity
+	}
+	if req.GetInboundOrder().GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	updated, err := s.sv.Modify(ctx, inboundOrderFromProto(req.GetInboundOrder()))
+	if err != nil {
+		return nil, err
+	}
+	return inboundOrderToProto(updated), nil
+}
+
+func (s *InboundOrderServer) PatchInboundOrder(ctx context.Context, req *pb.PatchInboundOrderRequest) (*pb.InboundOrder, error) {
+	if req.GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	fields, err := patchFields(req.GetFields(), inboundOrderPatch)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.sv.PartialModify(ctx, int(req.GetId()), fields)
+	if err != nil {
+		return nil, err
+	}
+	return inboundOrderToProto(updated), nil
+}
+
+func (s *InboundOrderServer) DeleteInboundOrder(ctx context.Context, req *pb.DeleteInboundOrderRequest) (*emptypb.Empty, error) {
+	if req.GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	if err := s.sv.Remove(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func inboundOrderToProto(o models.InboundOrder) *pb.InboundOrder {
+	return &pb.InboundOrder{
+		Id:             int64(o.Id),
+		OrderNumber:    o.OrderNumber,
+		OrderDate:      timestamppb.New(o.OrderDate),
+		EmployeeId:     int64(o.EmployeeId),
+		ProductBatchId: int64(o.ProductBatchId),
+		WarehouseId:    int64(o.WarehouseId),
+	}
+}
+
+// inboundOrderFromProto converts an inbound order, leaving its date zero when it is not set so that the service
+// dates it
+func inboundOrderFromProto(o *pb.InboundOrder) models.InboundOrder {
+	var orderDate time.Time
+	if o.GetOrderDate() != nil {
+		orderDate = o.GetOrderDate().AsTime()
+	}
+	return models.InboundOrder{
+		Id:             int(o.GetId()),
+		OrderNumber:    o.GetOrderNumber(),
+		OrderDate:      orderDate,
+		EmployeeId:     int(o.GetEmployeeId()),
+		ProductBatchId: int(o.GetProductBatchId()),
+		WarehouseId:    int(o.GetWarehouseId()),
+	}
+}
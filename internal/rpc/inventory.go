@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	pb "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// watchBuffer is the number of changes a stream may fall behind before it is ended
+const watchBuffer = 256
+
+// InventoryServer streams the changes of the product batches and sections
+type InventoryServer struct {
+	pb.UnimplementedInventoryServiceServer
+	broker *events.Broker
+}
+
+func (s *InventoryServer) WatchChanges(req *pb.WatchChangesRequest, stream grpc.ServerStreamingServer[pb.WatchChangesResponse]) error {
+	if req.GetSectionId() < 0 {
+		return ErrInvalidId
+	}
+	resources := make(map[pb.Resource]bool, len(req.GetResources()))
+	for _, resource := range req.GetResources() {
+		resources[resource] = true
+	}
+
+	changes, unsubscribe := s.broker.Subscribe(watchBuffer)
+	defer unsubscribe()
+
+	// headers are sent right away so that the client knows the subscription is active
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "the stream fell too far behind the changes, watch them again")
+			}
+			res := changeToProto(change)
+			if res == nil || (len(resources) > 0 && !resources[res.GetResource()]) || !inSection(res, req.GetSectionId()) {
+				continue
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// inSection reports whether a change concerns the section, every change does when sectionId is zero
+func inSection(res *pb.WatchChangesResponse, sectionId int64) bool {
+	switch {
+	case sectionId == 0:
+		return true
+	case res.GetProductBatch() != nil:
+		return res.GetProductBatch().GetSectionId() == sectionId
+	default:
+		return res.GetSection().GetId() == sectionId
+	}
+}
+
+// changeToProto converts a change of a product batch or a section, and returns nil for any other change
+func changeToProto(change events.Change) *pb.WatchChangesResponse {
+	res := &pb.WatchChangesResponse{OccurredAt: timestamppb.New(change.OccurredAt)}
+	switch change.Kind {
+	case events.KindCreated:
+		res.Kind = pb.ChangeKind_CHANGE_KIND_CREATED
+	case events.KindUpdated:
+		res.Kind = pb.ChangeKind_CHANGE_KIND_UPDATED
+	case events.KindDeleted:
+		res.Kind = pb.ChangeKind_CHANGE_KIND_DELETED
+	}
+
+	switch entity := change.Entity.(type) {
+	case models.ProductBatch:
+		res.Resource = pb.Resource_RESOURCE_PRODUCT_BATCH
+		res.Entity = &pb.WatchChangesResponse_ProductBatch{ProductBatch: productBatchToProto(entity)}
+	case models.Section:
+		res.Resource = pb.Resource_RESOURCE_SECTION
+		res.Entity = &pb.WatchChangesResponse_Section{Section: sectionToProto(entity)}
+	default:
+		return nil
+	}
+	return res
+}
+
+func sectionToProto(s models.Section) *pb.Section {
+	return &pb.Section{
+		Id:                 int64(s.Id),
+		SectionNumber:      s.SectionNumber,
+		CurrentTemperature: s.CurrentTemperature,
+		MinimumTemperature: s.MinimumTemperature,
+		CurrentCapacity:    int64(s.CurrentCapacity),
+		MinimumCapacity:    int64(s.MinimumCapacity),
+		MaximumCapacity:    int64(s.MaximumCapacity),
+		WarehouseId:        int64(s.WarehouseId),
+		ProductTypeId:      int64(s.ProductTypeId),
+	}
+}
@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	pb "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ProductBatchServer serves the product batches over gRPC
+type ProductBatchServer struct {
+	pb.UnimplementedProductBatchServiceServer
+	sv service.ProductBatchService
+}
+
+func (s *ProductBatchServer) ListProductBatches(ctx context.Context, _ *pb.ListProductBatchesRequest) (*pb.ListProductBatchesResponse, error) {
+	batches, err := s.sv.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListProductBatchesResponse{ProductBatches: mapSlice(batches, productBatchToProto)}, nil
+}
+
+func (s *ProductBatchServer) GetProductBatch(ctx context.Context, req *pb.GetProductBatchRequest) (*pb.ProductBatch, error) {
+	if req.GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	batch, err := s.sv.Retrieve(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return productBatchToProto(batch), nil
+}
+
+func (s *ProductBatchServer) CreateProductBatch(ctx context.Context, req *pb.CreateProductBatchRequest) (*pb.ProductBatch, error) {
+	if req.GetProductBatch() == nil {
+		return nil, ErrMissingEntity
+	}
+	batch := productBatchFromProto(req.GetProductBatch())
+	batch.Id = 0
+	created, err := s.sv.Register(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return productBatchToProto(created), nil
+}
+
+// UpdateProductBatch is not supported, the stock of a batch changes through the stock ledger
+func (s *ProductBatchServer) UpdateProductBatch(context.Context, *pb.UpdateProductBatchRequest) (*pb.ProductBatch, error) {
+	return nil, ErrProductBatchReadOnly
+}
+
+// PatchProductBatch is not supported, the stock of a batch changes through the stock ledger
+func (s *ProductBatchServer) PatchProductBatch(context.Context, *pb.PatchProductBatchRequest) (*pb.ProductBatch, error) {
+	return nil, ErrProductBatchReadOnly
+}
+
+// DeleteProductBatch is not supported, a batch is disposed of instead
+func (s *ProductBatchServer) DeleteProductBatch(context.Context, *pb.DeleteProductBatchRequest) (*emptypb.Empty, error) {
+	return nil, ErrProductBatchReadOnly
+}
+
+func productBatchToProto(b models.ProductBatch) *pb.ProductBatch {
+	return &pb.ProductBatch{
+		Id:                 int64(b.Id),
+		BatchNumber:        int64(b.BatchNumber),
+		CurrentQuantity:    int64(b.CurrentQuantity),
+		CurrentTemperature: b.CurrentTemperature,
+		DueDate:            b.DueDate,
+		InitialQuantity:    int64(b.InitialQuantity),
+		ManufacturingDate:  b.ManufacturingDate,
+		ManufacturingHour:  int64(b.ManufacturingHour),
+		MinimumTemperature: b.MinimumTemperature,
+		SectionId:          int64(b.SectionId),
+		ProductId:          int64(b.ProductId),
+		Status:             b.Status,
+	}
+}
+
+func productBatchFromProto(b *pb.ProductBatch) models.ProductBatch {
+	return models.ProductBatch{
+		Id:                 int(b.GetId()),
+		BatchNumber:        int(b.GetBatchNumber()),
+		CurrentQuantity:    int(b.GetCurrentQuantity()),
+		CurrentTemperature: b.GetCurrentTemperature(),
+		DueDate:            b.GetDueDate(),
+		InitialQuantity:    int(b.GetInitialQuantity()),
+		ManufacturingDate:  b.GetManufacturingDate(),
+		ManufacturingHour:  int(b.GetManufacturingHour()),
+		MinimumTemperature: b.GetMinimumTemperature(),
+		SectionId:          int(b.GetSectionId()),
+		ProductId:          int(b.GetProductId()),
+	}
+}
@@ -0,0 +1,155 @@
+package rpc
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	pb "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var purchaseOrderPatch = patch.NewSchema(models.PurchaseOrder{})
+
+// PurchaseOrderServer serves the purchase orders over gRPC
+type PurchaseOrderServer struct {
+	pb.UnimplementedPurchaseOrderServiceServer
+	sv service.PurchaseOrderService
+}
+
+func (s *PurchaseOrderServer) ListPurchaseOrders(ctx context.Context, _ *pb.ListPurchaseOrdersRequest) (*pb.ListPurchaseOrdersResponse, error) {
+	orders, err := s.sv.RetrieveAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPurchaseOrdersResponse{PurchaseOrders: mapSlice(orders, purchaseOrderToProto)}, nil
+}
+
+func (s *PurchaseOrderServer) ListPurchaseOrdersByBuyer(ctx context.Context, req *pb.ListPurchaseOrdersByBuyerRequest) (*pb.ListPurchaseOrdersByBuyerResponse, error) {
+	if req.GetBuyerId() < 1 {
+		return nil, ErrInvalidId
+	}
+	orders, err := s.sv.RetrieveByBuyer(ctx, int(req.GetBuyerId()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPurchaseOrdersByBuyerResponse{PurchaseOrders: mapSlice(orders, purchaseOrderToProto)}, nil
+}
+
+func (s *PurchaseOrderServer) GetPurchaseOrder(ctx context.Context, req *pb.GetPurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if req.GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	order, err := s.sv.Retrieve(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return purchaseOrderToProto(order), nil
+}
+
+func (s *PurchaseOrderServer) CreatePurchaseOrder(ctx context.Context, req *pb.CreatePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if req.GetPurchaseOrder() == nil {
+		return nil, ErrMissingEntity
+	}
+	order := purchaseOrderFromProto(req.GetPurchaseOrder())
+	order.Id = 0
+	created, err := s.sv.Register(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	return purchaseOrderToProto(created), nil
+}
+
+func (s *PurchaseOrderServer) UpdatePurchaseOrder(ctx context.Context, req *pb.UpdatePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if req.GetPurchaseOrder() == nil {
+		return nil, ErrMissingEntity
+	}
+	if req.GetPurchaseOrder().GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	updated, err := s.sv.Modify(ctx, purchaseOrderFromProto(req.GetPurchaseOrder()))
+	if err != nil {
+		return nil, err
+	}
+	return purchaseOrderToProto(updated), nil
+}
+
+func (s *PurchaseOrderServer) PatchPurchaseOrder(ctx context.Context, req *pb.PatchPurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if req.GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	fields, err := patchFields(req.GetFields(), purchaseOrderPatch)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.sv.PartialModify(ctx, int(req.GetId()), fields)
+	if err != nil {
+		return nil, err
+	}
+	return purchaseOrderToProto(updated), nil
+}
+
+func (s *PurchaseOrderServer) DeletePurchaseOrder(ctx context.Context, req *pb.DeletePurchaseOrderRequest) (*emptypb.Empty, error) {
+	if req.GetId() < 1 {
+		return nil, ErrInvalidId
+	}
+	if err := s.sv.Remove(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func purchaseOrderToProto(o models.PurchaseOrder) *pb.PurchaseOrder {
+	order := &pb.PurchaseOrder{
+		Id:            int64(o.Id),
+		OrderNumber:   o.OrderNumber,
+		OrderDate:     timestamppb.New(o.OrderDate),
+		TracingCode:   o.TracingCode,
+		BuyerId:       int64(o.BuyerID),
+		WarehouseId:   int64(o.WarehouseID),
+		CarrierId:     int64(o.CarrierID),
+		OrderStatusId: int64(o.OrderStatusID),
+	}
+	if o.OrderDetails != nil {
+		order.OrderDetails = mapSlice(*o.OrderDetails, orderDetailToProto)
+	}
+	return order
+}
+
+func purchaseOrderFromProto(o *pb.PurchaseOrder) models.PurchaseOrder {
+	order := models.PurchaseOrder{
+		Id:            int(o.GetId()),
+		OrderNumber:   o.GetOrderNumber(),
+		OrderDate:     o.GetOrderDate().AsTime(),
+		TracingCode:   o.GetTracingCode(),
+		BuyerID:       int(o.GetBuyerId()),
+		WarehouseID:   int(o.GetWarehouseId()),
+		CarrierID:     int(o.GetCarrierId()),
+		OrderStatusID: int(o.GetOrderStatusId()),
+	}
+	details := make([]models.OrderDetail, len(o.GetOrderDetails()))
+	for i, d := range o.GetOrderDetails() {
+		details[i] = models.OrderDetail{
+			Id:               int(d.GetId()),
+			Quantity:         int(d.GetQuantity()),
+			CleanLinesStatus: d.GetCleanLinesStatus(),
+			Temperature:      d.GetTemperature(),
+			ProductRecordID:  int(d.GetProductRecordId()),
+			PurchaseOrderID:  int(d.GetPurchaseOrderId()),
+		}
+	}
+	order.OrderDetails = &details
+	return order
+}
+
+func orderDetailToProto(d models.OrderDetail) *pb.OrderDetail {
+	return &pb.OrderDetail{
+		Id:               int64(d.Id),
+		Quantity:         int64(d.Quantity),
+		CleanLinesStatus: d.CleanLinesStatus,
+		Temperature:      d.Temperature,
+		ProductRecordId:  int64(d.ProductRecordID),
+		PurchaseOrderId:  int64(d.PurchaseOrderID),
+	}
+}
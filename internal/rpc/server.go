@@ -0,0 +1,100 @@
+// Package rpc serves the purchase order, inbound order and product batch services over gRPC, along with a stream of
+// the changes of the inventory, for the internal fulfillment services.
+//
+// The servers call the same service implementations as the REST handlers. The protobuf definitions live in the
+// proto directory and the generated code in pkg/pb, run buf generate from the proto directory after changing them.
+package rpc
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	mw "github.com/miloalej-dev/W17-G1-Bootcamp/internal/middleware"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	pb "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"log"
+	"runtime/debug"
+	"strings"
+)
+
+// ActorMetadataKey is the metadata key that identifies the user recorded in the audit trail, the gRPC counterpart
+// of the X-Actor header
+var ActorMetadataKey = strings.ToLower(mw.ActorHeader)
+
+// Services are the services exposed over gRPC
+type Services struct {
+	PurchaseOrder service.PurchaseOrderService
+	InboundOrder  service.InboundOrderService
+	ProductBatch  service.ProductBatchService
+}
+
+// NewServer returns a gRPC server exposing services, whose inventory stream follows the changes published to broker
+func NewServer(services Services, broker *events.Broker, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(recoveryInterceptor, auditInterceptor, errorInterceptor),
+		grpc.ChainStreamInterceptor(streamRecoveryInterceptor),
+	}, opts...)
+	server := grpc.NewServer(opts...)
+
+	pb.RegisterPurchaseOrderServiceServer(server, &PurchaseOrderServer{sv: services.PurchaseOrder})
+	pb.RegisterInboundOrderServiceServer(server, &InboundOrderServer{sv: services.InboundOrder})
+	pb.RegisterProductBatchServiceServer(server, &ProductBatchServer{sv: services.ProductBatch})
+	pb.RegisterInventoryServiceServer(server, &InventoryServer{broker: broker})
+	reflection.Register(server)
+
+	return server
+}
+
+// recoveryInterceptor logs the panics of the calls and answers them with an internal error, as the Recoverer
+// middleware does for the HTTP requests, instead of letting them take the whole server down
+func recoveryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res any, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = recovered(info.FullMethod, rec)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// streamRecoveryInterceptor is the counterpart of recoveryInterceptor for the streaming calls
+func streamRecoveryInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = recovered(info.FullMethod, rec)
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+// recovered logs the panic of a call along with its stack and returns the status error answered to the client
+func recovered(method string, rec any) error {
+	log.Printf("rpc: panic serving %s: %v\n%s", method, rec, debug.Stack())
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// auditInterceptor stores the actor of the call in its context, as the Audit middleware does for the HTTP requests,
+// so that its mutations are attributed in the audit trail
+func auditInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md := audit.Metadata{Actor: mw.AnonymousActor}
+	if values := metadata.ValueFromIncomingContext(ctx, ActorMetadataKey); len(values) > 0 && values[0] != "" {
+		md.Actor = values[0]
+	}
+
+	return handler(audit.WithMetadata(ctx, md), req)
+}
+
+// errorInterceptor converts the errors of the services into gRPC status errors
+func errorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	res, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,332 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/audit"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	pb "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// batchService keeps the product batches in memory and records the actor of the last mutation
+type batchService struct {
+	service.ProductBatchService
+	mu      sync.Mutex
+	batches map[int]models.ProductBatch
+	nextId  int
+	actor   string
+}
+
+func (s *batchService) RetrieveAll(ctx context.Context) ([]models.ProductBatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batches := make([]models.ProductBatch, 0, len(s.batches))
+	for id := 1; id <= s.nextId; id++ {
+		if batch, ok := s.batches[id]; ok {
+			batches = append(batches, batch)
+		}
+	}
+	return batches, nil
+}
+
+func (s *batchService) Retrieve(ctx context.Context, id int) (models.ProductBatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batch, ok := s.batches[id]
+	if !ok {
+		return models.ProductBatch{}, repository.ErrEntityNotFound
+	}
+	return batch, nil
+}
+
+func (s *batchService) Register(ctx context.Context, batch models.ProductBatch) (models.ProductBatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.batches {
+		if existing.BatchNumber == batch.BatchNumber {
+			return models.ProductBatch{}, repository.ErrProductBatchAlreadyExists
+		}
+	}
+	md, _ := audit.FromContext(ctx)
+	s.actor = md.Actor
+	s.nextId++
+	batch.Id = s.nextId
+	batch.Status = models.ProductBatchReceived
+	s.batches[batch.Id] = batch
+	return batch, nil
+}
+
+type ServerTestSuite struct {
+	suite.Suite
+	batches   *batchService
+	broker    *events.Broker
+	server    *grpc.Server
+	conn      *grpc.ClientConn
+	batch     pb.ProductBatchServiceClient
+	inventory pb.InventoryServiceClient
+}
+
+func (s *ServerTestSuite) SetupTest() {
+	s.batches = &batchService{batches: map[int]models.ProductBatch{}}
+	s.broker = events.NewBroker()
+	s.server = NewServer(Services{ProductBatch: events.NewProductBatchService(s.batches, s.broker)}, s.broker)
+
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	s.conn = conn
+	s.batch = pb.NewProductBatchServiceClient(conn)
+	s.inventory = pb.NewInventoryServiceClient(conn)
+}
+
+func (s *ServerTestSuite) TearDownTest() {
+	_ = s.conn.Close()
+	s.server.Stop()
+}
+
+func newBatch(number int64, sectionId int64) *pb.ProductBatch {
+	return &pb.ProductBatch{
+		BatchNumber:       number,
+		CurrentQuantity:   10,
+		DueDate:           "2025-12-01",
+		InitialQuantity:   10,
+		ManufacturingDate: "2025-06-01",
+		SectionId:         sectionId,
+		ProductId:         1,
+	}
+}
+
+func (s *ServerTestSuite) TestProductBatchLifecycle() {
+	// Arrange
+	ctx := metadata.AppendToOutgoingContext(context.Background(), ActorMetadataKey, "fulfillment")
+
+	// Act
+	created, err := s.batch.CreateProductBatch(ctx, &pb.CreateProductBatchRequest{ProductBatch: newBatch(100, 1)})
+	s.Require().NoError(err)
+	fetched, err := s.batch.GetProductBatch(ctx, &pb.GetProductBatchRequest{Id: created.GetId()})
+	s.Require().NoError(err)
+	list, err := s.batch.ListProductBatches(ctx, &pb.ListProductBatchesRequest{})
+	s.Require().NoError(err)
+
+	// Assert
+	s.Equal(int64(1), created.GetId())
+	s.Equal("fulfillment", s.batches.actor)
+	s.Equal(int64(100), fetched.GetBatchNumber())
+	s.Equal(models.ProductBatchReceived, fetched.GetStatus())
+	s.Len(list.GetProductBatches(), 1)
+}
+
+func (s *ServerTestSuite) TestErrors() {
+	// Arrange
+	ctx := context.Background()
+	_, err := s.batch.CreateProductBatch(ctx, &pb.CreateProductBatchRequest{ProductBatch: newBatch(100, 1)})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		title   string
+		call    func() error
+		code    codes.Code
+		message string
+	}{
+		{
+			title: "not found",
+			call: func() error {
+				_, err := s.batch.GetProductBatch(ctx, &pb.GetProductBatchRequest{Id: 99})
+				return err
+			},
+			code:    codes.NotFound,
+			message: repository.ErrEntityNotFound.Error(),
+		},
+		{
+			title: "already exists",
+			call: func() error {
+				_, err := s.batch.CreateProductBatch(ctx, &pb.CreateProductBatchRequest{ProductBatch: newBatch(100, 1)})
+				return err
+			},
+			code:    codes.AlreadyExists,
+			message: repository.ErrProductBatchAlreadyExists.Error(),
+		},
+		{
+			title: "invalid id",
+			call: func() error {
+				_, err := s.batch.GetProductBatch(ctx, &pb.GetProductBatchRequest{Id: 0})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "invalid ID, must be a positive integer greater than zero",
+		},
+		{
+			title: "missing entity",
+			call: func() error {
+				_, err := s.batch.CreateProductBatch(ctx, &pb.CreateProductBatchRequest{})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "the entity to create or update is required",
+		},
+		{
+			title: "update",
+			call: func() error {
+				batch := newBatch(100, 1)
+				batch.Id = 1
+				_, err := s.batch.UpdateProductBatch(ctx, &pb.UpdateProductBatchRequest{ProductBatch: batch})
+				return err
+			},
+			code:    codes.Unimplemented,
+			message: "product batches cannot be updated nor deleted",
+		},
+		{
+			title: "patch",
+			call: func() error {
+				_, err := s.batch.PatchProductBatch(ctx, &pb.PatchProductBatchRequest{
+					Id:     1,
+					Fields: &structpb.Struct{Fields: map[string]*structpb.Value{"current_quantity": structpb.NewNumberValue(4)}},
+				})
+				return err
+			},
+			code:    codes.Unimplemented,
+			message: "product batches cannot be updated nor deleted",
+		},
+		{
+			title: "delete",
+			call: func() error {
+				_, err := s.batch.DeleteProductBatch(ctx, &pb.DeleteProductBatchRequest{Id: 1})
+				return err
+			},
+			code:    codes.Unimplemented,
+			message: "product batches cannot be updated nor deleted",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.title, func() {
+			// Act
+			err := tc.call()
+
+			// Assert
+			st, ok := status.FromError(err)
+			s.Require().True(ok)
+			s.Equal(tc.code, st.Code())
+			s.Equal(tc.message, st.Message())
+		})
+	}
+}
+
+func (s *ServerTestSuite) TestPatchValidation() {
+	// Act
+	_, err := patchFields(&structpb.Struct{Fields: map[string]*structpb.Value{
+		"current_quantity": structpb.NewStringValue("ten"),
+		"unknown":          structpb.NewNumberValue(1),
+	}}, patch.NewSchema(models.ProductBatch{}))
+
+	// Assert
+	st := status.Convert(toStatus(err))
+	s.Equal(codes.InvalidArgument, st.Code())
+	s.Require().Len(st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	s.Require().True(ok)
+	s.Len(badRequest.GetFieldViolations(), 2)
+	s.Equal("/current_quantity", badRequest.GetFieldViolations()[0].GetField())
+	s.Equal("must be an integer", badRequest.GetFieldViolations()[0].GetDescription())
+}
+
+func (s *ServerTestSuite) TestRecoveryInterceptor() {
+	// Arrange
+	info := &grpc.UnaryServerInfo{FullMethod: "/fulfillment.v1.ProductBatchService/GetProductBatch"}
+	panicking := func(ctx context.Context, req any) (any, error) {
+		panic("nil map")
+	}
+
+	// Act
+	_, err := recoveryInterceptor(context.Background(), nil, info, panicking)
+
+	// Assert
+	st := status.Convert(err)
+	s.Equal(codes.Internal, st.Code())
+	s.Equal("internal server error", st.Message())
+}
+
+func (s *ServerTestSuite) TestWatchChanges() {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := s.inventory.WatchChanges(ctx, &pb.WatchChangesRequest{SectionId: 2})
+	s.Require().NoError(err)
+	_, err = stream.Header()
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.batch.CreateProductBatch(ctx, &pb.CreateProductBatchRequest{ProductBatch: newBatch(100, 1)})
+	s.Require().NoError(err)
+	created, err := s.batch.CreateProductBatch(ctx, &pb.CreateProductBatchRequest{ProductBatch: newBatch(101, 2)})
+	s.Require().NoError(err)
+	s.broker.Publish(events.Change{Resource: events.ResourceSection, Kind: events.KindUpdated, Entity: models.Section{Id: 2, SectionNumber: "S-2"}})
+
+	// Assert
+	change, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Equal(pb.Resource_RESOURCE_PRODUCT_BATCH, change.GetResource())
+	s.Equal(pb.ChangeKind_CHANGE_KIND_CREATED, change.GetKind())
+	s.Equal(created.GetId(), change.GetProductBatch().GetId())
+
+	change, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Equal(pb.Resource_RESOURCE_SECTION, change.GetResource())
+	s.Equal("S-2", change.GetSection().GetSectionNumber())
+}
+
+func TestServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+func TestToStatus(t *testing.T) {
+	testCases := []struct {
+		title string
+		err   error
+		code  codes.Code
+	}{
+		{title: "not found", err: repository.ErrProductNotFound, code: codes.NotFound},
+		{title: "conflict", err: service.ErrEntityAlreadyExists, code: codes.AlreadyExists},
+		{title: "invalid", err: repository.ErrInvalidEntity, code: codes.InvalidArgument},
+		{title: "foreign key", err: repository.ErrForeignKeyViolation, code: codes.FailedPrecondition},
+		{title: "status kept", err: status.Error(codes.Unavailable, "down"), code: codes.Unavailable},
+		{title: "deadline", err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			// Act
+			err := toStatus(tc.err)
+
+			// Assert
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+type AuditService interface {
+	RetrieveAll(ctx context.Context, filter models.AuditFilter) ([]models.AuditLog, error)
+}
@@ -1,14 +1,17 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 // BuyerService is an interface that represents a Buyer Service
 type BuyerService interface {
-	Register(buyer models.Buyer) (models.Buyer, error)
-	RetrieveAll() ([]models.Buyer, error)
-	Retrieve(id int) (models.Buyer, error)
-	Modify(buyer models.Buyer) (models.Buyer, error)
-	PartialModify(id int, fields map[string]any) (models.Buyer, error)
-	Remove(id int) error
-	RetrieveByPurchaseOrderReport(id int) ([]models.BuyerReport, error)
+	Register(ctx context.Context, buyer models.Buyer) (models.Buyer, error)
+	RetrieveAll(ctx context.Context) ([]models.Buyer, error)
+	Retrieve(ctx context.Context, id int) (models.Buyer, error)
+	Modify(ctx context.Context, buyer models.Buyer) (models.Buyer, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Buyer, error)
+	Remove(ctx context.Context, id int) error
+	RetrieveByPurchaseOrderReport(ctx context.Context, id int) ([]models.BuyerReport, error)
 }
@@ -1,14 +1,15 @@
 package service
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
 
 type CarrierService interface {
-	RetrieveAll() ([]models.Carrier, error)
-	Retrieve(id int) (models.Carrier, error)
-	Register(carrier models.Carrier) (models.Carrier, error)
-	Modify(carrier models.Carrier) (models.Carrier, error)
-	PartialModify(id int, fields map[string]any) (models.Carrier, error)
-	Remove(id int) error
+	RetrieveAll(ctx context.Context) ([]models.Carrier, error)
+	Retrieve(ctx context.Context, id int) (models.Carrier, error)
+	Register(ctx context.Context, carrier models.Carrier) (models.Carrier, error)
+	Modify(ctx context.Context, carrier models.Carrier) (models.Carrier, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Carrier, error)
+	Remove(ctx context.Context, id int) error
 }
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// CountSessionService runs the physical counts of the sections. A session is opened for a section, its batches are
+// counted by the employees of the warehouse and, once every batch is counted, it is approved and its variances are
+// posted to the stock ledger as adjustments.
+type CountSessionService interface {
+	RetrieveAll(ctx context.Context) ([]models.CountSession, error)
+	Retrieve(ctx context.Context, id int) (models.CountSession, error)
+	// Open snapshots the quantity of every batch of the section in a new session
+	Open(ctx context.Context, sectionId int) (models.CountSession, error)
+	// RecordCount records the quantity of a batch of an open session counted by an employee, its variance is taken
+	// against the quantity the batch holds at the time. Counting a batch again replaces its previous count.
+	RecordCount(ctx context.Context, id int, productBatchId int, quantity int, employeeId int) (models.CountLine, error)
+	// Approve posts the variances of an open session whose batches were all counted
+	Approve(ctx context.Context, id int) (models.CountSession, error)
+	// RetrieveWarehouseVariances sums the variances of every warehouse, or of the one given
+	RetrieveWarehouseVariances(ctx context.Context, warehouseId *int) ([]models.WarehouseCountVariance, error)
+	// RetrieveEmployeeVariances sums the variances of the batches counted by every employee, or by the one given
+	RetrieveEmployeeVariances(ctx context.Context, employeeId *int) ([]models.EmployeeCountVariance, error)
+}
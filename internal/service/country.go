@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// CountryService is an interface that represents a Country service, it also browses the provinces and the
+// localities of a country and manages its tax rules
+type CountryService interface {
+	RetrieveAll(ctx context.Context) ([]models.Country, error)
+	Retrieve(ctx context.Context, id int) (models.Country, error)
+	Register(ctx context.Context, country models.Country) (models.Country, error)
+	Modify(ctx context.Context, country models.Country) (models.Country, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Country, error)
+	// Remove deletes a country, it returns ErrCountryInUse while the country has provinces
+	Remove(ctx context.Context, id int) error
+	// RetrieveProvinces returns the provinces of a country
+	RetrieveProvinces(ctx context.Context, countryId int) ([]models.Province, error)
+	// RetrieveLocalities returns the localities of a province, which must belong to the country
+	RetrieveLocalities(ctx context.Context, countryId int, provinceId int) ([]models.Locality, error)
+	// RetrieveTaxRules returns the tax rules of a country
+	RetrieveTaxRules(ctx context.Context, countryId int) ([]models.TaxRule, error)
+	// RetrieveTaxRule returns a tax rule, which must belong to the country
+	RetrieveTaxRule(ctx context.Context, countryId int, id int) (models.TaxRule, error)
+	// RegisterTaxRule adds a tax rule to the country of the rule
+	RegisterTaxRule(ctx context.Context, rule models.TaxRule) (models.TaxRule, error)
+	// ModifyTaxRule replaces a tax rule, which must belong to the country of the rule
+	ModifyTaxRule(ctx context.Context, rule models.TaxRule) (models.TaxRule, error)
+	// RemoveTaxRule deletes a tax rule, which must belong to the country
+	RemoveTaxRule(ctx context.Context, countryId int, id int) error
+}
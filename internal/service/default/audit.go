@@ -0,0 +1,20 @@
+package _default
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+type AuditDefault struct {
+	// rp is the repository that will be used by the service
+	rp repository.AuditRepository
+}
+
+func NewAuditDefault(rp repository.AuditRepository) *AuditDefault {
+	return &AuditDefault{rp: rp}
+}
+
+func (s *AuditDefault) RetrieveAll(ctx context.Context, filter models.AuditFilter) ([]models.AuditLog, error) {
+	return s.rp.FindByFilter(ctx, filter)
+}
@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
@@ -17,14 +18,14 @@ type BuyerDefault struct {
 }
 
 // FindAll is a method that returns a map of all buyers
-func (s *BuyerDefault) RetrieveAll() ([]models.Buyer, error) {
-	v, err := s.rp.FindAll()
+func (s *BuyerDefault) RetrieveAll(ctx context.Context) ([]models.Buyer, error) {
+	v, err := s.rp.FindAll(ctx)
 
 	return v, err
 }
 
-func (s *BuyerDefault) Retrieve(id int) (models.Buyer, error) {
-	v, err := s.rp.FindById(id)
+func (s *BuyerDefault) Retrieve(ctx context.Context, id int) (models.Buyer, error) {
+	v, err := s.rp.FindById(ctx, id)
 	if err != nil {
 		return models.Buyer{}, err
 	}
@@ -32,16 +33,16 @@ func (s *BuyerDefault) Retrieve(id int) (models.Buyer, error) {
 
 }
 
-func (s *BuyerDefault) Register(buyer models.Buyer) (models.Buyer, error) {
-	v, err := s.rp.Create(buyer)
+func (s *BuyerDefault) Register(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
+	v, err := s.rp.Create(ctx, buyer)
 	if err != nil {
 		return models.Buyer{}, err
 	}
 	return v, nil
 }
 
-func (s *BuyerDefault) Remove(id int) error {
-	err := s.rp.Delete(id)
+func (s *BuyerDefault) Remove(ctx context.Context, id int) error {
+	err := s.rp.Delete(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -49,8 +50,8 @@ func (s *BuyerDefault) Remove(id int) error {
 
 }
 
-func (s *BuyerDefault) Modify(buyer models.Buyer) (models.Buyer, error) {
-	v, err := s.rp.Update(buyer)
+func (s *BuyerDefault) Modify(ctx context.Context, buyer models.Buyer) (models.Buyer, error) {
+	v, err := s.rp.Update(ctx, buyer)
 	if err != nil {
 		return models.Buyer{}, err
 	}
@@ -58,8 +59,8 @@ func (s *BuyerDefault) Modify(buyer models.Buyer) (models.Buyer, error) {
 	return v, nil
 }
 
-func (s *BuyerDefault) PartialModify(id int, fields map[string]any) (models.Buyer, error) {
-	v, err := s.rp.PartialUpdate(id, fields)
+func (s *BuyerDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Buyer, error) {
+	v, err := s.rp.PartialUpdate(ctx, id, fields)
 	if err != nil {
 		return models.Buyer{}, err
 	}
@@ -67,8 +68,8 @@ func (s *BuyerDefault) PartialModify(id int, fields map[string]any) (models.Buye
 	return v, nil
 }
 
-func (s *BuyerDefault) RetrieveByPurchaseOrderReport(id int) ([]models.BuyerReport, error) {
-	v, err := s.rp.FindByPurchaseOrderReport(id)
+func (s *BuyerDefault) RetrieveByPurchaseOrderReport(ctx context.Context, id int) ([]models.BuyerReport, error) {
+	v, err := s.rp.FindByPurchaseOrderReport(ctx, id)
 	if err != nil {
 		return nil, err
 	}
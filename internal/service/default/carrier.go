@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
@@ -11,12 +12,12 @@ type CarrierDefault struct {
 	rp repository.CarrierRepository
 }
 
-func (s *CarrierDefault) RetrieveAll() ([]models.Carrier, error) {
-	return s.rp.FindAll()
+func (s *CarrierDefault) RetrieveAll(ctx context.Context) ([]models.Carrier, error) {
+	return s.rp.FindAll(ctx)
 }
 
-func (s *CarrierDefault) Retrieve(id int) (models.Carrier, error) {
-	return s.rp.FindById(id)
+func (s *CarrierDefault) Retrieve(ctx context.Context, id int) (models.Carrier, error) {
+	return s.rp.FindById(ctx, id)
 }
 
 // NewCarrierDefault is a function that returns a new instance of CarrierDefault
@@ -24,18 +25,18 @@ func NewCarrierDefault(rp repository.CarrierRepository) *CarrierDefault {
 	return &CarrierDefault{rp: rp}
 }
 
-func (s *CarrierDefault) Register(entity models.Carrier) (models.Carrier, error) {
-	return s.rp.Create(entity)
+func (s *CarrierDefault) Register(ctx context.Context, entity models.Carrier) (models.Carrier, error) {
+	return s.rp.Create(ctx, entity)
 }
 
-func (s *CarrierDefault) Modify(entity models.Carrier) (models.Carrier, error) {
-	return s.rp.Update(entity)
+func (s *CarrierDefault) Modify(ctx context.Context, entity models.Carrier) (models.Carrier, error) {
+	return s.rp.Update(ctx, entity)
 }
 
-func (s *CarrierDefault) PartialModify(id int, fields map[string]interface{}) (models.Carrier, error) {
-	return s.rp.PartialUpdate(id, fields)
+func (s *CarrierDefault) PartialModify(ctx context.Context, id int, fields map[string]interface{}) (models.Carrier, error) {
+	return s.rp.PartialUpdate(ctx, id, fields)
 }
 
-func (s *CarrierDefault) Remove(id int) error {
-	return s.rp.Delete(id)
+func (s *CarrierDefault) Remove(ctx context.Context, id int) error {
+	return s.rp.Delete(ctx, id)
 }
@@ -0,0 +1,120 @@
+package _default
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+type CountSessionDefault struct {
+	// rp is the repository that will be used by the service
+	rp repository.CountSessionRepository
+	// sections is the repository of the sections being counted
+	sections repository.SectionRepository
+	// employees is the repository of the employees counting the sections
+	employees repository.EmployeeRepository
+	// now returns the time the sessions are opened, counted and approved at
+	now func() time.Time
+}
+
+func NewCountSessionDefault(rp repository.CountSessionRepository, sections repository.SectionRepository, employees repository.EmployeeRepository) *CountSessionDefault {
+	return &CountSessionDefault{rp: rp, sections: sections, employees: employees, now: time.Now}
+}
+
+func (s *CountSessionDefault) RetrieveAll(ctx context.Context) ([]models.CountSession, error) {
+	return s.rp.FindAll(ctx)
+}
+
+func (s *CountSessionDefault) Retrieve(ctx context.Context, id int) (models.CountSession, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+func (s *CountSessionDefault) Open(ctx context.Context, sectionId int) (models.CountSession, error) {
+	return s.rp.Create(ctx, models.CountSession{
+		SectionId: sectionId,
+		Status:    models.CountSessionOpen,
+		CreatedAt: s.now().UTC().Truncate(time.Microsecond),
+	})
+}
+
+// RecordCount checks that the batch belongs to the session and that the employee works in the warehouse of the
+// section being counted
+func (s *CountSessionDefault) RecordCount(ctx context.Context, id int, productBatchId int, quantity int, employeeId int) (models.CountLine, error) {
+	if quantity < 0 {
+		return models.CountLine{}, service.ErrInvalidCountedQuantity
+	}
+
+	session, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return models.CountLine{}, err
+	}
+	if session.Status != models.CountSessionOpen {
+		return models.CountLine{}, service.ErrCountSessionClosed
+	}
+
+	var line *models.CountLine
+	for i := range session.Lines {
+		if session.Lines[i].ProductBatchId == productBatchId {
+			line = &session.Lines[i]
+			break
+		}
+	}
+	if line == nil {
+		return models.CountLine{}, service.ErrBatchNotInCountSession
+	}
+
+	section, err := s.sections.FindById(ctx, session.SectionId)
+	if err != nil {
+		return models.CountLine{}, err
+	}
+	employee, err := s.employees.FindById(ctx, employeeId)
+	if err != nil {
+		return models.CountLine{}, err
+	}
+	if employee.WarehouseId != section.WarehouseId {
+		return models.CountLine{}, service.ErrEmployeeNotInWarehouse
+	}
+
+	line.Count(quantity, employee.Id, s.now().UTC().Truncate(time.Microsecond))
+	return s.rp.RecordCount(ctx, *line)
+}
+
+func (s *CountSessionDefault) Approve(ctx context.Context, id int) (models.CountSession, error) {
+	session, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return models.CountSession{}, err
+	}
+	if session.Status != models.CountSessionOpen {
+		return models.CountSession{}, service.ErrCountSessionClosed
+	}
+	for _, line := range session.Lines {
+		if line.CountedQuantity == nil {
+			return models.CountSession{}, service.ErrCountSessionIncomplete
+		}
+	}
+	return s.rp.Approve(ctx, id, s.now().UTC().Truncate(time.Microsecond))
+}
+
+func (s *CountSessionDefault) RetrieveWarehouseVariances(ctx context.Context, warehouseId *int) ([]models.WarehouseCountVariance, error) {
+	if warehouseId == nil {
+		return s.rp.FindAllWarehouseVariances(ctx)
+	}
+	variance, err := s.rp.FindWarehouseVariance(ctx, *warehouseId)
+	if err != nil {
+		return nil, err
+	}
+	return []models.WarehouseCountVariance{variance}, nil
+}
+
+func (s *CountSessionDefault) RetrieveEmployeeVariances(ctx context.Context, employeeId *int) ([]models.EmployeeCountVariance, error) {
+	if employeeId == nil {
+		return s.rp.FindAllEmployeeVariances(ctx)
+	}
+	variance, err := s.rp.FindEmployeeVariance(ctx, *employeeId)
+	if err != nil {
+		return nil, err
+	}
+	return []models.EmployeeCountVariance{variance}, nil
+}
@@ -0,0 +1,151 @@
+package _default
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// employeeStore keeps the employees in memory
+type employeeStore struct {
+	repository.EmployeeRepository
+	employees map[int]models.Employee
+}
+
+func (s *employeeStore) FindById(ctx context.Context, id int) (models.Employee, error) {
+	employee, ok := s.employees[id]
+	if !ok {
+		return models.Employee{}, repository.ErrEntityNotFound
+	}
+	return employee, nil
+}
+
+// countSessionStore keeps the count sessions in memory, approving a session only changes its status
+type countSessionStore struct {
+	repository.CountSessionRepository
+	sessions map[int]models.CountSession
+}
+
+func (s *countSessionStore) FindById(ctx context.Context, id int) (models.CountSession, error) {
+	session, ok := s.sessions[id]
+	if !ok {
+		return models.CountSession{}, repository.ErrEntityNotFound
+	}
+	return session, nil
+}
+
+func (s *countSessionStore) RecordCount(ctx context.Context, line models.CountLine) (models.CountLine, error) {
+	return line, nil
+}
+
+func (s *countSessionStore) Approve(ctx context.Context, id int, at time.Time) (models.CountSession, error) {
+	session := s.sessions[id]
+	session.Status = models.CountSessionApproved
+	session.ApprovedAt = &at
+	return session, nil
+}
+
+func newCountSessionDefault(session models.CountSession) *CountSessionDefault {
+	sections := &sectionStore{sections: map[int]models.Section{4: {Id: 4, WarehouseId: 1}}}
+	employees := &employeeStore{employees: map[int]models.Employee{
+		2: {Id: 2, WarehouseId: 1},
+		7: {Id: 7, WarehouseId: 2},
+	}}
+	sv := NewCountSessionDefault(&countSessionStore{sessions: map[int]models.CountSession{session.Id: session}}, sections, employees)
+	sv.now = func() time.Time { return time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC) }
+	return sv
+}
+
+func TestCountSessionDefault_RecordCount(t *testing.T) {
+	open := models.CountSession{Id: 5, SectionId: 4, Status: models.CountSessionOpen, Lines: []models.CountLine{
+		{Id: 1, CountSessionId: 5, ProductBatchId: 3, ExpectedQuantity: 200},
+	}}
+	approved := open
+	approved.Status = models.CountSessionApproved
+
+	tests := []struct {
+		title          string
+		session        models.CountSession
+		productBatchId int
+		quantity       int
+		employeeId     int
+		expectedError  error
+	}{
+		{title: "Success", session: open, productBatchId: 3, quantity: 194, employeeId: 2},
+		{title: "Error - Negative quantity", session: open, productBatchId: 3, quantity: -1, employeeId: 2, expectedError: service.ErrInvalidCountedQuantity},
+		{title: "Error - Session approved", session: approved, productBatchId: 3, quantity: 194, employeeId: 2, expectedError: service.ErrCountSessionClosed},
+		{title: "Error - Batch not in session", session: open, productBatchId: 8, quantity: 194, employeeId: 2, expectedError: service.ErrBatchNotInCountSession},
+		{title: "Error - Employee of another warehouse", session: open, productBatchId: 3, quantity: 194, employeeId: 7, expectedError: service.ErrEmployeeNotInWarehouse},
+		{title: "Error - Employee not found", session: open, productBatchId: 3, quantity: 194, employeeId: 9, expectedError: repository.ErrEntityNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv := newCountSessionDefault(tt.session)
+
+			// Act
+			line, err := sv.RecordCount(context.Background(), 5, tt.productBatchId, tt.quantity, tt.employeeId)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 194, *line.CountedQuantity)
+			require.Equal(t, -6, *line.Variance)
+			require.Equal(t, 2, *line.EmployeeId)
+			require.Equal(t, sv.now(), *line.CountedAt)
+		})
+	}
+}
+
+func TestCountSessionDefault_Approve(t *testing.T) {
+	counted := 150
+
+	tests := []struct {
+		title         string
+		session       models.CountSession
+		expectedError error
+	}{
+		{
+			title:   "Success",
+			session: models.CountSession{Id: 5, Status: models.CountSessionOpen, Lines: []models.CountLine{{ProductBatchId: 3, CountedQuantity: &counted}}},
+		},
+		{
+			title:         "Error - Batch not counted",
+			session:       models.CountSession{Id: 5, Status: models.CountSessionOpen, Lines: []models.CountLine{{ProductBatchId: 3, CountedQuantity: &counted}, {ProductBatchId: 8}}},
+			expectedError: service.ErrCountSessionIncomplete,
+		},
+		{
+			title:         "Error - Already approved",
+			session:       models.CountSession{Id: 5, Status: models.CountSessionApproved},
+			expectedError: service.ErrCountSessionClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv := newCountSessionDefault(tt.session)
+
+			// Act
+			session, err := sv.Approve(context.Background(), 5)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.CountSessionApproved, session.Status)
+			require.Equal(t, sv.now(), *session.ApprovedAt)
+		})
+	}
+}
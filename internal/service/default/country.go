@@ -0,0 +1,161 @@
+package _default
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"strings"
+)
+
+type CountryDefault struct {
+	// countries is the repository of the countries
+	countries repository.CountryRepository
+	// provinces is the repository of the provinces of the countries
+	provinces repository.ProvinceRepository
+	// localities is the repository of the localities of the provinces
+	localities repository.LocalityRepository
+	// taxRules is the repository of the tax rules of the countries
+	taxRules repository.TaxRuleRepository
+}
+
+func NewCountryDefault(countries repository.CountryRepository, provinces repository.ProvinceRepository, localities repository.LocalityRepository, taxRules repository.TaxRuleRepository) *CountryDefault {
+	return &CountryDefault{countries: countries, provinces: provinces, localities: localities, taxRules: taxRules}
+}
+
+func (s *CountryDefault) RetrieveAll(ctx context.Context) ([]models.Country, error) {
+	return s.countries.FindAll(ctx)
+}
+
+func (s *CountryDefault) Retrieve(ctx context.Context, id int) (models.Country, error) {
+	return s.countries.FindById(ctx, id)
+}
+
+func (s *CountryDefault) Register(ctx context.Context, country models.Country) (models.Country, error) {
+	if strings.TrimSpace(country.Country) == "" {
+		return models.Country{}, service.ErrInvalidCountryName
+	}
+	return s.countries.Create(ctx, country)
+}
+
+func (s *CountryDefault) Modify(ctx context.Context, country models.Country) (models.Country, error) {
+	if strings.TrimSpace(country.Country) == "" {
+		return models.Country{}, service.ErrInvalidCountryName
+	}
+	return s.countries.Update(ctx, country)
+}
+
+// PartialModify applies the fields to the country and validates the result before saving it
+func (s *CountryDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Country, error) {
+	country, err := s.countries.FindById(ctx, id)
+	if err != nil {
+		return models.Country{}, err
+	}
+	if err = patch.Apply(&country, fields); err != nil {
+		return models.Country{}, err
+	}
+	country.Id = id
+	return s.Modify(ctx, country)
+}
+
+func (s *CountryDefault) Remove(ctx context.Context, id int) error {
+	if _, err := s.countries.FindById(ctx, id); err != nil {
+		return err
+	}
+	provinces, err := s.provinces.FindByCountry(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(provinces) > 0 {
+		return service.ErrCountryInUse
+	}
+	err = s.countries.Delete(ctx, id)
+	if errors.Is(err, repository.ErrForeignKeyViolation) {
+		return service.ErrCountryInUse
+	}
+	return err
+}
+
+func (s *CountryDefault) RetrieveProvinces(ctx context.Context, countryId int) ([]models.Province, error) {
+	if _, err := s.countries.FindById(ctx, countryId); err != nil {
+		return nil, err
+	}
+	return s.provinces.FindByCountry(ctx, countryId)
+}
+
+// RetrieveLocalities returns ErrEntityNotFound when the province belongs to another country, like a missing one
+func (s *CountryDefault) RetrieveLocalities(ctx context.Context, countryId int, provinceId int) ([]models.Locality, error) {
+	if _, err := s.countries.FindById(ctx, countryId); err != nil {
+		return nil, err
+	}
+	province, err := s.provinces.FindById(ctx, provinceId)
+	if err != nil {
+		return nil, err
+	}
+	if province.CountryId != countryId {
+		return nil, repository.ErrEntityNotFound
+	}
+	return s.localities.FindByProvince(ctx, provinceId)
+}
+
+func (s *CountryDefault) RetrieveTaxRules(ctx context.Context, countryId int) ([]models.TaxRule, error) {
+	if _, err := s.countries.FindById(ctx, countryId); err != nil {
+		return nil, err
+	}
+	return s.taxRules.FindByCountry(ctx, countryId)
+}
+
+// RetrieveTaxRule returns ErrEntityNotFound when the tax rule belongs to another country, like a missing one
+func (s *CountryDefault) RetrieveTaxRule(ctx context.Context, countryId int, id int) (models.TaxRule, error) {
+	if _, err := s.countries.FindById(ctx, countryId); err != nil {
+		return models.TaxRule{}, err
+	}
+	rule, err := s.taxRules.FindById(ctx, id)
+	if err != nil {
+		return models.TaxRule{}, err
+	}
+	if rule.CountryId != countryId {
+		return models.TaxRule{}, repository.ErrEntityNotFound
+	}
+	return rule, nil
+}
+
+func (s *CountryDefault) RegisterTaxRule(ctx context.Context, rule models.TaxRule) (models.TaxRule, error) {
+	if err := validateTaxRule(rule); err != nil {
+		return models.TaxRule{}, err
+	}
+	if _, err := s.countries.FindById(ctx, rule.CountryId); err != nil {
+		return models.TaxRule{}, err
+	}
+	return s.taxRules.Create(ctx, rule)
+}
+
+func (s *CountryDefault) ModifyTaxRule(ctx context.Context, rule models.TaxRule) (models.TaxRule, error) {
+	if err := validateTaxRule(rule); err != nil {
+		return models.TaxRule{}, err
+	}
+	if _, err := s.RetrieveTaxRule(ctx, rule.CountryId, rule.Id); err != nil {
+		return models.TaxRule{}, err
+	}
+	return s.taxRules.Update(ctx, rule)
+}
+
+func (s *CountryDefault) RemoveTaxRule(ctx context.Context, countryId int, id int) error {
+	if _, err := s.RetrieveTaxRule(ctx, countryId, id); err != nil {
+		return err
+	}
+	return s.taxRules.Delete(ctx, id)
+}
+
+// validateTaxRule checks the tax rule has a name and its rate is a percentage
+func validateTaxRule(rule models.TaxRule) error {
+	if strings.TrimSpace(rule.Name) == "" {
+		return service.ErrInvalidTaxRuleName
+	}
+	if rule.Rate < 0 || rule.Rate > 100 {
+		return service.ErrInvalidTaxRate
+	}
+	return nil
+}
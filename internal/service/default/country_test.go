@@ -0,0 +1,181 @@
+package _default
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// countryStore keeps the countries in memory
+type countryStore struct {
+	repository.CountryRepository
+	countries map[int]models.Country
+}
+
+func (s *countryStore) FindById(ctx context.Context, id int) (models.Country, error) {
+	country, ok := s.countries[id]
+	if !ok {
+		return models.Country{}, repository.ErrEntityNotFound
+	}
+	return country, nil
+}
+
+func (s *countryStore) Delete(ctx context.Context, id int) error {
+	delete(s.countries, id)
+	return nil
+}
+
+// provinceStore keeps the provinces in memory
+type provinceStore struct {
+	repository.ProvinceRepository
+	provinces map[int]models.Province
+}
+
+func (s *provinceStore) FindById(ctx context.Context, id int) (models.Province, error) {
+	province, ok := s.provinces[id]
+	if !ok {
+		return models.Province{}, repository.ErrEntityNotFound
+	}
+	return province, nil
+}
+
+func (s *provinceStore) FindByCountry(ctx context.Context, countryId int) ([]models.Province, error) {
+	var provinces []models.Province
+	for _, province := range s.provinces {
+		if province.CountryId == countryId {
+			provinces = append(provinces, province)
+		}
+	}
+	return provinces, nil
+}
+
+// localityStore keeps the localities in memory
+type localityStore struct {
+	repository.LocalityRepository
+	localities []models.Locality
+}
+
+func (s *localityStore) FindById(ctx context.Context, id int) (models.Locality, error) {
+	for _, locality := range s.localities {
+		if locality.Id == id {
+			return locality, nil
+		}
+	}
+	return models.Locality{}, repository.ErrEntityNotFound
+}
+
+func (s *localityStore) FindByProvince(ctx context.Context, provinceId int) ([]models.Locality, error) {
+	var localities []models.Locality
+	for _, locality := range s.localities {
+		if locality.ProvinceId == provinceId {
+			localities = append(localities, locality)
+		}
+	}
+	return localities, nil
+}
+
+func newCountryDefault() *CountryDefault {
+	return NewCountryDefault(
+		&countryStore{countries: map[int]models.Country{1: {Id: 1, Country: "Argentina"}, 2: {Id: 2, Country: "Colombia"}, 4: {Id: 4, Country: "Chile"}}},
+		&provinceStore{provinces: map[int]models.Province{1: {Id: 1, Province: "Buenos Aires", CountryId: 1}, 5: {Id: 5, Province: "Atlántico", CountryId: 2}}},
+		&localityStore{localities: []models.Locality{{Id: 1, Locality: "La Plata", ProvinceId: 1}, {Id: 2, Locality: "Barranquilla", ProvinceId: 5}}},
+		&taxRuleStore{rules: []models.TaxRule{{Id: 1, CountryId: 1, Name: "IVA", Rate: 21}, {Id: 2, CountryId: 2, Name: "IVA", Rate: 19}}},
+	)
+}
+
+func TestCountryDefault_RetrieveLocalities(t *testing.T) {
+	tests := []struct {
+		title         string
+		countryId     int
+		provinceId    int
+		expected      []models.Locality
+		expectedError error
+	}{
+		{title: "Success - Province of the country", countryId: 1, provinceId: 1, expected: []models.Locality{{Id: 1, Locality: "La Plata", ProvinceId: 1}}},
+		{title: "Error - Province of another country", countryId: 1, provinceId: 5, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Missing country", countryId: 3, provinceId: 1, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Missing province", countryId: 1, provinceId: 9, expectedError: repository.ErrEntityNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv := newCountryDefault()
+
+			// Act
+			localities, err := sv.RetrieveLocalities(context.Background(), tt.countryId, tt.provinceId)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expected, localities)
+		})
+	}
+}
+
+func TestCountryDefault_Remove(t *testing.T) {
+	// Arrange
+	sv := newCountryDefault()
+
+	// Act
+	inUseErr := sv.Remove(context.Background(), 1)
+	missingErr := sv.Remove(context.Background(), 3)
+	err := sv.Remove(context.Background(), 4)
+
+	// Assert
+	require.ErrorIs(t, inUseErr, service.ErrCountryInUse)
+	require.ErrorIs(t, missingErr, repository.ErrEntityNotFound)
+	require.NoError(t, err)
+	_, err = sv.Retrieve(context.Background(), 4)
+	require.ErrorIs(t, err, repository.ErrEntityNotFound)
+}
+
+func TestCountryDefault_ModifyTaxRule(t *testing.T) {
+	tests := []struct {
+		title         string
+		rule          models.TaxRule
+		expected      models.TaxRule
+		expectedError error
+	}{
+		{title: "Success", rule: models.TaxRule{Id: 1, CountryId: 1, Name: "IVA reducido", Rate: 10.5}, expected: models.TaxRule{Id: 1, CountryId: 1, Name: "IVA reducido", Rate: 10.5}},
+		{title: "Error - Rule of another country", rule: models.TaxRule{Id: 2, CountryId: 1, Name: "IVA", Rate: 19}, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Missing country", rule: models.TaxRule{Id: 1, CountryId: 3, Name: "IVA", Rate: 21}, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Blank name", rule: models.TaxRule{Id: 1, CountryId: 1, Name: " ", Rate: 21}, expectedError: service.ErrInvalidTaxRuleName},
+		{title: "Error - Rate above 100", rule: models.TaxRule{Id: 1, CountryId: 1, Name: "IVA", Rate: 121}, expectedError: service.ErrInvalidTaxRate},
+		{title: "Error - Negative rate", rule: models.TaxRule{Id: 1, CountryId: 1, Name: "IVA", Rate: -1}, expectedError: service.ErrInvalidTaxRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv := newCountryDefault()
+
+			// Act
+			rule, err := sv.ModifyTaxRule(context.Background(), tt.rule)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expected, rule)
+		})
+	}
+}
+
+func TestCountryDefault_RemoveTaxRule(t *testing.T) {
+	// Arrange
+	sv := newCountryDefault()
+
+	// Act
+	otherCountryErr := sv.RemoveTaxRule(context.Background(), 1, 2)
+	err := sv.RemoveTaxRule(context.Background(), 1, 1)
+
+	// Assert
+	require.ErrorIs(t, otherCountryErr, repository.ErrEntityNotFound)
+	require.NoError(t, err)
+	_, err = sv.RetrieveTaxRule(context.Background(), 1, 1)
+	require.ErrorIs(t, err, repository.ErrEntityNotFound)
+	_, err = sv.RetrieveTaxRule(context.Background(), 2, 2)
+	require.NoError(t, err)
+}
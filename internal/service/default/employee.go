@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -14,40 +15,40 @@ func NewEmployeeService(repo repository.EmployeeRepository) *EmployeeService {
 	return &EmployeeService{rp: repo}
 }
 
-func (sv *EmployeeService) RetrieveAll() (e []models.Employee, err error) {
-	e, err = sv.rp.FindAll()
+func (sv *EmployeeService) RetrieveAll(ctx context.Context) (e []models.Employee, err error) {
+	e, err = sv.rp.FindAll(ctx)
 	return
 }
 
-func (sv *EmployeeService) Retrieve(id int) (models.Employee, error) {
-	return sv.rp.FindById(id)
+func (sv *EmployeeService) Retrieve(ctx context.Context, id int) (models.Employee, error) {
+	return sv.rp.FindById(ctx, id)
 }
 
-func (sv *EmployeeService) Register(emp models.Employee) (models.Employee, error) {
-	return sv.rp.Create(emp)
+func (sv *EmployeeService) Register(ctx context.Context, emp models.Employee) (models.Employee, error) {
+	return sv.rp.Create(ctx, emp)
 }
 
-func (sv *EmployeeService) Modify(emp models.Employee) (models.Employee, error) {
-	return sv.rp.Update(emp)
+func (sv *EmployeeService) Modify(ctx context.Context, emp models.Employee) (models.Employee, error) {
+	return sv.rp.Update(ctx, emp)
 }
 
-func (sv *EmployeeService) PartialModify(id int, fields map[string]interface{}) (models.Employee, error) {
-	return sv.rp.PartialUpdate(id, fields)
+func (sv *EmployeeService) PartialModify(ctx context.Context, id int, fields map[string]interface{}) (models.Employee, error) {
+	return sv.rp.PartialUpdate(ctx, id, fields)
 }
 
-func (sv *EmployeeService) Remove(id int) error {
-	return sv.rp.Delete(id)
+func (sv *EmployeeService) Remove(ctx context.Context, id int) error {
+	return sv.rp.Delete(ctx, id)
 }
 
-func (sv *EmployeeService) RetrieveInboundOrdersReport() ([]models.EmployeeInboundOrdersReport, error) {
-	return sv.rp.InboundOrdersReport()
+func (sv *EmployeeService) RetrieveInboundOrdersReport(ctx context.Context) ([]models.EmployeeInboundOrdersReport, error) {
+	return sv.rp.InboundOrdersReport(ctx)
 }
 
-func (sv *EmployeeService) RetrieveInboundOrdersReportById(id int) (models.EmployeeInboundOrdersReport, error) {
+func (sv *EmployeeService) RetrieveInboundOrdersReportById(ctx context.Context, id int) (models.EmployeeInboundOrdersReport, error) {
 
 	if id < 1 {
 		return models.EmployeeInboundOrdersReport{}, errors.New("id must be greater than 0")
 	}
 
-	return sv.rp.InboundOrdersReportById(id)
+	return sv.rp.InboundOrdersReportById(ctx, id)
 }
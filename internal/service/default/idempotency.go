@@ -0,0 +1,109 @@
+package _default
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+const (
+	// DefaultIdempotencyTTL is how long a stored response can be replayed
+	DefaultIdempotencyTTL = 24 * time.Hour
+	// maxIdempotencyKeyLength is the size of the idempotency_key column
+	maxIdempotencyKeyLength = 255
+)
+
+type IdempotencyDefault struct {
+	// rp is the repository that will be used by the service
+	rp repository.IdempotencyRepository
+	// ttl is how long a key is kept before it can be reused
+	ttl time.Duration
+	// now returns the current time
+	now func() time.Time
+}
+
+func NewIdempotencyDefault(rp repository.IdempotencyRepository, ttl time.Duration) *IdempotencyDefault {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyTTL
+	}
+	return &IdempotencyDefault{rp: rp, ttl: ttl, now: time.Now}
+}
+
+func (s *IdempotencyDefault) Begin(ctx context.Context, key string, method string, path string, body []byte) (models.IdempotencyKey, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return models.IdempotencyKey{}, service.ErrInvalidIdempotencyKey
+	}
+	hash := requestHash(method, path, body)
+
+	stored, err := s.rp.FindByKey(ctx, key)
+	switch {
+	case err == nil && s.now().Sub(stored.CreatedAt) > s.ttl:
+		// The key expired, it can be claimed by a new request
+		if err = s.rp.Delete(ctx, stored.Id); err != nil && !errors.Is(err, repository.ErrEntityNotFound) {
+			return models.IdempotencyKey{}, err
+		}
+	case err == nil:
+		return s.match(stored, hash)
+	case !errors.Is(err, repository.ErrEntityNotFound):
+		return models.IdempotencyKey{}, err
+	}
+
+	record, err := s.rp.Create(ctx, models.IdempotencyKey{
+		Key:         key,
+		Method:      method,
+		Path:        path,
+		RequestHash: hash,
+		CreatedAt:   s.now(),
+	})
+	if errors.Is(err, repository.ErrEntityAlreadyExists) {
+		// Another request claimed the key in the meantime
+		stored, err = s.rp.FindByKey(ctx, key)
+		if err != nil {
+			return models.IdempotencyKey{}, err
+		}
+		return s.match(stored, hash)
+	}
+	return record, err
+}
+
+func (s *IdempotencyDefault) Complete(ctx context.Context, record models.IdempotencyKey, statusCode int, contentType string, body []byte) (models.IdempotencyKey, error) {
+	record.StatusCode = statusCode
+	record.ContentType = contentType
+	record.Response = body
+	return s.rp.Update(ctx, record)
+}
+
+func (s *IdempotencyDefault) Release(ctx context.Context, record models.IdempotencyKey) error {
+	err := s.rp.Delete(ctx, record.Id)
+	if errors.Is(err, repository.ErrEntityNotFound) {
+		return nil
+	}
+	return err
+}
+
+// match checks a stored record against the hash of the incoming request
+func (s *IdempotencyDefault) match(stored models.IdempotencyKey, hash string) (models.IdempotencyKey, error) {
+	if stored.RequestHash != hash {
+		return models.IdempotencyKey{}, service.ErrIdempotencyKeyMismatch
+	}
+	if !stored.Completed() {
+		return models.IdempotencyKey{}, service.ErrIdempotencyKeyInProgress
+	}
+	return stored, nil
+}
+
+// requestHash fingerprints a request by its method, path and body
+func requestHash(method string, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
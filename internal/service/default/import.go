@@ -0,0 +1,130 @@
+package _default
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"io"
+)
+
+// errImportFailed rolls back an atomic import once one of its rows failed
+var errImportFailed = errors.New("import failed")
+
+// ImportDefault creates entities in bulk through the same services used by the single-entity endpoints, so every
+// row goes through the same business rules
+type ImportDefault struct {
+	tx repository.Transactor
+}
+
+func NewImportDefault(tx repository.Transactor) *ImportDefault {
+	return &ImportDefault{tx: tx}
+}
+
+// Import creates the entity of every row. In atomic mode all rows share a single transaction that is rolled back as
+// soon as the import ends with a failed row; in best-effort mode each row is committed on its own.
+func (s *ImportDefault) Import(ctx context.Context, resource string, mode string, rows service.ImportRowReader) (models.ImportReport, error) {
+	report := models.ImportReport{Resource: resource, Mode: mode, Rows: make([]models.ImportRowResult, 0)}
+
+	var err error
+	switch mode {
+	case models.ImportModeAtomic:
+		err = s.tx.Transaction(ctx, func(repos repository.Repositories) error {
+			if err := s.importRows(&report, rows, func(entity any) (int, error) {
+				return s.register(ctx, repos, entity)
+			}); err != nil {
+				return err
+			}
+			if report.Failed > 0 {
+				return errImportFailed
+			}
+			return nil
+		})
+		if errors.Is(err, errImportFailed) {
+			s.rollBack(&report)
+			err = nil
+		}
+		report.Committed = err == nil && report.Failed == 0
+	case models.ImportModeBestEffort:
+		err = s.importRows(&report, rows, func(entity any) (id int, err error) {
+			err = s.tx.Transaction(ctx, func(repos repository.Repositories) error {
+				id, err = s.register(ctx, repos, entity)
+				return err
+			})
+			return id, err
+		})
+		report.Committed = err == nil
+	default:
+		return models.ImportReport{}, service.ErrInvalidImportMode
+	}
+
+	if err != nil {
+		return models.ImportReport{}, err
+	}
+	if report.Total == 0 {
+		return models.ImportReport{}, service.ErrEmptyImport
+	}
+	return report, nil
+}
+
+// importRows reads the rows until the end of the stream and creates each valid one with create
+func (s *ImportDefault) importRows(report *models.ImportReport, rows service.ImportRowReader, create func(entity any) (int, error)) error {
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		report.Total++
+		result := models.ImportRowResult{Line: row.Line, Status: models.ImportStatusCreated}
+		if row.Err == nil {
+			var id int
+			id, row.Err = create(row.Entity)
+			result.Id = &id
+		}
+		if row.Err != nil {
+			result.Id = nil
+			result.Status = models.ImportStatusFailed
+			result.Error = row.Err.Error()
+			report.Failed++
+		} else {
+			report.Created++
+		}
+		report.Rows = append(report.Rows, result)
+	}
+}
+
+// rollBack marks the rows created by a rolled back atomic import as discarded
+func (s *ImportDefault) rollBack(report *models.ImportReport) {
+	for i := range report.Rows {
+		if report.Rows[i].Status == models.ImportStatusCreated {
+			report.Rows[i].Status = models.ImportStatusRolledBack
+			report.Rows[i].Id = nil
+		}
+	}
+	report.Created = 0
+}
+
+// register creates the entity with the service of its type and returns its ID
+func (s *ImportDefault) register(ctx context.Context, repos repository.Repositories, entity any) (int, error) {
+	switch e := entity.(type) {
+	case models.Product:
+		created, err := NewProductDefault(repos.Product).Register(ctx, e)
+		return created.Id, err
+	case models.Seller:
+		created, err := NewSellerService(repos.Seller).Register(ctx, e)
+		return created.Id, err
+	case models.LocalityDoc:
+		created, err := NewLocalityService(repos.Locality).RegisterWithNames(ctx, e)
+		return created.Id, err
+	case models.ProductBatch:
+		created, err := NewProductBatchDefault(repos.ProductBatch).Register(ctx, e)
+		return created.Id, err
+	default:
+		return 0, service.ErrUnsupportedImportEntity
+	}
+}
@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 	"time"
@@ -14,31 +15,31 @@ func NewInboundOrderService(inboundOrderRepository repository.InboundOrderReposi
 	return &InboundOrderService{InboundOrderRepository: inboundOrderRepository}
 }
 
-func (i *InboundOrderService) RetrieveAll() ([]models.InboundOrder, error) {
-	return i.FindAll()
+func (i *InboundOrderService) RetrieveAll(ctx context.Context) ([]models.InboundOrder, error) {
+	return i.FindAll(ctx)
 }
 
-func (i *InboundOrderService) Retrieve(id int) (models.InboundOrder, error) {
-	return i.FindById(id)
+func (i *InboundOrderService) Retrieve(ctx context.Context, id int) (models.InboundOrder, error) {
+	return i.FindById(ctx, id)
 }
 
-func (i *InboundOrderService) Register(inboundOrder models.InboundOrder) (models.InboundOrder, error) {
+func (i *InboundOrderService) Register(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error) {
 
 	if inboundOrder.OrderDate.IsZero() {
 		inboundOrder.OrderDate = time.Now()
 	}
 
-	return i.Create(inboundOrder)
+	return i.Create(ctx, inboundOrder)
 }
 
-func (i *InboundOrderService) Modify(inboundOrder models.InboundOrder) (models.InboundOrder, error) {
-	return i.Update(inboundOrder)
+func (i *InboundOrderService) Modify(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error) {
+	return i.Update(ctx, inboundOrder)
 }
 
-func (i *InboundOrderService) PartialModify(id int, fields map[string]any) (models.InboundOrder, error) {
-	return i.PartialUpdate(id, fields)
+func (i *InboundOrderService) PartialModify(ctx context.Context, id int, fields map[string]any) (models.InboundOrder, error) {
+	return i.PartialUpdate(ctx, id, fields)
 }
 
-func (i *InboundOrderService) Remove(id int) error {
-	return i.Delete(id)
+func (i *InboundOrderService) Remove(ctx context.Context, id int) error {
+	return i.Delete(ctx, id)
 }
@@ -0,0 +1,126 @@
+package _default
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+type InvoiceDefault struct {
+	// orders is the repository of the purchase orders being priced
+	orders repository.PurchaseOrderRepository
+	// details is the repository of the lines of the purchase orders
+	details repository.OrderDetailRepository
+	// buyers is the repository of the buyers billed
+	buyers repository.BuyerRepository
+	// warehouses, localities, provinces and countries resolve the country the taxes are charged in
+	warehouses repository.WarehouseRepository
+	localities repository.LocalityRepository
+	provinces  repository.ProvinceRepository
+	countries  repository.CountryRepository
+	// taxRules is the repository of the tax rules of every country
+	taxRules repository.TaxRuleRepository
+}
+
+func NewInvoiceDefault(
+	orders repository.PurchaseOrderRepository,
+	details repository.OrderDetailRepository,
+	buyers repository.BuyerRepository,
+	warehouses repository.WarehouseRepository,
+	localities repository.LocalityRepository,
+	provinces repository.ProvinceRepository,
+	countries repository.CountryRepository,
+	taxRules repository.TaxRuleRepository,
+) *InvoiceDefault {
+	return &InvoiceDefault{
+		orders:     orders,
+		details:    details,
+		buyers:     buyers,
+		warehouses: warehouses,
+		localities: localities,
+		provinces:  provinces,
+		countries:  countries,
+		taxRules:   taxRules,
+	}
+}
+
+func (s *InvoiceDefault) RetrievePricedOrder(ctx context.Context, id int) (models.PricedPurchaseOrder, error) {
+	order, err := s.orders.FindById(ctx, id)
+	if err != nil {
+		return models.PricedPurchaseOrder{}, err
+	}
+	warehouse, err := s.warehouses.FindById(ctx, order.WarehouseID)
+	if err != nil {
+		return models.PricedPurchaseOrder{}, err
+	}
+	country, err := s.findCountry(ctx, warehouse)
+	if err != nil {
+		return models.PricedPurchaseOrder{}, err
+	}
+	pricing, err := s.price(ctx, order, country)
+	if err != nil {
+		return models.PricedPurchaseOrder{}, err
+	}
+	return models.NewPricedPurchaseOrder(order, pricing), nil
+}
+
+// RetrieveInvoice bills the purchase order as it is priced now, the invoice number is derived from the order ID and
+// the invoice is issued at the order date so the same order is always billed under the same number and date
+func (s *InvoiceDefault) RetrieveInvoice(ctx context.Context, id int) (models.Invoice, error) {
+	order, err := s.orders.FindById(ctx, id)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	buyer, err := s.buyers.FindById(ctx, order.BuyerID)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	warehouse, err := s.warehouses.FindById(ctx, order.WarehouseID)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	country, err := s.findCountry(ctx, warehouse)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	pricing, err := s.price(ctx, order, country)
+	if err != nil {
+		return models.Invoice{}, err
+	}
+	return models.Invoice{
+		Number:               models.InvoiceNumber(order.Id),
+		IssuedAt:             order.OrderDate,
+		OrderNumber:          order.OrderNumber,
+		OrderDate:            order.OrderDate,
+		TracingCode:          order.TracingCode,
+		Buyer:                buyer,
+		Warehouse:            warehouse,
+		Country:              country,
+		PurchaseOrderPricing: pricing,
+	}, nil
+}
+
+// findCountry resolves the country of a warehouse through its locality and province
+func (s *InvoiceDefault) findCountry(ctx context.Context, warehouse models.Warehouse) (models.Country, error) {
+	locality, err := s.localities.FindById(ctx, warehouse.LocalityId)
+	if err != nil {
+		return models.Country{}, err
+	}
+	province, err := s.provinces.FindById(ctx, locality.ProvinceId)
+	if err != nil {
+		return models.Country{}, err
+	}
+	return s.countries.FindById(ctx, province.CountryId)
+}
+
+func (s *InvoiceDefault) price(ctx context.Context, order models.PurchaseOrder, country models.Country) (models.PurchaseOrderPricing, error) {
+	lines, err := s.details.FindPricedByPurchaseOrder(ctx, order.Id)
+	if err != nil {
+		return models.PurchaseOrderPricing{}, err
+	}
+	rules, err := s.taxRules.FindByCountry(ctx, country.Id)
+	if err != nil {
+		return models.PurchaseOrderPricing{}, err
+	}
+	return models.NewPurchaseOrderPricing(lines, rules), nil
+}
@@ -0,0 +1,176 @@
+package _default
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// buyerStore keeps the buyers in memory
+type buyerStore struct {
+	repository.BuyerRepository
+	buyers map[int]models.Buyer
+}
+
+func (s *buyerStore) FindById(ctx context.Context, id int) (models.Buyer, error) {
+	buyer, ok := s.buyers[id]
+	if !ok {
+		return models.Buyer{}, repository.ErrEntityNotFound
+	}
+	return buyer, nil
+}
+
+// warehouseStore keeps the warehouses in memory
+type warehouseStore struct {
+	repository.WarehouseRepository
+	warehouses map[int]models.Warehouse
+}
+
+func (s *warehouseStore) FindById(ctx context.Context, id int) (models.Warehouse, error) {
+	warehouse, ok := s.warehouses[id]
+	if !ok {
+		return models.Warehouse{}, repository.ErrEntityNotFound
+	}
+	return warehouse, nil
+}
+
+// taxRuleStore keeps the tax rules in memory
+type taxRuleStore struct {
+	repository.TaxRuleRepository
+	rules []models.TaxRule
+}
+
+func (s *taxRuleStore) FindByCountry(ctx context.Context, countryId int) ([]models.TaxRule, error) {
+	rules := make([]models.TaxRule, 0)
+	for _, rule := range s.rules {
+		if rule.CountryId == countryId {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (s *taxRuleStore) FindById(ctx context.Context, id int) (models.TaxRule, error) {
+	for _, rule := range s.rules {
+		if rule.Id == id {
+			return rule, nil
+		}
+	}
+	return models.TaxRule{}, repository.ErrEntityNotFound
+}
+
+func (s *taxRuleStore) Update(ctx context.Context, rule models.TaxRule) (models.TaxRule, error) {
+	for i := range s.rules {
+		if s.rules[i].Id == rule.Id {
+			s.rules[i] = rule
+		}
+	}
+	return rule, nil
+}
+
+func (s *taxRuleStore) Delete(ctx context.Context, id int) error {
+	s.rules = slices.DeleteFunc(s.rules, func(rule models.TaxRule) bool { return rule.Id == id })
+	return nil
+}
+
+func newInvoiceFixture() *InvoiceDefault {
+	orderDate := time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC)
+	orders := &purchaseOrderStore{orders: map[int]models.PurchaseOrder{
+		1: {Id: 1, OrderNumber: "PO-001", OrderDate: orderDate, TracingCode: "TR-001", BuyerID: 1, WarehouseID: 1, OrderStatusID: models.OrderStatusPending},
+		2: {Id: 2, OrderNumber: "PO-002", OrderDate: orderDate, TracingCode: "TR-002", BuyerID: 1, WarehouseID: 2, OrderStatusID: models.OrderStatusDelivered},
+	}}
+	details := &orderDetailStore{
+		details: map[int]models.OrderDetail{
+			1: {Id: 1, Quantity: 2, ProductRecordID: 1, PurchaseOrderID: 1},
+			2: {Id: 2, Quantity: 6, ProductRecordID: 2, PurchaseOrderID: 1},
+			3: {Id: 3, Quantity: 4, ProductRecordID: 1, PurchaseOrderID: 2},
+		},
+		nextId: 3,
+	}
+	return NewInvoiceDefault(
+		orders,
+		details,
+		&buyerStore{buyers: map[int]models.Buyer{1: {Id: 1, CardNumberId: "B-1", FirstName: "Ana", LastName: "Pérez"}}},
+		&warehouseStore{warehouses: map[int]models.Warehouse{1: {Id: 1, WarehouseCode: "W-AR", LocalityId: 1}, 2: {Id: 2, WarehouseCode: "W-CO", LocalityId: 2}}},
+		&localityStore{localities: []models.Locality{{Id: 1, Locality: "La Plata", ProvinceId: 1}, {Id: 2, Locality: "Barranquilla", ProvinceId: 5}}},
+		&provinceStore{provinces: map[int]models.Province{1: {Id: 1, Province: "Buenos Aires", CountryId: 1}, 5: {Id: 5, Province: "Atlántico", CountryId: 2}}},
+		&countryStore{countries: map[int]models.Country{1: {Id: 1, Country: "Argentina"}, 2: {Id: 2, Country: "Colombia"}}},
+		&taxRuleStore{rules: []models.TaxRule{{Id: 1, CountryId: 1, Name: "IVA", Rate: 21}, {Id: 2, CountryId: 2, Name: "IVA", Rate: 19}}},
+	)
+}
+
+func TestInvoiceDefault_RetrievePricedOrder(t *testing.T) {
+	tests := []struct {
+		title            string
+		id               int
+		expectedSubtotal float64
+		expectedTax      float64
+		expectedTotal    float64
+		expectedError    error
+	}{
+		{title: "Success - Taxed in Argentina", id: 1, expectedSubtotal: 20, expectedTax: 4.2, expectedTotal: 24.2},
+		{title: "Success - Taxed in Colombia", id: 2, expectedSubtotal: 10, expectedTax: 1.9, expectedTotal: 11.9},
+		{title: "Error - Order not found", id: 9, expectedError: repository.ErrEntityNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv := newInvoiceFixture()
+
+			// Act
+			order, err := sv.RetrievePricedOrder(context.Background(), tt.id)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.id, order.Id)
+			require.NotEmpty(t, order.Lines)
+			require.Equal(t, tt.expectedSubtotal, order.Subtotal)
+			require.Equal(t, tt.expectedTax, order.TaxTotal)
+			require.Equal(t, tt.expectedTotal, order.Total)
+		})
+	}
+}
+
+func TestInvoiceDefault_RetrieveInvoice(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// Arrange
+		sv := newInvoiceFixture()
+
+		// Act
+		invoice, err := sv.RetrieveInvoice(context.Background(), 1)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, "INV-00000001", invoice.Number)
+		require.Equal(t, time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC), invoice.IssuedAt)
+		require.Equal(t, "PO-001", invoice.OrderNumber)
+		require.Equal(t, "Ana", invoice.Buyer.FirstName)
+		require.Equal(t, "W-AR", invoice.Warehouse.WarehouseCode)
+		require.Equal(t, "Argentina", invoice.Country.Country)
+		require.Len(t, invoice.Lines, 2)
+		require.Equal(t, 15.0, invoice.Lines[1].Amount)
+		require.Equal(t, []models.PurchaseOrderTax{{Name: "IVA", Rate: 21, Amount: 4.2}}, invoice.Taxes)
+		require.Equal(t, 24.2, invoice.Total)
+	})
+
+	t.Run("Error - Order not found", func(t *testing.T) {
+		// Arrange
+		sv := newInvoiceFixture()
+
+		// Act
+		_, err := sv.RetrieveInvoice(context.Background(), 9)
+
+		// Assert
+		require.ErrorIs(t, err, repository.ErrEntityNotFound)
+	})
+}
@@ -1,8 +1,13 @@
 package _default
 
 import (
+	"context"
+	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"strings"
 )
 
 type LocalityService struct {
@@ -13,45 +18,67 @@ func NewLocalityService(rp repository.LocalityRepository) *LocalityService {
 	return &LocalityService{rp}
 }
 
-func (l LocalityService) RetrieveAll() ([]models.Locality, error) {
-	localities, err := l.rp.FindAll()
+func (l LocalityService) RetrieveAll(ctx context.Context) ([]models.Locality, error) {
+	localities, err := l.rp.FindAll(ctx)
 	return localities, err
 }
 
-func (l LocalityService) Retrieve(id int) (models.Locality, error) {
-	return l.rp.FindById(id)
+func (l LocalityService) Retrieve(ctx context.Context, id int) (models.Locality, error) {
+	return l.rp.FindById(ctx, id)
 }
-func (l LocalityService) RetrieveLocalityBySeller(id int) (models.LocalitySellerCount, error) {
-	return l.rp.FindLocalityBySeller(id)
+func (l LocalityService) RetrieveLocalityBySeller(ctx context.Context, id int) (models.LocalitySellerCount, error) {
+	return l.rp.FindLocalityBySeller(ctx, id)
 }
-func (l LocalityService) Register(locality models.Locality) (models.Locality, error) {
-	return l.rp.Create(locality)
+func (l LocalityService) Register(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	return l.rp.Create(ctx, locality)
 }
 
-func (l LocalityService) Modify(locality models.Locality) (models.Locality, error) {
-	return l.rp.Update(locality)
+func (l LocalityService) Modify(ctx context.Context, locality models.Locality) (models.Locality, error) {
+	return l.rp.Update(ctx, locality)
 }
 
-func (l LocalityService) PartialModify(id int, fields map[string]any) (models.Locality, error) {
-	return l.rp.PartialUpdate(id, fields)
+// PartialModify applies the fields to the locality before saving it, the fields are keyed by JSON name which differs
+// from the column names
+func (l LocalityService) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Locality, error) {
+	locality, err := l.rp.FindById(ctx, id)
+	if err != nil {
+		return models.Locality{}, err
+	}
+	if err = patch.Apply(&locality, fields); err != nil {
+		return models.Locality{}, err
+	}
+	locality.Id = id
+	return l.rp.Update(ctx, locality)
 }
 
-func (l LocalityService) Remove(id int) error {
-	return l.rp.Delete(id)
+func (l LocalityService) Remove(ctx context.Context, id int) error {
+	err := l.rp.Delete(ctx, id)
+	if errors.Is(err, repository.ErrForeignKeyViolation) {
+		return service.ErrLocalityInUse
+	}
+	return err
 }
 
-func (l LocalityService) RetrieveCarriers() ([]models.LocalityCarrierCount, error) {
-	return l.rp.FindAllCarriers()
+func (l LocalityService) RetrieveCarriers(ctx context.Context) ([]models.LocalityCarrierCount, error) {
+	return l.rp.FindAllCarriers(ctx)
 }
 
-func (l LocalityService) RetrieveCarriersByLocality(id int) ([]models.LocalityCarrierCount, error) {
-	return l.rp.FindCarriersByLocality(id)
+func (l LocalityService) RetrieveCarriersByLocality(ctx context.Context, id int) ([]models.LocalityCarrierCount, error) {
+	return l.rp.FindCarriersByLocality(ctx, id)
 }
 
-func (l LocalityService) RetrieveAllLocalitiesBySeller() ([]models.LocalitySellerCount, error) {
-	return l.rp.FindAllLocality()
+func (l LocalityService) RetrieveAllLocalitiesBySeller(ctx context.Context) ([]models.LocalitySellerCount, error) {
+	return l.rp.FindAllLocality(ctx)
 }
 
-func (l LocalityService) RegisterWithNames(locality models.LocalityDoc) (models.LocalityDoc, error) {
-	return l.rp.CreateWithNames(locality)
+func (l LocalityService) RegisterWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error) {
+	return l.rp.CreateWithNames(ctx, locality)
+}
+
+func (l LocalityService) SearchByName(ctx context.Context, name string) ([]models.LocalityDoc, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, service.ErrEmptyLocalitySearch
+	}
+	return l.rp.SearchByName(ctx, name)
 }
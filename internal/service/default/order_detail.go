@@ -1,34 +1,215 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
 
 type OrderDetailDefault struct {
 	// rp is the repository that will be used by the service
 	rp repository.OrderDetailRepository
+	// orders is the repository of the purchase orders the lines belong to
+	orders repository.PurchaseOrderRepository
+	// batches, sections and records tell which product a picked batch holds and where it is stored
+	batches  repository.ProductBatchRepository
+	sections repository.SectionRepository
+	records  repository.ProductRecordRepository
 }
 
-func NewOrderDetailDefault(rp repository.OrderDetailRepository) *OrderDetailDefault {
-	return &OrderDetailDefault{rp: rp}
+func NewOrderDetailDefault(
+	rp repository.OrderDetailRepository,
+	orders repository.PurchaseOrderRepository,
+	batches repository.ProductBatchRepository,
+	sections repository.SectionRepository,
+	records repository.ProductRecordRepository,
+) *OrderDetailDefault {
+	return &OrderDetailDefault{rp: rp, orders: orders, batches: batches, sections: sections, records: records}
 }
 
-func (s *OrderDetailDefault) FindAll() (v []models.OrderDetail, err error) {
-	return s.rp.FindAll()
+func (s *OrderDetailDefault) RetrieveAll(ctx context.Context) (v []models.OrderDetail, err error) {
+	return s.rp.FindAll(ctx)
 }
 
-func (s *OrderDetailDefault) FindByID(id int) (models.OrderDetail, error) {
-	return s.rp.FindById(id)
+func (s *OrderDetailDefault) RetrieveByPurchaseOrders(ctx context.Context, purchaseOrderIds []int) ([]models.OrderDetail, error) {
+	return s.rp.FindByPurchaseOrders(ctx, purchaseOrderIds)
 }
 
-func (s *OrderDetailDefault) Create(ss models.OrderDetail) (models.OrderDetail, error) {
+func (s *OrderDetailDefault) Retrieve(ctx context.Context, id int) (models.OrderDetail, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+func (s *OrderDetailDefault) Register(ctx context.Context, ss models.OrderDetail) (models.OrderDetail, error) {
+
+	return s.rp.Create(ctx, ss)
+}
+func (s *OrderDetailDefault) Modify(ctx context.Context, ss models.OrderDetail) (models.OrderDetail, error) {
+	return s.rp.Update(ctx, ss)
+}
+func (s *OrderDetailDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.OrderDetail, error) {
+	return s.rp.PartialUpdate(ctx, id, fields)
+}
+func (s *OrderDetailDefault) Remove(ctx context.Context, id int) error {
+	return s.rp.Delete(ctx, id)
+}
+
+// RetrieveLines retrieves the lines of the purchase order, the totals are always computed from the current lines
+func (s *OrderDetailDefault) RetrieveLines(ctx context.Context, purchaseOrderId int) (models.PurchaseOrderLines, error) {
+	if _, err := s.orders.FindById(ctx, purchaseOrderId); err != nil {
+		return models.PurchaseOrderLines{}, err
+	}
+	details, err := s.rp.FindByPurchaseOrder(ctx, purchaseOrderId)
+	if err != nil {
+		return models.PurchaseOrderLines{}, err
+	}
+	totals, err := s.rp.FindTotals(ctx, purchaseOrderId)
+	if err != nil {
+		return models.PurchaseOrderLines{}, err
+	}
+	return models.PurchaseOrderLines{PurchaseOrderTotals: totals, OrderDetails: details}, nil
+}
 
-	return s.rp.Create(ss)
+func (s *OrderDetailDefault) RetrieveLine(ctx context.Context, purchaseOrderId int, id int) (models.OrderDetail, error) {
+	if _, err := s.orders.FindById(ctx, purchaseOrderId); err != nil {
+		return models.OrderDetail{}, err
+	}
+	return s.findLine(ctx, purchaseOrderId, id)
 }
-func (s *OrderDetailDefault) Update(ss models.OrderDetail) (models.OrderDetail, error) {
-	return s.rp.Update(ss)
+
+func (s *OrderDetailDefault) AddLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error) {
+	if err := s.checkEditable(ctx, purchaseOrderId); err != nil {
+		return models.OrderDetail{}, err
+	}
+	if detail.Quantity < 1 {
+		return models.OrderDetail{}, service.ErrInvalidOrderDetailQuantity
+	}
+	detail.Id = 0
+	detail.PurchaseOrderID = purchaseOrderId
+	return s.rp.Create(ctx, detail)
+}
+
+// ModifyLine modifies a line of a purchase order that was not shipped yet. Once stock was picked for the line its
+// product cannot change and its quantity cannot be lowered below the quantity picked.
+func (s *OrderDetailDefault) ModifyLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error) {
+	if err := s.checkEditable(ctx, purchaseOrderId); err != nil {
+		return models.OrderDetail{}, err
+	}
+	current, err := s.findLine(ctx, purchaseOrderId, detail.Id)
+	if err != nil {
+		return models.OrderDetail{}, err
+	}
+	if detail.Quantity < 1 {
+		return models.OrderDetail{}, service.ErrInvalidOrderDetailQuantity
+	}
+	picks, err := s.rp.FindPicks(ctx, detail.Id)
+	if err != nil {
+		return models.OrderDetail{}, err
+	}
+	picked := 0
+	for _, pick := range picks {
+		picked += pick.Quantity
+	}
+	if picked > 0 && detail.ProductRecordID != current.ProductRecordID {
+		return models.OrderDetail{}, service.ErrOrderDetailProductPicked
+	}
+	if detail.Quantity < picked {
+		return models.OrderDetail{}, service.ErrOrderDetailQuantityBelowPicked
+	}
+	// a line cannot be moved to another order
+	detail.PurchaseOrderID = purchaseOrderId
+	return s.rp.Update(ctx, detail)
 }
-func (s *OrderDetailDefault) Delete(id int) error {
-	return s.rp.Delete(id)
+
+func (s *OrderDetailDefault) RemoveLine(ctx context.Context, purchaseOrderId int, id int) error {
+	if err := s.checkEditable(ctx, purchaseOrderId); err != nil {
+		return err
+	}
+	if _, err := s.findLine(ctx, purchaseOrderId, id); err != nil {
+		return err
+	}
+	picks, err := s.rp.FindPicks(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(picks) > 0 {
+		return service.ErrOrderDetailPicked
+	}
+	return s.rp.Delete(ctx, id)
+}
+
+func (s *OrderDetailDefault) RetrievePicks(ctx context.Context, purchaseOrderId int, id int) ([]models.OrderDetailPick, error) {
+	if _, err := s.orders.FindById(ctx, purchaseOrderId); err != nil {
+		return nil, err
+	}
+	if _, err := s.findLine(ctx, purchaseOrderId, id); err != nil {
+		return nil, err
+	}
+	return s.rp.FindPicks(ctx, id)
+}
+
+func (s *OrderDetailDefault) Pick(ctx context.Context, purchaseOrderId int, id int, pick models.OrderDetailPick) (models.OrderDetailPick, error) {
+	order, err := s.orders.FindById(ctx, purchaseOrderId)
+	if err != nil {
+		return models.OrderDetailPick{}, err
+	}
+	if order.Shipped() {
+		return models.OrderDetailPick{}, service.ErrPurchaseOrderShipped
+	}
+	detail, err := s.findLine(ctx, purchaseOrderId, id)
+	if err != nil {
+		return models.OrderDetailPick{}, err
+	}
+	if pick.Quantity < 1 {
+		return models.OrderDetailPick{}, service.ErrInvalidOrderDetailQuantity
+	}
+
+	batch, err := s.batches.FindById(ctx, pick.ProductBatchId)
+	if err != nil {
+		return models.OrderDetailPick{}, err
+	}
+	if err = checkBatchAvailable(batch); err != nil {
+		return models.OrderDetailPick{}, err
+	}
+	record, err := s.records.FindById(ctx, detail.ProductRecordID)
+	if err != nil {
+		return models.OrderDetailPick{}, err
+	}
+	if batch.ProductId != record.ProductId {
+		return models.OrderDetailPick{}, service.ErrPickedProductMismatch
+	}
+	section, err := s.sections.FindById(ctx, batch.SectionId)
+	if err != nil {
+		return models.OrderDetailPick{}, err
+	}
+	if section.WarehouseId != order.WarehouseID {
+		return models.OrderDetailPick{}, service.ErrBatchNotInOrderWarehouse
+	}
+
+	pick.OrderDetailId = detail.Id
+	return s.rp.CreatePick(ctx, pick)
+}
+
+// checkEditable returns an error unless the purchase order exists and was not shipped yet
+func (s *OrderDetailDefault) checkEditable(ctx context.Context, purchaseOrderId int) error {
+	order, err := s.orders.FindById(ctx, purchaseOrderId)
+	if err != nil {
+		return err
+	}
+	if order.Shipped() {
+		return service.ErrPurchaseOrderShipped
+	}
+	return nil
+}
+
+// findLine retrieves a line, a line of another purchase order is reported as not found
+func (s *OrderDetailDefault) findLine(ctx context.Context, purchaseOrderId int, id int) (models.OrderDetail, error) {
+	detail, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return models.OrderDetail{}, err
+	}
+	if detail.PurchaseOrderID != purchaseOrderId {
+		return models.OrderDetail{}, repository.ErrEntityNotFound
+	}
+	return detail, nil
 }
@@ -0,0 +1,319 @@
+package _default
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// purchaseOrderStore keeps the purchase orders in memory
+type purchaseOrderStore struct {
+	repository.PurchaseOrderRepository
+	orders map[int]models.PurchaseOrder
+}
+
+func (s *purchaseOrderStore) FindById(ctx context.Context, id int) (models.PurchaseOrder, error) {
+	order, ok := s.orders[id]
+	if !ok {
+		return models.PurchaseOrder{}, repository.ErrEntityNotFound
+	}
+	return order, nil
+}
+
+// orderDetailStore keeps the purchase order lines in memory, the totals are computed at a fixed unit price
+type orderDetailStore struct {
+	repository.OrderDetailRepository
+	details map[int]models.OrderDetail
+	nextId  int
+	picks   []models.OrderDetailPick
+}
+
+func (s *orderDetailStore) FindById(ctx context.Context, id int) (models.OrderDetail, error) {
+	detail, ok := s.details[id]
+	if !ok {
+		return models.OrderDetail{}, repository.ErrEntityNotFound
+	}
+	return detail, nil
+}
+
+func (s *orderDetailStore) Create(ctx context.Context, detail models.OrderDetail) (models.OrderDetail, error) {
+	s.nextId++
+	detail.Id = s.nextId
+	s.details[detail.Id] = detail
+	return detail, nil
+}
+
+func (s *orderDetailStore) Update(ctx context.Context, detail models.OrderDetail) (models.OrderDetail, error) {
+	s.details[detail.Id] = detail
+	return detail, nil
+}
+
+func (s *orderDetailStore) Delete(ctx context.Context, id int) error {
+	delete(s.details, id)
+	return nil
+}
+
+func (s *orderDetailStore) FindByPurchaseOrder(ctx context.Context, purchaseOrderId int) ([]models.OrderDetail, error) {
+	details := make([]models.OrderDetail, 0)
+	for id := 1; id <= s.nextId; id++ {
+		if detail, ok := s.details[id]; ok && detail.PurchaseOrderID == purchaseOrderId {
+			details = append(details, detail)
+		}
+	}
+	return details, nil
+}
+
+func (s *orderDetailStore) FindTotals(ctx context.Context, purchaseOrderId int) (models.PurchaseOrderTotals, error) {
+	priced, _ := s.FindPricedByPurchaseOrder(ctx, purchaseOrderId)
+	return models.NewPurchaseOrderTotals(purchaseOrderId, priced), nil
+}
+
+func (s *orderDetailStore) FindPricedByPurchaseOrder(ctx context.Context, purchaseOrderId int) ([]models.PricedOrderDetail, error) {
+	details, _ := s.FindByPurchaseOrder(ctx, purchaseOrderId)
+	priced := make([]models.PricedOrderDetail, len(details))
+	for i, detail := range details {
+		priced[i] = models.PricedOrderDetail{OrderDetail: detail, UnitPrice: 2.5}
+	}
+	return priced, nil
+}
+
+func (s *orderDetailStore) FindPicks(ctx context.Context, orderDetailId int) ([]models.OrderDetailPick, error) {
+	picks := make([]models.OrderDetailPick, 0)
+	for _, pick := range s.picks {
+		if pick.OrderDetailId == orderDetailId {
+			picks = append(picks, pick)
+		}
+	}
+	return picks, nil
+}
+
+func (s *orderDetailStore) CreatePick(ctx context.Context, pick models.OrderDetailPick) (models.OrderDetailPick, error) {
+	picked := 0
+	for _, p := range s.picks {
+		if p.OrderDetailId == pick.OrderDetailId {
+			picked += p.Quantity
+		}
+	}
+	if picked+pick.Quantity > s.details[pick.OrderDetailId].Quantity {
+		return models.OrderDetailPick{}, repository.ErrOrderDetailOverPicked
+	}
+	pick.Id = len(s.picks) + 1
+	s.picks = append(s.picks, pick)
+	return pick, nil
+}
+
+func newOrderDetailFixture() (*OrderDetailDefault, *orderDetailStore) {
+	orders := &purchaseOrderStore{orders: map[int]models.PurchaseOrder{
+		1: {Id: 1, WarehouseID: 1, OrderStatusID: models.OrderStatusPending},
+		2: {Id: 2, WarehouseID: 1, OrderStatusID: models.OrderStatusInTransit},
+		3: {Id: 3, WarehouseID: 1, OrderStatusID: models.OrderStatusDelivered},
+	}}
+	details := &orderDetailStore{
+		details: map[int]models.OrderDetail{
+			1: {Id: 1, Quantity: 2, ProductRecordID: 1, PurchaseOrderID: 1},
+			2: {Id: 2, Quantity: 5, ProductRecordID: 1, PurchaseOrderID: 2},
+		},
+		nextId: 2,
+	}
+	batches := &productBatchStore{batches: map[int]models.ProductBatch{
+		1: {Id: 1, SectionId: 1, ProductId: 1, Status: models.ProductBatchAvailable},
+		2: {Id: 2, SectionId: 1, ProductId: 2, Status: models.ProductBatchAvailable},
+		3: {Id: 3, SectionId: 2, ProductId: 1, Status: models.ProductBatchAvailable},
+		4: {Id: 4, SectionId: 1, ProductId: 1, Status: models.ProductBatchQuarantined},
+	}}
+	sections := &sectionStore{sections: map[int]models.Section{
+		1: {Id: 1, WarehouseId: 1},
+		2: {Id: 2, WarehouseId: 2},
+	}}
+	records := &productRecordStore{records: []models.ProductRecord{{Id: 1, ProductId: 1}}}
+	return NewOrderDetailDefault(details, orders, batches, sections, records), details
+}
+
+func TestOrderDetailDefault_AddLine(t *testing.T) {
+	tests := []struct {
+		title           string
+		purchaseOrderId int
+		quantity        int
+		expectedError   error
+	}{
+		{title: "Success - Pending order", purchaseOrderId: 1, quantity: 3},
+		{title: "Error - Order in transit", purchaseOrderId: 2, quantity: 3, expectedError: service.ErrPurchaseOrderShipped},
+		{title: "Error - Order delivered", purchaseOrderId: 3, quantity: 3, expectedError: service.ErrPurchaseOrderShipped},
+		{title: "Error - Order not found", purchaseOrderId: 9, quantity: 3, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Quantity not positive", purchaseOrderId: 1, quantity: 0, expectedError: service.ErrInvalidOrderDetailQuantity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv, store := newOrderDetailFixture()
+
+			// Act
+			detail, err := sv.AddLine(context.Background(), tt.purchaseOrderId, models.OrderDetail{Id: 7, Quantity: tt.quantity, ProductRecordID: 1, PurchaseOrderID: 5})
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Len(t, store.details, 2)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 3, detail.Id)
+			require.Equal(t, tt.purchaseOrderId, detail.PurchaseOrderID)
+		})
+	}
+}
+
+func TestOrderDetailDefault_ModifyLine(t *testing.T) {
+	tests := []struct {
+		title           string
+		purchaseOrderId int
+		detailId        int
+		quantity        int
+		productRecordId int
+		picked          int
+		expectedError   error
+	}{
+		{title: "Success - Line of the order", purchaseOrderId: 1, detailId: 1, quantity: 9, productRecordId: 1},
+		{title: "Success - Product of a line not picked", purchaseOrderId: 1, detailId: 1, quantity: 9, productRecordId: 2},
+		{title: "Success - Quantity of the picks", purchaseOrderId: 1, detailId: 1, quantity: 2, productRecordId: 1, picked: 2},
+		{title: "Error - Line of another order", purchaseOrderId: 1, detailId: 2, quantity: 9, productRecordId: 1, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Order shipped", purchaseOrderId: 2, detailId: 2, quantity: 9, productRecordId: 1, expectedError: service.ErrPurchaseOrderShipped},
+		{title: "Error - Quantity below the picks", purchaseOrderId: 1, detailId: 1, quantity: 1, productRecordId: 1, picked: 2, expectedError: service.ErrOrderDetailQuantityBelowPicked},
+		{title: "Error - Product of a picked line", purchaseOrderId: 1, detailId: 1, quantity: 9, productRecordId: 2, picked: 1, expectedError: service.ErrOrderDetailProductPicked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv, store := newOrderDetailFixture()
+			if tt.picked > 0 {
+				store.picks = []models.OrderDetailPick{{Id: 1, OrderDetailId: tt.detailId, ProductBatchId: 1, Quantity: tt.picked}}
+			}
+			before := store.details[tt.detailId]
+
+			// Act
+			_, err := sv.ModifyLine(context.Background(), tt.purchaseOrderId, models.OrderDetail{Id: tt.detailId, Quantity: tt.quantity, ProductRecordID: tt.productRecordId})
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Equal(t, before, store.details[tt.detailId])
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.quantity, store.details[tt.detailId].Quantity)
+			require.Equal(t, tt.purchaseOrderId, store.details[tt.detailId].PurchaseOrderID)
+		})
+	}
+}
+
+func TestOrderDetailDefault_RemoveLine(t *testing.T) {
+	// Arrange
+	sv, store := newOrderDetailFixture()
+
+	// Act
+	shippedErr := sv.RemoveLine(context.Background(), 2, 2)
+	err := sv.RemoveLine(context.Background(), 1, 1)
+
+	// Assert
+	require.ErrorIs(t, shippedErr, service.ErrPurchaseOrderShipped)
+	require.NoError(t, err)
+	require.Contains(t, store.details, 2)
+	require.NotContains(t, store.details, 1)
+}
+
+func TestOrderDetailDefault_RemoveLine_Picked(t *testing.T) {
+	// Arrange
+	sv, store := newOrderDetailFixture()
+	store.picks = []models.OrderDetailPick{{Id: 1, OrderDetailId: 1, ProductBatchId: 1, Quantity: 1}}
+
+	// Act
+	err := sv.RemoveLine(context.Background(), 1, 1)
+
+	// Assert
+	require.ErrorIs(t, err, service.ErrOrderDetailPicked)
+	require.Contains(t, store.details, 1)
+}
+
+func TestOrderDetailDefault_RetrieveLines_RecomputesTotals(t *testing.T) {
+	// Arrange
+	sv, _ := newOrderDetailFixture()
+	_, err := sv.AddLine(context.Background(), 1, models.OrderDetail{Quantity: 4, ProductRecordID: 1})
+	require.NoError(t, err)
+
+	// Act
+	lines, err := sv.RetrieveLines(context.Background(), 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, lines.OrderDetails, 2)
+	require.Equal(t, models.PurchaseOrderTotals{PurchaseOrderId: 1, LinesCount: 2, TotalQuantity: 6, TotalAmount: 15}, lines.PurchaseOrderTotals)
+}
+
+func TestOrderDetailDefault_Pick(t *testing.T) {
+	tests := []struct {
+		title           string
+		purchaseOrderId int
+		detailId        int
+		productBatchId  int
+		quantity        int
+		expectedError   error
+	}{
+		{title: "Success - Batch of the product in the warehouse", purchaseOrderId: 1, detailId: 1, productBatchId: 1, quantity: 2},
+		{title: "Error - Order shipped", purchaseOrderId: 2, detailId: 2, productBatchId: 1, quantity: 2, expectedError: service.ErrPurchaseOrderShipped},
+		{title: "Error - Line of another order", purchaseOrderId: 1, detailId: 2, productBatchId: 1, quantity: 2, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Quantity not positive", purchaseOrderId: 1, detailId: 1, productBatchId: 1, quantity: 0, expectedError: service.ErrInvalidOrderDetailQuantity},
+		{title: "Error - Batch not found", purchaseOrderId: 1, detailId: 1, productBatchId: 9, quantity: 2, expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Batch quarantined", purchaseOrderId: 1, detailId: 1, productBatchId: 4, quantity: 2, expectedError: repository.ErrProductBatchNotAvailable},
+		{title: "Error - Batch of another product", purchaseOrderId: 1, detailId: 1, productBatchId: 2, quantity: 2, expectedError: service.ErrPickedProductMismatch},
+		{title: "Error - Batch in another warehouse", purchaseOrderId: 1, detailId: 1, productBatchId: 3, quantity: 2, expectedError: service.ErrBatchNotInOrderWarehouse},
+		{title: "Error - Line over picked", purchaseOrderId: 1, detailId: 1, productBatchId: 1, quantity: 3, expectedError: repository.ErrOrderDetailOverPicked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv, store := newOrderDetailFixture()
+			employeeId := 2
+
+			// Act
+			pick, err := sv.Pick(context.Background(), tt.purchaseOrderId, tt.detailId, models.OrderDetailPick{
+				OrderDetailId:  7,
+				ProductBatchId: tt.productBatchId,
+				Quantity:       tt.quantity,
+				EmployeeId:     &employeeId,
+			})
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, store.picks)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.detailId, pick.OrderDetailId)
+			require.Len(t, store.picks, 1)
+		})
+	}
+}
+
+func TestOrderDetailDefault_RetrievePicks(t *testing.T) {
+	// Arrange
+	sv, _ := newOrderDetailFixture()
+	_, err := sv.Pick(context.Background(), 1, 1, models.OrderDetailPick{ProductBatchId: 1, Quantity: 1})
+	require.NoError(t, err)
+
+	// Act
+	picks, err := sv.RetrievePicks(context.Background(), 1, 1)
+	_, otherErr := sv.RetrievePicks(context.Background(), 1, 2)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []models.OrderDetailPick{{Id: 1, OrderDetailId: 1, ProductBatchId: 1, Quantity: 1}}, picks)
+	require.ErrorIs(t, otherErr, repository.ErrEntityNotFound)
+}
@@ -0,0 +1,23 @@
+package _default
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+type OrderStatusDefault struct {
+	rp repository.OrderStatusRepository
+}
+
+func NewOrderStatusDefault(rp repository.OrderStatusRepository) *OrderStatusDefault {
+	return &OrderStatusDefault{rp: rp}
+}
+
+func (s *OrderStatusDefault) RetrieveAll(ctx context.Context) ([]models.OrderStatus, error) {
+	return s.rp.FindAll(ctx)
+}
+
+func (s *OrderStatusDefault) Retrieve(ctx context.Context, id int) (models.OrderStatus, error) {
+	return s.rp.FindById(ctx, id)
+}
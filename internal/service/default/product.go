@@ -5,6 +5,7 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -26,44 +27,48 @@ type ProductDefault struct {
 
 // RetrieveAll retrieves all products by calling the repository's FindAll method.
 // It directly passes through the results and any error from the repository.
-func (s *ProductDefault) RetrieveAll() (v []models.Product, err error) {
-	return s.rp.FindAll()
+func (s *ProductDefault) RetrieveAll(ctx context.Context) (v []models.Product, err error) {
+	return s.rp.FindAll(ctx)
+}
+
+func (s *ProductDefault) RetrieveByIds(ctx context.Context, ids []int) ([]models.Product, error) {
+	return s.rp.FindByIds(ctx, ids)
 }
 
 // Register attempts to add a new product using the repository.
 // If the repository returns any error, it is replaced with the generic
 // errorProduct.ErrorCreate.
-func (s *ProductDefault) Register(body models.Product) (models.Product, error) {
-	return s.rp.Create(body)
+func (s *ProductDefault) Register(ctx context.Context, body models.Product) (models.Product, error) {
+	return s.rp.Create(ctx, body)
 }
 
 // Retrieve retrieves a single product by its ID from the repository.
 // If the repository returns any error (e.g., not found), it is replaced
 // with the generic errorProduct.ErrorNotFound.
-func (s *ProductDefault) Retrieve(id int) (models.Product, error) {
-	return s.rp.FindById(id)
+func (s *ProductDefault) Retrieve(ctx context.Context, id int) (models.Product, error) {
+	return s.rp.FindById(ctx, id)
 
 }
-func (s *ProductDefault) Modify(body models.Product) (models.Product, error) {
-	return s.rp.Update(body)
+func (s *ProductDefault) Modify(ctx context.Context, body models.Product) (models.Product, error) {
+	return s.rp.Update(ctx, body)
 }
 
-func (s *ProductDefault) PartialModify(id int, fields map[string]any) (models.Product, error) {
-	return s.rp.PartialUpdate(id, fields)
+func (s *ProductDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Product, error) {
+	return s.rp.PartialUpdate(ctx, id, fields)
 
 }
-func (s *ProductDefault) Remove(id int) (err error) {
-	return s.rp.Delete(id)
+func (s *ProductDefault) Remove(ctx context.Context, id int) (err error) {
+	return s.rp.Delete(ctx, id)
 }
 
-func (s *ProductDefault) RetrieveRecordsCountByProductId(id int) (models.ProductReport, error) {
+func (s *ProductDefault) RetrieveRecordsCountByProductId(ctx context.Context, id int) (models.ProductReport, error) {
 
-	if _, err := s.Retrieve(id); err == nil {
-		return s.rp.FindRecordsCountByProductId(id)
+	if _, err := s.Retrieve(ctx, id); err == nil {
+		return s.rp.FindRecordsCountByProductId(ctx, id)
 	}
 	return models.ProductReport{}, service.ErrProductNotFound
 }
 
-func (s *ProductDefault) RetrieveRecordsCount() ([]models.ProductReport, error) {
-	return s.rp.FindRecordsCount()
+func (s *ProductDefault) RetrieveRecordsCount(ctx context.Context) ([]models.ProductReport, error) {
+	return s.rp.FindRecordsCount(ctx)
 }
@@ -5,15 +5,29 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"strings"
+	"time"
 )
 
+// productBatchStatuses are the statuses a batch can be moved to by hand
+var productBatchStatuses = map[string]bool{
+	models.ProductBatchReceived:        true,
+	models.ProductBatchUnderInspection: true,
+	models.ProductBatchAvailable:       true,
+	models.ProductBatchQuarantined:     true,
+	models.ProductBatchExpired:         true,
+	models.ProductBatchDisposed:        true,
+}
+
 // NewProductDefault is a constructor function that creates a new instance of ProductDefault.
 // It takes a ProductRepository as a dependency, promoting loose coupling and testability.
 
 func NewProductBatchDefault(rp repository.ProductBatchRepository) *ProductBatchDefault {
-	return &ProductBatchDefault{rp: rp}
+	return &ProductBatchDefault{rp: rp, now: time.Now}
 }
 
 // ProductDefault is the default concrete implementation of the product service.
@@ -21,39 +35,129 @@ type ProductBatchDefault struct {
 	// rp is the repository dependency. By using an interface, this service
 	// is decoupled from the specific database implementation (e.g., in-memory, SQL).
 	rp repository.ProductBatchRepository
+	// now returns the time the status of the batches is changed at
+	now func() time.Time
 }
 
 // RetrieveAll retrieves all products by calling the repository's FindAll method.
 // It directly passes through the results and any error from the repository.
-func (s *ProductBatchDefault) RetrieveAll() (v []models.ProductBatch, err error) {
-	return s.rp.FindAll()
+func (s *ProductBatchDefault) RetrieveAll(ctx context.Context) (v []models.ProductBatch, err error) {
+	return s.rp.FindAll(ctx)
+}
+
+func (s *ProductBatchDefault) RetrieveBySections(ctx context.Context, sectionIds []int) ([]models.ProductBatch, error) {
+	return s.rp.FindBySections(ctx, sectionIds)
 }
 
 // Register attempts to add a new product using the repository.
 // If the repository returns any error, it is replaced with the generic
 // errorProduct.ErrorCreate.
-func (s *ProductBatchDefault) Register(body models.ProductBatch) (models.ProductBatch, error) {
+func (s *ProductBatchDefault) Register(ctx context.Context, body models.ProductBatch) (models.ProductBatch, error) {
 
 	// Convert hours from string to data base format TIME hours
 	body.ManufacturingHour = body.ManufacturingHour * 100
-	return s.rp.Create(body)
+	// A new batch is inspected before its stock can be sold
+	body.Status = models.ProductBatchReceived
+	return s.rp.Create(ctx, body)
 }
 
 // Retrieve retrieves a single product by its ID from the repository.
 // If the repository returns any error (e.g., not found), it is replaced
 // with the generic errorProduct.ErrorNotFound.
-func (s *ProductBatchDefault) Retrieve(id int) (models.ProductBatch, error) {
-	return s.rp.FindById(id)
+func (s *ProductBatchDefault) Retrieve(ctx context.Context, id int) (models.ProductBatch, error) {
+	return s.rp.FindById(ctx, id)
+
+}
+func (s *ProductBatchDefault) Modify(ctx context.Context, body models.ProductBatch) (models.ProductBatch, error) {
+	return s.rp.Update(ctx, body)
+}
+
+func (s *ProductBatchDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.ProductBatch, error) {
+	return s.rp.PartialUpdate(ctx, id, fields)
+
+}
+func (s *ProductBatchDefault) Remove(ctx context.Context, id int) (err error) {
+	return s.rp.Delete(ctx, id)
+}
 
+// Trace follows a batch through the supply chain, from the seller of its product to the buyers it was shipped to
+func (s *ProductBatchDefault) Trace(ctx context.Context, batchNumber string) (models.BatchTrace, error) {
+	return s.rp.FindTrace(ctx, batchNumber)
 }
-func (s *ProductBatchDefault) Modify(body models.ProductBatch) (models.ProductBatch, error) {
-	return s.rp.Update(body)
+
+// ChangeStatus checks the reason and that the batch can reach the status from its current one, the change is made
+// only if the batch is still in that status. The stock of a disposed batch is posted as a disposal with the reason
+// code, so the waste reports can tell why it was thrown away, the other changes keep no reason code.
+func (s *ProductBatchDefault) ChangeStatus(ctx context.Context, id int, status string, reason string, reasonCode *string, employeeId *int) (models.ProductBatch, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return models.ProductBatch{}, service.ErrProductBatchStatusReasonRequired
+	}
+	if !productBatchStatuses[status] {
+		return models.ProductBatch{}, service.ErrInvalidProductBatchStatus
+	}
+	if status != models.ProductBatchDisposed {
+		reasonCode = nil
+	} else if reasonCode == nil || !disposalReasonCodes[*reasonCode] {
+		return models.ProductBatch{}, service.ErrInvalidDisposalReasonCode
+	}
+
+	batch, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return models.ProductBatch{}, err
+	}
+	if batch.Status == models.ProductBatchRecalled {
+		return models.ProductBatch{}, repository.ErrProductBatchRecalled
+	}
+	if !batch.CanMoveTo(status) {
+		return models.ProductBatch{}, service.ErrInvalidProductBatchStatusChange
+	}
+	// a batch quarantined by a recall stays out of sale until the recall disposes of it or is closed
+	recalled, err := s.rp.InOpenRecall(ctx, batch.Id)
+	if err != nil {
+		return models.ProductBatch{}, err
+	}
+	if recalled {
+		return models.ProductBatch{}, repository.ErrProductBatchRecalled
+	}
+
+	return s.rp.ChangeStatus(ctx, models.ProductBatchStatusChange{
+		ProductBatchId: batch.Id,
+		FromStatus:     batch.Status,
+		ToStatus:       status,
+		Reason:         reason,
+		ReasonCode:     reasonCode,
+		EmployeeId:     employeeId,
+		ChangedAt:      s.now().UTC().Truncate(time.Microsecond),
+	})
 }
 
-func (s *ProductBatchDefault) PartialModify(id int, fields map[string]any) (models.ProductBatch, error) {
-	return s.rp.PartialUpdate(id, fields)
+func (s *ProductBatchDefault) RetrieveStatusChanges(ctx context.Context, id int) ([]models.ProductBatchStatusChange, error) {
+	if _, err := s.rp.FindById(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.rp.FindStatusChanges(ctx, id)
+}
+
+// RetrieveExpiring retrieves the sellable batches with stock left whose due date is on or before until
+func (s *ProductBatchDefault) RetrieveExpiring(ctx context.Context, until time.Time) ([]models.ProductBatch, error) {
+	return s.rp.FindExpiring(ctx, until.UTC().Format(time.DateOnly))
+}
 
+// ExpireDue expires the batches whose due date is before today
+func (s *ProductBatchDefault) ExpireDue(ctx context.Context) ([]models.ProductBatch, error) {
+	now := s.now().UTC()
+	return s.rp.Expire(ctx, now.Format(time.DateOnly), now.Truncate(time.Microsecond))
 }
-func (s *ProductBatchDefault) Remove(id int) (err error) {
-	return s.rp.Delete(id)
+
+// checkBatchAvailable returns an error unless the stock of the batch can be picked and transferred
+func checkBatchAvailable(batch models.ProductBatch) error {
+	switch batch.Status {
+	case models.ProductBatchAvailable:
+		return nil
+	case models.ProductBatchRecalled:
+		return repository.ErrProductBatchRecalled
+	default:
+		return repository.ErrProductBatchNotAvailable
+	}
 }
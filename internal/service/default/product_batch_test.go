@@ -0,0 +1,123 @@
+package _default
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// batchStatusStore keeps the product batches in memory along with the last status change made, the day batches
+// were expired for and the batches of the open recalls
+type batchStatusStore struct {
+	*productBatchStore
+	change   models.ProductBatchStatusChange
+	today    string
+	recalled map[int]bool
+}
+
+func (s *batchStatusStore) InOpenRecall(ctx context.Context, id int) (bool, error) {
+	return s.recalled[id], nil
+}
+
+func (s *batchStatusStore) ChangeStatus(ctx context.Context, change models.ProductBatchStatusChange) (models.ProductBatch, error) {
+	s.change = change
+	batch := s.batches[change.ProductBatchId]
+	batch.Status = change.ToStatus
+	return batch, nil
+}
+
+func (s *batchStatusStore) Expire(ctx context.Context, today string, at time.Time) ([]models.ProductBatch, error) {
+	s.today = today
+	return []models.ProductBatch{}, nil
+}
+
+func newProductBatchDefault(batches ...models.ProductBatch) (*ProductBatchDefault, *batchStatusStore) {
+	store := &batchStatusStore{productBatchStore: &productBatchStore{batches: make(map[int]models.ProductBatch, len(batches))}}
+	for _, batch := range batches {
+		store.batches[batch.Id] = batch
+	}
+	sv := NewProductBatchDefault(store)
+	sv.now = func() time.Time { return time.Date(2025, 6, 10, 23, 30, 0, 0, time.UTC) }
+	return sv, store
+}
+
+func TestProductBatchDefault_ChangeStatus(t *testing.T) {
+	employeeId := 3
+	available := models.ProductBatch{Id: 1, Status: models.ProductBatchAvailable}
+	expired := models.ProductBatch{Id: 2, Status: models.ProductBatchExpired}
+	recalled := models.ProductBatch{Id: 3, Status: models.ProductBatchRecalled}
+	quarantined := models.ProductBatch{Id: 4, Status: models.ProductBatchQuarantined}
+	damaged := models.AdjustmentDamaged
+
+	tests := []struct {
+		title              string
+		id                 int
+		status             string
+		reason             string
+		reasonCode         string
+		expectedReasonCode *string
+		expectedError      error
+	}{
+		{title: "Success", id: 1, status: models.ProductBatchQuarantined, reason: "  Damaged packaging "},
+		{title: "Success - Reason code of a batch kept", id: 1, status: models.ProductBatchQuarantined, reason: "Damaged packaging", reasonCode: models.AdjustmentDamaged},
+		{title: "Success - Disposed", id: 1, status: models.ProductBatchDisposed, reason: "Damaged packaging", reasonCode: models.AdjustmentDamaged, expectedReasonCode: &damaged},
+		{title: "Error - Blank reason", id: 1, status: models.ProductBatchQuarantined, reason: "  ", expectedError: service.ErrProductBatchStatusReasonRequired},
+		{title: "Error - Unknown status", id: 1, status: "sold", reason: "Sold", expectedError: service.ErrInvalidProductBatchStatus},
+		{title: "Error - Recalled by hand", id: 1, status: models.ProductBatchRecalled, reason: "Recalled", expectedError: service.ErrInvalidProductBatchStatus},
+		{title: "Error - Disposed without reason code", id: 1, status: models.ProductBatchDisposed, reason: "Damaged packaging", expectedError: service.ErrInvalidDisposalReasonCode},
+		{title: "Error - Disposed as stolen", id: 1, status: models.ProductBatchDisposed, reason: "Stolen", reasonCode: models.AdjustmentTheft, expectedError: service.ErrInvalidDisposalReasonCode},
+		{title: "Error - Not found", id: 9, status: models.ProductBatchQuarantined, reason: "Damaged", expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Expired made available", id: 2, status: models.ProductBatchAvailable, reason: "Checked", expectedError: service.ErrInvalidProductBatchStatusChange},
+		{title: "Error - Batch recalled", id: 3, status: models.ProductBatchAvailable, reason: "Checked", expectedError: repository.ErrProductBatchRecalled},
+		{title: "Error - Batch quarantined by an open recall", id: 4, status: models.ProductBatchAvailable, reason: "Checked", expectedError: repository.ErrProductBatchRecalled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv, store := newProductBatchDefault(available, expired, recalled, quarantined)
+			store.recalled = map[int]bool{3: true, 4: true}
+			var reasonCode *string
+			if tt.reasonCode != "" {
+				reasonCode = &tt.reasonCode
+			}
+
+			// Act
+			batch, err := sv.ChangeStatus(context.Background(), tt.id, tt.status, tt.reason, reasonCode, &employeeId)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.status, batch.Status)
+			require.Equal(t, models.ProductBatchStatusChange{
+				ProductBatchId: tt.id,
+				FromStatus:     models.ProductBatchAvailable,
+				ToStatus:       tt.status,
+				Reason:         "Damaged packaging",
+				ReasonCode:     tt.expectedReasonCode,
+				EmployeeId:     &employeeId,
+				ChangedAt:      sv.now(),
+			}, store.change)
+		})
+	}
+}
+
+func TestProductBatchDefault_ExpireDue(t *testing.T) {
+	// Arrange
+	sv, store := newProductBatchDefault()
+
+	// Act
+	_, err := sv.ExpireDue(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "2025-06-10", store.today)
+}
@@ -1,38 +1,53 @@
 package _default
 
 import (
+	"context"
 	"errors"
 	"github.com/go-sql-driver/mysql"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
 )
 
-func NewProductRecordDefault(rp repository.ProductRecordRepository) *ProductRecordDefault {
+func NewProductRecordDefault(rp repository.ProductRecordRepository, products repository.ProductRepository) *ProductRecordDefault {
 	return &ProductRecordDefault{
-		rp: rp,
+		rp:       rp,
+		products: products,
+		now:      time.Now,
 	}
 }
 
 type ProductRecordDefault struct {
 	// rp is the repository that will be used by the service
 	rp repository.ProductRecordRepository
+	// products is the repository of the products the records belong to
+	products repository.ProductRepository
+	// now returns the current time
+	now func() time.Time
 }
 
-func (s ProductRecordDefault) RetrieveAll() ([]models.ProductRecord, error) {
-	return s.rp.FindAll()
+func (s ProductRecordDefault) RetrieveAll(ctx context.Context) ([]models.ProductRecord, error) {
+	return s.rp.FindAll(ctx)
 }
 
-func (s ProductRecordDefault) Retrieve(id int) (models.ProductRecord, error) {
-	return s.rp.FindById(id)
+func (s ProductRecordDefault) RetrieveByIds(ctx context.Context, ids []int) ([]models.ProductRecord, error) {
+	return s.rp.FindByIds(ctx, ids)
 }
 
-func (s ProductRecordDefault) Register(productRecord models.ProductRecord) (models.ProductRecord, error) {
+func (s ProductRecordDefault) Retrieve(ctx context.Context, id int) (models.ProductRecord, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+// Register appends the record, the time is set by the server and truncated to the precision of the database
+func (s ProductRecordDefault) Register(ctx context.Context, productRecord models.ProductRecord) (models.ProductRecord, error) {
+	productRecord.Id = 0
+	productRecord.LastUpdate = s.now().Truncate(time.Microsecond)
 
-	value, err := s.rp.Create(productRecord)
+	value, err := s.rp.Create(ctx, productRecord)
 	var mysqlErr *mysql.MySQLError
 	if err != nil {
-		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1452 {
+		if errors.Is(err, repository.ErrForeignKeyViolation) || errors.As(err, &mysqlErr) && mysqlErr.Number == 1452 {
 			return models.ProductRecord{}, service.ErrProductIdConflict
 		}
 		return models.ProductRecord{}, err
@@ -40,14 +55,46 @@ func (s ProductRecordDefault) Register(productRecord models.ProductRecord) (mode
 	return value, nil
 }
 
-func (s ProductRecordDefault) Modify(productRecord models.ProductRecord) (models.ProductRecord, error) {
-	return s.rp.Update(productRecord)
+func (s ProductRecordDefault) RetrieveCurrentPrice(ctx context.Context, productId int) (models.ProductPrice, error) {
+	return s.RetrievePriceAt(ctx, productId, s.now())
 }
 
-func (s ProductRecordDefault) PartialModify(id int, fields map[string]any) (models.ProductRecord, error) {
-	return s.rp.PartialUpdate(id, fields)
+func (s ProductRecordDefault) RetrievePriceAt(ctx context.Context, productId int, at time.Time) (models.ProductPrice, error) {
+	if _, err := s.products.FindById(ctx, productId); err != nil {
+		return models.ProductPrice{}, err
+	}
+	record, err := s.rp.FindAt(ctx, productId, at)
+	if err != nil {
+		return models.ProductPrice{}, err
+	}
+	return models.NewProductPrice(record), nil
 }
 
-func (p ProductRecordDefault) Remove(id int) error {
-	return p.rp.Delete(id)
+// RetrievePriceTrend includes the price already in effect at the start of the range, followed by the records made
+// within it
+func (s ProductRecordDefault) RetrievePriceTrend(ctx context.Context, productId int, from time.Time, to time.Time) (models.ProductPriceTrend, error) {
+	if from.After(to) {
+		return models.ProductPriceTrend{}, service.ErrInvalidDateRange
+	}
+	if _, err := s.products.FindById(ctx, productId); err != nil {
+		return models.ProductPriceTrend{}, err
+	}
+
+	prices := make([]models.ProductPrice, 0)
+	initial, err := s.rp.FindAt(ctx, productId, from)
+	switch {
+	case err == nil && initial.LastUpdate.Before(from):
+		prices = append(prices, models.NewProductPrice(initial))
+	case err != nil && !errors.Is(err, repository.ErrEntityNotFound):
+		return models.ProductPriceTrend{}, err
+	}
+
+	records, err := s.rp.FindBetween(ctx, productId, from, to)
+	if err != nil {
+		return models.ProductPriceTrend{}, err
+	}
+	for _, record := range records {
+		prices = append(prices, models.NewProductPrice(record))
+	}
+	return models.NewProductPriceTrend(productId, from, to, prices), nil
 }
@@ -0,0 +1,173 @@
+package _default
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// productStore keeps the products in memory
+type productStore struct {
+	repository.ProductRepository
+	products map[int]models.Product
+}
+
+func (s *productStore) FindById(ctx context.Context, id int) (models.Product, error) {
+	product, ok := s.products[id]
+	if !ok {
+		return models.Product{}, repository.ErrProductNotFound
+	}
+	return product, nil
+}
+
+// productRecordStore keeps the price history in memory, ordered by time
+type productRecordStore struct {
+	repository.ProductRecordRepository
+	records []models.ProductRecord
+}
+
+func (s *productRecordStore) FindById(ctx context.Context, id int) (models.ProductRecord, error) {
+	for _, record := range s.records {
+		if record.Id == id {
+			return record, nil
+		}
+	}
+	return models.ProductRecord{}, repository.ErrEntityNotFound
+}
+
+func (s *productRecordStore) Create(ctx context.Context, record models.ProductRecord) (models.ProductRecord, error) {
+	record.Id = len(s.records) + 1
+	s.records = append(s.records, record)
+	return record, nil
+}
+
+func (s *productRecordStore) FindAt(ctx context.Context, productId int, at time.Time) (models.ProductRecord, error) {
+	for i := len(s.records) - 1; i >= 0; i-- {
+		if s.records[i].ProductId == productId && !s.records[i].LastUpdate.After(at) {
+			return s.records[i], nil
+		}
+	}
+	return models.ProductRecord{}, repository.ErrEntityNotFound
+}
+
+func (s *productRecordStore) FindBetween(ctx context.Context, productId int, from time.Time, to time.Time) ([]models.ProductRecord, error) {
+	records := make([]models.ProductRecord, 0)
+	for _, record := range s.records {
+		if record.ProductId == productId && !record.LastUpdate.Before(from) && !record.LastUpdate.After(to) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func june(day int) time.Time {
+	return time.Date(2024, 6, day, 0, 0, 0, 0, time.UTC)
+}
+
+func newProductRecordFixture() ProductRecordDefault {
+	records := &productRecordStore{records: []models.ProductRecord{
+		{Id: 1, LastUpdate: june(1), PurchasePrice: 4, SalePrice: 10, ProductId: 1},
+		{Id: 2, LastUpdate: june(10), PurchasePrice: 6, SalePrice: 12, ProductId: 1},
+		{Id: 3, LastUpdate: june(20), PurchasePrice: 5, SalePrice: 20, ProductId: 1},
+	}}
+	products := &productStore{products: map[int]models.Product{1: {Id: 1}, 2: {Id: 2}}}
+	sv := *NewProductRecordDefault(records, products)
+	sv.now = func() time.Time { return june(15) }
+	return sv
+}
+
+func TestProductRecordDefault_Register_SetsTimestamp(t *testing.T) {
+	// Arrange
+	sv := newProductRecordFixture()
+
+	// Act
+	record, err := sv.Register(context.Background(), models.ProductRecord{Id: 9, LastUpdate: june(2), PurchasePrice: 7, SalePrice: 14, ProductId: 1})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 4, record.Id)
+	require.Equal(t, june(15), record.LastUpdate)
+}
+
+func TestProductRecordDefault_RetrievePriceAt(t *testing.T) {
+	tests := []struct {
+		title          string
+		productId      int
+		at             time.Time
+		expectedRecord int
+		expectedMargin float64
+		expectedError  error
+	}{
+		{title: "Success - Between two records", productId: 1, at: june(12), expectedRecord: 2, expectedMargin: 50},
+		{title: "Success - At the instant of a record", productId: 1, at: june(20), expectedRecord: 3, expectedMargin: 75},
+		{title: "Error - Before the first record", productId: 1, at: time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC), expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Product without records", productId: 2, at: june(12), expectedError: repository.ErrEntityNotFound},
+		{title: "Error - Product not found", productId: 3, at: june(12), expectedError: repository.ErrProductNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv := newProductRecordFixture()
+
+			// Act
+			price, err := sv.RetrievePriceAt(context.Background(), tt.productId, tt.at)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRecord, price.ProductRecordId)
+			require.Equal(t, tt.expectedMargin, price.MarginPercentage)
+		})
+	}
+}
+
+func TestProductRecordDefault_RetrieveCurrentPrice(t *testing.T) {
+	// Arrange
+	sv := newProductRecordFixture()
+
+	// Act
+	price, err := sv.RetrieveCurrentPrice(context.Background(), 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 2, price.ProductRecordId)
+}
+
+func TestProductRecordDefault_RetrievePriceTrend(t *testing.T) {
+	t.Run("Success - Includes the price in effect at the start", func(t *testing.T) {
+		// Arrange
+		sv := newProductRecordFixture()
+
+		// Act
+		trend, err := sv.RetrievePriceTrend(context.Background(), 1, june(5), june(30))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, trend.Prices, 3)
+		require.Equal(t, 10.0, trend.MinSalePrice)
+		require.Equal(t, 20.0, trend.MaxSalePrice)
+		require.Equal(t, 14.0, trend.AverageSalePrice)
+		require.Equal(t, 5.0, trend.AveragePurchasePrice)
+		require.Equal(t, 61.67, trend.AverageMarginPercentage)
+	})
+
+	t.Run("Error - From after to", func(t *testing.T) {
+		// Arrange
+		sv := newProductRecordFixture()
+
+		// Act
+		_, err := sv.RetrievePriceTrend(context.Background(), 1, june(30), june(5))
+
+		// Assert
+		require.ErrorIs(t, err, service.ErrInvalidDateRange)
+	})
+}
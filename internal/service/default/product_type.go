@@ -0,0 +1,94 @@
+package _default
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"strings"
+)
+
+type ProductTypeDefault struct {
+	// rp is the repository of the product types
+	rp repository.ProductTypeRepository
+}
+
+func NewProductTypeDefault(rp repository.ProductTypeRepository) *ProductTypeDefault {
+	return &ProductTypeDefault{rp: rp}
+}
+
+func (s *ProductTypeDefault) RetrieveAll(ctx context.Context) ([]models.ProductType, error) {
+	return s.rp.FindAll(ctx)
+}
+
+func (s *ProductTypeDefault) Retrieve(ctx context.Context, id int) (models.ProductType, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+func (s *ProductTypeDefault) Register(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	if err := validateProductType(productType); err != nil {
+		return models.ProductType{}, err
+	}
+	return s.rp.Create(ctx, productType)
+}
+
+func (s *ProductTypeDefault) Modify(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	if err := validateProductType(productType); err != nil {
+		return models.ProductType{}, err
+	}
+	return s.rp.Update(ctx, productType)
+}
+
+// PartialModify applies the fields to the product type and validates the result before saving it
+func (s *ProductTypeDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.ProductType, error) {
+	productType, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return models.ProductType{}, err
+	}
+	if err = patch.Apply(&productType, fields); err != nil {
+		return models.ProductType{}, err
+	}
+	productType.Id = id
+	if err = validateProductType(productType); err != nil {
+		return models.ProductType{}, err
+	}
+	return s.rp.Update(ctx, productType)
+}
+
+// Remove checks the usage of the product type before deleting it. The foreign keys of the products and the sections
+// still reject the deletion when a reference is created in between.
+func (s *ProductTypeDefault) Remove(ctx context.Context, id int) error {
+	usage, err := s.rp.FindUsage(ctx, id)
+	if err != nil {
+		return err
+	}
+	if usage.InUse() {
+		return service.ErrProductTypeInUse
+	}
+	err = s.rp.Delete(ctx, id)
+	if errors.Is(err, repository.ErrForeignKeyViolation) {
+		return service.ErrProductTypeInUse
+	}
+	return err
+}
+
+func (s *ProductTypeDefault) RetrieveUsage(ctx context.Context, id *int) ([]models.ProductTypeUsage, error) {
+	if id == nil {
+		return s.rp.FindAllUsage(ctx)
+	}
+	usage, err := s.rp.FindUsage(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+	return []models.ProductTypeUsage{usage}, nil
+}
+
+// validateProductType checks that the product type has a name
+func validateProductType(productType models.ProductType) error {
+	if strings.TrimSpace(productType.Name) == "" {
+		return service.ErrInvalidProductTypeName
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package _default
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// productTypeStore keeps the product types and the number of references to them in memory
+type productTypeStore struct {
+	repository.ProductTypeRepository
+	productTypes map[int]models.ProductType
+	usages       map[int]models.ProductTypeUsage
+	// deleteErr is returned by Delete, to simulate a reference created after the usage was checked
+	deleteErr error
+}
+
+func (s *productTypeStore) FindById(ctx context.Context, id int) (models.ProductType, error) {
+	productType, ok := s.productTypes[id]
+	if !ok {
+		return models.ProductType{}, repository.ErrEntityNotFound
+	}
+	return productType, nil
+}
+
+func (s *productTypeStore) Update(ctx context.Context, productType models.ProductType) (models.ProductType, error) {
+	s.productTypes[productType.Id] = productType
+	return productType, nil
+}
+
+func (s *productTypeStore) FindUsage(ctx context.Context, id int) (models.ProductTypeUsage, error) {
+	if _, ok := s.productTypes[id]; !ok {
+		return models.ProductTypeUsage{}, repository.ErrEntityNotFound
+	}
+	return s.usages[id], nil
+}
+
+func (s *productTypeStore) Delete(ctx context.Context, id int) error {
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
+	delete(s.productTypes, id)
+	return nil
+}
+
+func TestProductTypeDefault_Remove(t *testing.T) {
+	tests := []struct {
+		title         string
+		usage         models.ProductTypeUsage
+		deleteErr     error
+		id            int
+		expectedError error
+	}{
+		{title: "Success - Unused type", id: 1},
+		{title: "Error - Used by products", usage: models.ProductTypeUsage{ProductsCount: 2}, id: 1, expectedError: service.ErrProductTypeInUse},
+		{title: "Error - Used by sections", usage: models.ProductTypeUsage{SectionsCount: 1}, id: 1, expectedError: service.ErrProductTypeInUse},
+		{title: "Error - Referenced meanwhile", deleteErr: repository.ErrForeignKeyViolation, id: 1, expectedError: service.ErrProductTypeInUse},
+		{title: "Error - Not found", id: 2, expectedError: repository.ErrEntityNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			store := &productTypeStore{
+				productTypes: map[int]models.ProductType{1: {Id: 1, Name: "Fruits"}},
+				usages:       map[int]models.ProductTypeUsage{1: tt.usage},
+				deleteErr:    tt.deleteErr,
+			}
+			sv := NewProductTypeDefault(store)
+
+			// Act
+			err := sv.Remove(context.Background(), tt.id)
+
+			// Assert
+			if tt.expectedError == nil {
+				require.NoError(t, err)
+				require.NotContains(t, store.productTypes, tt.id)
+			} else {
+				require.ErrorIs(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestProductTypeDefault_PartialModify(t *testing.T) {
+	// Arrange
+	store := &productTypeStore{productTypes: map[int]models.ProductType{1: {Id: 1, Name: "Fruits", Description: "Fresh fruits"}}}
+	sv := NewProductTypeDefault(store)
+
+	// Act
+	updated, err := sv.PartialModify(context.Background(), 1, map[string]any{"description": "Fruits stored between 2 and 8 degrees"})
+	_, blankErr := sv.PartialModify(context.Background(), 1, map[string]any{"name": "  "})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, models.ProductType{Id: 1, Name: "Fruits", Description: "Fruits stored between 2 and 8 degrees"}, updated)
+	require.ErrorIs(t, blankErr, service.ErrInvalidProductTypeName)
+	require.Equal(t, "Fruits", store.productTypes[1].Name)
+}
@@ -0,0 +1,75 @@
+package _default
+
+import (
+	"context"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"strings"
+)
+
+type ProvinceDefault struct {
+	// provinces is the repository of the provinces
+	provinces repository.ProvinceRepository
+	// localities is the repository of the localities of the provinces
+	localities repository.LocalityRepository
+}
+
+func NewProvinceDefault(provinces repository.ProvinceRepository, localities repository.LocalityRepository) *ProvinceDefault {
+	return &ProvinceDefault{provinces: provinces, localities: localities}
+}
+
+func (s *ProvinceDefault) RetrieveAll(ctx context.Context) ([]models.Province, error) {
+	return s.provinces.FindAll(ctx)
+}
+
+func (s *ProvinceDefault) Retrieve(ctx context.Context, id int) (models.Province, error) {
+	return s.provinces.FindById(ctx, id)
+}
+
+func (s *ProvinceDefault) Register(ctx context.Context, province models.Province) (models.Province, error) {
+	if strings.TrimSpace(province.Province) == "" {
+		return models.Province{}, service.ErrInvalidProvinceName
+	}
+	return s.provinces.Create(ctx, province)
+}
+
+func (s *ProvinceDefault) Modify(ctx context.Context, province models.Province) (models.Province, error) {
+	if strings.TrimSpace(province.Province) == "" {
+		return models.Province{}, service.ErrInvalidProvinceName
+	}
+	return s.provinces.Update(ctx, province)
+}
+
+// PartialModify applies the fields to the province and validates the result before saving it
+func (s *ProvinceDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Province, error) {
+	province, err := s.provinces.FindById(ctx, id)
+	if err != nil {
+		return models.Province{}, err
+	}
+	if err = patch.Apply(&province, fields); err != nil {
+		return models.Province{}, err
+	}
+	province.Id = id
+	return s.Modify(ctx, province)
+}
+
+func (s *ProvinceDefault) Remove(ctx context.Context, id int) error {
+	if _, err := s.provinces.FindById(ctx, id); err != nil {
+		return err
+	}
+	localities, err := s.localities.FindByProvince(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(localities) > 0 {
+		return service.ErrProvinceInUse
+	}
+	err = s.provinces.Delete(ctx, id)
+	if errors.Is(err, repository.ErrForeignKeyViolation) {
+		return service.ErrProvinceInUse
+	}
+	return err
+}
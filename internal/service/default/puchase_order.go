@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
@@ -14,30 +15,30 @@ func NewPurchaseOrderDefault(rp repository.PurchaseOrderRepository) *PurchaseOrd
 	return &PurchaseOrderDefault{rp: rp}
 }
 
-func (s *PurchaseOrderDefault) RetrieveAll() (v []models.PurchaseOrder, err error) {
-	return s.rp.FindAll()
+func (s *PurchaseOrderDefault) RetrieveAll(ctx context.Context) (v []models.PurchaseOrder, err error) {
+	return s.rp.FindAll(ctx)
 }
 
-func (s *PurchaseOrderDefault) Retrieve(id int) (models.PurchaseOrder, error) {
-	return s.rp.FindById(id)
+func (s *PurchaseOrderDefault) Retrieve(ctx context.Context, id int) (models.PurchaseOrder, error) {
+	return s.rp.FindById(ctx, id)
 }
 
-func (s *PurchaseOrderDefault) Register(ss models.PurchaseOrder) (models.PurchaseOrder, error) {
+func (s *PurchaseOrderDefault) Register(ctx context.Context, ss models.PurchaseOrder) (models.PurchaseOrder, error) {
 
-	return s.rp.Create(ss)
+	return s.rp.Create(ctx, ss)
 }
-func (s *PurchaseOrderDefault) Modify(ss models.PurchaseOrder) (models.PurchaseOrder, error) {
-	return s.rp.Update(ss)
+func (s *PurchaseOrderDefault) Modify(ctx context.Context, ss models.PurchaseOrder) (models.PurchaseOrder, error) {
+	return s.rp.Update(ctx, ss)
 }
 
-func (s *PurchaseOrderDefault) PartialModify(id int, fields map[string]any) (models.PurchaseOrder, error) {
-	return s.rp.PartialUpdate(id, fields)
+func (s *PurchaseOrderDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.PurchaseOrder, error) {
+	return s.rp.PartialUpdate(ctx, id, fields)
 }
 
-func (s *PurchaseOrderDefault) Remove(id int) error {
-	return s.rp.Delete(id)
+func (s *PurchaseOrderDefault) Remove(ctx context.Context, id int) error {
+	return s.rp.Delete(ctx, id)
 }
 
-func (s *PurchaseOrderDefault) RetrieveByBuyer(id int) ([]models.PurchaseOrder, error) {
-	return s.rp.FindByBuyerId(id)
+func (s *PurchaseOrderDefault) RetrieveByBuyer(ctx context.Context, id int) ([]models.PurchaseOrder, error) {
+	return s.rp.FindByBuyerId(ctx, id)
 }
@@ -0,0 +1,185 @@
+package _default
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+// recallSeverities are the severities a recall can be opened with
+var recallSeverities = map[string]bool{
+	models.RecallSeverityLow:    true,
+	models.RecallSeverityMedium: true,
+	models.RecallSeverityHigh:   true,
+}
+
+type RecallDefault struct {
+	// rp is the repository that will be used by the service
+	rp repository.RecallRepository
+	// now returns the time the recalls are opened and closed and their batches quarantined and disposed at
+	now func() time.Time
+}
+
+func NewRecallDefault(rp repository.RecallRepository) *RecallDefault {
+	return &RecallDefault{rp: rp, now: time.Now}
+}
+
+func (s *RecallDefault) RetrieveAll(ctx context.Context) ([]models.Recall, error) {
+	return s.rp.FindAll(ctx)
+}
+
+func (s *RecallDefault) Retrieve(ctx context.Context, id int) (models.Recall, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+// Open checks the severity and that the recall targets either a product or a set of batches, a batch number given
+// twice is recalled once
+func (s *RecallDefault) Open(ctx context.Context, recall models.Recall, batchNumbers []string) (models.Recall, error) {
+	if !recallSeverities[recall.Severity] {
+		return models.Recall{}, service.ErrInvalidRecallSeverity
+	}
+	if (recall.ProductId == nil) == (len(batchNumbers) == 0) {
+		return models.Recall{}, service.ErrInvalidRecallScope
+	}
+
+	unique := make([]string, 0, len(batchNumbers))
+	seen := make(map[string]bool, len(batchNumbers))
+	for _, number := range batchNumbers {
+		if !seen[number] {
+			seen[number] = true
+			unique = append(unique, number)
+		}
+	}
+
+	recall.Id = 0
+	recall.Status = models.RecallOpen
+	recall.CreatedAt = s.now().UTC().Truncate(time.Microsecond)
+	recall.ClosedAt = nil
+	return s.rp.Create(ctx, recall, unique)
+}
+
+func (s *RecallDefault) Quarantine(ctx context.Context, id int, productBatchId int) (models.RecallBatch, error) {
+	if err := s.checkBatch(ctx, id, productBatchId, models.RecallBatchRecalled); err != nil {
+		return models.RecallBatch{}, err
+	}
+	return s.rp.Quarantine(ctx, id, productBatchId, s.now().UTC().Truncate(time.Microsecond))
+}
+
+func (s *RecallDefault) Dispose(ctx context.Context, id int, productBatchId int, employeeId *int) (models.RecallBatch, error) {
+	if err := s.checkBatch(ctx, id, productBatchId, models.RecallBatchQuarantined); err != nil {
+		return models.RecallBatch{}, err
+	}
+	return s.rp.Dispose(ctx, id, productBatchId, employeeId, s.now().UTC().Truncate(time.Microsecond))
+}
+
+func (s *RecallDefault) Close(ctx context.Context, id int) (models.Recall, error) {
+	recall, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return models.Recall{}, err
+	}
+	if recall.Status != models.RecallOpen {
+		return models.Recall{}, service.ErrRecallClosed
+	}
+	for _, line := range recall.Batches {
+		if line.Status != models.RecallBatchDisposed {
+			return models.Recall{}, service.ErrRecallIncomplete
+		}
+	}
+	return s.rp.Close(ctx, id, s.now().UTC().Truncate(time.Microsecond))
+}
+
+func (s *RecallDefault) RetrieveStock(ctx context.Context, id int) ([]models.RecallStock, error) {
+	if _, err := s.rp.FindById(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.rp.FindStock(ctx, id)
+}
+
+func (s *RecallDefault) RetrieveOrders(ctx context.Context, id int) ([]models.RecallOrders, error) {
+	if _, err := s.rp.FindById(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.rp.FindOrders(ctx, id)
+}
+
+func (s *RecallDefault) RetrieveReports(ctx context.Context, id *int) ([]models.RecallReport, error) {
+	var recalls []models.Recall
+	if id == nil {
+		var err error
+		if recalls, err = s.rp.FindAll(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		recall, err := s.rp.FindById(ctx, *id)
+		if err != nil {
+			return nil, err
+		}
+		recalls = []models.Recall{recall}
+	}
+
+	reports := make([]models.RecallReport, len(recalls))
+	for i, recall := range recalls {
+		orders, err := s.rp.FindOrders(ctx, recall.Id)
+		if err != nil {
+			return nil, err
+		}
+		reports[i] = newRecallReport(recall, orders)
+	}
+	return reports, nil
+}
+
+// checkBatch returns an error unless the recall is open and the batch is one of its batches in the given status
+func (s *RecallDefault) checkBatch(ctx context.Context, id int, productBatchId int, status string) error {
+	recall, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if recall.Status != models.RecallOpen {
+		return service.ErrRecallClosed
+	}
+	for _, line := range recall.Batches {
+		if line.ProductBatchId != productBatchId {
+			continue
+		}
+		if line.Status != status {
+			return service.ErrInvalidRecallBatchStatus
+		}
+		return nil
+	}
+	return service.ErrBatchNotInRecall
+}
+
+// newRecallReport sums the batches of a recall and the purchase orders they were picked for
+func newRecallReport(recall models.Recall, orders []models.RecallOrders) models.RecallReport {
+	report := models.RecallReport{
+		RecallId:     recall.Id,
+		ProductId:    recall.ProductId,
+		Severity:     recall.Severity,
+		Status:       recall.Status,
+		CreatedAt:    recall.CreatedAt,
+		ClosedAt:     recall.ClosedAt,
+		BatchesCount: len(recall.Batches),
+		BuyersCount:  len(orders),
+	}
+	for _, line := range recall.Batches {
+		report.RecalledQuantity += line.RecalledQuantity
+		if line.QuarantinedQuantity != nil {
+			report.QuarantinedQuantity += *line.QuarantinedQuantity
+		}
+		if line.DisposedQuantity != nil {
+			report.DisposedQuantity += *line.DisposedQuantity
+		}
+		if line.Status == models.RecallBatchDisposed {
+			report.DisposedBatchesCount++
+		}
+	}
+	for _, buyer := range orders {
+		report.PurchaseOrdersCount += len(buyer.PurchaseOrders)
+		for _, order := range buyer.PurchaseOrders {
+			report.PickedQuantity += order.PickedQuantity
+		}
+	}
+	return report
+}
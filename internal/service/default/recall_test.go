@@ -0,0 +1,235 @@
+package _default
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// recallStore keeps the recalls in memory, moving a batch of a recall only changes its status
+type recallStore struct {
+	repository.RecallRepository
+	recalls      map[int]models.Recall
+	orders       map[int][]models.RecallOrders
+	batchNumbers []string
+}
+
+func (s *recallStore) FindAll(ctx context.Context) ([]models.Recall, error) {
+	recalls := make([]models.Recall, 0, len(s.recalls))
+	for id := 1; len(recalls) < len(s.recalls); id++ {
+		if recall, ok := s.recalls[id]; ok {
+			recalls = append(recalls, recall)
+		}
+	}
+	return recalls, nil
+}
+
+func (s *recallStore) FindById(ctx context.Context, id int) (models.Recall, error) {
+	recall, ok := s.recalls[id]
+	if !ok {
+		return models.Recall{}, repository.ErrEntityNotFound
+	}
+	return recall, nil
+}
+
+func (s *recallStore) Create(ctx context.Context, recall models.Recall, batchNumbers []string) (models.Recall, error) {
+	s.batchNumbers = batchNumbers
+	recall.Id = len(s.recalls) + 1
+	return recall, nil
+}
+
+func (s *recallStore) Quarantine(ctx context.Context, id int, productBatchId int, at time.Time) (models.RecallBatch, error) {
+	return models.RecallBatch{RecallId: id, ProductBatchId: productBatchId, Status: models.RecallBatchQuarantined, QuarantinedAt: &at}, nil
+}
+
+func (s *recallStore) Dispose(ctx context.Context, id int, productBatchId int, employeeId *int, at time.Time) (models.RecallBatch, error) {
+	return models.RecallBatch{RecallId: id, ProductBatchId: productBatchId, Status: models.RecallBatchDisposed, DisposedAt: &at}, nil
+}
+
+func (s *recallStore) Close(ctx context.Context, id int, at time.Time) (models.Recall, error) {
+	recall := s.recalls[id]
+	recall.Status = models.RecallClosed
+	recall.ClosedAt = &at
+	return recall, nil
+}
+
+func (s *recallStore) FindOrders(ctx context.Context, id int) ([]models.RecallOrders, error) {
+	return s.orders[id], nil
+}
+
+func newRecallDefault(recalls ...models.Recall) (*RecallDefault, *recallStore) {
+	store := &recallStore{recalls: make(map[int]models.Recall, len(recalls)), orders: make(map[int][]models.RecallOrders)}
+	for _, recall := range recalls {
+		store.recalls[recall.Id] = recall
+	}
+	sv := NewRecallDefault(store)
+	sv.now = func() time.Time { return time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC) }
+	return sv, store
+}
+
+func TestRecallDefault_Open(t *testing.T) {
+	productId := 1
+
+	tests := []struct {
+		title                string
+		recall               models.Recall
+		batchNumbers         []string
+		expectedBatchNumbers []string
+		expectedError        error
+	}{
+		{title: "Success - Product", recall: models.Recall{ProductId: &productId, Severity: models.RecallSeverityHigh}, expectedBatchNumbers: []string{}},
+		{title: "Success - Batch numbers given twice", recall: models.Recall{Severity: models.RecallSeverityLow}, batchNumbers: []string{"6", "7", "6"}, expectedBatchNumbers: []string{"6", "7"}},
+		{title: "Error - Unknown severity", recall: models.Recall{ProductId: &productId, Severity: "critical"}, expectedError: service.ErrInvalidRecallSeverity},
+		{title: "Error - Product and batch numbers", recall: models.Recall{ProductId: &productId, Severity: models.RecallSeverityLow}, batchNumbers: []string{"6"}, expectedError: service.ErrInvalidRecallScope},
+		{title: "Error - Neither product nor batch numbers", recall: models.Recall{Severity: models.RecallSeverityLow}, expectedError: service.ErrInvalidRecallScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv, store := newRecallDefault()
+
+			// Act
+			recall, err := sv.Open(context.Background(), tt.recall, tt.batchNumbers)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.RecallOpen, recall.Status)
+			require.Equal(t, sv.now(), recall.CreatedAt)
+			require.Equal(t, tt.expectedBatchNumbers, store.batchNumbers)
+		})
+	}
+}
+
+func TestRecallDefault_Dispose(t *testing.T) {
+	open := models.Recall{Id: 2, Status: models.RecallOpen, Batches: []models.RecallBatch{
+		{Id: 4, RecallId: 2, ProductBatchId: 6, Status: models.RecallBatchQuarantined},
+		{Id: 5, RecallId: 2, ProductBatchId: 7, Status: models.RecallBatchRecalled},
+	}}
+	closed := open
+	closed.Status = models.RecallClosed
+
+	tests := []struct {
+		title          string
+		recall         models.Recall
+		productBatchId int
+		expectedError  error
+	}{
+		{title: "Success", recall: open, productBatchId: 6},
+		{title: "Error - Batch not quarantined", recall: open, productBatchId: 7, expectedError: service.ErrInvalidRecallBatchStatus},
+		{title: "Error - Batch not in recall", recall: open, productBatchId: 8, expectedError: service.ErrBatchNotInRecall},
+		{title: "Error - Recall closed", recall: closed, productBatchId: 6, expectedError: service.ErrRecallClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv, _ := newRecallDefault(tt.recall)
+
+			// Act
+			line, err := sv.Dispose(context.Background(), 2, tt.productBatchId, nil)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.RecallBatchDisposed, line.Status)
+			require.Equal(t, sv.now(), *line.DisposedAt)
+		})
+	}
+}
+
+func TestRecallDefault_Close(t *testing.T) {
+	disposed := models.Recall{Id: 2, Status: models.RecallOpen, Batches: []models.RecallBatch{
+		{Id: 4, RecallId: 2, ProductBatchId: 6, Status: models.RecallBatchDisposed},
+	}}
+	pending := models.Recall{Id: 2, Status: models.RecallOpen, Batches: []models.RecallBatch{
+		{Id: 4, RecallId: 2, ProductBatchId: 6, Status: models.RecallBatchDisposed},
+		{Id: 5, RecallId: 2, ProductBatchId: 7, Status: models.RecallBatchQuarantined},
+	}}
+	closed := disposed
+	closed.Status = models.RecallClosed
+
+	tests := []struct {
+		title         string
+		recall        models.Recall
+		expectedError error
+	}{
+		{title: "Success", recall: disposed},
+		{title: "Error - Batch not disposed", recall: pending, expectedError: service.ErrRecallIncomplete},
+		{title: "Error - Recall closed", recall: closed, expectedError: service.ErrRecallClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv, _ := newRecallDefault(tt.recall)
+
+			// Act
+			recall, err := sv.Close(context.Background(), 2)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.RecallClosed, recall.Status)
+			require.Equal(t, sv.now(), *recall.ClosedAt)
+		})
+	}
+}
+
+func TestRecallDefault_RetrieveReports(t *testing.T) {
+	// Arrange
+	quarantined, disposed := 180, 180
+	sv, store := newRecallDefault(
+		models.Recall{Id: 1, Status: models.RecallOpen, Batches: []models.RecallBatch{
+			{ProductBatchId: 6, Status: models.RecallBatchDisposed, RecalledQuantity: 200, QuarantinedQuantity: &quarantined, DisposedQuantity: &disposed},
+			{ProductBatchId: 7, Status: models.RecallBatchRecalled, RecalledQuantity: 150},
+		}},
+		models.Recall{Id: 2, Status: models.RecallOpen},
+	)
+	store.orders[1] = []models.RecallOrders{
+		{Buyer: models.Buyer{Id: 1}, PurchaseOrders: []models.RecallPurchaseOrder{{PurchaseOrderId: 1, PickedQuantity: 15}, {PurchaseOrderId: 3, PickedQuantity: 5}}},
+		{Buyer: models.Buyer{Id: 2}, PurchaseOrders: []models.RecallPurchaseOrder{{PurchaseOrderId: 2, PickedQuantity: 8}}},
+	}
+
+	// Act
+	reports, err := sv.RetrieveReports(context.Background(), nil)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	require.Equal(t, models.RecallReport{
+		RecallId:             1,
+		Status:               models.RecallOpen,
+		BatchesCount:         2,
+		DisposedBatchesCount: 1,
+		RecalledQuantity:     350,
+		QuarantinedQuantity:  180,
+		DisposedQuantity:     180,
+		PickedQuantity:       28,
+		PurchaseOrdersCount:  3,
+		BuyersCount:          2,
+	}, reports[0])
+	require.Zero(t, reports[1].BatchesCount)
+
+	// Act
+	missing := 9
+	_, err = sv.RetrieveReports(context.Background(), &missing)
+
+	// Assert
+	require.ErrorIs(t, err, repository.ErrEntityNotFound)
+}
@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
@@ -14,32 +15,40 @@ func NewSectionService(rp repository.SectionRepository) *SectionService {
 	return &SectionService{rp: rp}
 }
 
-func (s *SectionService) RetrieveAll() (v []models.Section, err error) {
-	return s.rp.FindAll()
+func (s *SectionService) RetrieveAll(ctx context.Context) (v []models.Section, err error) {
+	return s.rp.FindAll(ctx)
 }
 
-func (s *SectionService) Retrieve(id int) (models.Section, error) {
-	return s.rp.FindById(id)
+func (s *SectionService) RetrieveByIds(ctx context.Context, ids []int) ([]models.Section, error) {
+	return s.rp.FindByIds(ctx, ids)
 }
 
-func (s *SectionService) Register(ss models.Section) (models.Section, error) {
+func (s *SectionService) RetrieveByWarehouses(ctx context.Context, warehouseIds []int) ([]models.Section, error) {
+	return s.rp.FindByWarehouses(ctx, warehouseIds)
+}
+
+func (s *SectionService) Retrieve(ctx context.Context, id int) (models.Section, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+func (s *SectionService) Register(ctx context.Context, ss models.Section) (models.Section, error) {
 
-	return s.rp.Create(ss)
+	return s.rp.Create(ctx, ss)
 }
-func (s *SectionService) Modify(ss models.Section) (models.Section, error) {
-	return s.rp.Update(ss)
+func (s *SectionService) Modify(ctx context.Context, ss models.Section) (models.Section, error) {
+	return s.rp.Update(ctx, ss)
 }
-func (s *SectionService) PartialModify(id int, fields map[string]any) (models.Section, error) {
-	return s.rp.PartialUpdate(id, fields)
+func (s *SectionService) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Section, error) {
+	return s.rp.PartialUpdate(ctx, id, fields)
 }
-func (s *SectionService) Remove(id int) error {
-	return s.rp.Delete(id)
+func (s *SectionService) Remove(ctx context.Context, id int) error {
+	return s.rp.Delete(ctx, id)
 }
-func (s *SectionService) RetrieveSectionReport(sectionId *int) (interface{}, error) {
+func (s *SectionService) RetrieveSectionReport(ctx context.Context, sectionId *int) (interface{}, error) {
 	if sectionId != nil {
 		// if there is an id, Get the specific report for that section
-		return s.rp.FindSectionReport(*sectionId)
+		return s.rp.FindSectionReport(ctx, *sectionId)
 	}
 	// if there is no id then find all reports
-	return s.rp.FindAllSectionReports()
+	return s.rp.FindAllSectionReports(ctx)
 }
@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
@@ -15,26 +16,30 @@ func NewSellerService(repository repository.SellerRepository) *SellerService {
 	}
 }
 
-func (s *SellerService) RetrieveAll() ([]models.Seller, error) {
-	return s.repository.FindAll()
+func (s *SellerService) RetrieveAll(ctx context.Context) ([]models.Seller, error) {
+	return s.repository.FindAll(ctx)
 }
 
-func (s *SellerService) Retrieve(id int) (models.Seller, error) {
-	return s.repository.FindById(id)
+func (s *SellerService) RetrieveByIds(ctx context.Context, ids []int) ([]models.Seller, error) {
+	return s.repository.FindByIds(ctx, ids)
 }
 
-func (s *SellerService) Register(seller models.Seller) (models.Seller, error) {
-	return s.repository.Create(seller)
+func (s *SellerService) Retrieve(ctx context.Context, id int) (models.Seller, error) {
+	return s.repository.FindById(ctx, id)
 }
 
-func (s *SellerService) Modify(seller models.Seller) (models.Seller, error) {
-	return s.repository.Update(seller)
+func (s *SellerService) Register(ctx context.Context, seller models.Seller) (models.Seller, error) {
+	return s.repository.Create(ctx, seller)
 }
 
-func (s *SellerService) PartialModify(id int, fields map[string]any) (models.Seller, error) {
-	return s.repository.PartialUpdate(id, fields)
+func (s *SellerService) Modify(ctx context.Context, seller models.Seller) (models.Seller, error) {
+	return s.repository.Update(ctx, seller)
 }
 
-func (s *SellerService) Remove(id int) error {
-	return s.repository.Delete(id)
+func (s *SellerService) PartialModify(ctx context.Context, id int, fields map[string]any) (models.Seller, error) {
+	return s.repository.PartialUpdate(ctx, id, fields)
+}
+
+func (s *SellerService) Remove(ctx context.Context, id int) error {
+	return s.repository.Delete(ctx, id)
 }
@@ -0,0 +1,84 @@
+package _default
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// disposalReasonCodes are the reason codes of the stock disposed of by hand, the one of the stock that spoiled or was
+// damaged
+var disposalReasonCodes = map[string]bool{
+	models.AdjustmentExpired:              true,
+	models.AdjustmentDamaged:              true,
+	models.AdjustmentTemperatureExcursion: true,
+}
+
+// adjustmentReasonCodes maps the reason codes of the adjustments that can be registered by hand to whether they
+// must take stock out of the batch, the variances of the count sessions are posted when the sessions are approved
+var adjustmentReasonCodes = map[string]bool{
+	models.AdjustmentExpired:              true,
+	models.AdjustmentDamaged:              true,
+	models.AdjustmentTemperatureExcursion: true,
+	models.AdjustmentTheft:                true,
+	models.AdjustmentFound:                false,
+}
+
+type StockDefault struct {
+	// rp is the stock ledger
+	rp repository.StockMovementRepository
+}
+
+func NewStockDefault(rp repository.StockMovementRepository) *StockDefault {
+	return &StockDefault{rp: rp}
+}
+
+func (s *StockDefault) RetrieveBalances(ctx context.Context, productId int, warehouseId int) ([]models.StockBalance, error) {
+	return s.rp.FindBalances(ctx, productId, warehouseId)
+}
+
+func (s *StockDefault) RetrieveMovements(ctx context.Context, productBatchId int) ([]models.StockMovement, error) {
+	return s.rp.FindByProductBatch(ctx, productBatchId)
+}
+
+// RegisterMovement posts a movement by hand. Adjustments and disposals need a reason code and keep the capacity of the
+// section in sync like RegisterAdjustment, disposals take stock out so their quantity must be negative. Picks are
+// recorded for the lines of the purchase orders only.
+func (s *StockDefault) RegisterMovement(ctx context.Context, movement models.StockMovement) (models.StockMovement, error) {
+	switch movement.Reason {
+	case models.StockMovementAdjustment:
+		return s.RegisterAdjustment(ctx, movement)
+	case models.StockMovementDisposal:
+		if movement.ReasonCode == nil || !disposalReasonCodes[*movement.ReasonCode] {
+			return models.StockMovement{}, service.ErrInvalidDisposalReasonCode
+		}
+		if movement.Quantity >= 0 {
+			return models.StockMovement{}, service.ErrInvalidStockMovementQuantity
+		}
+		return s.rp.Adjust(ctx, movement)
+	default:
+		return models.StockMovement{}, service.ErrInvalidStockMovementReason
+	}
+}
+
+// RegisterAdjustment posts an adjustment by hand. Spoiled, damaged and stolen stock is taken out so its quantity must
+// be negative, found stock is added so its quantity must be positive.
+func (s *StockDefault) RegisterAdjustment(ctx context.Context, movement models.StockMovement) (models.StockMovement, error) {
+	if movement.ReasonCode == nil {
+		return models.StockMovement{}, service.ErrInvalidAdjustmentReasonCode
+	}
+	outbound, ok := adjustmentReasonCodes[*movement.ReasonCode]
+	if !ok {
+		return models.StockMovement{}, service.ErrInvalidAdjustmentReasonCode
+	}
+	if movement.Quantity == 0 || outbound != (movement.Quantity < 0) {
+		return models.StockMovement{}, service.ErrInvalidStockMovementQuantity
+	}
+	movement.Reason = models.StockMovementAdjustment
+	return s.rp.Adjust(ctx, movement)
+}
+
+func (s *StockDefault) RetrieveWaste(ctx context.Context, productId int, sellerId int, warehouseId int) ([]models.WasteReport, error) {
+	return s.rp.FindWaste(ctx, productId, sellerId, warehouseId)
+}
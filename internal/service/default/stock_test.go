@@ -0,0 +1,132 @@
+package _default
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// stockMovementStore keeps the posted stock movements in memory
+type stockMovementStore struct {
+	movements []models.StockMovement
+}
+
+func (s *stockMovementStore) FindByProductBatch(ctx context.Context, productBatchId int) ([]models.StockMovement, error) {
+	movements := make([]models.StockMovement, 0)
+	for _, movement := range s.movements {
+		if movement.ProductBatchId == productBatchId {
+			movements = append(movements, movement)
+		}
+	}
+	return movements, nil
+}
+
+func (s *stockMovementStore) Adjust(ctx context.Context, movement models.StockMovement) (models.StockMovement, error) {
+	movement.Id = len(s.movements) + 1
+	s.movements = append(s.movements, movement)
+	return movement, nil
+}
+
+func (s *stockMovementStore) FindWaste(ctx context.Context, productId int, sellerId int, warehouseId int) ([]models.WasteReport, error) {
+	return []models.WasteReport{}, nil
+}
+
+func (s *stockMovementStore) FindBalances(ctx context.Context, productId int, warehouseId int) ([]models.StockBalance, error) {
+	return []models.StockBalance{}, nil
+}
+
+func TestStockDefault_RegisterMovement(t *testing.T) {
+	tests := []struct {
+		title         string
+		reason        string
+		reasonCode    string
+		quantity      int
+		expectedError error
+	}{
+		{title: "Success - Disposal", reason: models.StockMovementDisposal, reasonCode: models.AdjustmentDamaged, quantity: -1},
+		{title: "Success - Adjustment adds stock", reason: models.StockMovementAdjustment, reasonCode: models.AdjustmentFound, quantity: 3},
+		{title: "Success - Adjustment removes stock", reason: models.StockMovementAdjustment, reasonCode: models.AdjustmentTheft, quantity: -3},
+		{title: "Error - Pick by hand", reason: models.StockMovementPick, quantity: -4, expectedError: service.ErrInvalidStockMovementReason},
+		{title: "Error - Disposal adds stock", reason: models.StockMovementDisposal, reasonCode: models.AdjustmentExpired, quantity: 1, expectedError: service.ErrInvalidStockMovementQuantity},
+		{title: "Error - Disposal without reason code", reason: models.StockMovementDisposal, quantity: -1, expectedError: service.ErrInvalidDisposalReasonCode},
+		{title: "Error - Disposal of stolen stock", reason: models.StockMovementDisposal, reasonCode: models.AdjustmentTheft, quantity: -1, expectedError: service.ErrInvalidDisposalReasonCode},
+		{title: "Error - Adjustment without reason code", reason: models.StockMovementAdjustment, quantity: 3, expectedError: service.ErrInvalidAdjustmentReasonCode},
+		{title: "Error - Zero adjustment", reason: models.StockMovementAdjustment, reasonCode: models.AdjustmentFound, quantity: 0, expectedError: service.ErrInvalidStockMovementQuantity},
+		{title: "Error - Receipt by hand", reason: models.StockMovementReceipt, quantity: 10, expectedError: service.ErrInvalidStockMovementReason},
+		{title: "Error - Transfer by hand", reason: models.StockMovementTransfer, quantity: -10, expectedError: service.ErrInvalidStockMovementReason},
+		{title: "Error - Unknown reason", reason: "gift", quantity: -1, expectedError: service.ErrInvalidStockMovementReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			manual := models.StockMovement{ProductBatchId: 3, Quantity: tt.quantity, Reason: tt.reason}
+			if tt.reasonCode != "" {
+				manual.ReasonCode = &tt.reasonCode
+			}
+			store := &stockMovementStore{}
+			sv := NewStockDefault(store)
+
+			// Act
+			movement, err := sv.RegisterMovement(context.Background(), manual)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, store.movements)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, movement.Id)
+			require.Equal(t, tt.quantity, movement.Quantity)
+		})
+	}
+}
+
+func TestStockDefault_RegisterAdjustment(t *testing.T) {
+	tests := []struct {
+		title         string
+		reasonCode    string
+		quantity      int
+		expectedError error
+	}{
+		{title: "Success - Expired", reasonCode: models.AdjustmentExpired, quantity: -12},
+		{title: "Success - Temperature excursion", reasonCode: models.AdjustmentTemperatureExcursion, quantity: -200},
+		{title: "Success - Found", reasonCode: models.AdjustmentFound, quantity: 3},
+		{title: "Error - Damaged adds stock", reasonCode: models.AdjustmentDamaged, quantity: 4, expectedError: service.ErrInvalidStockMovementQuantity},
+		{title: "Error - Found removes stock", reasonCode: models.AdjustmentFound, quantity: -3, expectedError: service.ErrInvalidStockMovementQuantity},
+		{title: "Error - Zero theft", reasonCode: models.AdjustmentTheft, quantity: 0, expectedError: service.ErrInvalidStockMovementQuantity},
+		{title: "Error - Count variance by hand", reasonCode: models.AdjustmentCountShortage, quantity: -1, expectedError: service.ErrInvalidAdjustmentReasonCode},
+		{title: "Error - Without reason code", quantity: -1, expectedError: service.ErrInvalidAdjustmentReasonCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			employeeId := 2
+			adjustment := models.StockMovement{ProductBatchId: 3, Quantity: tt.quantity, EmployeeId: &employeeId}
+			if tt.reasonCode != "" {
+				adjustment.ReasonCode = &tt.reasonCode
+			}
+			store := &stockMovementStore{}
+			sv := NewStockDefault(store)
+
+			// Act
+			movement, err := sv.RegisterAdjustment(context.Background(), adjustment)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, store.movements)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.StockMovementAdjustment, movement.Reason)
+			require.Equal(t, tt.reasonCode, *movement.ReasonCode)
+			require.Equal(t, tt.quantity, movement.Quantity)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package _default
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
+
+type TransferOrderDefault struct {
+	// rp is the repository that will be used by the service
+	rp repository.TransferOrderRepository
+	// sections is the repository of the sections the batches are moved between
+	sections repository.SectionRepository
+	// batches is the repository of the batches being moved
+	batches repository.ProductBatchRepository
+	// now returns the time the orders are drafted and moved at
+	now func() time.Time
+}
+
+func NewTransferOrderDefault(rp repository.TransferOrderRepository, sections repository.SectionRepository, batches repository.ProductBatchRepository) *TransferOrderDefault {
+	return &TransferOrderDefault{rp: rp, sections: sections, batches: batches, now: time.Now}
+}
+
+func (s *TransferOrderDefault) RetrieveAll(ctx context.Context) ([]models.TransferOrder, error) {
+	return s.rp.FindAll(ctx)
+}
+
+func (s *TransferOrderDefault) Retrieve(ctx context.Context, id int) (models.TransferOrder, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+// Register drafts the transfer order. Its sections must belong to its warehouses and its batches must be available,
+// hold stock, be stored in the source section and not be moved by another order not received yet. The quantity of
+// every line is the stock of its batch until the order is dispatched.
+func (s *TransferOrderDefault) Register(ctx context.Context, order models.TransferOrder) (models.TransferOrder, error) {
+	if order.SourceWarehouseId == order.DestinationWarehouseId {
+		return models.TransferOrder{}, service.ErrSameWarehouseTransfer
+	}
+	if err := s.checkSection(ctx, order.SourceSectionId, order.SourceWarehouseId); err != nil {
+		return models.TransferOrder{}, err
+	}
+	if err := s.checkSection(ctx, order.DestinationSectionId, order.DestinationWarehouseId); err != nil {
+		return models.TransferOrder{}, err
+	}
+	if len(order.Lines) == 0 {
+		return models.TransferOrder{}, service.ErrEmptyTransferOrder
+	}
+
+	seen := make(map[int]bool, len(order.Lines))
+	for i, line := range order.Lines {
+		if seen[line.ProductBatchId] {
+			return models.TransferOrder{}, service.ErrDuplicatedTransferBatch
+		}
+		seen[line.ProductBatchId] = true
+
+		batch, err := s.batches.FindById(ctx, line.ProductBatchId)
+		if err != nil {
+			return models.TransferOrder{}, err
+		}
+		if batch.SectionId != order.SourceSectionId {
+			return models.TransferOrder{}, service.ErrBatchNotInSection
+		}
+		if err = checkBatchAvailable(batch); err != nil {
+			return models.TransferOrder{}, err
+		}
+		if batch.CurrentQuantity == 0 {
+			return models.TransferOrder{}, service.ErrEmptyTransferBatch
+		}
+		open, err := s.rp.FindOpenByProductBatch(ctx, batch.Id)
+		if err != nil {
+			return models.TransferOrder{}, err
+		}
+		if len(open) > 0 {
+			return models.TransferOrder{}, service.ErrBatchInOpenTransfer
+		}
+		order.Lines[i] = models.TransferOrderLine{ProductBatchId: batch.Id, Quantity: batch.CurrentQuantity}
+	}
+
+	order.Id = 0
+	order.Status = models.TransferOrderDraft
+	order.CreatedAt = s.now().UTC().Truncate(time.Microsecond)
+	order.DispatchedAt, order.InTransitAt, order.ReceivedAt = nil, nil, nil
+	return s.rp.Create(ctx, order)
+}
+
+func (s *TransferOrderDefault) Dispatch(ctx context.Context, id int) (models.TransferOrder, error) {
+	if _, err := s.checkStatus(ctx, id, models.TransferOrderDispatched); err != nil {
+		return models.TransferOrder{}, err
+	}
+	return s.rp.Dispatch(ctx, id, s.now().UTC().Truncate(time.Microsecond))
+}
+
+func (s *TransferOrderDefault) MarkInTransit(ctx context.Context, id int, carrierId *int) (models.TransferOrder, error) {
+	order, err := s.checkStatus(ctx, id, models.TransferOrderInTransit)
+	if err != nil {
+		return models.TransferOrder{}, err
+	}
+	if carrierId == nil && order.CarrierId == nil {
+		return models.TransferOrder{}, service.ErrTransferOrderCarrierRequired
+	}
+	return s.rp.MarkInTransit(ctx, id, carrierId, s.now().UTC().Truncate(time.Microsecond))
+}
+
+func (s *TransferOrderDefault) Receive(ctx context.Context, id int) (models.TransferOrder, error) {
+	if _, err := s.checkStatus(ctx, id, models.TransferOrderReceived); err != nil {
+		return models.TransferOrder{}, err
+	}
+	return s.rp.Receive(ctx, id, s.now().UTC().Truncate(time.Microsecond))
+}
+
+// checkSection verifies that the section exists and belongs to the warehouse
+func (s *TransferOrderDefault) checkSection(ctx context.Context, sectionId int, warehouseId int) error {
+	section, err := s.sections.FindById(ctx, sectionId)
+	if err != nil {
+		return err
+	}
+	if section.WarehouseId != warehouseId {
+		return service.ErrSectionNotInWarehouse
+	}
+	return nil
+}
+
+// checkStatus retrieves the order and verifies that it can move to the status
+func (s *TransferOrderDefault) checkStatus(ctx context.Context, id int, status string) (models.TransferOrder, error) {
+	order, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return models.TransferOrder{}, err
+	}
+	if !order.CanMoveTo(status) {
+		return models.TransferOrder{}, service.ErrInvalidTransferOrderStatus
+	}
+	return order, nil
+}
@@ -0,0 +1,247 @@
+package _default
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// sectionStore keeps the sections in memory
+type sectionStore struct {
+	repository.SectionRepository
+	sections map[int]models.Section
+}
+
+func (s *sectionStore) FindById(ctx context.Context, id int) (models.Section, error) {
+	section, ok := s.sections[id]
+	if !ok {
+		return models.Section{}, repository.ErrEntityNotFound
+	}
+	return section, nil
+}
+
+// productBatchStore keeps the product batches in memory
+type productBatchStore struct {
+	repository.ProductBatchRepository
+	batches map[int]models.ProductBatch
+}
+
+func (s *productBatchStore) FindById(ctx context.Context, id int) (models.ProductBatch, error) {
+	batch, ok := s.batches[id]
+	if !ok {
+		return models.ProductBatch{}, repository.ErrEntityNotFound
+	}
+	return batch, nil
+}
+
+// transferOrderStore keeps the transfer orders in memory, moving an order only changes its status
+type transferOrderStore struct {
+	repository.TransferOrderRepository
+	orders map[int]models.TransferOrder
+}
+
+func (s *transferOrderStore) FindById(ctx context.Context, id int) (models.TransferOrder, error) {
+	order, ok := s.orders[id]
+	if !ok {
+		return models.TransferOrder{}, repository.ErrEntityNotFound
+	}
+	return order, nil
+}
+
+func (s *transferOrderStore) FindOpenByProductBatch(ctx context.Context, productBatchId int) ([]models.TransferOrder, error) {
+	var open []models.TransferOrder
+	for _, order := range s.orders {
+		if order.Status == models.TransferOrderReceived {
+			continue
+		}
+		for _, line := range order.Lines {
+			if line.ProductBatchId == productBatchId {
+				open = append(open, order)
+			}
+		}
+	}
+	return open, nil
+}
+
+func (s *transferOrderStore) Create(ctx context.Context, order models.TransferOrder) (models.TransferOrder, error) {
+	order.Id = len(s.orders) + 1
+	s.orders[order.Id] = order
+	return order, nil
+}
+
+func (s *transferOrderStore) MarkInTransit(ctx context.Context, id int, carrierId *int, at time.Time) (models.TransferOrder, error) {
+	order := s.orders[id]
+	if carrierId != nil {
+		order.CarrierId = carrierId
+	}
+	order.Status = models.TransferOrderInTransit
+	order.InTransitAt = &at
+	s.orders[id] = order
+	return order, nil
+}
+
+func (s *transferOrderStore) Receive(ctx context.Context, id int, at time.Time) (models.TransferOrder, error) {
+	order := s.orders[id]
+	order.Status = models.TransferOrderReceived
+	order.ReceivedAt = &at
+	s.orders[id] = order
+	return order, nil
+}
+
+func newTransferOrderDefault(orders map[int]models.TransferOrder) *TransferOrderDefault {
+	sections := &sectionStore{sections: map[int]models.Section{
+		1: {Id: 1, WarehouseId: 1},
+		2: {Id: 2, WarehouseId: 2},
+	}}
+	batches := &productBatchStore{batches: map[int]models.ProductBatch{
+		3: {Id: 3, CurrentQuantity: 200, SectionId: 1, Status: models.ProductBatchAvailable},
+		4: {Id: 4, CurrentQuantity: 150, SectionId: 1, Status: models.ProductBatchAvailable},
+		5: {Id: 5, CurrentQuantity: 100, SectionId: 2, Status: models.ProductBatchAvailable},
+		6: {Id: 6, CurrentQuantity: 80, SectionId: 1, Status: models.ProductBatchExpired},
+		7: {Id: 7, CurrentQuantity: 0, SectionId: 1, Status: models.ProductBatchAvailable},
+	}}
+	sv := NewTransferOrderDefault(&transferOrderStore{orders: orders}, sections, batches)
+	sv.now = func() time.Time { return time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC) }
+	return sv
+}
+
+func TestTransferOrderDefault_Register(t *testing.T) {
+	tests := []struct {
+		title         string
+		orders        map[int]models.TransferOrder
+		order         models.TransferOrder
+		expectedError error
+	}{
+		{
+			title: "Success",
+			order: models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 3}, {ProductBatchId: 4}}},
+		},
+		{
+			title:  "Success - Batch of a received order",
+			orders: map[int]models.TransferOrder{9: {Id: 9, Status: models.TransferOrderReceived, Lines: []models.TransferOrderLine{{ProductBatchId: 4}}}},
+			order:  models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 3}, {ProductBatchId: 4}}},
+		},
+		{
+			title:         "Error - Batch of an open order",
+			orders:        map[int]models.TransferOrder{9: {Id: 9, Status: models.TransferOrderDraft, Lines: []models.TransferOrderLine{{ProductBatchId: 4}}}},
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 3}, {ProductBatchId: 4}}},
+			expectedError: service.ErrBatchInOpenTransfer,
+		},
+		{
+			title:         "Error - Empty batch",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 7}}},
+			expectedError: service.ErrEmptyTransferBatch,
+		},
+		{
+			title:         "Error - Same warehouse",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 1, DestinationSectionId: 1, Lines: []models.TransferOrderLine{{ProductBatchId: 3}}},
+			expectedError: service.ErrSameWarehouseTransfer,
+		},
+		{
+			title:         "Error - Section of another warehouse",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 3, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 3}}},
+			expectedError: service.ErrSectionNotInWarehouse,
+		},
+		{
+			title:         "Error - Section not found",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 9, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 3}}},
+			expectedError: repository.ErrEntityNotFound,
+		},
+		{
+			title:         "Error - No lines",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2},
+			expectedError: service.ErrEmptyTransferOrder,
+		},
+		{
+			title:         "Error - Batch expired",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 3}, {ProductBatchId: 6}}},
+			expectedError: repository.ErrProductBatchNotAvailable,
+		},
+		{
+			title:         "Error - Duplicated batch",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 3}, {ProductBatchId: 3}}},
+			expectedError: service.ErrDuplicatedTransferBatch,
+		},
+		{
+			title:         "Error - Batch of another section",
+			order:         models.TransferOrder{SourceWarehouseId: 1, SourceSectionId: 1, DestinationWarehouseId: 2, DestinationSectionId: 2, Lines: []models.TransferOrderLine{{ProductBatchId: 5}}},
+			expectedError: service.ErrBatchNotInSection,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			orders := map[int]models.TransferOrder{}
+			for id, order := range tt.orders {
+				orders[id] = order
+			}
+			sv := newTransferOrderDefault(orders)
+
+			// Act
+			order, err := sv.Register(context.Background(), tt.order)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tt.orders)+1, order.Id)
+			require.Equal(t, models.TransferOrderDraft, order.Status)
+			require.Equal(t, sv.now(), order.CreatedAt)
+			require.Equal(t, []models.TransferOrderLine{{ProductBatchId: 3, Quantity: 200}, {ProductBatchId: 4, Quantity: 150}}, order.Lines)
+		})
+	}
+}
+
+func TestTransferOrderDefault_MarkInTransit(t *testing.T) {
+	carrierId := 2
+
+	tests := []struct {
+		title         string
+		order         models.TransferOrder
+		carrierId     *int
+		expectedError error
+	}{
+		{title: "Success - Carrier given", order: models.TransferOrder{Id: 1, Status: models.TransferOrderDispatched}, carrierId: &carrierId},
+		{title: "Success - Carrier assigned when drafted", order: models.TransferOrder{Id: 1, Status: models.TransferOrderDispatched, CarrierId: &carrierId}},
+		{title: "Error - Without carrier", order: models.TransferOrder{Id: 1, Status: models.TransferOrderDispatched}, expectedError: service.ErrTransferOrderCarrierRequired},
+		{title: "Error - Not dispatched", order: models.TransferOrder{Id: 1, Status: models.TransferOrderDraft}, carrierId: &carrierId, expectedError: service.ErrInvalidTransferOrderStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Arrange
+			sv := newTransferOrderDefault(map[int]models.TransferOrder{1: tt.order})
+
+			// Act
+			order, err := sv.MarkInTransit(context.Background(), 1, tt.carrierId)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.TransferOrderInTransit, order.Status)
+			require.Equal(t, carrierId, *order.CarrierId)
+		})
+	}
+}
+
+func TestTransferOrderDefault_Receive(t *testing.T) {
+	// Arrange
+	sv := newTransferOrderDefault(map[int]models.TransferOrder{1: {Id: 1, Status: models.TransferOrderDispatched}})
+
+	// Act
+	_, err := sv.Receive(context.Background(), 1)
+
+	// Assert
+	require.ErrorIs(t, err, service.ErrInvalidTransferOrderStatus)
+}
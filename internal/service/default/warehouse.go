@@ -1,6 +1,7 @@
 package _default
 
 import (
+	"context"
 	"errors"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
@@ -17,37 +18,41 @@ func NewWarehouseDefault(rp repository.WarehouseRepository) *WarehouseDefault {
 	return &WarehouseDefault{rp: rp}
 }
 
-func (s *WarehouseDefault) RetrieveAll() ([]models.Warehouse, error) {
-	return s.rp.FindAll()
+func (s *WarehouseDefault) RetrieveAll(ctx context.Context) ([]models.Warehouse, error) {
+	return s.rp.FindAll(ctx)
 }
 
-func (s *WarehouseDefault) Retrieve(id int) (models.Warehouse, error) {
-	return s.rp.FindById(id)
+func (s *WarehouseDefault) RetrieveByIds(ctx context.Context, ids []int) ([]models.Warehouse, error) {
+	return s.rp.FindByIds(ctx, ids)
 }
 
-func (s *WarehouseDefault) Register(entity models.Warehouse) (models.Warehouse, error) {
+func (s *WarehouseDefault) Retrieve(ctx context.Context, id int) (models.Warehouse, error) {
+	return s.rp.FindById(ctx, id)
+}
+
+func (s *WarehouseDefault) Register(ctx context.Context, entity models.Warehouse) (models.Warehouse, error) {
 	if entity.MinimumCapacity < 0 {
 		return models.Warehouse{}, errors.New("minimum Capacity must not be negative")
 	}
-	return s.rp.Create(entity)
+	return s.rp.Create(ctx, entity)
 }
 
-func (s *WarehouseDefault) Modify(entity models.Warehouse) (models.Warehouse, error) {
+func (s *WarehouseDefault) Modify(ctx context.Context, entity models.Warehouse) (models.Warehouse, error) {
 	if entity.MinimumCapacity < 0 {
 		return models.Warehouse{}, errors.New("minimum Capacity must not be negative")
 	}
-	return s.rp.Update(entity)
+	return s.rp.Update(ctx, entity)
 }
 
-func (s *WarehouseDefault) PartialModify(id int, fields map[string]interface{}) (models.Warehouse, error) {
+func (s *WarehouseDefault) PartialModify(ctx context.Context, id int, fields map[string]interface{}) (models.Warehouse, error) {
 	if val, ok := fields["minimum_capacity"]; ok {
 		if val.(float64) < 0 {
 			return models.Warehouse{}, errors.New("minimum Capacity must not be negative")
 		}
 	}
-	return s.rp.PartialUpdate(id, fields)
+	return s.rp.PartialUpdate(ctx, id, fields)
 }
 
-func (s *WarehouseDefault) Remove(id int) error {
-	return s.rp.Delete(id)
+func (s *WarehouseDefault) Remove(ctx context.Context, id int) error {
+	return s.rp.Delete(ctx, id)
 }
@@ -0,0 +1,306 @@
+package _default
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/webhook"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+)
+
+const (
+	// webhookBatchSize is the number of due deliveries attempted by a single call to DeliverDue
+	webhookBatchSize = 100
+	// webhookTimeout is how long a receiver has to answer a delivery
+	webhookTimeout = 10 * time.Second
+	// maxWebhookErrorLength is the size of the last_error column
+	maxWebhookErrorLength = 255
+)
+
+// WebhookRetryPolicy decides when a failed delivery is attempted again. The delay doubles after every failed attempt,
+// starting at InitialBackoff and never exceeding MaxBackoff, until MaxAttempts attempts failed and the delivery is
+// moved to the dead-letter list.
+type WebhookRetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultWebhookRetryPolicy retries a delivery for about a day before giving up on it
+var DefaultWebhookRetryPolicy = WebhookRetryPolicy{
+	MaxAttempts:    10,
+	InitialBackoff: 30 * time.Second,
+	MaxBackoff:     6 * time.Hour,
+}
+
+// backoff returns the delay before the next attempt of a delivery that failed attempts times
+func (p WebhookRetryPolicy) backoff(attempts int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
+
+type WebhookDefault struct {
+	// subscriptions is the repository of the webhook subscriptions
+	subscriptions repository.WebhookSubscriptionRepository
+	// deliveries is the repository of the deliveries of the events
+	deliveries repository.WebhookDeliveryRepository
+	// client sends the deliveries to the receivers
+	client *http.Client
+	// policy decides when failed deliveries are attempted again
+	policy WebhookRetryPolicy
+	// now returns the current time
+	now func() time.Time
+}
+
+// NewWebhookDefault returns the webhook service, a nil client uses a client with a 10 seconds timeout and the zero
+// fields of the policy take the value of DefaultWebhookRetryPolicy
+func NewWebhookDefault(subscriptions repository.WebhookSubscriptionRepository, deliveries repository.WebhookDeliveryRepository, client *http.Client, policy WebhookRetryPolicy) *WebhookDefault {
+	if client == nil {
+		client = &http.Client{Timeout: webhookTimeout}
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DefaultWebhookRetryPolicy.MaxAttempts
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = DefaultWebhookRetryPolicy.InitialBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DefaultWebhookRetryPolicy.MaxBackoff
+	}
+	return &WebhookDefault{subscriptions: subscriptions, deliveries: deliveries, client: client, policy: policy, now: time.Now}
+}
+
+func (s *WebhookDefault) RetrieveAll(ctx context.Context) ([]models.WebhookSubscription, error) {
+	return s.subscriptions.FindAll(ctx)
+}
+
+func (s *WebhookDefault) Retrieve(ctx context.Context, id int) (models.WebhookSubscription, error) {
+	return s.subscriptions.FindById(ctx, id)
+}
+
+func (s *WebhookDefault) Register(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	if err := validateSubscription(subscription); err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	subscription.CreatedAt = s.now()
+	return s.subscriptions.Create(ctx, subscription)
+}
+
+// Modify replaces a subscription, keeping the date it was created at
+func (s *WebhookDefault) Modify(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	if err := validateSubscription(subscription); err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	current, err := s.subscriptions.FindById(ctx, subscription.Id)
+	if err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	subscription.CreatedAt = current.CreatedAt
+	return s.subscriptions.Update(ctx, subscription)
+}
+
+// PartialModify applies the fields to the subscription and validates the result before saving it
+func (s *WebhookDefault) PartialModify(ctx context.Context, id int, fields map[string]any) (models.WebhookSubscription, error) {
+	subscription, err := s.subscriptions.FindById(ctx, id)
+	if err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	createdAt := subscription.CreatedAt
+	if err = patch.Apply(&subscription, fields); err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	subscription.Id, subscription.CreatedAt = id, createdAt
+	if err = validateSubscription(subscription); err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	return s.subscriptions.Update(ctx, subscription)
+}
+
+func (s *WebhookDefault) Remove(ctx context.Context, id int) error {
+	return s.subscriptions.Delete(ctx, id)
+}
+
+func (s *WebhookDefault) Publish(ctx context.Context, event models.WebhookEvent) error {
+	if event.Id == "" {
+		event.Id = newEventId()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = s.now()
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	subscriptions, err := s.subscriptions.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribed(event.Type) {
+			continue
+		}
+		_, err = s.deliveries.Create(ctx, models.WebhookDelivery{
+			SubscriptionId: subscription.Id,
+			EventId:        event.Id,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         models.WebhookDeliveryPending,
+			NextAttemptAt:  &now,
+			CreatedAt:      now,
+		})
+		// the event was already queued for the subscription, or the subscription was deleted meanwhile
+		if errors.Is(err, repository.ErrEntityAlreadyExists) || errors.Is(err, repository.ErrForeignKeyViolation) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *WebhookDefault) DeliverDue(ctx context.Context) error {
+	due, err := s.deliveries.FindDue(ctx, s.now(), webhookBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range due {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if _, err = s.attempt(ctx, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *WebhookDefault) RetrieveDeliveries(ctx context.Context, subscriptionId int) ([]models.WebhookDelivery, error) {
+	if _, err := s.subscriptions.FindById(ctx, subscriptionId); err != nil {
+		return nil, err
+	}
+	return s.deliveries.FindBySubscription(ctx, subscriptionId)
+}
+
+func (s *WebhookDefault) RetrieveDeadLetters(ctx context.Context) ([]models.WebhookDelivery, error) {
+	return s.deliveries.FindByStatus(ctx, models.WebhookDeliveryDead)
+}
+
+// Redeliver resets the attempts of the delivery, so that it is retried again with the retry policy when the
+// attempt fails
+func (s *WebhookDefault) Redeliver(ctx context.Context, id int) (models.WebhookDelivery, error) {
+	delivery, err := s.deliveries.FindById(ctx, id)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	delivery.Attempts = 0
+	return s.attempt(ctx, delivery)
+}
+
+// attempt sends the delivery to its subscription and stores the outcome. Failures of the receiver are recorded in
+// the delivery, only the errors of the repositories are returned.
+func (s *WebhookDefault) attempt(ctx context.Context, delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	subscription, err := s.subscriptions.FindById(ctx, delivery.SubscriptionId)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+
+	status, err := s.send(ctx, subscription, delivery)
+	now := s.now()
+	delivery.Attempts++
+	delivery.ResponseStatus = status
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+	case !subscription.Active || delivery.Attempts >= s.policy.MaxAttempts:
+		delivery.Status = models.WebhookDeliveryDead
+		delivery.LastError = truncate(err.Error(), maxWebhookErrorLength)
+		delivery.NextAttemptAt = nil
+	default:
+		next := now.Add(s.policy.backoff(delivery.Attempts))
+		delivery.Status = models.WebhookDeliveryPending
+		delivery.LastError = truncate(err.Error(), maxWebhookErrorLength)
+		delivery.NextAttemptAt = &next
+	}
+	return s.deliveries.Update(ctx, delivery)
+}
+
+// send posts the signed payload of the delivery to the subscription, any response other than 2xx is an error
+func (s *WebhookDefault) send(ctx context.Context, subscription models.WebhookSubscription, delivery models.WebhookDelivery) (int, error) {
+	if !subscription.Active {
+		return 0, errors.New("the subscription is inactive")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.IdHeader, delivery.EventId)
+	req.Header.Set(webhook.EventHeader, delivery.EventType)
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(subscription.Secret, s.now(), delivery.Payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// the body is drained so that the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("the receiver responded with status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// validateSubscription checks the URL and the event types of a subscription
+func validateSubscription(subscription models.WebhookSubscription) error {
+	u, err := url.Parse(subscription.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return service.ErrInvalidWebhookUrl
+	}
+	if len(subscription.EventTypes) == 0 {
+		return service.ErrEmptyWebhookEventTypes
+	}
+	for _, eventType := range subscription.EventTypes {
+		if !slices.Contains(models.WebhookEventTypes, eventType) {
+			return service.ErrUnknownWebhookEventType
+		}
+	}
+	return nil
+}
+
+// newEventId returns a random id for an event that has no natural one
+func newEventId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length]
+}
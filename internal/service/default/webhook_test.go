@@ -0,0 +1,328 @@
+package _default
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/webhook"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// subscriptionStore keeps the webhook subscriptions in memory
+type subscriptionStore struct {
+	repository.WebhookSubscriptionRepository
+	subscriptions map[int]models.WebhookSubscription
+}
+
+func (s *subscriptionStore) FindAll(ctx context.Context) ([]models.WebhookSubscription, error) {
+	var all []models.WebhookSubscription
+	for _, subscription := range s.subscriptions {
+		all = append(all, subscription)
+	}
+	return all, nil
+}
+
+func (s *subscriptionStore) FindById(ctx context.Context, id int) (models.WebhookSubscription, error) {
+	subscription, ok := s.subscriptions[id]
+	if !ok {
+		return models.WebhookSubscription{}, repository.ErrEntityNotFound
+	}
+	return subscription, nil
+}
+
+func (s *subscriptionStore) Create(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	subscription.Id = len(s.subscriptions) + 1
+	s.subscriptions[subscription.Id] = subscription
+	return subscription, nil
+}
+
+func (s *subscriptionStore) Update(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error) {
+	s.subscriptions[subscription.Id] = subscription
+	return subscription, nil
+}
+
+// deliveryStore keeps the webhook deliveries in memory
+type deliveryStore struct {
+	deliveries map[int]models.WebhookDelivery
+}
+
+func (s *deliveryStore) FindById(ctx context.Context, id int) (models.WebhookDelivery, error) {
+	delivery, ok := s.deliveries[id]
+	if !ok {
+		return models.WebhookDelivery{}, repository.ErrEntityNotFound
+	}
+	return delivery, nil
+}
+
+func (s *deliveryStore) find(match func(models.WebhookDelivery) bool) []models.WebhookDelivery {
+	var found []models.WebhookDelivery
+	for _, delivery := range s.deliveries {
+		if match(delivery) {
+			found = append(found, delivery)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Id < found[j].Id })
+	return found
+}
+
+func (s *deliveryStore) FindBySubscription(ctx context.Context, subscriptionId int) ([]models.WebhookDelivery, error) {
+	return s.find(func(d models.WebhookDelivery) bool { return d.SubscriptionId == subscriptionId }), nil
+}
+
+func (s *deliveryStore) FindByStatus(ctx context.Context, status string) ([]models.WebhookDelivery, error) {
+	return s.find(func(d models.WebhookDelivery) bool { return d.Status == status }), nil
+}
+
+func (s *deliveryStore) FindDue(ctx context.Context, at time.Time, limit int) ([]models.WebhookDelivery, error) {
+	return s.find(func(d models.WebhookDelivery) bool {
+		return d.Status == models.WebhookDeliveryPending && !d.NextAttemptAt.After(at)
+	}), nil
+}
+
+func (s *deliveryStore) Create(ctx context.Context, delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	for _, d := range s.deliveries {
+		if d.SubscriptionId == delivery.SubscriptionId && d.EventId == delivery.EventId {
+			return models.WebhookDelivery{}, repository.ErrEntityAlreadyExists
+		}
+	}
+	delivery.Id = len(s.deliveries) + 1
+	s.deliveries[delivery.Id] = delivery
+	return delivery, nil
+}
+
+func (s *deliveryStore) Update(ctx context.Context, delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	s.deliveries[delivery.Id] = delivery
+	return delivery, nil
+}
+
+// receiver records the deliveries it receives and answers them with its current status
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	received []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.received = append(r.received, req)
+	r.bodies = append(r.bodies, body)
+	w.WriteHeader(r.status)
+}
+
+func (r *receiver) respond(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+type WebhookTestSuite struct {
+	suite.Suite
+	receiver   *receiver
+	server     *httptest.Server
+	deliveries *deliveryStore
+	sv         *WebhookDefault
+	now        time.Time
+}
+
+func (s *WebhookTestSuite) SetupTest() {
+	s.receiver = &receiver{status: http.StatusNoContent}
+	s.server = httptest.NewServer(s.receiver)
+	s.deliveries = &deliveryStore{deliveries: map[int]models.WebhookDelivery{}}
+	s.now = time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	s.sv = NewWebhookDefault(&subscriptionStore{subscriptions: map[int]models.WebhookSubscription{}}, s.deliveries, s.server.Client(), WebhookRetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     time.Hour,
+	})
+	s.sv.now = func() time.Time { return s.now }
+}
+
+func (s *WebhookTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *WebhookTestSuite) subscribe(eventTypes ...string) models.WebhookSubscription {
+	subscription, err := s.sv.Register(context.Background(), models.WebhookSubscription{Url: s.server.URL, Secret: "s3cr3t", EventTypes: eventTypes, Active: true})
+	s.Require().NoError(err)
+	return subscription
+}
+
+func (s *WebhookTestSuite) TestDeliverDue_SignedDelivery() {
+	// Arrange
+	s.subscribe(models.WebhookEventPurchaseOrderCreated)
+	s.subscribe(models.WebhookEventInboundOrderReceived)
+	event := models.WebhookEvent{Id: "purchase_order.created:1", Type: models.WebhookEventPurchaseOrderCreated, Data: models.PurchaseOrder{Id: 1}}
+
+	// Act
+	publishErr := s.sv.Publish(context.Background(), event)
+	deliverErr := s.sv.DeliverDue(context.Background())
+
+	// Assert
+	s.Require().NoError(publishErr)
+	s.Require().NoError(deliverErr)
+	s.Require().Len(s.receiver.received, 1)
+	req, body := s.receiver.received[0], s.receiver.bodies[0]
+	s.Equal("purchase_order.created:1", req.Header.Get(webhook.IdHeader))
+	s.Equal(models.WebhookEventPurchaseOrderCreated, req.Header.Get(webhook.EventHeader))
+	s.NoError(webhook.Verify("s3cr3t", req.Header.Get(webhook.SignatureHeader), body, s.now, 5*time.Minute))
+
+	var received models.WebhookEvent
+	s.Require().NoError(json.Unmarshal(body, &received))
+	s.Equal(event.Id, received.Id)
+
+	delivery, _ := s.deliveries.FindById(context.Background(), 1)
+	s.Equal(models.WebhookDeliveryDelivered, delivery.Status)
+	s.Equal(http.StatusNoContent, delivery.ResponseStatus)
+	s.Equal(1, delivery.Attempts)
+}
+
+func (s *WebhookTestSuite) TestPublish_Deduplicated() {
+	// Arrange
+	s.subscribe(models.WebhookEventProductBatchExpiring)
+	event := models.WebhookEvent{Id: "product_batch.expiring:7", Type: models.WebhookEventProductBatchExpiring}
+
+	// Act
+	s.Require().NoError(s.sv.Publish(context.Background(), event))
+	s.Require().NoError(s.sv.Publish(context.Background(), event))
+	s.Require().NoError(s.sv.DeliverDue(context.Background()))
+
+	// Assert
+	s.Len(s.deliveries.deliveries, 1)
+	s.Len(s.receiver.received, 1)
+}
+
+func (s *WebhookTestSuite) TestDeliverDue_RetriesWithBackoffThenDeadLetter() {
+	// Arrange
+	s.subscribe(models.WebhookEventSectionTemperatureExcursion)
+	s.receiver.respond(http.StatusInternalServerError)
+	s.Require().NoError(s.sv.Publish(context.Background(), models.WebhookEvent{Type: models.WebhookEventSectionTemperatureExcursion}))
+
+	// Act - first attempt fails, the second one is not due until the backoff elapsed
+	s.Require().NoError(s.sv.DeliverDue(context.Background()))
+	s.now = s.now.Add(59 * time.Second)
+	s.Require().NoError(s.sv.DeliverDue(context.Background()))
+	first, _ := s.deliveries.FindById(context.Background(), 1)
+
+	// Act - the second attempt waits twice as long
+	s.now = s.now.Add(time.Second)
+	s.Require().NoError(s.sv.DeliverDue(context.Background()))
+	second, _ := s.deliveries.FindById(context.Background(), 1)
+
+	// Act - the third attempt exhausts the policy
+	s.now = s.now.Add(2 * time.Minute)
+	s.Require().NoError(s.sv.DeliverDue(context.Background()))
+	dead, err := s.sv.RetrieveDeadLetters(context.Background())
+
+	// Assert
+	s.Equal(models.WebhookDeliveryPending, first.Status)
+	s.Equal(1, first.Attempts)
+	s.Equal(http.StatusInternalServerError, first.ResponseStatus)
+	s.Equal(2, second.Attempts)
+	s.Equal(s.now, *second.NextAttemptAt)
+
+	s.Require().NoError(err)
+	s.Require().Len(dead, 1)
+	s.Equal(3, dead[0].Attempts)
+	s.Nil(dead[0].NextAttemptAt)
+	s.Equal("the receiver responded with status 500", dead[0].LastError)
+	s.Len(s.receiver.received, 3)
+}
+
+func (s *WebhookTestSuite) TestRedeliver_DeadLetter() {
+	// Arrange
+	s.subscribe(models.WebhookEventInboundOrderReceived)
+	s.receiver.respond(http.StatusBadGateway)
+	s.Require().NoError(s.sv.Publish(context.Background(), models.WebhookEvent{Id: "inbound_order.received:3", Type: models.WebhookEventInboundOrderReceived}))
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.sv.DeliverDue(context.Background()))
+		s.now = s.now.Add(time.Hour)
+	}
+	s.receiver.respond(http.StatusOK)
+
+	// Act
+	delivery, err := s.sv.Redeliver(context.Background(), 1)
+	dead, _ := s.sv.RetrieveDeadLetters(context.Background())
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(models.WebhookDeliveryDelivered, delivery.Status)
+	s.Equal(1, delivery.Attempts)
+	s.Empty(delivery.LastError)
+	s.NotNil(delivery.DeliveredAt)
+	s.Empty(dead)
+	s.Len(s.receiver.received, 4)
+}
+
+func (s *WebhookTestSuite) TestRedeliver_NotFound() {
+	// Act
+	_, err := s.sv.Redeliver(context.Background(), 1)
+
+	// Assert
+	s.ErrorIs(err, repository.ErrEntityNotFound)
+}
+
+func (s *WebhookTestSuite) TestPublish_InactiveSubscription() {
+	// Arrange
+	subscription := s.subscribe(models.WebhookEventPurchaseOrderCreated)
+	_, err := s.sv.PartialModify(context.Background(), subscription.Id, map[string]any{"active": false})
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(s.sv.Publish(context.Background(), models.WebhookEvent{Type: models.WebhookEventPurchaseOrderCreated}))
+
+	// Assert
+	s.Empty(s.deliveries.deliveries)
+}
+
+func (s *WebhookTestSuite) TestRegister_Invalid() {
+	tests := []struct {
+		name         string
+		subscription models.WebhookSubscription
+		expectedErr  error
+	}{
+		{name: "Error - Relative URL", subscription: models.WebhookSubscription{Url: "/hooks", EventTypes: []string{models.WebhookEventPurchaseOrderCreated}}, expectedErr: service.ErrInvalidWebhookUrl},
+		{name: "Error - Unsupported scheme", subscription: models.WebhookSubscription{Url: "ftp://example.com", EventTypes: []string{models.WebhookEventPurchaseOrderCreated}}, expectedErr: service.ErrInvalidWebhookUrl},
+		{name: "Error - No event types", subscription: models.WebhookSubscription{Url: "https://example.com"}, expectedErr: service.ErrEmptyWebhookEventTypes},
+		{name: "Error - Unknown event type", subscription: models.WebhookSubscription{Url: "https://example.com", EventTypes: []string{"order.shipped"}}, expectedErr: service.ErrUnknownWebhookEventType},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := s.sv.Register(context.Background(), tt.subscription)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	// Arrange
+	policy := WebhookRetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	// Act
+	delays := []time.Duration{policy.backoff(1), policy.backoff(2), policy.backoff(3), policy.backoff(4), policy.backoff(5), policy.backoff(9)}
+
+	// Assert
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}, delays)
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
@@ -1,20 +1,23 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type EmployeeService interface {
-	RetrieveAll() (e []models.Employee, err error)
+	RetrieveAll(ctx context.Context) (e []models.Employee, err error)
 
-	Retrieve(id int) (models.Employee, error)
+	Retrieve(ctx context.Context, id int) (models.Employee, error)
 
-	Register(emp models.Employee) (models.Employee, error)
-	Modify(emp models.Employee) (models.Employee, error)
+	Register(ctx context.Context, emp models.Employee) (models.Employee, error)
+	Modify(ctx context.Context, emp models.Employee) (models.Employee, error)
 
-	PartialModify(id int, fields map[string]interface{}) (models.Employee, error)
+	PartialModify(ctx context.Context, id int, fields map[string]interface{}) (models.Employee, error)
 
-	Remove(id int) error
+	Remove(ctx context.Context, id int) error
 
-	RetrieveInboundOrdersReport() ([]models.EmployeeInboundOrdersReport, error)
+	RetrieveInboundOrdersReport(ctx context.Context) ([]models.EmployeeInboundOrdersReport, error)
 
-	RetrieveInboundOrdersReportById(id int) (models.EmployeeInboundOrdersReport, error)
+	RetrieveInboundOrdersReportById(ctx context.Context, id int) (models.EmployeeInboundOrdersReport, error)
 }
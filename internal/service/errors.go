@@ -15,6 +15,182 @@ var (
 	// ErrEmptyEntity is returned when an entity is empty
 	ErrEmptyEntity = errors.New("empty entity")
 
+	// ErrIdempotencyKeyMismatch is returned when an idempotency key is reused with a different request
+	ErrIdempotencyKeyMismatch = errors.New("idempotency key already used with a different request")
+
+	// ErrIdempotencyKeyInProgress is returned when the request that first used an idempotency key has not finished yet
+	ErrIdempotencyKeyInProgress = errors.New("a request with the same idempotency key is still in progress")
+
+	// ErrInvalidIdempotencyKey is returned when an idempotency key is empty or too long
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key, must have between 1 and 255 characters")
+
+	// ErrInvalidImportMode is returned when an import mode is neither atomic nor best-effort
+	ErrInvalidImportMode = errors.New("invalid import mode, must be one of atomic or best-effort")
+
+	// ErrEmptyImport is returned when an import does not contain any row
+	ErrEmptyImport = errors.New("the import does not contain any row")
+
+	// ErrUnsupportedImportEntity is returned when an import row holds an entity that cannot be imported
+	ErrUnsupportedImportEntity = errors.New("unsupported import entity")
+
+	// ErrInvalidWebhookUrl is returned when the URL of a webhook subscription is not an absolute http or https URL
+	ErrInvalidWebhookUrl = errors.New("invalid webhook url, must be an absolute http or https URL")
+
+	// ErrUnknownWebhookEventType is returned when a webhook subscription subscribes to an event type that does not exist
+	ErrUnknownWebhookEventType = errors.New("unknown webhook event type, must be one of purchase_order.created, inbound_order.received, product_batch.expiring or section.temperature_excursion")
+
+	// ErrEmptyWebhookEventTypes is returned when a webhook subscription does not subscribe to any event type
+	ErrEmptyWebhookEventTypes = errors.New("a webhook subscription must subscribe to at least one event type")
+
+	// ErrInvalidProductTypeName is returned when the name of a product type is blank
+	ErrInvalidProductTypeName = errors.New("invalid product type name, must not be blank")
+
+	// ErrProductTypeInUse is returned when a product type being deleted is still referenced by products or sections
+	ErrProductTypeInUse = errors.New("the product type is still used by products or sections")
+
+	// ErrInvalidCountryName is returned when the name of a country is blank
+	ErrInvalidCountryName = errors.New("invalid country name, must not be blank")
+
+	// ErrInvalidProvinceName is returned when the name of a province is blank
+	ErrInvalidProvinceName = errors.New("invalid province name, must not be blank")
+
+	// ErrCountryInUse is returned when a country being deleted still has provinces or tax rules
+	ErrCountryInUse = errors.New("the country still has provinces or tax rules")
+
+	// ErrInvalidTaxRuleName is returned when the name of a tax rule is blank
+	ErrInvalidTaxRuleName = errors.New("invalid tax rule name, must not be blank")
+
+	// ErrInvalidTaxRate is returned when the rate of a tax rule is not a percentage
+	ErrInvalidTaxRate = errors.New("invalid tax rate, must be between 0 and 100")
+
+	// ErrProvinceInUse is returned when a province being deleted still has localities
+	ErrProvinceInUse = errors.New("the province still has localities")
+
+	// ErrLocalityInUse is returned when a locality being deleted is still referenced by sellers, carriers or warehouses
+	ErrLocalityInUse = errors.New("the locality is still used by sellers, carriers or warehouses")
+
+	// ErrEmptyLocalitySearch is returned when a locality search does not contain a name
+	ErrEmptyLocalitySearch = errors.New("the name to search must not be blank")
+
+	// ErrPurchaseOrderShipped is returned when the lines of a purchase order in transit or delivered are changed
+	ErrPurchaseOrderShipped = errors.New("the purchase order was already shipped, its lines cannot be changed")
+
+	// ErrInvalidOrderDetailQuantity is returned when the quantity of a purchase order line is not positive
+	ErrInvalidOrderDetailQuantity = errors.New("invalid quantity, must be a positive integer greater than zero")
+
+	// ErrInvalidDateRange is returned when the start of a date range is after its end
+	ErrInvalidDateRange = errors.New("invalid date range, from must not be after to")
+
+	// ErrInvalidStockMovementReason is returned when a stock movement registered by hand is not an adjustment or a
+	// disposal
+	ErrInvalidStockMovementReason = errors.New("invalid reason, must be one of adjustment or disposal")
+
+	// ErrInvalidAdjustmentReasonCode is returned when an adjustment is registered without a reason code, or with one
+	// that cannot be registered by hand
+	ErrInvalidAdjustmentReasonCode = errors.New("invalid reason code, adjustments must be expired, damaged, temperature_excursion, theft or found")
+
+	// ErrInvalidDisposalReasonCode is returned when a disposal is registered by hand without a reason code, or with
+	// one of stock that did not spoil nor was damaged
+	ErrInvalidDisposalReasonCode = errors.New("invalid reason code, disposals must be expired, damaged or temperature_excursion")
+
+	// ErrInvalidStockMovementQuantity is returned when a stock movement does not change the stock, or goes the other
+	// way than its reason: disposals and adjustments of spoiled, damaged or stolen stock take it out, found stock adds
+	// it
+	ErrInvalidStockMovementQuantity = errors.New("invalid quantity, must not be zero, negative for disposals and losses and positive for found stock")
+
+	// ErrSameWarehouseTransfer is returned when a transfer order moves batches within a single warehouse
+	ErrSameWarehouseTransfer = errors.New("the source and destination warehouses of a transfer order must differ")
+
+	// ErrSectionNotInWarehouse is returned when a section of a transfer order does not belong to its warehouse
+	ErrSectionNotInWarehouse = errors.New("the section does not belong to the warehouse")
+
+	// ErrEmptyTransferOrder is returned when a transfer order has no lines
+	ErrEmptyTransferOrder = errors.New("a transfer order must move at least one product batch")
+
+	// ErrDuplicatedTransferBatch is returned when a product batch appears in more than one line of a transfer order
+	ErrDuplicatedTransferBatch = errors.New("a product batch can only appear once in a transfer order")
+
+	// ErrBatchNotInSection is returned when a product batch of a transfer order is not stored in its source section
+	ErrBatchNotInSection = errors.New("the product batch is not stored in the source section")
+
+	// ErrEmptyTransferBatch is returned when a product batch of a transfer order holds no stock
+	ErrEmptyTransferBatch = errors.New("the product batch holds no stock to transfer")
+
+	// ErrBatchInOpenTransfer is returned when a product batch is already moved by a transfer order not received yet
+	ErrBatchInOpenTransfer = errors.New("the product batch is already part of a transfer order not received yet")
+
+	// ErrInvalidTransferOrderStatus is returned when a transfer order is moved out of the draft, dispatched, in transit
+	// and received order
+	ErrInvalidTransferOrderStatus = errors.New("invalid status change, transfer orders go from draft to dispatched, in transit and received")
+
+	// ErrTransferOrderCarrierRequired is returned when a transfer order is handed over without a carrier
+	ErrTransferOrderCarrierRequired = errors.New("a carrier must be assigned before the transfer order is in transit")
+
+	// ErrInvalidCountedQuantity is returned when a batch is counted with a negative quantity
+	ErrInvalidCountedQuantity = errors.New("the counted quantity must not be negative")
+
+	// ErrCountSessionClosed is returned when an approved count session is counted or approved again
+	ErrCountSessionClosed = errors.New("the count session was already approved")
+
+	// ErrBatchNotInCountSession is returned when a product batch counted is not one of the batches of the session
+	ErrBatchNotInCountSession = errors.New("the product batch is not part of the count session")
+
+	// ErrEmployeeNotInWarehouse is returned when a section is counted by an employee of another warehouse
+	ErrEmployeeNotInWarehouse = errors.New("the employee does not work in the warehouse of the section")
+
+	// ErrCountSessionIncomplete is returned when a count session is approved before all its batches are counted
+	ErrCountSessionIncomplete = errors.New("every product batch of the count session must be counted before it is approved")
+
+	// ErrPickedProductMismatch is returned when the product batch picked for a purchase order line holds another
+	// product than the one ordered
+	ErrPickedProductMismatch = errors.New("the product batch does not hold the product of the order line")
+
+	// ErrOrderDetailQuantityBelowPicked is returned when the quantity of a purchase order line is lowered below the
+	// quantity already picked for it
+	ErrOrderDetailQuantityBelowPicked = errors.New("the quantity of the order line must not be lower than the quantity picked")
+
+	// ErrOrderDetailProductPicked is returned when the product record of a purchase order line is changed after stock
+	// was picked for it
+	ErrOrderDetailProductPicked = errors.New("the product of the order line cannot change once it was picked")
+
+	// ErrOrderDetailPicked is returned when a purchase order line is removed after stock was picked for it
+	ErrOrderDetailPicked = errors.New("the order line cannot be removed once it was picked")
+
+	// ErrBatchNotInOrderWarehouse is returned when a product batch picked for a purchase order is stored in another
+	// warehouse than the one the order ships from
+	ErrBatchNotInOrderWarehouse = errors.New("the product batch is not stored in the warehouse of the purchase order")
+
+	// ErrInvalidRecallSeverity is returned when a recall is opened with a severity other than low, medium or high
+	ErrInvalidRecallSeverity = errors.New("invalid severity, must be one of low, medium or high")
+
+	// ErrInvalidRecallScope is returned when a recall is opened for both a product and a set of batches, or for neither
+	ErrInvalidRecallScope = errors.New("a recall targets either a product or a set of batch numbers")
+
+	// ErrRecallClosed is returned when the batches of a closed recall are quarantined or disposed, or it is closed
+	// again
+	ErrRecallClosed = errors.New("the recall was already closed")
+
+	// ErrBatchNotInRecall is returned when a product batch quarantined or disposed is not one of the batches of the
+	// recall
+	ErrBatchNotInRecall = errors.New("the product batch is not part of the recall")
+
+	// ErrInvalidRecallBatchStatus is returned when a recalled batch is disposed before it is quarantined, or
+	// quarantined twice
+	ErrInvalidRecallBatchStatus = errors.New("invalid status change, recalled batches are quarantined and then disposed")
+
+	// ErrRecallIncomplete is returned when a recall is closed before all its batches are disposed
+	ErrRecallIncomplete = errors.New("every product batch of the recall must be disposed before it is closed")
+
+	// ErrInvalidProductBatchStatus is returned when a product batch is moved to an unknown status, or to recalled
+	ErrInvalidProductBatchStatus = errors.New("invalid status, must be one of received, under_inspection, available, quarantined, expired or disposed")
+
+	// ErrInvalidProductBatchStatusChange is returned when a product batch is moved to a status it cannot reach from
+	// its current one
+	ErrInvalidProductBatchStatusChange = errors.New("the product batch cannot be moved from its current status to the given one")
+
+	// ErrProductBatchStatusReasonRequired is returned when the status of a product batch is changed without a reason
+	ErrProductBatchStatusReasonRequired = errors.New("a reason is required to change the status of a product batch")
+
 	ErrProductIdConflict = errors.New("the product with the given id does not exists")
 	ErrProductNotFound   = errors.New("product not found")
 )
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// IdempotencyService decides whether a request sent with an idempotency key must be executed or replayed
+type IdempotencyService interface {
+	// Begin claims the key for the request. It returns the stored record, which is already completed when the
+	// request is a replay of a previous one.
+	Begin(ctx context.Context, key string, method string, path string, body []byte) (models.IdempotencyKey, error)
+	// Complete stores the response of the request that claimed the key
+	Complete(ctx context.Context, record models.IdempotencyKey, statusCode int, contentType string, body []byte) (models.IdempotencyKey, error)
+	// Release frees the key so the request can be retried, used when the request failed unexpectedly
+	Release(ctx context.Context, record models.IdempotencyKey) error
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// ImportRowReader streams the rows of an import, Next returns io.EOF once every row has been read
+type ImportRowReader interface {
+	Next() (models.ImportRow, error)
+}
+
+// ImportService creates entities in bulk from a stream of rows
+type ImportService interface {
+	// Import creates the entity of every row read, in the given mode, and reports the outcome of each one
+	Import(ctx context.Context, resource string, mode string, rows ImportRowReader) (models.ImportReport, error)
+}
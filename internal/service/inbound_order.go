@@ -1,12 +1,15 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type InboundOrderService interface {
-	RetrieveAll() ([]models.InboundOrder, error)
-	Retrieve(id int) (models.InboundOrder, error)
-	Register(inboundOrder models.InboundOrder) (models.InboundOrder, error)
-	Modify(inboundOrder models.InboundOrder) (models.InboundOrder, error)
-	PartialModify(id int, fields map[string]any) (models.InboundOrder, error)
-	Remove(id int) error
+	RetrieveAll(ctx context.Context) ([]models.InboundOrder, error)
+	Retrieve(ctx context.Context, id int) (models.InboundOrder, error)
+	Register(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error)
+	Modify(ctx context.Context, inboundOrder models.InboundOrder) (models.InboundOrder, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.InboundOrder, error)
+	Remove(ctx context.Context, id int) error
 }
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// InvoiceService prices the purchase orders and bills them
+type InvoiceService interface {
+	// RetrievePricedOrder retrieves a purchase order along with its line amounts, subtotal, taxes and grand total
+	RetrievePricedOrder(ctx context.Context, id int) (models.PricedPurchaseOrder, error)
+	// RetrieveInvoice retrieves the invoice of a purchase order, taxed with the rules of the country of its warehouse
+	RetrieveInvoice(ctx context.Context, id int) (models.Invoice, error)
+}
@@ -1,17 +1,22 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type LocalityService interface {
-	RetrieveAll() ([]models.Locality, error)
-	Retrieve(id int) (models.Locality, error)
-	RetrieveCarriers() ([]models.LocalityCarrierCount, error)
-	Register(locality models.Locality) (models.Locality, error)
-	RegisterWithNames(locality models.LocalityDoc) (models.LocalityDoc, error) // Nuevo método para manejo de LocalityDoc
-	Modify(locality models.Locality) (models.Locality, error)
-	PartialModify(id int, fields map[string]any) (models.Locality, error)
-	Remove(id int) error
-	RetrieveLocalityBySeller(id int) (models.LocalitySellerCount, error)
-	RetrieveAllLocalitiesBySeller() ([]models.LocalitySellerCount, error)
-	RetrieveCarriersByLocality(id int) ([]models.LocalityCarrierCount, error)
+	RetrieveAll(ctx context.Context) ([]models.Locality, error)
+	Retrieve(ctx context.Context, id int) (models.Locality, error)
+	RetrieveCarriers(ctx context.Context) ([]models.LocalityCarrierCount, error)
+	Register(ctx context.Context, locality models.Locality) (models.Locality, error)
+	RegisterWithNames(ctx context.Context, locality models.LocalityDoc) (models.LocalityDoc, error) // Nuevo método para manejo de LocalityDoc
+	Modify(ctx context.Context, locality models.Locality) (models.Locality, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Locality, error)
+	Remove(ctx context.Context, id int) error
+	RetrieveLocalityBySeller(ctx context.Context, id int) (models.LocalitySellerCount, error)
+	RetrieveAllLocalitiesBySeller(ctx context.Context) ([]models.LocalitySellerCount, error)
+	RetrieveCarriersByLocality(ctx context.Context, id int) ([]models.LocalityCarrierCount, error)
+	// SearchByName returns the localities whose name contains name, ignoring case and accents
+	SearchByName(ctx context.Context, name string) ([]models.LocalityDoc, error)
 }
@@ -1,12 +1,35 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type OrderDetailService interface {
-	RetrieveAll() (v []models.OrderDetail, err error)
-	Retrieve(id int) (models.OrderDetail, error)
-	Register(s models.OrderDetail) (models.OrderDetail, error)
-	Modify(s models.OrderDetail) (models.OrderDetail, error)
-	PartialModify(id int, fields map[string]any) (models.OrderDetail, error)
-	Remove(id int) error
+	RetrieveAll(ctx context.Context) (v []models.OrderDetail, err error)
+	Retrieve(ctx context.Context, id int) (models.OrderDetail, error)
+	// RetrieveByPurchaseOrders retrieves the lines of the given purchase orders
+	RetrieveByPurchaseOrders(ctx context.Context, purchaseOrderIds []int) ([]models.OrderDetail, error)
+	Register(ctx context.Context, s models.OrderDetail) (models.OrderDetail, error)
+	Modify(ctx context.Context, s models.OrderDetail) (models.OrderDetail, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.OrderDetail, error)
+	Remove(ctx context.Context, id int) error
+	// RetrieveLines retrieves the lines of a purchase order along with the totals computed from them
+	RetrieveLines(ctx context.Context, purchaseOrderId int) (models.PurchaseOrderLines, error)
+	// RetrieveLine retrieves a line of a purchase order, a line of another order is not found
+	RetrieveLine(ctx context.Context, purchaseOrderId int, id int) (models.OrderDetail, error)
+	// AddLine adds a line to a purchase order, it returns ErrPurchaseOrderShipped once the order was shipped
+	AddLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error)
+	// ModifyLine replaces a line of a purchase order, it returns ErrPurchaseOrderShipped once the order was shipped,
+	// ErrOrderDetailProductPicked when the product of a picked line changes and ErrOrderDetailQuantityBelowPicked when
+	// the quantity is lowered below the quantity picked
+	ModifyLine(ctx context.Context, purchaseOrderId int, detail models.OrderDetail) (models.OrderDetail, error)
+	// RemoveLine removes a line of a purchase order, it returns ErrPurchaseOrderShipped once the order was shipped and
+	// ErrOrderDetailPicked once stock was picked for the line
+	RemoveLine(ctx context.Context, purchaseOrderId int, id int) error
+	// RetrievePicks retrieves the product batches picked for a line of a purchase order
+	RetrievePicks(ctx context.Context, purchaseOrderId int, id int) ([]models.OrderDetailPick, error)
+	// Pick takes stock of a product batch to fulfill a line of a purchase order, the batch must hold the product of the
+	// line and be stored in the warehouse of the order. It returns ErrPurchaseOrderShipped once the order was shipped.
+	Pick(ctx context.Context, purchaseOrderId int, id int, pick models.OrderDetailPick) (models.OrderDetailPick, error)
 }
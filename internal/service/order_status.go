@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// OrderStatusService is an interface that represents an OrderStatus service, the statuses are read only
+type OrderStatusService interface {
+	RetrieveAll(ctx context.Context) ([]models.OrderStatus, error)
+	Retrieve(ctx context.Context, id int) (models.OrderStatus, error)
+}
@@ -3,19 +3,24 @@
 
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 // ProductService defines the set of methods that a product service must implement.
 // It acts as a contract for the business logic, decoupling the application's core
 // from the transport layer (e.g., HTTP handlers) and the data access layer.
 
 type ProductService interface {
-	RetrieveAll() ([]models.Product, error)
-	Retrieve(id int) (models.Product, error)
-	Register(Product models.Product) (models.Product, error)
-	Modify(Product models.Product) (models.Product, error)
-	PartialModify(id int, fields map[string]any) (models.Product, error)
-	Remove(id int) error
-	RetrieveRecordsCountByProductId(id int) (models.ProductReport, error)
-	RetrieveRecordsCount() ([]models.ProductReport, error)
+	RetrieveAll(ctx context.Context) ([]models.Product, error)
+	Retrieve(ctx context.Context, id int) (models.Product, error)
+	// RetrieveByIds retrieves the products with the given ids, the ids that do not exist are skipped
+	RetrieveByIds(ctx context.Context, ids []int) ([]models.Product, error)
+	Register(ctx context.Context, Product models.Product) (models.Product, error)
+	Modify(ctx context.Context, Product models.Product) (models.Product, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Product, error)
+	Remove(ctx context.Context, id int) error
+	RetrieveRecordsCountByProductId(ctx context.Context, id int) (models.ProductReport, error)
+	RetrieveRecordsCount(ctx context.Context) ([]models.ProductReport, error)
 }
@@ -3,17 +3,36 @@
 
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
 
 // ProductService defines the set of methods that a product service must implement.
 // It acts as a contract for the business logic, decoupling the application's core
 // from the transport layer (e.g., HTTP handlers) and the data access layer.
 
 type ProductBatchService interface {
-	RetrieveAll() ([]models.ProductBatch, error)
-	Retrieve(id int) (models.ProductBatch, error)
-	Register(ProductBatch models.ProductBatch) (models.ProductBatch, error)
-	Modify(ProductBatch models.ProductBatch) (models.ProductBatch, error)
-	PartialModify(id int, fields map[string]any) (models.ProductBatch, error)
-	Remove(id int) error
+	RetrieveAll(ctx context.Context) ([]models.ProductBatch, error)
+	Retrieve(ctx context.Context, id int) (models.ProductBatch, error)
+	// RetrieveBySections retrieves the batches stored in the given sections
+	RetrieveBySections(ctx context.Context, sectionIds []int) ([]models.ProductBatch, error)
+	Register(ctx context.Context, ProductBatch models.ProductBatch) (models.ProductBatch, error)
+	Modify(ctx context.Context, ProductBatch models.ProductBatch) (models.ProductBatch, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.ProductBatch, error)
+	Remove(ctx context.Context, id int) error
+	// Trace follows the batch with the given number from its seller to the buyers it was shipped to
+	Trace(ctx context.Context, batchNumber string) (models.BatchTrace, error)
+	// ChangeStatus moves a batch to the given status for the given reason, changed by the employee if given. A batch
+	// is disposed with the reason code of its stock, expired, damaged or temperature_excursion. It returns
+	// ErrProductBatchRecalled while the batch is part of an open recall.
+	ChangeStatus(ctx context.Context, id int, status string, reason string, reasonCode *string, employeeId *int) (models.ProductBatch, error)
+	// RetrieveStatusChanges retrieves the status changes of a batch, oldest first
+	RetrieveStatusChanges(ctx context.Context, id int) ([]models.ProductBatchStatusChange, error)
+	// RetrieveExpiring retrieves the batches with stock left that may still be sold and whose due date is on or before
+	// until
+	RetrieveExpiring(ctx context.Context, until time.Time) ([]models.ProductBatch, error)
+	// ExpireDue moves the batches past their due date to expired and returns them
+	ExpireDue(ctx context.Context) ([]models.ProductBatch, error)
 }
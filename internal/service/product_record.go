@@ -1,12 +1,24 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"time"
+)
 
+// ProductRecordService manages the price history of the products, a price change is registered as a new record
 type ProductRecordService interface {
-	RetrieveAll() ([]models.ProductRecord, error)
-	Retrieve(id int) (models.ProductRecord, error)
-	Register(productRecord models.ProductRecord) (models.ProductRecord, error)
-	Modify(productRecord models.ProductRecord) (models.ProductRecord, error)
-	PartialModify(id int, fields map[string]any) (models.ProductRecord, error)
-	Remove(id int) error
+	RetrieveAll(ctx context.Context) ([]models.ProductRecord, error)
+	Retrieve(ctx context.Context, id int) (models.ProductRecord, error)
+	// RetrieveByIds retrieves the records with the given ids, the ids that do not exist are skipped
+	RetrieveByIds(ctx context.Context, ids []int) ([]models.ProductRecord, error)
+	// Register appends a record to the history of its product, stamped with the current time
+	Register(ctx context.Context, productRecord models.ProductRecord) (models.ProductRecord, error)
+	// RetrieveCurrentPrice retrieves the price of the product in effect now
+	RetrieveCurrentPrice(ctx context.Context, productId int) (models.ProductPrice, error)
+	// RetrievePriceAt retrieves the price of the product in effect at the instant
+	RetrievePriceAt(ctx context.Context, productId int, at time.Time) (models.ProductPrice, error)
+	// RetrievePriceTrend summarizes the prices of the product in effect within the range, it returns
+	// ErrInvalidDateRange when from is after to
+	RetrievePriceTrend(ctx context.Context, productId int, from time.Time, to time.Time) (models.ProductPriceTrend, error)
 }
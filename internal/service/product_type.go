@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// ProductTypeService is an interface that represents a ProductType service
+type ProductTypeService interface {
+	RetrieveAll(ctx context.Context) ([]models.ProductType, error)
+	Retrieve(ctx context.Context, id int) (models.ProductType, error)
+	Register(ctx context.Context, productType models.ProductType) (models.ProductType, error)
+	Modify(ctx context.Context, productType models.ProductType) (models.ProductType, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.ProductType, error)
+	// Remove deletes a product type, it returns ErrProductTypeInUse while a product or a section references it
+	Remove(ctx context.Context, id int) error
+	// RetrieveUsage counts the products and the sections of a product type, or of every type when id is nil
+	RetrieveUsage(ctx context.Context, id *int) ([]models.ProductTypeUsage, error)
+}
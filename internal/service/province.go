@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// ProvinceService is an interface that represents a Province service
+type ProvinceService interface {
+	RetrieveAll(ctx context.Context) ([]models.Province, error)
+	Retrieve(ctx context.Context, id int) (models.Province, error)
+	Register(ctx context.Context, province models.Province) (models.Province, error)
+	Modify(ctx context.Context, province models.Province) (models.Province, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Province, error)
+	// Remove deletes a province, it returns ErrProvinceInUse while the province has localities
+	Remove(ctx context.Context, id int) error
+}
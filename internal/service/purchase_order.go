@@ -1,13 +1,16 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type PurchaseOrderService interface {
-	RetrieveAll() (v []models.PurchaseOrder, err error)
-	Retrieve(id int) (models.PurchaseOrder, error)
-	Register(s models.PurchaseOrder) (models.PurchaseOrder, error)
-	Modify(s models.PurchaseOrder) (models.PurchaseOrder, error)
-	PartialModify(id int, fields map[string]any) (models.PurchaseOrder, error)
-	Remove(id int) error
-	RetrieveByBuyer(id int) ([]models.PurchaseOrder, error)
+	RetrieveAll(ctx context.Context) (v []models.PurchaseOrder, err error)
+	Retrieve(ctx context.Context, id int) (models.PurchaseOrder, error)
+	Register(ctx context.Context, s models.PurchaseOrder) (models.PurchaseOrder, error)
+	Modify(ctx context.Context, s models.PurchaseOrder) (models.PurchaseOrder, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.PurchaseOrder, error)
+	Remove(ctx context.Context, id int) error
+	RetrieveByBuyer(ctx context.Context, id int) ([]models.PurchaseOrder, error)
 }
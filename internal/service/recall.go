@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// RecallService runs the recall campaigns. A recall is opened for a product or a set of batches, which blocks them
+// from being picked, then every batch is quarantined and disposed and, once all of them are disposed, the recall is
+// closed.
+type RecallService interface {
+	RetrieveAll(ctx context.Context) ([]models.Recall, error)
+	Retrieve(ctx context.Context, id int) (models.Recall, error)
+	// Open recalls every batch of the product of the recall, or the batches with the given numbers
+	Open(ctx context.Context, recall models.Recall, batchNumbers []string) (models.Recall, error)
+	// Quarantine sets apart the stock of a recalled batch
+	Quarantine(ctx context.Context, id int, productBatchId int) (models.RecallBatch, error)
+	// Dispose throws away the stock of a quarantined batch, disposed by the employee if given
+	Dispose(ctx context.Context, id int, productBatchId int, employeeId *int) (models.RecallBatch, error)
+	// Close closes an open recall whose batches were all disposed
+	Close(ctx context.Context, id int) (models.Recall, error)
+	// RetrieveStock retrieves the stock of the batches of a recall per warehouse
+	RetrieveStock(ctx context.Context, id int) ([]models.RecallStock, error)
+	// RetrieveOrders retrieves the purchase orders the batches of a recall were picked for, per buyer
+	RetrieveOrders(ctx context.Context, id int) ([]models.RecallOrders, error)
+	// RetrieveReports sums up every recall, or the one given
+	RetrieveReports(ctx context.Context, id *int) ([]models.RecallReport, error)
+}
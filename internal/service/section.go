@@ -1,13 +1,20 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type SectionService interface {
-	RetrieveAll() (v []models.Section, err error)
-	Retrieve(id int) (models.Section, error)
-	Register(s models.Section) (models.Section, error)
-	Modify(s models.Section) (models.Section, error)
-	PartialModify(id int, fields map[string]any) (models.Section, error)
-	Remove(id int) error
-	RetrieveSectionReport(sectionId *int) (interface{}, error)
+	RetrieveAll(ctx context.Context) (v []models.Section, err error)
+	Retrieve(ctx context.Context, id int) (models.Section, error)
+	// RetrieveByIds retrieves the sections with the given ids, the ids that do not exist are skipped
+	RetrieveByIds(ctx context.Context, ids []int) ([]models.Section, error)
+	// RetrieveByWarehouses retrieves the sections of the given warehouses
+	RetrieveByWarehouses(ctx context.Context, warehouseIds []int) ([]models.Section, error)
+	Register(ctx context.Context, s models.Section) (models.Section, error)
+	Modify(ctx context.Context, s models.Section) (models.Section, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Section, error)
+	Remove(ctx context.Context, id int) error
+	RetrieveSectionReport(ctx context.Context, sectionId *int) (interface{}, error)
 }
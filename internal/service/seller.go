@@ -1,12 +1,17 @@
 package service
 
-import "github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
 
 type SellerService interface {
-	RetrieveAll() ([]models.Seller, error)
-	Retrieve(id int) (models.Seller, error)
-	Register(seller models.Seller) (models.Seller, error)
-	Modify(seller models.Seller) (models.Seller, error)
-	PartialModify(id int, fields map[string]any) (models.Seller, error)
-	Remove(id int) error
+	RetrieveAll(ctx context.Context) ([]models.Seller, error)
+	Retrieve(ctx context.Context, id int) (models.Seller, error)
+	// RetrieveByIds retrieves the sellers with the given ids, the ids that do not exist are skipped
+	RetrieveByIds(ctx context.Context, ids []int) ([]models.Seller, error)
+	Register(ctx context.Context, seller models.Seller) (models.Seller, error)
+	Modify(ctx context.Context, seller models.Seller) (models.Seller, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Seller, error)
+	Remove(ctx context.Context, id int) error
 }
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// StockService records the movements of the stock ledger and reports the balances derived from it
+type StockService interface {
+	// RetrieveBalances retrieves the stock on hand, reserved and available of the products per warehouse, a zero
+	// product or warehouse ID matches all of them
+	RetrieveBalances(ctx context.Context, productId int, warehouseId int) ([]models.StockBalance, error)
+	// RetrieveMovements retrieves the ledger of a batch, oldest first
+	RetrieveMovements(ctx context.Context, productBatchId int) ([]models.StockMovement, error)
+	// RegisterMovement posts an adjustment or a disposal with the reason code that explains it, receipts are posted
+	// when the product batches are created and picks when the lines of the purchase orders are picked
+	RegisterMovement(ctx context.Context, movement models.StockMovement) (models.StockMovement, error)
+	// RegisterAdjustment posts an adjustment with the reason code that explains it, made by the responsible employee
+	RegisterAdjustment(ctx context.Context, movement models.StockMovement) (models.StockMovement, error)
+	// RetrieveWaste retrieves the stock removed because it spoiled or was damaged per product and warehouse, a zero
+	// product, seller or warehouse ID matches all of them
+	RetrieveWaste(ctx context.Context, productId int, sellerId int, warehouseId int) ([]models.WasteReport, error)
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// TransferOrderService moves product batches between warehouses. A transfer order is drafted, dispatched, handed
+// to its carrier and received, in that order.
+type TransferOrderService interface {
+	RetrieveAll(ctx context.Context) ([]models.TransferOrder, error)
+	Retrieve(ctx context.Context, id int) (models.TransferOrder, error)
+	// Register drafts a transfer order for batches stored in its source section
+	Register(ctx context.Context, order models.TransferOrder) (models.TransferOrder, error)
+	// Dispatch takes the batches of a draft order out of the source section
+	Dispatch(ctx context.Context, id int) (models.TransferOrder, error)
+	// MarkInTransit hands a dispatched order to its carrier, it returns ErrTransferOrderCarrierRequired when the order
+	// has no carrier and none is given
+	MarkInTransit(ctx context.Context, id int, carrierId *int) (models.TransferOrder, error)
+	// Receive stores the batches of an order in transit in the destination section
+	Receive(ctx context.Context, id int) (models.TransferOrder, error)
+}
@@ -1,14 +1,17 @@
 package service
 
 import (
+	"context"
 	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
 )
 
 type WarehouseService interface {
-	RetrieveAll() ([]models.Warehouse, error)
-	Retrieve(id int) (models.Warehouse, error)
-	Register(seller models.Warehouse) (models.Warehouse, error)
-	Modify(seller models.Warehouse) (models.Warehouse, error)
-	PartialModify(id int, fields map[string]any) (models.Warehouse, error)
-	Remove(id int) error
+	RetrieveAll(ctx context.Context) ([]models.Warehouse, error)
+	Retrieve(ctx context.Context, id int) (models.Warehouse, error)
+	// RetrieveByIds retrieves the warehouses with the given ids, the ids that do not exist are skipped
+	RetrieveByIds(ctx context.Context, ids []int) ([]models.Warehouse, error)
+	Register(ctx context.Context, seller models.Warehouse) (models.Warehouse, error)
+	Modify(ctx context.Context, seller models.Warehouse) (models.Warehouse, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.Warehouse, error)
+	Remove(ctx context.Context, id int) error
 }
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+)
+
+// WebhookService manages the webhook subscriptions and delivers the events they subscribed to
+type WebhookService interface {
+	RetrieveAll(ctx context.Context) ([]models.WebhookSubscription, error)
+	Retrieve(ctx context.Context, id int) (models.WebhookSubscription, error)
+	Register(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error)
+	Modify(ctx context.Context, subscription models.WebhookSubscription) (models.WebhookSubscription, error)
+	PartialModify(ctx context.Context, id int, fields map[string]any) (models.WebhookSubscription, error)
+	Remove(ctx context.Context, id int) error
+
+	// Publish queues a delivery of the event for every active subscription to its type. An event is queued once per
+	// subscription, publishing it again is a no-op.
+	Publish(ctx context.Context, event models.WebhookEvent) error
+	// DeliverDue attempts the pending deliveries whose next attempt is due
+	DeliverDue(ctx context.Context) error
+	// RetrieveDeliveries returns the deliveries of a subscription, the latest first
+	RetrieveDeliveries(ctx context.Context, subscriptionId int) ([]models.WebhookDelivery, error)
+	// RetrieveDeadLetters returns the deliveries that failed every attempt, the latest first
+	RetrieveDeadLetters(ctx context.Context) ([]models.WebhookDelivery, error)
+	// Redeliver attempts a delivery again right away, whatever its status, and returns its outcome
+	Redeliver(ctx context.Context, id int) (models.WebhookDelivery, error)
+}
@@ -0,0 +1,166 @@
+// Package webhook turns what happens in the API into webhook events and keeps their deliveries going.
+//
+// The Worker listens to the changes of the sections published by the services, scans the product batches that are
+// about to expire and periodically attempts the due deliveries of the webhook service. The events of the orders are
+// published by the outbox relay instead, so that they are never lost.
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"log"
+	"time"
+)
+
+const (
+	// DefaultInterval is the time between two rounds of deliveries
+	DefaultInterval = 10 * time.Second
+	// DefaultExpiryWindow is how long before its due date a product batch is notified as expiring
+	DefaultExpiryWindow = 72 * time.Hour
+	// changesBuffer is the number of changes the worker may fall behind before it misses some
+	changesBuffer = 1024
+)
+
+// Worker publishes the webhook events and delivers them in the background
+type Worker struct {
+	webhooks service.WebhookService
+	batches  service.ProductBatchService
+	broker   *events.Broker
+	// interval is the time between two rounds of deliveries and expiring batch scans
+	interval time.Duration
+	// expiryWindow is how long before its due date a product batch is notified as expiring
+	expiryWindow time.Duration
+	// now returns the current time
+	now func() time.Time
+	// excursions holds the sections whose temperature is out of range, so that an excursion is notified once when it
+	// starts and not on every update of the section
+	excursions map[int]bool
+}
+
+// NewWorker returns a worker that publishes the changes of broker and the expiring batches of the batches service
+// to webhooks, zero durations take their default value
+func NewWorker(webhooks service.WebhookService, batches service.ProductBatchService, broker *events.Broker, interval time.Duration, expiryWindow time.Duration) *Worker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	if expiryWindow <= 0 {
+		expiryWindow = DefaultExpiryWindow
+	}
+	return &Worker{
+		webhooks:     webhooks,
+		batches:      batches,
+		broker:       broker,
+		interval:     interval,
+		expiryWindow: expiryWindow,
+		now:          time.Now,
+		excursions:   make(map[int]bool),
+	}
+}
+
+// Run publishes and delivers the events until ctx is done. Errors are logged, the next round tries again.
+func (w *Worker) Run(ctx context.Context) {
+	go w.forward(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.PublishExpiring(ctx); err != nil {
+			log.Printf("webhooks: scanning the expiring product batches: %v", err)
+		}
+		if err := w.webhooks.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("webhooks: delivering the due events: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// forward publishes the events matching the changes of the broker until ctx is done. The subscription is renewed
+// when the broker drops it for falling behind.
+func (w *Worker) forward(ctx context.Context) {
+	for ctx.Err() == nil {
+		changes, unsubscribe := w.broker.Subscribe(changesBuffer)
+		w.consume(ctx, changes)
+		unsubscribe()
+	}
+}
+
+func (w *Worker) consume(ctx context.Context, changes <-chan events.Change) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change, ok := <-changes:
+			if !ok {
+				log.Printf("webhooks: fell behind the changes, some events were not published")
+				return
+			}
+			if err := w.Handle(ctx, change); err != nil {
+				log.Printf("webhooks: publishing the %s %s change: %v", change.Resource, change.Kind, err)
+			}
+		}
+	}
+}
+
+// Handle publishes the temperature excursion a change of a section starts, if any
+func (w *Worker) Handle(ctx context.Context, change events.Change) error {
+	if entity, ok := change.Entity.(models.Section); ok {
+		inExcursion := change.Kind != events.KindDeleted && entity.CurrentTemperature < entity.MinimumTemperature
+		started := inExcursion && !w.excursions[entity.Id]
+		if inExcursion {
+			w.excursions[entity.Id] = true
+		} else {
+			delete(w.excursions, entity.Id)
+		}
+		if started {
+			return w.webhooks.Publish(ctx, models.WebhookEvent{
+				Type:       models.WebhookEventSectionTemperatureExcursion,
+				OccurredAt: change.OccurredAt,
+				Data:       entity,
+			})
+		}
+	}
+	return nil
+}
+
+// PublishExpiring publishes an event for every product batch with stock left that may still be sold and whose due
+// date falls within the expiry window. The event id is derived from the batch and its due date, so a batch is only notified once per due date.
+func (w *Worker) PublishExpiring(ctx context.Context) error {
+	now := w.now()
+	batches, err := w.batches.RetrieveExpiring(ctx, now.Add(w.expiryWindow))
+	if err != nil {
+		return err
+	}
+	for _, batch := range batches {
+		dueDate, ok := parseDate(batch.DueDate)
+		if !ok {
+			continue
+		}
+		err = w.webhooks.Publish(ctx, models.WebhookEvent{
+			Id:         fmt.Sprintf("%s:%d:%s", models.WebhookEventProductBatchExpiring, batch.Id, dueDate.Format(time.DateOnly)),
+			Type:       models.WebhookEventProductBatchExpiring,
+			OccurredAt: now,
+			Data:       batch,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseDate reads the due date of a product batch, stored as a date with or without a time
+func parseDate(value string) (time.Time, bool) {
+	if len(value) < len(time.DateOnly) {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(time.DateOnly, value[:len(time.DateOnly)])
+	return date, err == nil
+}
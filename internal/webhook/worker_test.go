@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/events"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+// publisher records the published events
+type publisher struct {
+	service.WebhookService
+	published []models.WebhookEvent
+}
+
+func (p *publisher) Publish(ctx context.Context, event models.WebhookEvent) error {
+	p.published = append(p.published, event)
+	return nil
+}
+
+// batchLister returns a fixed list of expiring product batches and records the limit it was asked for
+type batchLister struct {
+	service.ProductBatchService
+	batches []models.ProductBatch
+	until   time.Time
+}
+
+func (b *batchLister) RetrieveExpiring(ctx context.Context, until time.Time) ([]models.ProductBatch, error) {
+	b.until = until
+	return b.batches, nil
+}
+
+func TestWorker_Handle(t *testing.T) {
+	tests := []struct {
+		name          string
+		changes       []events.Change
+		expectedTypes []string
+		expectedIds   []string
+	}{
+		{
+			name:    "Success - Section in range",
+			changes: []events.Change{{Resource: events.ResourceSection, Kind: events.KindUpdated, Entity: models.Section{Id: 1, CurrentTemperature: 2, MinimumTemperature: 0}}},
+		},
+		{
+			name:    "Success - Orders are published by the outbox",
+			changes: []events.Change{{Resource: events.ResourcePurchaseOrder, Kind: events.KindCreated, Entity: models.PurchaseOrder{Id: 4}}},
+		},
+		{
+			name: "Success - Temperature excursion notified once until it ends",
+			changes: []events.Change{
+				{Resource: events.ResourceSection, Kind: events.KindUpdated, Entity: models.Section{Id: 1, CurrentTemperature: -2, MinimumTemperature: 0}},
+				{Resource: events.ResourceSection, Kind: events.KindUpdated, Entity: models.Section{Id: 1, CurrentTemperature: -3, MinimumTemperature: 0}},
+				{Resource: events.ResourceSection, Kind: events.KindUpdated, Entity: models.Section{Id: 1, CurrentTemperature: 1, MinimumTemperature: 0}},
+				{Resource: events.ResourceSection, Kind: events.KindUpdated, Entity: models.Section{Id: 1, CurrentTemperature: -1, MinimumTemperature: 0}},
+			},
+			expectedTypes: []string{models.WebhookEventSectionTemperatureExcursion, models.WebhookEventSectionTemperatureExcursion},
+			expectedIds:   []string{"", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			webhooks := &publisher{}
+			worker := NewWorker(webhooks, &batchLister{}, events.NewBroker(), 0, 0)
+
+			// Act
+			for _, change := range tt.changes {
+				require.NoError(t, worker.Handle(context.Background(), change))
+			}
+
+			// Assert
+			var types, ids []string
+			for _, event := range webhooks.published {
+				types = append(types, event.Type)
+				ids = append(ids, event.Id)
+			}
+			require.Equal(t, tt.expectedTypes, types)
+			require.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
+
+func TestWorker_PublishExpiring(t *testing.T) {
+	// Arrange
+	webhooks := &publisher{}
+	batches := &batchLister{batches: []models.ProductBatch{
+		{Id: 1, CurrentQuantity: 10, DueDate: "2025-07-02", Status: models.ProductBatchAvailable},
+		{Id: 4, CurrentQuantity: 5, DueDate: "2025-07-03T00:00:00Z", Status: models.ProductBatchReceived},
+		{Id: 5, CurrentQuantity: 5, DueDate: "unknown", Status: models.ProductBatchAvailable},
+	}}
+	worker := NewWorker(webhooks, batches, events.NewBroker(), time.Minute, 72*time.Hour)
+	worker.now = func() time.Time { return time.Date(2025, 7, 1, 8, 0, 0, 0, time.UTC) }
+
+	// Act
+	err := worker.PublishExpiring(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 7, 4, 8, 0, 0, 0, time.UTC), batches.until)
+	require.Len(t, webhooks.published, 2)
+	require.Equal(t, "product_batch.expiring:1:2025-07-02", webhooks.published[0].Id)
+	require.Equal(t, "product_batch.expiring:4:2025-07-03", webhooks.published[1].Id)
+	require.Equal(t, models.WebhookEventProductBatchExpiring, webhooks.published[1].Type)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const buyersPath = "/api/v1/buyers"
+
+// BuyerClient is the client of the buyers
+type BuyerClient struct {
+	c *Client
+}
+
+// List returns every buyer
+func (r *BuyerClient) List(ctx context.Context) ([]models.Buyer, error) {
+	return list[models.Buyer](ctx, r.c, buyersPath)
+}
+
+// All returns an iterator over every buyer
+func (r *BuyerClient) All(ctx context.Context) iter.Seq2[models.Buyer, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the buyer with the given id
+func (r *BuyerClient) Get(ctx context.Context, id int) (models.Buyer, error) {
+	return get[models.Buyer](ctx, r.c, buyersPath, id)
+}
+
+// Create creates a buyer
+func (r *BuyerClient) Create(ctx context.Context, body request.BuyerRequest) (models.Buyer, error) {
+	return create[models.Buyer](ctx, r.c, buyersPath, body, nil)
+}
+
+// Patch updates the given fields of the buyer with the given id, as a JSON Merge Patch
+func (r *BuyerClient) Patch(ctx context.Context, id int, fields map[string]any) (models.Buyer, error) {
+	return mergePatch[models.Buyer](ctx, r.c, buyersPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the buyer with the given id
+func (r *BuyerClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.Buyer, error) {
+	return jsonPatch[models.Buyer](ctx, r.c, buyersPath, id, ops)
+}
+
+// Delete deletes the buyer with the given id
+func (r *BuyerClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, buyersPath, id)
+}
+
+// PurchaseOrdersReport returns the number of purchase orders of every buyer
+func (r *BuyerClient) PurchaseOrdersReport(ctx context.Context) ([]models.BuyerReport, error) {
+	return report[[]models.BuyerReport](ctx, r.c, buyersPath+"/reportPurchaseOrders", nil)
+}
+
+// PurchaseOrdersReportByBuyer returns the number of purchase orders of a buyer
+func (r *BuyerClient) PurchaseOrdersReportByBuyer(ctx context.Context, id int) ([]models.BuyerReport, error) {
+	return report[[]models.BuyerReport](ctx, r.c, buyersPath+"/reportPurchaseOrders", &id)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const carriersPath = "/api/v1/carriers"
+
+// CarrierClient is the client of the carriers
+type CarrierClient struct {
+	c *Client
+}
+
+// List returns every carrier
+func (r *CarrierClient) List(ctx context.Context) ([]models.Carrier, error) {
+	return list[models.Carrier](ctx, r.c, carriersPath)
+}
+
+// All returns an iterator over every carrier
+func (r *CarrierClient) All(ctx context.Context) iter.Seq2[models.Carrier, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the carrier with the given id
+func (r *CarrierClient) Get(ctx context.Context, id int) (models.Carrier, error) {
+	return get[models.Carrier](ctx, r.c, carriersPath, id)
+}
+
+// Create creates a carrier
+func (r *CarrierClient) Create(ctx context.Context, body request.CarrierRequest) (models.Carrier, error) {
+	return create[models.Carrier](ctx, r.c, carriersPath, body, nil)
+}
+
+// Update replaces the carrier with the given id
+func (r *CarrierClient) Update(ctx context.Context, id int, body request.CarrierRequest) (models.Carrier, error) {
+	return update[models.Carrier](ctx, r.c, carriersPath, id, body)
+}
+
+// Patch updates the given fields of the carrier with the given id, as a JSON Merge Patch
+func (r *CarrierClient) Patch(ctx context.Context, id int, fields map[string]any) (models.Carrier, error) {
+	return mergePatch[models.Carrier](ctx, r.c, carriersPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the carrier with the given id
+func (r *CarrierClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.Carrier, error) {
+	return jsonPatch[models.Carrier](ctx, r.c, carriersPath, id, ops)
+}
+
+// Delete deletes the carrier with the given id
+func (r *CarrierClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, carriersPath, id)
+}
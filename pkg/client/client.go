@@ -0,0 +1,346 @@
+// Package client is a typed Go client of the REST API.
+//
+// A Client exposes a client for every resource, which sends the pkg/request structs and returns the pkg/models
+// structs, so that other services do not build requests by hand nor duplicate the models:
+//
+//	c := client.New("http://localhost:8080")
+//	section, err := c.Sections.Get(ctx, 1)
+//	if errors.Is(err, client.ErrNotFound) {
+//		// ...
+//	}
+//
+// Every call takes a context. Idempotent calls, and creations sent with an idempotency key, are retried with an
+// exponential backoff when the API cannot be reached or answers 429, 502, 503 or 504. Errors returned by the API are
+// reported as *Error values that match the sentinel errors of this package.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Media types of the bodies sent by the client
+const (
+	mediaTypeJSON       = "application/json"
+	mediaTypeMergePatch = "application/merge-patch+json"
+	mediaTypeJSONPatch  = "application/json-patch+json"
+)
+
+const (
+	// IdempotencyKeyHeader is the header that makes the API replay the response of a creation sent twice
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// ActorHeader is the header that identifies the user recorded in the audit trail
+	ActorHeader = "X-Actor"
+)
+
+// RetryPolicy configures the retries of the calls that are safe to repeat
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a call is sent, including the first one, 1 disables the retries
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, it doubles on every retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of a new Client
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second}
+
+// backoff returns the wait before the given retry, starting at 1
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < retry && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, p.MaxBackoff)
+}
+
+// Option configures a Client
+type Option func(c *Client)
+
+// WithHTTPClient sets the HTTP client used to send the requests, http.DefaultClient by default
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client, DefaultRetryPolicy by default
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithActor sets the user the API records in the audit trail for the mutations sent by the client
+func WithActor(actor string) Option {
+	return func(c *Client) {
+		c.actor = actor
+	}
+}
+
+// Client is the client of the API, it is safe for concurrent use
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	retry      RetryPolicy
+	actor      string
+
+	Buyers         *BuyerClient
+	Carriers       *CarrierClient
+	Employees      *EmployeeClient
+	InboundOrders  *InboundOrderClient
+	Localities     *LocalityClient
+	Products       *ProductClient
+	ProductBatches *ProductBatchClient
+	ProductRecords *ProductRecordClient
+	PurchaseOrders *PurchaseOrderClient
+	Sections       *SectionClient
+	Sellers        *SellerClient
+	Warehouses     *WarehouseClient
+}
+
+// New returns a client of the API served at baseURL, such as http://localhost:8080
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.Buyers = &BuyerClient{c: c}
+	c.Carriers = &CarrierClient{c: c}
+	c.Employees = &EmployeeClient{c: c}
+	c.InboundOrders = &InboundOrderClient{c: c}
+	c.Localities = &LocalityClient{c: c}
+	c.Products = &ProductClient{c: c}
+	c.ProductBatches = &ProductBatchClient{c: c}
+	c.ProductRecords = &ProductRecordClient{c: c}
+	c.PurchaseOrders = &PurchaseOrderClient{c: c}
+	c.Sections = &SectionClient{c: c}
+	c.Sellers = &SellerClient{c: c}
+	c.Warehouses = &WarehouseClient{c: c}
+	return c
+}
+
+// CallOption configures a single call
+type CallOption func(r *call)
+
+// WithIdempotencyKey sends the creation with an idempotency key, which also makes it safe to retry
+func WithIdempotencyKey(key string) CallOption {
+	return func(r *call) {
+		r.idempotencyKey = key
+	}
+}
+
+// Ptr returns a pointer to v, to fill the optional fields of the request structs
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// PatchOperation is an operation of a JSON Patch (RFC 6902) document
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value"`
+}
+
+// MarshalJSON sends the value of the add, replace and test operations even when it is nil, as null, and leaves it
+// out of the other operations
+func (o PatchOperation) MarshalJSON() ([]byte, error) {
+	type operation PatchOperation
+	if o.Op == "add" || o.Op == "replace" || o.Op == "test" {
+		return json.Marshal(operation(o))
+	}
+	return json.Marshal(struct {
+		Op   string `json:"op"`
+		Path string `json:"path"`
+		From string `json:"from,omitempty"`
+	}{Op: o.Op, Path: o.Path, From: o.From})
+}
+
+// call is a request sent to the API
+type call struct {
+	method         string
+	path           string
+	query          url.Values
+	body           any
+	contentType    string
+	idempotencyKey string
+}
+
+// envelope is the body of the responses of the API
+type envelope struct {
+	Data    json.RawMessage `json:"data"`
+	Message string          `json:"message"`
+	Errors  []FieldError    `json:"errors"`
+}
+
+// do sends the call, retrying it when it is safe to, and decodes the data of the response into a T
+func do[T any](ctx context.Context, c *Client, r call) (T, error) {
+	var result T
+
+	var body []byte
+	if r.body != nil {
+		var err error
+		if body, err = json.Marshal(r.body); err != nil {
+			return result, err
+		}
+	}
+
+	retryable := r.method == http.MethodGet || r.method == http.MethodPut || r.method == http.MethodDelete ||
+		r.idempotencyKey != ""
+	attempts := 1
+	if retryable {
+		attempts = max(c.retry.MaxAttempts, 1)
+	}
+
+	var res *http.Response
+	for attempt := 1; ; attempt++ {
+		var err error
+		res, err = c.send(ctx, r, body)
+
+		retry := attempt < attempts && ctx.Err() == nil &&
+			(err != nil || res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusBadGateway ||
+				res.StatusCode == http.StatusServiceUnavailable || res.StatusCode == http.StatusGatewayTimeout)
+		if !retry {
+			if err != nil {
+				return result, err
+			}
+			break
+		}
+
+		wait := c.retry.backoff(attempt)
+		if res != nil {
+			if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil {
+				wait = min(time.Duration(seconds)*time.Second, c.retry.MaxBackoff)
+			}
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	defer res.Body.Close()
+
+	payload, err := io.ReadAll(res.Body)
+	if err != nil {
+		return result, err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		return result, newError(res.StatusCode, payload)
+	}
+	if res.StatusCode == http.StatusNoContent || len(payload) == 0 {
+		return result, nil
+	}
+
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		return result, err
+	}
+	if len(env.Data) > 0 {
+		if err := json.Unmarshal(env.Data, &result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// send sends a single attempt of the call
+func (c *Client) send(ctx context.Context, r call, body []byte) (*http.Response, error) {
+	target := c.baseURL + r.path
+	if len(r.query) > 0 {
+		target += "?" + r.query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeJSON)
+	if body != nil {
+		contentType := r.contentType
+		if contentType == "" {
+			contentType = mediaTypeJSON
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	if r.idempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, r.idempotencyKey)
+	}
+	if c.actor != "" {
+		req.Header.Set(ActorHeader, c.actor)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil && errors.Is(err, ctx.Err()) {
+		return nil, ctx.Err()
+	}
+	return res, err
+}
+
+// helpers shared by the clients of the resources
+
+func list[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	return do[[]T](ctx, c, call{method: http.MethodGet, path: path})
+}
+
+func get[T any](ctx context.Context, c *Client, path string, id int) (T, error) {
+	return do[T](ctx, c, call{method: http.MethodGet, path: path + "/" + strconv.Itoa(id)})
+}
+
+func report[T any](ctx context.Context, c *Client, path string, id *int) (T, error) {
+	r := call{method: http.MethodGet, path: path}
+	if id != nil {
+		r.query = url.Values{"id": {strconv.Itoa(*id)}}
+	}
+	return do[T](ctx, c, r)
+}
+
+func create[T any](ctx context.Context, c *Client, path string, body any, opts []CallOption) (T, error) {
+	r := call{method: http.MethodPost, path: path, body: body}
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return do[T](ctx, c, r)
+}
+
+func update[T any](ctx context.Context, c *Client, path string, id int, body any) (T, error) {
+	return do[T](ctx, c, call{method: http.MethodPut, path: path + "/" + strconv.Itoa(id), body: body})
+}
+
+func mergePatch[T any](ctx context.Context, c *Client, path string, id int, fields map[string]any) (T, error) {
+	return do[T](ctx, c, call{method: http.MethodPatch, path: path + "/" + strconv.Itoa(id), body: fields, contentType: mediaTypeMergePatch})
+}
+
+func jsonPatch[T any](ctx context.Context, c *Client, path string, id int, ops []PatchOperation) (T, error) {
+	return do[T](ctx, c, call{method: http.MethodPatch, path: path + "/" + strconv.Itoa(id), body: ops, contentType: mediaTypeJSONPatch})
+}
+
+func remove(ctx context.Context, c *Client, path string, id int) error {
+	_, err := do[struct{}](ctx, c, call{method: http.MethodDelete, path: path + "/" + strconv.Itoa(id)})
+	return err
+}
@@ -0,0 +1,562 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/application"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/handler"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/repository"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/internal/service"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/patch"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// memoryService is an in-memory implementation of the CRUD methods shared by the services, entities are unique by
+// the key returned by key
+type memoryService[T any] struct {
+	mu       sync.Mutex
+	lastId   int
+	entities map[int]T
+	key      func(T) string
+}
+
+func newMemoryService[T any](key func(T) string) *memoryService[T] {
+	return &memoryService[T]{entities: make(map[int]T), key: key}
+}
+
+func (s *memoryService[T]) RetrieveAll(ctx context.Context) ([]T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]int, 0, len(s.entities))
+	for id := range s.entities {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	entities := make([]T, 0, len(ids))
+	for _, id := range ids {
+		entities = append(entities, s.entities[id])
+	}
+	return entities, nil
+}
+
+func (s *memoryService[T]) RetrieveByIds(ctx context.Context, ids []int) ([]T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entities := make([]T, 0, len(ids))
+	for _, id := range ids {
+		if entity, ok := s.entities[id]; ok {
+			entities = append(entities, entity)
+		}
+	}
+	return entities, nil
+}
+
+func (s *memoryService[T]) Retrieve(ctx context.Context, id int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entity, ok := s.entities[id]
+	if !ok {
+		return entity, repository.ErrEntityNotFound
+	}
+	return entity, nil
+}
+
+func (s *memoryService[T]) Register(ctx context.Context, entity T) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.entities {
+		if s.key(existing) == s.key(entity) {
+			var zero T
+			return zero, repository.ErrEntityAlreadyExists
+		}
+	}
+	s.lastId++
+	reflect.ValueOf(&entity).Elem().FieldByName("Id").SetInt(int64(s.lastId))
+	s.entities[s.lastId] = entity
+	return entity, nil
+}
+
+func (s *memoryService[T]) Modify(ctx context.Context, entity T) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := int(reflect.ValueOf(entity).FieldByName("Id").Int())
+	if _, ok := s.entities[id]; !ok {
+		var zero T
+		return zero, repository.ErrEntityNotFound
+	}
+	s.entities[id] = entity
+	return entity, nil
+}
+
+func (s *memoryService[T]) PartialModify(ctx context.Context, id int, fields map[string]any) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entity, ok := s.entities[id]
+	if !ok {
+		return entity, repository.ErrEntityNotFound
+	}
+	if err := patch.Apply(&entity, fields); err != nil {
+		return entity, err
+	}
+	s.entities[id] = entity
+	return entity, nil
+}
+
+func (s *memoryService[T]) Remove(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entities[id]; !ok {
+		return repository.ErrEntityNotFound
+	}
+	delete(s.entities, id)
+	return nil
+}
+
+type sectionService struct {
+	*memoryService[models.Section]
+}
+
+func (s sectionService) RetrieveByWarehouses(ctx context.Context, warehouseIds []int) ([]models.Section, error) {
+	sections, _ := s.RetrieveAll(ctx)
+	return slices.DeleteFunc(sections, func(section models.Section) bool {
+		return !slices.Contains(warehouseIds, section.WarehouseId)
+	}), nil
+}
+
+func (s sectionService) RetrieveSectionReport(ctx context.Context, sectionId *int) (interface{}, error) {
+	if sectionId == nil {
+		return []models.SectionReport{}, nil
+	}
+	section, err := s.Retrieve(ctx, *sectionId)
+	if err != nil {
+		return nil, repository.ErrSectionNotFound
+	}
+	return models.SectionReport{SectionId: section.Id, SectionNumber: section.SectionNumber, ProductsCount: 3}, nil
+}
+
+type buyerService struct {
+	*memoryService[models.Buyer]
+}
+
+func (s buyerService) RetrieveByPurchaseOrderReport(ctx context.Context, id int) ([]models.BuyerReport, error) {
+	buyers, _ := s.RetrieveAll(ctx)
+	var report []models.BuyerReport
+	for _, buyer := range buyers {
+		if id == 0 || buyer.Id == id {
+			report = append(report, models.BuyerReport{Buyer: buyer, PurchaseOrdersCount: 2})
+		}
+	}
+	if len(report) == 0 {
+		return nil, repository.ErrEntityNotFound
+	}
+	return report, nil
+}
+
+// productRecordService answers the price lookups of the product 1, whose price changed on the 1st of January 2024
+type productRecordService struct {
+	service.ProductRecordService
+}
+
+func (s productRecordService) price(productId int, at time.Time) (models.ProductPrice, error) {
+	if productId != 1 {
+		return models.ProductPrice{}, repository.ErrProductNotFound
+	}
+	if at.Before(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		return models.ProductPrice{ProductId: 1, ProductRecordId: 1, PurchasePrice: 8, SalePrice: 10, MarginPercentage: 20}, nil
+	}
+	return models.ProductPrice{ProductId: 1, ProductRecordId: 2, PurchasePrice: 9, SalePrice: 12, MarginPercentage: 25}, nil
+}
+
+func (s productRecordService) RetrieveCurrentPrice(ctx context.Context, productId int) (models.ProductPrice, error) {
+	return s.price(productId, time.Now())
+}
+
+func (s productRecordService) RetrievePriceAt(ctx context.Context, productId int, at time.Time) (models.ProductPrice, error) {
+	return s.price(productId, at)
+}
+
+func (s productRecordService) RetrievePriceTrend(ctx context.Context, productId int, from time.Time, to time.Time) (models.ProductPriceTrend, error) {
+	if from.After(to) {
+		return models.ProductPriceTrend{}, service.ErrInvalidDateRange
+	}
+	first, err := s.price(productId, from)
+	if err != nil {
+		return models.ProductPriceTrend{}, err
+	}
+	last, _ := s.price(productId, to)
+	return models.ProductPriceTrend{ProductId: productId, From: from, To: to, Prices: []models.ProductPrice{first, last}}, nil
+}
+
+// ClientTestSuite runs the client against the router of the API, backed by in-memory services
+type ClientTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	client *Client
+}
+
+func (s *ClientTestSuite) SetupTest() {
+	handlers := application.Handlers{
+		Section:       handler.NewSectionDefault(sectionService{newMemoryService(func(s models.Section) string { return s.SectionNumber })}),
+		Buyer:         handler.NewBuyerHandler(buyerService{newMemoryService(func(b models.Buyer) string { return b.CardNumberId })}),
+		ProductRecord: handler.NewProductRecordHandler(productRecordService{}),
+	}
+	s.server = httptest.NewServer(application.NewRouter(handlers, nil))
+	s.client = New(s.server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}))
+}
+
+func (s *ClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func sectionRequest(number string) request.SectionRequest {
+	return request.SectionRequest{
+		SectionNumber:      Ptr(number),
+		CurrentTemperature: Ptr(4.5),
+		MinimumTemperature: Ptr(-2.0),
+		CurrentCapacity:    Ptr(10),
+		MinimumCapacity:    Ptr(5),
+		MaximumCapacity:    Ptr(50),
+		WarehouseId:        Ptr(1),
+		ProductTypeId:      Ptr(2),
+	}
+}
+
+func (s *ClientTestSuite) TestSections() {
+	ctx := context.Background()
+
+	// Create
+	created, err := s.client.Sections.Create(ctx, sectionRequest("S-1"))
+	s.Require().NoError(err)
+	s.Equal(models.Section{Id: 1, SectionNumber: "S-1", CurrentTemperature: 4.5, MinimumTemperature: -2, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 50, WarehouseId: 1, ProductTypeId: 2}, created)
+	_, err = s.client.Sections.Create(ctx, sectionRequest("S-2"))
+	s.Require().NoError(err)
+
+	// Get and List
+	section, err := s.client.Sections.Get(ctx, 1)
+	s.Require().NoError(err)
+	s.Equal(created, section)
+	sections, err := s.client.Sections.List(ctx)
+	s.Require().NoError(err)
+	s.Len(sections, 2)
+
+	// Patch and JSONPatch
+	section, err = s.client.Sections.Patch(ctx, 1, map[string]any{"current_capacity": 20})
+	s.Require().NoError(err)
+	s.Equal(20, section.CurrentCapacity)
+	section, err = s.client.Sections.JSONPatch(ctx, 1, []PatchOperation{
+		{Op: "test", Path: "/current_capacity", Value: 20},
+		{Op: "replace", Path: "/section_number", Value: "S-10"},
+	})
+	s.Require().NoError(err)
+	s.Equal("S-10", section.SectionNumber)
+
+	// Report
+	report, err := s.client.Sections.ProductsReportBySection(ctx, 1)
+	s.Require().NoError(err)
+	s.Equal(models.SectionReport{SectionId: 1, SectionNumber: "S-10", ProductsCount: 3}, report)
+
+	// Delete
+	s.Require().NoError(s.client.Sections.Delete(ctx, 1))
+	_, err = s.client.Sections.Get(ctx, 1)
+	s.ErrorIs(err, ErrNotFound)
+}
+
+func (s *ClientTestSuite) TestAll() {
+	ctx := context.Background()
+	for _, number := range []string{"S-1", "S-2", "S-3"} {
+		_, err := s.client.Sections.Create(ctx, sectionRequest(number))
+		s.Require().NoError(err)
+	}
+
+	var numbers []string
+	for section, err := range s.client.Sections.All(ctx) {
+		s.Require().NoError(err)
+		numbers = append(numbers, section.SectionNumber)
+		if len(numbers) == 2 {
+			break
+		}
+	}
+
+	s.Equal([]string{"S-1", "S-2"}, numbers)
+}
+
+func (s *ClientTestSuite) TestErrors() {
+	ctx := context.Background()
+	_, err := s.client.Sections.Create(ctx, sectionRequest("S-1"))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		title    string
+		call     func() error
+		sentinel error
+		status   int
+		errors   []FieldError
+	}{
+		{
+			title: "duplicated entity",
+			call: func() error {
+				_, err := s.client.Sections.Create(ctx, sectionRequest("S-1"))
+				return err
+			},
+			sentinel: ErrAlreadyExists,
+			status:   http.StatusConflict,
+		},
+		{
+			title: "invalid entity",
+			call: func() error {
+				body := sectionRequest("S-2")
+				body.MinimumCapacity = Ptr(-1)
+				body.SectionNumber = nil
+				_, err := s.client.Sections.Create(ctx, body)
+				return err
+			},
+			sentinel: ErrInvalidEntity,
+			status:   http.StatusUnprocessableEntity,
+			errors: []FieldError{
+				{Pointer: "/minimum_capacity", Message: "must be at least 0"},
+				{Pointer: "/section_number", Message: "must be a string"},
+			},
+		},
+		{
+			title: "unknown patch field",
+			call: func() error {
+				_, err := s.client.Sections.Patch(ctx, 1, map[string]any{"color": "red"})
+				return err
+			},
+			sentinel: ErrInvalidEntity,
+			status:   http.StatusUnprocessableEntity,
+			errors:   []FieldError{{Pointer: "/color", Message: "is not allowed"}},
+		},
+		{
+			title: "not found",
+			call: func() error {
+				_, err := s.client.Buyers.PurchaseOrdersReportByBuyer(ctx, 7)
+				return err
+			},
+			sentinel: ErrNotFound,
+			status:   http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.title, func() {
+			err := tc.call()
+
+			s.ErrorIs(err, tc.sentinel)
+			var apiErr *Error
+			s.Require().ErrorAs(err, &apiErr)
+			s.Equal(tc.status, apiErr.StatusCode)
+			s.Equal(tc.errors, apiErr.Errors)
+		})
+	}
+}
+
+func (s *ClientTestSuite) TestBuyersReport() {
+	ctx := context.Background()
+	buyer, err := s.client.Buyers.Create(ctx, request.BuyerRequest{CardNumberId: Ptr("C-1"), FirstName: Ptr("Ana"), LastName: Ptr("Diaz")})
+	s.Require().NoError(err)
+
+	report, err := s.client.Buyers.PurchaseOrdersReport(ctx)
+
+	s.Require().NoError(err)
+	s.Equal([]models.BuyerReport{{Buyer: buyer, PurchaseOrdersCount: 2}}, report)
+}
+
+func (s *ClientTestSuite) TestProductPrices() {
+	ctx := context.Background()
+	from := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	current, err := s.client.ProductRecords.CurrentPrice(ctx, 1)
+	s.Require().NoError(err)
+	s.Equal(2, current.ProductRecordId)
+
+	at, err := s.client.ProductRecords.PriceAt(ctx, 1, from)
+	s.Require().NoError(err)
+	s.Equal(1, at.ProductRecordId)
+
+	trend, err := s.client.ProductRecords.PriceTrend(ctx, 1, from, to)
+	s.Require().NoError(err)
+	s.True(from.Equal(trend.From))
+	s.True(to.Equal(trend.To))
+	s.Len(trend.Prices, 2)
+
+	_, err = s.client.ProductRecords.CurrentPrice(ctx, 2)
+	var apiErr *Error
+	s.Require().ErrorAs(err, &apiErr)
+	s.Equal(http.StatusNotFound, apiErr.StatusCode)
+
+	_, err = s.client.ProductRecords.PriceTrend(ctx, 1, to, from)
+	s.Require().ErrorAs(err, &apiErr)
+	s.Equal(http.StatusBadRequest, apiErr.StatusCode)
+}
+
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
+
+// flakyServer answers 503 to the first failures requests and 200 without data afterwards
+func flakyServer(failures int32, attempts *atomic.Int32, keys *[]string) *httptest.Server {
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*keys = append(*keys, r.Header.Get(IdempotencyKeyHeader))
+		mu.Unlock()
+		if attempts.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": null}`))
+	}))
+}
+
+func TestPatchOperation_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		title     string
+		operation PatchOperation
+		expected  string
+	}{
+		{
+			title:     "replace with null sends the value",
+			operation: PatchOperation{Op: "replace", Path: "/seller_id"},
+			expected:  `{"op":"replace","path":"/seller_id","value":null}`,
+		},
+		{
+			title:     "add sends the value",
+			operation: PatchOperation{Op: "add", Path: "/description", Value: "frozen"},
+			expected:  `{"op":"add","path":"/description","value":"frozen"}`,
+		},
+		{
+			title:     "remove leaves the value out",
+			operation: PatchOperation{Op: "remove", Path: "/seller_id"},
+			expected:  `{"op":"remove","path":"/seller_id"}`,
+		},
+		{
+			title:     "copy sends the source",
+			operation: PatchOperation{Op: "copy", From: "/address", Path: "/telephone"},
+			expected:  `{"op":"copy","path":"/telephone","from":"/address"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			data, err := json.Marshal(tc.operation)
+
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expected, string(data))
+		})
+	}
+}
+
+func TestClient_Retries(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	testCases := []struct {
+		title    string
+		failures int32
+		call     func(c *Client) error
+		attempts int32
+		keys     []string
+		status   int
+	}{
+		{
+			title:    "idempotent call is retried",
+			failures: 2,
+			call: func(c *Client) error {
+				_, err := c.Sections.List(context.Background())
+				return err
+			},
+			attempts: 3,
+			keys:     []string{"", "", ""},
+		},
+		{
+			title:    "retries are exhausted",
+			failures: 5,
+			call: func(c *Client) error {
+				_, err := c.Sections.List(context.Background())
+				return err
+			},
+			attempts: 3,
+			keys:     []string{"", "", ""},
+			status:   http.StatusServiceUnavailable,
+		},
+		{
+			title:    "creation is not retried",
+			failures: 1,
+			call: func(c *Client) error {
+				_, err := c.PurchaseOrders.Create(context.Background(), request.PurchaseOrderRequest{})
+				return err
+			},
+			attempts: 1,
+			keys:     []string{""},
+			status:   http.StatusServiceUnavailable,
+		},
+		{
+			title:    "creation with an idempotency key is retried",
+			failures: 1,
+			call: func(c *Client) error {
+				_, err := c.PurchaseOrders.Create(context.Background(), request.PurchaseOrderRequest{}, WithIdempotencyKey("order-1"))
+				return err
+			},
+			attempts: 2,
+			keys:     []string{"order-1", "order-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			// Arrange
+			var attempts atomic.Int32
+			var keys []string
+			server := flakyServer(tc.failures, &attempts, &keys)
+			defer server.Close()
+			c := New(server.URL, WithRetryPolicy(policy))
+
+			// Act
+			err := tc.call(c)
+
+			// Assert
+			require.Equal(t, tc.attempts, attempts.Load())
+			require.Equal(t, tc.keys, keys)
+			if tc.status == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var apiErr *Error
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, tc.status, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestClient_ContextCanceledDuringBackoff(t *testing.T) {
+	// Arrange
+	var attempts atomic.Int32
+	var keys []string
+	server := flakyServer(10, &attempts, &keys)
+	defer server.Close()
+	c := New(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour, MaxBackoff: time.Hour}))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// Act
+	_, err := c.Sections.Get(ctx, 1)
+
+	// Assert
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Equal(t, int32(1), attempts.Load())
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const employeesPath = "/api/v1/employees"
+
+// EmployeeClient is the client of the employees
+type EmployeeClient struct {
+	c *Client
+}
+
+// List returns every employee
+func (r *EmployeeClient) List(ctx context.Context) ([]models.Employee, error) {
+	return list[models.Employee](ctx, r.c, employeesPath)
+}
+
+// All returns an iterator over every employee
+func (r *EmployeeClient) All(ctx context.Context) iter.Seq2[models.Employee, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the employee with the given id
+func (r *EmployeeClient) Get(ctx context.Context, id int) (models.Employee, error) {
+	return get[models.Employee](ctx, r.c, employeesPath, id)
+}
+
+// Create creates an employee
+func (r *EmployeeClient) Create(ctx context.Context, body request.EmployeeRequest) (models.Employee, error) {
+	return create[models.Employee](ctx, r.c, employeesPath, body, nil)
+}
+
+// Patch updates the given fields of the employee with the given id, as a JSON Merge Patch
+func (r *EmployeeClient) Patch(ctx context.Context, id int, fields map[string]any) (models.Employee, error) {
+	return mergePatch[models.Employee](ctx, r.c, employeesPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the employee with the given id
+func (r *EmployeeClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.Employee, error) {
+	return jsonPatch[models.Employee](ctx, r.c, employeesPath, id, ops)
+}
+
+// Delete deletes the employee with the given id
+func (r *EmployeeClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, employeesPath, id)
+}
+
+// InboundOrdersReport returns the number of inbound orders of every employee
+func (r *EmployeeClient) InboundOrdersReport(ctx context.Context) ([]models.EmployeeInboundOrdersReport, error) {
+	return report[[]models.EmployeeInboundOrdersReport](ctx, r.c, employeesPath+"/reportInboundOrders", nil)
+}
+
+// InboundOrdersReportByEmployee returns the number of inbound orders of an employee
+func (r *EmployeeClient) InboundOrdersReportByEmployee(ctx context.Context, id int) (models.EmployeeInboundOrdersReport, error) {
+	return report[models.EmployeeInboundOrdersReport](ctx, r.c, employeesPath+"/reportInboundOrders", &id)
+}
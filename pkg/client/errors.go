@@ -0,0 +1,91 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by the *Error values returned by the API, they mirror the errors of its repositories
+var (
+	// ErrBadRequest is matched when the API rejects a malformed request or invalid parameters
+	ErrBadRequest = errors.New("bad request")
+	// ErrNotFound is matched when the requested entity does not exist, like repository.ErrEntityNotFound
+	ErrNotFound = errors.New("entity not found")
+	// ErrAlreadyExists is matched when the entity conflicts with an existing one, like repository.ErrEntityAlreadyExists
+	ErrAlreadyExists = errors.New("entity already exists")
+	// ErrInvalidEntity is matched when the body does not describe a valid entity, like repository.ErrInvalidEntity
+	ErrInvalidEntity = errors.New("invalid entity")
+)
+
+// FieldError describes why a field of the body or a parameter of a request is invalid
+type FieldError struct {
+	// Pointer is the JSON pointer to the invalid field of the body
+	Pointer string `json:"pointer,omitempty"`
+	// Parameter is the name of the invalid path or query parameter
+	Parameter string `json:"parameter,omitempty"`
+	// Message explains why the value is invalid
+	Message string `json:"message"`
+}
+
+// Error is an error response of the API
+type Error struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Message is the message of the response
+	Message string
+	// Errors details the invalid fields of a request rejected by validation
+	Errors []FieldError
+}
+
+func (e *Error) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("client: %d %s", e.StatusCode, e.Message)
+	}
+	details := make([]string, len(e.Errors))
+	for i, field := range e.Errors {
+		name := field.Pointer
+		if field.Parameter != "" {
+			name = field.Parameter
+		}
+		details[i] = name + " " + field.Message
+	}
+	return fmt.Sprintf("client: %d %s: %s", e.StatusCode, e.Message, strings.Join(details, ", "))
+}
+
+// Is matches the sentinel error of the status code of the response
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrAlreadyExists:
+		return e.StatusCode == http.StatusConflict
+	case ErrInvalidEntity:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// newError builds the error of a response, whose body is usually an envelope but may be plain text
+func newError(statusCode int, body []byte) *Error {
+	e := &Error{StatusCode: statusCode}
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err == nil {
+		e.Message, e.Errors = env.Message, env.Errors
+		// some handlers render the message in the data field
+		if e.Message == "" {
+			_ = json.Unmarshal(env.Data, &e.Message)
+		}
+	} else {
+		e.Message = strings.TrimSpace(string(body))
+	}
+	if e.Message == "" {
+		e.Message = http.StatusText(statusCode)
+	}
+	return e
+}
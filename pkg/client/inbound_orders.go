@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const inboundOrdersPath = "/api/v1/inbound-orders"
+
+// InboundOrderClient is the client of the inbound orders
+type InboundOrderClient struct {
+	c *Client
+}
+
+// List returns every inbound order
+func (r *InboundOrderClient) List(ctx context.Context) ([]models.InboundOrder, error) {
+	return list[models.InboundOrder](ctx, r.c, inboundOrdersPath)
+}
+
+// All returns an iterator over every inbound order
+func (r *InboundOrderClient) All(ctx context.Context) iter.Seq2[models.InboundOrder, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the inbound order with the given id
+func (r *InboundOrderClient) Get(ctx context.Context, id int) (models.InboundOrder, error) {
+	return get[models.InboundOrder](ctx, r.c, inboundOrdersPath, id)
+}
+
+// Create creates an inbound order, sending it with WithIdempotencyKey makes it safe to retry
+func (r *InboundOrderClient) Create(ctx context.Context, body request.InboundOrder, opts ...CallOption) (models.InboundOrder, error) {
+	return create[models.InboundOrder](ctx, r.c, inboundOrdersPath, body, opts)
+}
+
+// Update replaces the inbound order with the given id
+func (r *InboundOrderClient) Update(ctx context.Context, id int, body request.InboundOrder) (models.InboundOrder, error) {
+	return update[models.InboundOrder](ctx, r.c, inboundOrdersPath, id, body)
+}
+
+// Patch updates the given fields of the inbound order with the given id, as a JSON Merge Patch
+func (r *InboundOrderClient) Patch(ctx context.Context, id int, fields map[string]any) (models.InboundOrder, error) {
+	return mergePatch[models.InboundOrder](ctx, r.c, inboundOrdersPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the inbound order with the given id
+func (r *InboundOrderClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.InboundOrder, error) {
+	return jsonPatch[models.InboundOrder](ctx, r.c, inboundOrdersPath, id, ops)
+}
+
+// Delete deletes the inbound order with the given id
+func (r *InboundOrderClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, inboundOrdersPath, id)
+}
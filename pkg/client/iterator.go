@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// iterate returns an iterator over the entities of a collection. The collection is fetched when the iteration
+// starts; the API returns whole collections, so a single page is requested and its entities are yielded one at a
+// time. An error is yielded once, with the zero entity, and ends the iteration.
+func iterate[T any](ctx context.Context, fetch func(ctx context.Context) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		entities, err := fetch(ctx)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for _, entity := range entities {
+			if ctx.Err() != nil {
+				var zero T
+				yield(zero, ctx.Err())
+				return
+			}
+			if !yield(entity, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+)
+
+const localitiesPath = "/api/v1/localities"
+
+// LocalityClient is the client of the localities
+type LocalityClient struct {
+	c *Client
+}
+
+// Create creates a locality
+func (r *LocalityClient) Create(ctx context.Context, body request.LocalityRequest) (models.LocalityDoc, error) {
+	return create[models.LocalityDoc](ctx, r.c, localitiesPath, body, nil)
+}
+
+// SellersReport returns the number of sellers of every locality
+func (r *LocalityClient) SellersReport(ctx context.Context) ([]models.LocalitySellerCount, error) {
+	return report[[]models.LocalitySellerCount](ctx, r.c, localitiesPath+"/reportSellers", nil)
+}
+
+// SellersReportByLocality returns the number of sellers of a locality
+func (r *LocalityClient) SellersReportByLocality(ctx context.Context, id int) ([]models.LocalitySellerCount, error) {
+	return report[[]models.LocalitySellerCount](ctx, r.c, localitiesPath+"/reportSellers", &id)
+}
+
+// CarriersReport returns the number of carriers of every locality
+func (r *LocalityClient) CarriersReport(ctx context.Context) ([]models.LocalityCarrierCount, error) {
+	return report[[]models.LocalityCarrierCount](ctx, r.c, localitiesPath+"/reportCarriers", nil)
+}
+
+// CarriersReportByLocality returns the number of carriers of a locality
+func (r *LocalityClient) CarriersReportByLocality(ctx context.Context, id int) ([]models.LocalityCarrierCount, error) {
+	return report[[]models.LocalityCarrierCount](ctx, r.c, localitiesPath+"/reportCarriers", &id)
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+)
+
+const productBatchesPath = "/api/v1/productBatches"
+
+// ProductBatchClient is the client of the product batches
+type ProductBatchClient struct {
+	c *Client
+}
+
+// Create creates a product batch, sending it with WithIdempotencyKey makes it safe to retry
+func (r *ProductBatchClient) Create(ctx context.Context, body request.ProductBatchRequest, opts ...CallOption) (models.ProductBatch, error) {
+	return create[models.ProductBatch](ctx, r.c, productBatchesPath, body, opts)
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const productRecordsPath = "/api/v1/productRecords"
+
+// ProductRecordClient is the client of the product records
+type ProductRecordClient struct {
+	c *Client
+}
+
+// List returns every product record
+func (r *ProductRecordClient) List(ctx context.Context) ([]models.ProductRecord, error) {
+	return list[models.ProductRecord](ctx, r.c, productRecordsPath)
+}
+
+// All returns an iterator over every product record
+func (r *ProductRecordClient) All(ctx context.Context) iter.Seq2[models.ProductRecord, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the product record with the given id
+func (r *ProductRecordClient) Get(ctx context.Context, id int) (models.ProductRecord, error) {
+	return get[models.ProductRecord](ctx, r.c, productRecordsPath, id)
+}
+
+// Create creates a product record
+func (r *ProductRecordClient) Create(ctx context.Context, body request.ProductRecordRequest) (models.ProductRecord, error) {
+	return create[models.ProductRecord](ctx, r.c, productRecordsPath, body, nil)
+}
+
+// CurrentPrice returns the price of the product with the given id in effect now
+func (r *ProductRecordClient) CurrentPrice(ctx context.Context, productId int) (models.ProductPrice, error) {
+	return do[models.ProductPrice](ctx, r.c, call{method: http.MethodGet, path: productPricesPath(productId, "current")})
+}
+
+// PriceAt returns the price of the product with the given id in effect at the given instant
+func (r *ProductRecordClient) PriceAt(ctx context.Context, productId int, at time.Time) (models.ProductPrice, error) {
+	return do[models.ProductPrice](ctx, r.c, call{
+		method: http.MethodGet,
+		path:   productPricesPath(productId, "at"),
+		query:  url.Values{"date": {at.Format(time.RFC3339)}},
+	})
+}
+
+// PriceTrend summarizes the prices of the product with the given id in effect between from and to
+func (r *ProductRecordClient) PriceTrend(ctx context.Context, productId int, from time.Time, to time.Time) (models.ProductPriceTrend, error) {
+	return do[models.ProductPriceTrend](ctx, r.c, call{
+		method: http.MethodGet,
+		path:   productPricesPath(productId, "trend"),
+		query:  url.Values{"from": {from.Format(time.RFC3339)}, "to": {to.Format(time.RFC3339)}},
+	})
+}
+
+// productPricesPath returns the path of the given price lookup of a product
+func productPricesPath(productId int, lookup string) string {
+	return "/api/v1/products/" + strconv.Itoa(productId) + "/prices/" + lookup
+}
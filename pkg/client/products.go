@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const productsPath = "/api/v1/products"
+
+// ProductClient is the client of the products
+type ProductClient struct {
+	c *Client
+}
+
+// List returns every product
+func (r *ProductClient) List(ctx context.Context) ([]models.Product, error) {
+	return list[models.Product](ctx, r.c, productsPath)
+}
+
+// All returns an iterator over every product
+func (r *ProductClient) All(ctx context.Context) iter.Seq2[models.Product, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the product with the given id
+func (r *ProductClient) Get(ctx context.Context, id int) (models.Product, error) {
+	return get[models.Product](ctx, r.c, productsPath, id)
+}
+
+// Create creates a product
+func (r *ProductClient) Create(ctx context.Context, body request.ProductRequest) (models.Product, error) {
+	return create[models.Product](ctx, r.c, productsPath, body, nil)
+}
+
+// Patch updates the given fields of the product with the given id, as a JSON Merge Patch
+func (r *ProductClient) Patch(ctx context.Context, id int, fields map[string]any) (models.Product, error) {
+	return mergePatch[models.Product](ctx, r.c, productsPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the product with the given id
+func (r *ProductClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.Product, error) {
+	return jsonPatch[models.Product](ctx, r.c, productsPath, id, ops)
+}
+
+// Delete deletes the product with the given id
+func (r *ProductClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, productsPath, id)
+}
+
+// RecordsReport returns the number of records of every product
+func (r *ProductClient) RecordsReport(ctx context.Context) ([]models.ProductReport, error) {
+	return report[[]models.ProductReport](ctx, r.c, productsPath+"/reportRecords", nil)
+}
+
+// RecordsReportByProduct returns the number of records of a product
+func (r *ProductClient) RecordsReportByProduct(ctx context.Context, id int) (models.ProductReport, error) {
+	return report[models.ProductReport](ctx, r.c, productsPath+"/reportRecords", &id)
+}
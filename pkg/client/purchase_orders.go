@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+)
+
+const purchaseOrdersPath = "/api/v1/purchaseOrders"
+
+// PurchaseOrderClient is the client of the purchase orders
+type PurchaseOrderClient struct {
+	c *Client
+}
+
+// Create creates a purchase order, sending it with WithIdempotencyKey makes it safe to retry
+func (r *PurchaseOrderClient) Create(ctx context.Context, body request.PurchaseOrderRequest, opts ...CallOption) (models.PurchaseOrder, error) {
+	return create[models.PurchaseOrder](ctx, r.c, purchaseOrdersPath, body, opts)
+}
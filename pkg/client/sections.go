@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const sectionsPath = "/api/v1/sections"
+
+// SectionClient is the client of the sections
+type SectionClient struct {
+	c *Client
+}
+
+// List returns every section
+func (r *SectionClient) List(ctx context.Context) ([]models.Section, error) {
+	return list[models.Section](ctx, r.c, sectionsPath)
+}
+
+// All returns an iterator over every section
+func (r *SectionClient) All(ctx context.Context) iter.Seq2[models.Section, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the section with the given id
+func (r *SectionClient) Get(ctx context.Context, id int) (models.Section, error) {
+	return get[models.Section](ctx, r.c, sectionsPath, id)
+}
+
+// Create creates a section
+func (r *SectionClient) Create(ctx context.Context, body request.SectionRequest) (models.Section, error) {
+	return create[models.Section](ctx, r.c, sectionsPath, body, nil)
+}
+
+// Patch updates the given fields of the section with the given id, as a JSON Merge Patch
+func (r *SectionClient) Patch(ctx context.Context, id int, fields map[string]any) (models.Section, error) {
+	return mergePatch[models.Section](ctx, r.c, sectionsPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the section with the given id
+func (r *SectionClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.Section, error) {
+	return jsonPatch[models.Section](ctx, r.c, sectionsPath, id, ops)
+}
+
+// Delete deletes the section with the given id
+func (r *SectionClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, sectionsPath, id)
+}
+
+// ProductsReport returns the number of products of every section
+func (r *SectionClient) ProductsReport(ctx context.Context) ([]models.SectionReport, error) {
+	return report[[]models.SectionReport](ctx, r.c, sectionsPath+"/reportProducts", nil)
+}
+
+// ProductsReportBySection returns the number of products of a section
+func (r *SectionClient) ProductsReportBySection(ctx context.Context, id int) (models.SectionReport, error) {
+	return report[models.SectionReport](ctx, r.c, sectionsPath+"/reportProducts", &id)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const sellersPath = "/api/v1/sellers"
+
+// SellerClient is the client of the sellers
+type SellerClient struct {
+	c *Client
+}
+
+// List returns every seller
+func (r *SellerClient) List(ctx context.Context) ([]models.Seller, error) {
+	return list[models.Seller](ctx, r.c, sellersPath)
+}
+
+// All returns an iterator over every seller
+func (r *SellerClient) All(ctx context.Context) iter.Seq2[models.Seller, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the seller with the given id
+func (r *SellerClient) Get(ctx context.Context, id int) (models.Seller, error) {
+	return get[models.Seller](ctx, r.c, sellersPath, id)
+}
+
+// Create creates a seller
+func (r *SellerClient) Create(ctx context.Context, body request.SellerRequest) (models.Seller, error) {
+	return create[models.Seller](ctx, r.c, sellersPath, body, nil)
+}
+
+// Update replaces the seller with the given id
+func (r *SellerClient) Update(ctx context.Context, id int, body request.SellerRequest) (models.Seller, error) {
+	return update[models.Seller](ctx, r.c, sellersPath, id, body)
+}
+
+// Patch updates the given fields of the seller with the given id, as a JSON Merge Patch
+func (r *SellerClient) Patch(ctx context.Context, id int, fields map[string]any) (models.Seller, error) {
+	return mergePatch[models.Seller](ctx, r.c, sellersPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the seller with the given id
+func (r *SellerClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.Seller, error) {
+	return jsonPatch[models.Seller](ctx, r.c, sellersPath, id, ops)
+}
+
+// Delete deletes the seller with the given id
+func (r *SellerClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, sellersPath, id)
+}
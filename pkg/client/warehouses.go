@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/models"
+	"github.com/miloalej-dev/W17-G1-Bootcamp/pkg/request"
+	"iter"
+)
+
+const warehousesPath = "/api/v1/warehouses"
+
+// WarehouseClient is the client of the warehouses
+type WarehouseClient struct {
+	c *Client
+}
+
+// List returns every warehouse
+func (r *WarehouseClient) List(ctx context.Context) ([]models.Warehouse, error) {
+	return list[models.Warehouse](ctx, r.c, warehousesPath)
+}
+
+// All returns an iterator over every warehouse
+func (r *WarehouseClient) All(ctx context.Context) iter.Seq2[models.Warehouse, error] {
+	return iterate(ctx, r.List)
+}
+
+// Get returns the warehouse with the given id
+func (r *WarehouseClient) Get(ctx context.Context, id int) (models.Warehouse, error) {
+	return get[models.Warehouse](ctx, r.c, warehousesPath, id)
+}
+
+// Create creates a warehouse
+func (r *WarehouseClient) Create(ctx context.Context, body request.WarehouseRequest) (models.Warehouse, error) {
+	return create[models.Warehouse](ctx, r.c, warehousesPath, body, nil)
+}
+
+// Patch updates the given fields of the warehouse with the given id, as a JSON Merge Patch
+func (r *WarehouseClient) Patch(ctx context.Context, id int, fields map[string]any) (models.Warehouse, error) {
+	return mergePatch[models.Warehouse](ctx, r.c, warehousesPath, id, fields)
+}
+
+// JSONPatch applies the JSON Patch operations to the warehouse with the given id
+func (r *WarehouseClient) JSONPatch(ctx context.Context, id int, ops []PatchOperation) (models.Warehouse, error) {
+	return jsonPatch[models.Warehouse](ctx, r.c, warehousesPath, id, ops)
+}
+
+// Delete deletes the warehouse with the given id
+func (r *WarehouseClient) Delete(ctx context.Context, id int) error {
+	return remove(ctx, r.c, warehousesPath, id)
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// AuditActionCreate is recorded when an entity is inserted
+	AuditActionCreate = "create"
+	// AuditActionUpdate is recorded when an entity is updated, fully or partially
+	AuditActionUpdate = "update"
+	// AuditActionDelete is recorded when an entity is removed
+	AuditActionDelete = "delete"
+)
+
+// AuditLog is an immutable record of a single mutation over a persisted entity
+type AuditLog struct {
+	Id         int             `json:"id" gorm:"primaryKey"`
+	Actor      string          `json:"actor"`
+	RequestId  string          `json:"request_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityId   string          `json:"entity_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	Diff       json.RawMessage `json:"diff"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditFilter holds the optional criteria used to search the audit trail
+type AuditFilter struct {
+	EntityType string
+	EntityId   string
+	Actor      string
+	Action     string
+	RequestId  string
+	From       *time.Time
+	To         *time.Time
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
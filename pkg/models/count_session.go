@@ -0,0 +1,76 @@
+package models
+
+import "time"
+
+// Statuses of a count session
+const (
+	// CountSessionOpen is a count session whose batches are being counted
+	CountSessionOpen = "open"
+	// CountSessionApproved is a count session whose variances were posted to the stock ledger
+	CountSessionApproved = "approved"
+)
+
+// CountSession is a physical count of the batches of a section. The quantity every batch is expected to hold is
+// snapshotted when the session is opened, and taken again when the batch is counted.
+type CountSession struct {
+	Id         int         `json:"id" gorm:"primaryKey"`
+	SectionId  int         `json:"section_id"`
+	Status     string      `json:"status"`
+	CreatedAt  time.Time   `json:"created_at"`
+	ApprovedAt *time.Time  `json:"approved_at"`
+	Lines      []CountLine `json:"lines" gorm:"foreignKey:CountSessionId"`
+}
+
+func (CountSession) TableName() string {
+	return "count_sessions"
+}
+
+// CountLine is a batch of a count session. The counted quantity, its variance from the expected quantity and the
+// employee who counted it are empty until the batch is counted, the expected quantity is then the one the batch held
+// at the time.
+type CountLine struct {
+	Id               int        `json:"id" gorm:"primaryKey"`
+	CountSessionId   int        `json:"count_session_id"`
+	ProductBatchId   int        `json:"product_batch_id"`
+	ExpectedQuantity int        `json:"expected_quantity"`
+	CountedQuantity  *int       `json:"counted_quantity"`
+	Variance         *int       `json:"variance"`
+	EmployeeId       *int       `json:"employee_id"`
+	CountedAt        *time.Time `json:"counted_at"`
+}
+
+func (CountLine) TableName() string {
+	return "count_lines"
+}
+
+// Count records the quantity counted by the employee along with its variance from the expected quantity
+func (l *CountLine) Count(quantity int, employeeId int, at time.Time) {
+	variance := quantity - l.ExpectedQuantity
+	l.CountedQuantity = &quantity
+	l.Variance = &variance
+	l.EmployeeId = &employeeId
+	l.CountedAt = &at
+}
+
+// CountVariance sums the variances of the batches counted in approved count sessions. The net variance offsets the
+// shortages with the overages, the absolute variance adds them up.
+type CountVariance struct {
+	LinesCount       int `json:"lines_count"`
+	MismatchesCount  int `json:"mismatches_count"`
+	NetVariance      int `json:"net_variance"`
+	AbsoluteVariance int `json:"absolute_variance"`
+}
+
+// WarehouseCountVariance is the variance of the count sessions of the sections of a warehouse
+type WarehouseCountVariance struct {
+	WarehouseId   int    `json:"warehouse_id"`
+	WarehouseCode string `json:"warehouse_code"`
+	SessionsCount int    `json:"sessions_count"`
+	CountVariance
+}
+
+// EmployeeCountVariance is the variance of the batches counted by an employee
+type EmployeeCountVariance struct {
+	Employee
+	CountVariance
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// IdempotencyKey stores the outcome of a request sent with an Idempotency-Key header so that retries of the same
+// request can be answered with the original response instead of being executed again
+type IdempotencyKey struct {
+	Id          int       `json:"id" gorm:"primaryKey"`
+	Key         string    `json:"key" gorm:"column:idempotency_key"`
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	RequestHash string    `json:"request_hash"`
+	StatusCode  int       `json:"status_code"` // StatusCode is zero while the original request is still in progress
+	ContentType string    `json:"content_type"`
+	Response    []byte    `json:"response"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Completed reports whether the original request already finished and its response can be replayed
+func (k IdempotencyKey) Completed() bool {
+	return k.StatusCode != 0
+}
+
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
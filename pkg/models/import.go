@@ -0,0 +1,44 @@
+package models
+
+const (
+	// ImportModeAtomic creates every row or none of them
+	ImportModeAtomic = "atomic"
+	// ImportModeBestEffort creates every valid row and reports the failing ones
+	ImportModeBestEffort = "best-effort"
+)
+
+const (
+	// ImportStatusCreated is reported for a row that was persisted
+	ImportStatusCreated = "created"
+	// ImportStatusFailed is reported for a row that could not be decoded, validated or persisted
+	ImportStatusFailed = "failed"
+	// ImportStatusRolledBack is reported for a valid row discarded because another row of an atomic import failed
+	ImportStatusRolledBack = "rolled_back"
+)
+
+// ImportRow is a single decoded row of an import, Entity holds the model to create unless the row is invalid, in
+// which case Err describes the problem
+type ImportRow struct {
+	Line   int
+	Entity any
+	Err    error
+}
+
+// ImportRowResult is the outcome of a single row of an import
+type ImportRowResult struct {
+	Line   int    `json:"line"`
+	Id     *int   `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ImportReport summarizes the outcome of an import row by row
+type ImportReport struct {
+	Resource  string            `json:"resource"`
+	Mode      string            `json:"mode"`
+	Total     int               `json:"total"`
+	Created   int               `json:"created"`
+	Failed    int               `json:"failed"`
+	Committed bool              `json:"committed"`
+	Rows      []ImportRowResult `json:"rows"`
+}
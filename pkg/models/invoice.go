@@ -0,0 +1,125 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaxRule is a tax charged on the purchase orders shipped from a country, as a percentage of their subtotal
+type TaxRule struct {
+	Id        int     `json:"id"`
+	CountryId int     `json:"country_id"`
+	Name      string  `json:"name"`
+	Rate      float64 `json:"rate"`
+}
+
+func (TaxRule) TableName() string {
+	return "tax_rules"
+}
+
+// PricedOrderDetail is a line of a purchase order at the sale price of its product record
+type PricedOrderDetail struct {
+	OrderDetail
+	UnitPrice float64 `json:"unit_price"`
+	Amount    float64 `json:"amount" gorm:"-"`
+}
+
+// PurchaseOrderTax is the amount of a tax rule charged on a purchase order
+type PurchaseOrderTax struct {
+	Name   string  `json:"name"`
+	Rate   float64 `json:"rate"`
+	Amount float64 `json:"amount"`
+}
+
+// PurchaseOrderPricing is what a purchase order costs, computed from its lines and the tax rules of its country
+type PurchaseOrderPricing struct {
+	Lines    []PricedOrderDetail `json:"lines"`
+	Subtotal float64             `json:"subtotal"`
+	Taxes    []PurchaseOrderTax  `json:"taxes"`
+	TaxTotal float64             `json:"tax_total"`
+	Total    float64             `json:"total"`
+}
+
+// NewPurchaseOrderPricing prices the lines and charges every tax rule on the subtotal. Every amount is rounded to
+// two decimals, the totals are the sums of the rounded amounts so an invoice always adds up.
+func NewPurchaseOrderPricing(lines []PricedOrderDetail, rules []TaxRule) PurchaseOrderPricing {
+	pricing := PurchaseOrderPricing{
+		Lines: make([]PricedOrderDetail, len(lines)),
+		Taxes: make([]PurchaseOrderTax, len(rules)),
+	}
+	for i, line := range lines {
+		line.Amount = round(line.UnitPrice * float64(line.Quantity))
+		pricing.Lines[i] = line
+		pricing.Subtotal += line.Amount
+	}
+	pricing.Subtotal = round(pricing.Subtotal)
+
+	for i, rule := range rules {
+		tax := PurchaseOrderTax{Name: rule.Name, Rate: rule.Rate, Amount: round(pricing.Subtotal * rule.Rate / 100)}
+		pricing.Taxes[i] = tax
+		pricing.TaxTotal += tax.Amount
+	}
+	pricing.TaxTotal = round(pricing.TaxTotal)
+	pricing.Total = round(pricing.Subtotal + pricing.TaxTotal)
+	return pricing
+}
+
+// NewPurchaseOrderTotals sums the priced lines of a purchase order, the amount is the subtotal the order is priced
+// at by NewPurchaseOrderPricing
+func NewPurchaseOrderTotals(purchaseOrderId int, lines []PricedOrderDetail) PurchaseOrderTotals {
+	totals := PurchaseOrderTotals{
+		PurchaseOrderId: purchaseOrderId,
+		LinesCount:      len(lines),
+		TotalAmount:     NewPurchaseOrderPricing(lines, nil).Subtotal,
+	}
+	for _, line := range lines {
+		totals.TotalQuantity += line.Quantity
+	}
+	return totals
+}
+
+// PricedPurchaseOrder is a purchase order along with what it costs, the priced lines take the place of its order
+// details
+type PricedPurchaseOrder struct {
+	Id            int       `json:"id"`
+	OrderNumber   string    `json:"order_number"`
+	OrderDate     time.Time `json:"order_date"`
+	TracingCode   string    `json:"tracing_code"`
+	BuyerID       int       `json:"buyer_id"`
+	WarehouseID   int       `json:"warehouse_id"`
+	CarrierID     int       `json:"carrier_id"`
+	OrderStatusID int       `json:"order_status_id"`
+	PurchaseOrderPricing
+}
+
+func NewPricedPurchaseOrder(order PurchaseOrder, pricing PurchaseOrderPricing) PricedPurchaseOrder {
+	return PricedPurchaseOrder{
+		Id:                   order.Id,
+		OrderNumber:          order.OrderNumber,
+		OrderDate:            order.OrderDate,
+		TracingCode:          order.TracingCode,
+		BuyerID:              order.BuyerID,
+		WarehouseID:          order.WarehouseID,
+		CarrierID:            order.CarrierID,
+		OrderStatusID:        order.OrderStatusID,
+		PurchaseOrderPricing: pricing,
+	}
+}
+
+// Invoice is the document billed for a purchase order, the taxes are the ones of the country of its warehouse
+type Invoice struct {
+	Number      string    `json:"number"`
+	IssuedAt    time.Time `json:"issued_at"`
+	OrderNumber string    `json:"order_number"`
+	OrderDate   time.Time `json:"order_date"`
+	TracingCode string    `json:"tracing_code"`
+	Buyer       Buyer     `json:"buyer"`
+	Warehouse   Warehouse `json:"warehouse"`
+	Country     Country   `json:"country"`
+	PurchaseOrderPricing
+}
+
+// InvoiceNumber returns the number an invoice of a purchase order is billed under
+func InvoiceNumber(purchaseOrderId int) string {
+	return fmt.Sprintf("INV-%08d", purchaseOrderId)
+}
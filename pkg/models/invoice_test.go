@@ -0,0 +1,96 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPurchaseOrderPricing(t *testing.T) {
+	tests := []struct {
+		title            string
+		lines            []PricedOrderDetail
+		rules            []TaxRule
+		expectedAmounts  []float64
+		expectedSubtotal float64
+		expectedTaxes    []PurchaseOrderTax
+		expectedTotal    float64
+	}{
+		{
+			title: "Single tax",
+			lines: []PricedOrderDetail{
+				{OrderDetail: OrderDetail{Id: 1, Quantity: 3}, UnitPrice: 29.99},
+				{OrderDetail: OrderDetail{Id: 2, Quantity: 10}, UnitPrice: 2.79},
+			},
+			rules:            []TaxRule{{Id: 1, CountryId: 1, Name: "IVA", Rate: 21}},
+			expectedAmounts:  []float64{89.97, 27.9},
+			expectedSubtotal: 117.87,
+			expectedTaxes:    []PurchaseOrderTax{{Name: "IVA", Rate: 21, Amount: 24.75}},
+			expectedTotal:    142.62,
+		},
+		{
+			title:            "Several taxes",
+			lines:            []PricedOrderDetail{{OrderDetail: OrderDetail{Id: 1, Quantity: 1}, UnitPrice: 100}},
+			rules:            []TaxRule{{Id: 1, Name: "IVA", Rate: 16}, {Id: 2, Name: "IEPS", Rate: 8}},
+			expectedAmounts:  []float64{100},
+			expectedSubtotal: 100,
+			expectedTaxes:    []PurchaseOrderTax{{Name: "IVA", Rate: 16, Amount: 16}, {Name: "IEPS", Rate: 8, Amount: 8}},
+			expectedTotal:    124,
+		},
+		{
+			title:            "No tax rules",
+			lines:            []PricedOrderDetail{{OrderDetail: OrderDetail{Id: 1, Quantity: 2}, UnitPrice: 5.5}},
+			rules:            []TaxRule{},
+			expectedAmounts:  []float64{11},
+			expectedSubtotal: 11,
+			expectedTaxes:    []PurchaseOrderTax{},
+			expectedTotal:    11,
+		},
+		{
+			title:            "No lines",
+			lines:            []PricedOrderDetail{},
+			rules:            []TaxRule{{Id: 1, Name: "IVA", Rate: 19}},
+			expectedAmounts:  []float64{},
+			expectedSubtotal: 0,
+			expectedTaxes:    []PurchaseOrderTax{{Name: "IVA", Rate: 19, Amount: 0}},
+			expectedTotal:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			pricing := NewPurchaseOrderPricing(tt.lines, tt.rules)
+
+			// Assert
+			amounts := make([]float64, len(pricing.Lines))
+			for i, line := range pricing.Lines {
+				amounts[i] = line.Amount
+			}
+			require.Equal(t, tt.expectedAmounts, amounts)
+			require.Equal(t, tt.expectedSubtotal, pricing.Subtotal)
+			require.Equal(t, tt.expectedTaxes, pricing.Taxes)
+			require.Equal(t, tt.expectedTotal, pricing.Total)
+			require.Equal(t, round(pricing.Total-pricing.Subtotal), pricing.TaxTotal)
+		})
+	}
+}
+
+func TestNewPurchaseOrderTotals(t *testing.T) {
+	// Arrange
+	lines := []PricedOrderDetail{
+		{OrderDetail: OrderDetail{Id: 1, Quantity: 3}, UnitPrice: 0.3355},
+		{OrderDetail: OrderDetail{Id: 2, Quantity: 3}, UnitPrice: 0.3355},
+	}
+
+	// Act
+	totals := NewPurchaseOrderTotals(7, lines)
+
+	// Assert
+	require.Equal(t, PurchaseOrderTotals{PurchaseOrderId: 7, LinesCount: 2, TotalQuantity: 6, TotalAmount: 2.02}, totals)
+	require.Equal(t, NewPurchaseOrderPricing(lines, nil).Subtotal, totals.TotalAmount)
+}
+
+func TestInvoiceNumber(t *testing.T) {
+	require.Equal(t, "INV-00000042", InvoiceNumber(42))
+}
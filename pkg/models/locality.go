@@ -25,12 +25,21 @@ type LocalityCarrierCount struct {
 	TotalCarriers int    `gorm:"column:total_carriers"`
 }
 
+type Country struct {
+	Id      int    `json:"id" gorm:"primaryKey"`
+	Country string `json:"country"`
+}
+
 type Province struct {
 	Id        int    `json:"id" gorm:"primaryKey"`
 	Province  string `json:"province"`
 	CountryId int    `json:"country_id" gorm:"column:country_id"`
 }
 
+func (Country) TableName() string {
+	return "countries"
+}
+
 func (Province) TableName() string {
 	return "provinces"
 }
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type OrderDetail struct {
 	Id               int     `json:"id"`
 	Quantity         int     `json:"quantity"`
@@ -8,3 +10,36 @@ type OrderDetail struct {
 	ProductRecordID  int     `json:"product_record_id"`
 	PurchaseOrderID  int     `json:"purchase_order_id"`
 }
+
+// PurchaseOrderTotals are computed from the lines of a purchase order, the amount at the sale price of the product
+// record of each line
+type PurchaseOrderTotals struct {
+	PurchaseOrderId int     `json:"purchase_order_id"`
+	LinesCount      int     `json:"lines_count"`
+	TotalQuantity   int     `json:"total_quantity"`
+	TotalAmount     float64 `json:"total_amount"`
+}
+
+// PurchaseOrderLines are the lines of a purchase order along with its totals
+type PurchaseOrderLines struct {
+	PurchaseOrderTotals
+	OrderDetails []OrderDetail `json:"order_details"`
+}
+
+// OrderDetailPick is the stock of a product batch picked to fulfill a line of a purchase order, it tells which
+// batches the buyer of the order received
+type OrderDetailPick struct {
+	Id             int `json:"id" gorm:"primaryKey"`
+	OrderDetailId  int `json:"order_detail_id"`
+	ProductBatchId int `json:"product_batch_id"`
+	Quantity       int `json:"quantity"`
+	// EmployeeId is the employee who picked the stock, if known
+	EmployeeId *int `json:"employee_id"`
+	// StockMovementId is the pick in the stock ledger
+	StockMovementId int       `json:"stock_movement_id"`
+	PickedAt        time.Time `json:"picked_at"`
+}
+
+func (OrderDetailPick) TableName() string {
+	return "order_detail_picks"
+}
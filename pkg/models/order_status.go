@@ -0,0 +1,12 @@
+package models
+
+// OrderStatus is a status of the purchase orders, the statuses are a fixed catalog seeded with the database
+type OrderStatus struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (OrderStatus) TableName() string {
+	return "order_status"
+}
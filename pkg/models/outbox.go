@@ -0,0 +1,49 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Aggregates whose changes are recorded in the outbox
+const (
+	OutboxAggregatePurchaseOrder = "purchase_order"
+	OutboxAggregateInboundOrder  = "inbound_order"
+)
+
+// Types of the domain events recorded in the outbox
+const (
+	OutboxEventPurchaseOrderCreated = "purchase_order.created"
+	OutboxEventInboundOrderCreated  = "inbound_order.created"
+)
+
+// Statuses of an outbox event
+const (
+	// OutboxPending is the status of an event not yet dispatched to every publisher
+	OutboxPending = "pending"
+	// OutboxDispatched is the status of an event every publisher accepted
+	OutboxDispatched = "dispatched"
+	// OutboxDead is the status of an event given up on after too many failed attempts, it is no longer dispatched
+	OutboxDead = "dead"
+)
+
+// OutboxEvent is a domain event written in the same transaction as the change it describes, and dispatched to the
+// publishers once committed
+type OutboxEvent struct {
+	Id            int    `json:"id" gorm:"primaryKey"`
+	AggregateType string `json:"aggregate_type"`
+	AggregateId   int    `json:"aggregate_id"`
+	EventType     string `json:"event_type"`
+	// Payload is the JSON representation of the aggregate after the change
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	LastError     string          `json:"last_error"`
+	NextAttemptAt *time.Time      `json:"next_attempt_at"`
+	DispatchedAt  *time.Time      `json:"dispatched_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox"
+}
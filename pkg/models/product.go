@@ -34,12 +34,6 @@ type ProductReport struct {
 	RecordsCount int    `json:"records_count"`
 }
 
-// ProductType represents the type of product.
-type ProductType struct {
-	Id          int
-	Description string
-}
-
 // NewProduct is a function that creates a new Product
 func NewProduct(id int, productCode string, description string, width float64, height float64, length float64, netWeight float64, expirationRate float64, recommendedFreezingTemperature float64, freezingRate float64, productTypeId int, sellerId *int) *Product {
 	return &Product{
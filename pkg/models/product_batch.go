@@ -1,5 +1,36 @@
 package models
 
+import "time"
+
+// Statuses of a product batch. A batch is received, inspected and made available, only the stock of available
+// batches can be picked and transferred. Recalled batches are set apart by their recall.
+const (
+	// ProductBatchReceived is a batch just received, not inspected yet
+	ProductBatchReceived = "received"
+	// ProductBatchUnderInspection is a batch whose quality is being checked
+	ProductBatchUnderInspection = "under_inspection"
+	// ProductBatchAvailable is a batch whose stock can be sold
+	ProductBatchAvailable = "available"
+	// ProductBatchQuarantined is a batch whose stock was set apart
+	ProductBatchQuarantined = "quarantined"
+	// ProductBatchExpired is a batch past its due date
+	ProductBatchExpired = "expired"
+	// ProductBatchRecalled is a batch under an open recall
+	ProductBatchRecalled = "recalled"
+	// ProductBatchDisposed is a batch whose stock was thrown away
+	ProductBatchDisposed = "disposed"
+)
+
+// productBatchTransitions are the statuses a batch can be moved to from each status by hand, a batch is recalled
+// and moved out of recalled by its recall only
+var productBatchTransitions = map[string][]string{
+	ProductBatchReceived:        {ProductBatchUnderInspection, ProductBatchAvailable, ProductBatchQuarantined, ProductBatchExpired, ProductBatchDisposed},
+	ProductBatchUnderInspection: {ProductBatchAvailable, ProductBatchQuarantined, ProductBatchExpired, ProductBatchDisposed},
+	ProductBatchAvailable:       {ProductBatchUnderInspection, ProductBatchQuarantined, ProductBatchExpired, ProductBatchDisposed},
+	ProductBatchQuarantined:     {ProductBatchUnderInspection, ProductBatchAvailable, ProductBatchExpired, ProductBatchDisposed},
+	ProductBatchExpired:         {ProductBatchDisposed},
+}
+
 // Product represents the structure of a product.
 type ProductBatch struct {
 	Id                 int     `json:"id"`
@@ -13,6 +44,34 @@ type ProductBatch struct {
 	MinimumTemperature float64 `json:"minimum_temperature"`
 	SectionId          int     `json:"section_id"`
 	ProductId          int     `json:"product_id"`
+	Status             string  `json:"status"`
+}
+
+// CanMoveTo reports whether the batch can be moved by hand from its current status to the given one
+func (b ProductBatch) CanMoveTo(status string) bool {
+	for _, next := range productBatchTransitions[b.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ProductBatchStatusChange is a change of the status of a product batch along with the reason it was made for and
+// the employee who made it, if any
+type ProductBatchStatusChange struct {
+	Id             int       `json:"id" gorm:"primaryKey"`
+	ProductBatchId int       `json:"product_batch_id"`
+	FromStatus     string    `json:"from_status"`
+	ToStatus       string    `json:"to_status"`
+	Reason         string    `json:"reason"`
+	ReasonCode     *string   `json:"reason_code"`
+	EmployeeId     *int      `json:"employee_id"`
+	ChangedAt      time.Time `json:"changed_at"`
+}
+
+func (ProductBatchStatusChange) TableName() string {
+	return "product_batch_status_changes"
 }
 
 // NewProductBatch is a function that creates a new Product
@@ -31,3 +90,35 @@ func NewProductBatch(id int, batchNumber int, currentQuantity int, currentTemper
 		ProductId:          productId,
 	}
 }
+
+// BatchTrace follows a product batch from the seller of its product to the buyers it was shipped to
+type BatchTrace struct {
+	ProductBatch ProductBatch `json:"product_batch"`
+	Product      Product      `json:"product"`
+	// Seller is the seller of the product, if it has one
+	Seller *Seller `json:"seller"`
+	// Receipts are the inbound orders the batch was received with
+	Receipts []BatchReceipt `json:"receipts"`
+	// Section and Warehouse are where the batch is stored now
+	Section   Section   `json:"section"`
+	Warehouse Warehouse `json:"warehouse"`
+	// Shipments are the purchase orders the batch was picked for, in the order they were first picked
+	Shipments []BatchShipment `json:"shipments"`
+}
+
+// BatchReceipt is an inbound order of a traced batch along with the employee who received it and its warehouse
+type BatchReceipt struct {
+	InboundOrder InboundOrder `json:"inbound_order"`
+	Employee     Employee     `json:"employee"`
+	Warehouse    Warehouse    `json:"warehouse"`
+}
+
+// BatchShipment is a purchase order a traced batch was picked for along with its buyer and carrier, the picked
+// quantity sums the picks of the batch for the lines of the order
+type BatchShipment struct {
+	PurchaseOrder  PurchaseOrder     `json:"purchase_order"`
+	Buyer          Buyer             `json:"buyer"`
+	Carrier        Carrier           `json:"carrier"`
+	PickedQuantity int               `json:"picked_quantity"`
+	Picks          []OrderDetailPick `json:"picks"`
+}
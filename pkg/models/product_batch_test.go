@@ -48,3 +48,28 @@ func TestNewProductBatch(t *testing.T) {
 	assert.Equal(t, expectedSectionId, productBatch.SectionId, "SectionId should match")
 	assert.Equal(t, expectedProductId, productBatch.ProductId, "ProductId should match")
 }
+
+func TestProductBatch_CanMoveTo(t *testing.T) {
+	tests := []struct {
+		title    string
+		from     string
+		to       string
+		expected bool
+	}{
+		{title: "received to available", from: ProductBatchReceived, to: ProductBatchAvailable, expected: true},
+		{title: "available to quarantined", from: ProductBatchAvailable, to: ProductBatchQuarantined, expected: true},
+		{title: "quarantined back to available", from: ProductBatchQuarantined, to: ProductBatchAvailable, expected: true},
+		{title: "expired to disposed", from: ProductBatchExpired, to: ProductBatchDisposed, expected: true},
+		{title: "expired back to available", from: ProductBatchExpired, to: ProductBatchAvailable},
+		{title: "available to recalled", from: ProductBatchAvailable, to: ProductBatchRecalled},
+		{title: "recalled to available", from: ProductBatchRecalled, to: ProductBatchAvailable},
+		{title: "disposed to available", from: ProductBatchDisposed, to: ProductBatchAvailable},
+		{title: "available to available", from: ProductBatchAvailable, to: ProductBatchAvailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ProductBatch{Status: tt.from}.CanMoveTo(tt.to))
+		})
+	}
+}
@@ -1,20 +1,105 @@
 package models
 
+import (
+	"math"
+	"time"
+)
+
+// ProductRecord is an entry of the price history of a product, the prices are in effect from LastUpdate until the
+// next record of the product
 type ProductRecord struct {
-	Id            int     `json:"id"`
-	LastUpdate    string  `json:"last_update"`
-	PurchasePrice float64 `json:"purchase_price"`
-	SalePrice     float64 `json:"sale_price"`
-	ProductId     int     `json:"product_id"`
+	Id            int       `json:"id"`
+	LastUpdate    time.Time `json:"last_update"`
+	PurchasePrice float64   `json:"purchase_price"`
+	SalePrice     float64   `json:"sale_price"`
+	ProductId     int       `json:"product_id"`
 }
 
 // NewProductRecord is a function that creates a new productRecord
-func NewProductRecord(id int, lastUpdateDate string, purchasePrice float64, salePrice float64, productId int) *ProductRecord {
+func NewProductRecord(id int, lastUpdate time.Time, purchasePrice float64, salePrice float64, productId int) *ProductRecord {
 	return &ProductRecord{
 		Id:            id,
-		LastUpdate:    lastUpdateDate,
+		LastUpdate:    lastUpdate,
 		PurchasePrice: purchasePrice,
 		SalePrice:     salePrice,
 		ProductId:     productId,
 	}
 }
+
+// ProductPrice is the price of a product taken from a record of its history
+type ProductPrice struct {
+	ProductId        int       `json:"product_id"`
+	ProductRecordId  int       `json:"product_record_id"`
+	EffectiveFrom    time.Time `json:"effective_from"`
+	PurchasePrice    float64   `json:"purchase_price"`
+	SalePrice        float64   `json:"sale_price"`
+	MarginPercentage float64   `json:"margin_percentage"`
+}
+
+// NewProductPrice returns the price of a record, the margin is the share of the sale price that is profit
+func NewProductPrice(record ProductRecord) ProductPrice {
+	return ProductPrice{
+		ProductId:        record.ProductId,
+		ProductRecordId:  record.Id,
+		EffectiveFrom:    record.LastUpdate,
+		PurchasePrice:    record.PurchasePrice,
+		SalePrice:        record.SalePrice,
+		MarginPercentage: marginPercentage(record.PurchasePrice, record.SalePrice),
+	}
+}
+
+// ProductPriceTrend summarizes the prices of a product in effect within a date range
+type ProductPriceTrend struct {
+	ProductId               int            `json:"product_id"`
+	From                    time.Time      `json:"from"`
+	To                      time.Time      `json:"to"`
+	MinSalePrice            float64        `json:"min_sale_price"`
+	MaxSalePrice            float64        `json:"max_sale_price"`
+	AverageSalePrice        float64        `json:"average_sale_price"`
+	MinPurchasePrice        float64        `json:"min_purchase_price"`
+	MaxPurchasePrice        float64        `json:"max_purchase_price"`
+	AveragePurchasePrice    float64        `json:"average_purchase_price"`
+	AverageMarginPercentage float64        `json:"average_margin_percentage"`
+	Prices                  []ProductPrice `json:"prices"`
+}
+
+// NewProductPriceTrend computes the statistics of the prices, which must be ordered by time. Without prices every
+// statistic is zero.
+func NewProductPriceTrend(productId int, from time.Time, to time.Time, prices []ProductPrice) ProductPriceTrend {
+	trend := ProductPriceTrend{ProductId: productId, From: from, To: to, Prices: prices}
+	if len(prices) == 0 {
+		trend.Prices = make([]ProductPrice, 0)
+		return trend
+	}
+
+	trend.MinSalePrice, trend.MaxSalePrice = prices[0].SalePrice, prices[0].SalePrice
+	trend.MinPurchasePrice, trend.MaxPurchasePrice = prices[0].PurchasePrice, prices[0].PurchasePrice
+	var sales, purchases, margins float64
+	for _, price := range prices {
+		trend.MinSalePrice = math.Min(trend.MinSalePrice, price.SalePrice)
+		trend.MaxSalePrice = math.Max(trend.MaxSalePrice, price.SalePrice)
+		trend.MinPurchasePrice = math.Min(trend.MinPurchasePrice, price.PurchasePrice)
+		trend.MaxPurchasePrice = math.Max(trend.MaxPurchasePrice, price.PurchasePrice)
+		sales += price.SalePrice
+		purchases += price.PurchasePrice
+		margins += price.MarginPercentage
+	}
+	count := float64(len(prices))
+	trend.AverageSalePrice = round(sales / count)
+	trend.AveragePurchasePrice = round(purchases / count)
+	trend.AverageMarginPercentage = round(margins / count)
+	return trend
+}
+
+// marginPercentage is the profit over the sale price, a product sold for free has no margin
+func marginPercentage(purchasePrice float64, salePrice float64) float64 {
+	if salePrice == 0 {
+		return 0
+	}
+	return round((salePrice - purchasePrice) / salePrice * 100)
+}
+
+// round rounds a price or a percentage to two decimals
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -9,7 +10,7 @@ import (
 func TestNewProductRecord(t *testing.T) {
 
 	id := 5
-	lastUpdate := "2023-10-10"
+	lastUpdate := time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC)
 	purchasePrice := 4.75
 	salePrice := 5.50
 	productId := 45
@@ -23,3 +24,36 @@ func TestNewProductRecord(t *testing.T) {
 	require.Equal(t, salePrice, pr.SalePrice)
 	require.Equal(t, productId, pr.ProductId)
 }
+
+func TestNewProductPrice_MarginPercentage(t *testing.T) {
+	tests := []struct {
+		title          string
+		purchasePrice  float64
+		salePrice      float64
+		expectedMargin float64
+	}{
+		{title: "Profit", purchasePrice: 3.99, salePrice: 29.99, expectedMargin: 86.7},
+		{title: "Loss", purchasePrice: 22.99, salePrice: 2.79, expectedMargin: -724.01},
+		{title: "Free product", purchasePrice: 1, salePrice: 0, expectedMargin: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			price := NewProductPrice(ProductRecord{PurchasePrice: tt.purchasePrice, SalePrice: tt.salePrice})
+
+			// Assert
+			require.Equal(t, tt.expectedMargin, price.MarginPercentage)
+		})
+	}
+}
+
+func TestNewProductPriceTrend_WithoutPrices(t *testing.T) {
+	// Act
+	trend := NewProductPriceTrend(1, time.Time{}, time.Time{}, nil)
+
+	// Assert
+	require.NotNil(t, trend.Prices)
+	require.Zero(t, trend.MaxSalePrice)
+	require.Zero(t, trend.AverageMarginPercentage)
+}
@@ -0,0 +1,28 @@
+package models
+
+// ProductType represents the type of product, which tells the sections able to store a product
+type ProductType struct {
+	// Unique product type identifier.
+	Id int `json:"id"`
+	// The name of the product type, unique among the types.
+	Name string `json:"name"`
+	// A description of the product type.
+	Description string `json:"description"`
+}
+
+func (ProductType) TableName() string {
+	return "product_type"
+}
+
+// ProductTypeUsage counts the products and the sections of a product type
+type ProductTypeUsage struct {
+	ProductTypeId int    `json:"product_type_id"`
+	Name          string `json:"name"`
+	ProductsCount int    `json:"products_count"`
+	SectionsCount int    `json:"sections_count"`
+}
+
+// InUse tells whether a product or a section still references the type
+func (u ProductTypeUsage) InUse() bool {
+	return u.ProductsCount > 0 || u.SectionsCount > 0
+}
@@ -2,6 +2,13 @@ package models
 
 import "time"
 
+// statuses of the purchase orders, from the order_status table
+const (
+	OrderStatusPending   = 1
+	OrderStatusInTransit = 2
+	OrderStatusDelivered = 3
+)
+
 type PurchaseOrder struct {
 	Id            int            `json:"id"`
 	OrderNumber   string         `json:"order_number"`
@@ -13,3 +20,8 @@ type PurchaseOrder struct {
 	OrderStatusID int            `json:"order_status_id"`
 	OrderDetails  *[]OrderDetail `json:"order_details"`
 }
+
+// Shipped reports whether the order already left the warehouse, its lines cannot be changed anymore
+func (p PurchaseOrder) Shipped() bool {
+	return p.OrderStatusID == OrderStatusInTransit || p.OrderStatusID == OrderStatusDelivered
+}
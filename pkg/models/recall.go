@@ -0,0 +1,111 @@
+package models
+
+import "time"
+
+// Severities of a recall
+const (
+	// RecallSeverityLow is a recall of stock unlikely to cause harm
+	RecallSeverityLow = "low"
+	// RecallSeverityMedium is a recall of stock that may cause temporary harm
+	RecallSeverityMedium = "medium"
+	// RecallSeverityHigh is a recall of stock that may cause serious harm
+	RecallSeverityHigh = "high"
+)
+
+// Statuses of a recall
+const (
+	// RecallOpen is a recall whose batches are being quarantined and disposed
+	RecallOpen = "open"
+	// RecallClosed is a recall whose batches were all disposed
+	RecallClosed = "closed"
+)
+
+// Statuses of a batch of a recall, a recalled batch is quarantined and then disposed
+const (
+	// RecallBatchRecalled is a batch blocked by the recall, its stock was not set apart yet
+	RecallBatchRecalled = "recalled"
+	// RecallBatchQuarantined is a batch whose stock was set apart until it is disposed
+	RecallBatchQuarantined = "quarantined"
+	// RecallBatchDisposed is a batch whose stock was thrown away
+	RecallBatchDisposed = "disposed"
+)
+
+// Recall is a recall campaign of every batch of a product, or of a set of batches. The batches of an open recall
+// cannot be picked for purchase orders.
+type Recall struct {
+	Id int `json:"id" gorm:"primaryKey"`
+	// ProductId is the product recalled, empty when the recall targets a set of batches
+	ProductId *int          `json:"product_id"`
+	Severity  string        `json:"severity"`
+	Reason    string        `json:"reason"`
+	Status    string        `json:"status"`
+	CreatedAt time.Time     `json:"created_at"`
+	ClosedAt  *time.Time    `json:"closed_at"`
+	Batches   []RecallBatch `json:"batches" gorm:"foreignKey:RecallId"`
+}
+
+func (Recall) TableName() string {
+	return "recalls"
+}
+
+// RecallBatch is a batch of a recall. The recalled quantity is the stock the batch held when the recall was opened,
+// the quarantined and disposed quantities the stock it held when it was quarantined and disposed.
+type RecallBatch struct {
+	Id                  int        `json:"id" gorm:"primaryKey"`
+	RecallId            int        `json:"recall_id"`
+	ProductBatchId      int        `json:"product_batch_id"`
+	Status              string     `json:"status"`
+	RecalledQuantity    int        `json:"recalled_quantity"`
+	QuarantinedQuantity *int       `json:"quarantined_quantity"`
+	QuarantinedAt       *time.Time `json:"quarantined_at"`
+	DisposedQuantity    *int       `json:"disposed_quantity"`
+	DisposedAt          *time.Time `json:"disposed_at"`
+}
+
+func (RecallBatch) TableName() string {
+	return "recall_batches"
+}
+
+// RecallStock is the stock of the batches of a recall stored in a warehouse, the pending batches are the ones not
+// quarantined yet
+type RecallStock struct {
+	WarehouseId         int    `json:"warehouse_id"`
+	WarehouseCode       string `json:"warehouse_code"`
+	BatchesCount        int    `json:"batches_count"`
+	PendingBatchesCount int    `json:"pending_batches_count"`
+	Quantity            int    `json:"quantity"`
+}
+
+// RecallOrders are the purchase orders of a buyer the batches of a recall were picked for
+type RecallOrders struct {
+	Buyer
+	PurchaseOrders []RecallPurchaseOrder `json:"purchase_orders"`
+}
+
+// RecallPurchaseOrder is a purchase order the batches of a recall were picked for, along with the quantity picked
+// from them
+type RecallPurchaseOrder struct {
+	PurchaseOrderId int    `json:"purchase_order_id"`
+	OrderNumber     string `json:"order_number"`
+	OrderStatusId   int    `json:"order_status_id"`
+	PickedQuantity  int    `json:"picked_quantity"`
+}
+
+// RecallReport sums up a recall: the stock it blocked, how much of it was quarantined and disposed, and the stock
+// of its batches that had already been picked for the purchase orders of the buyers
+type RecallReport struct {
+	RecallId             int        `json:"recall_id"`
+	ProductId            *int       `json:"product_id"`
+	Severity             string     `json:"severity"`
+	Status               string     `json:"status"`
+	CreatedAt            time.Time  `json:"created_at"`
+	ClosedAt             *time.Time `json:"closed_at"`
+	BatchesCount         int        `json:"batches_count"`
+	DisposedBatchesCount int        `json:"disposed_batches_count"`
+	RecalledQuantity     int        `json:"recalled_quantity"`
+	QuarantinedQuantity  int        `json:"quarantined_quantity"`
+	DisposedQuantity     int        `json:"disposed_quantity"`
+	PickedQuantity       int        `json:"picked_quantity"`
+	PurchaseOrdersCount  int        `json:"purchase_orders_count"`
+	BuyersCount          int        `json:"buyers_count"`
+}
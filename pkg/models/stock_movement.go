@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+// Reasons of a stock movement
+const (
+	// StockMovementReceipt is the stock a batch held when it was created
+	StockMovementReceipt = "receipt"
+	// StockMovementPick is the stock picked from a batch to fulfill a purchase order
+	StockMovementPick = "pick"
+	// StockMovementAdjustment corrects the stock of a batch
+	StockMovementAdjustment = "adjustment"
+	// StockMovementTransfer moves the stock of a batch between sections
+	StockMovementTransfer = "transfer"
+	// StockMovementDisposal is the stock of a batch thrown away
+	StockMovementDisposal = "disposal"
+)
+
+// Reason codes of the adjustments and the disposals, they tell why the stock of a batch was corrected or thrown away
+const (
+	// AdjustmentExpired is stock removed from a batch past its due date
+	AdjustmentExpired = "expired"
+	// AdjustmentDamaged is stock removed from a batch because it was damaged
+	AdjustmentDamaged = "damaged"
+	// AdjustmentTemperatureExcursion is stock removed from a batch kept out of its temperature range
+	AdjustmentTemperatureExcursion = "temperature_excursion"
+	// AdjustmentTheft is stock missing from a batch because it was stolen
+	AdjustmentTheft = "theft"
+	// AdjustmentFound is stock added to a batch because it was found
+	AdjustmentFound = "found"
+	// AdjustmentCountShortage is a batch counted short of the quantity it was expected to hold
+	AdjustmentCountShortage = "count_shortage"
+	// AdjustmentCountOverage is a batch counted over the quantity it was expected to hold
+	AdjustmentCountOverage = "count_overage"
+	// AdjustmentRecalled is stock thrown away because its batch was recalled
+	AdjustmentRecalled = "recalled"
+)
+
+// StockMovement is an entry of the append-only stock ledger, the current quantity of a batch is the sum of its
+// movements
+type StockMovement struct {
+	Id             int    `json:"id" gorm:"primaryKey"`
+	ProductBatchId int    `json:"product_batch_id"`
+	SectionId      int    `json:"section_id"`
+	Quantity       int    `json:"quantity"`
+	Reason         string `json:"reason"`
+	// ReasonCode tells why an adjustment or a disposal was made, if known
+	ReasonCode *string `json:"reason_code"`
+	// EmployeeId is the employee who moved the stock, if known
+	EmployeeId *int `json:"employee_id"`
+	// Actor is the user who recorded the movement
+	Actor     string    `json:"actor"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (StockMovement) TableName() string {
+	return "stock_movements"
+}
+
+// StockBalance is the stock of a product in a warehouse. The stock on hand is derived from the ledger, the blocked
+// stock is held by batches that are not available, the reserved stock is the quantity ordered by the pending purchase
+// orders and what is left is available.
+type StockBalance struct {
+	ProductId   int `json:"product_id"`
+	WarehouseId int `json:"warehouse_id"`
+	OnHand      int `json:"on_hand"`
+	Blocked     int `json:"blocked"`
+	Reserved    int `json:"reserved"`
+	Available   int `json:"available"`
+}
+
+// WasteReport sums the stock removed from the batches of a product in a warehouse because it spoiled, was damaged or
+// was recalled, the quantities are the units removed
+type WasteReport struct {
+	ProductId                    int     `json:"product_id"`
+	ProductDescription           string  `json:"product_description"`
+	SellerId                     *int    `json:"seller_id"`
+	SellerName                   *string `json:"seller_name"`
+	WarehouseId                  int     `json:"warehouse_id"`
+	WarehouseCode                string  `json:"warehouse_code"`
+	ExpiredQuantity              int     `json:"expired_quantity"`
+	DamagedQuantity              int     `json:"damaged_quantity"`
+	TemperatureExcursionQuantity int     `json:"temperature_excursion_quantity"`
+	RecalledQuantity             int     `json:"recalled_quantity"`
+	TotalQuantity                int     `json:"total_quantity"`
+}
@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+// Statuses of a transfer order, in the order they are reached
+const (
+	// TransferOrderDraft is a transfer order being prepared, its batches are still in the source section
+	TransferOrderDraft = "draft"
+	// TransferOrderDispatched is a transfer order whose batches left the source section
+	TransferOrderDispatched = "dispatched"
+	// TransferOrderInTransit is a transfer order whose batches were picked up by its carrier
+	TransferOrderInTransit = "in_transit"
+	// TransferOrderReceived is a transfer order whose batches were stored in the destination section
+	TransferOrderReceived = "received"
+)
+
+// transferOrderTransitions maps every status of a transfer order to the one that follows it
+var transferOrderTransitions = map[string]string{
+	TransferOrderDraft:      TransferOrderDispatched,
+	TransferOrderDispatched: TransferOrderInTransit,
+	TransferOrderInTransit:  TransferOrderReceived,
+}
+
+// TransferOrder moves product batches from a section of a warehouse to a section of another warehouse
+type TransferOrder struct {
+	Id                     int                 `json:"id" gorm:"primaryKey"`
+	SourceWarehouseId      int                 `json:"source_warehouse_id"`
+	SourceSectionId        int                 `json:"source_section_id"`
+	DestinationWarehouseId int                 `json:"destination_warehouse_id"`
+	DestinationSectionId   int                 `json:"destination_section_id"`
+	CarrierId              *int                `json:"carrier_id"`
+	Status                 string              `json:"status"`
+	CreatedAt              time.Time           `json:"created_at"`
+	DispatchedAt           *time.Time          `json:"dispatched_at"`
+	InTransitAt            *time.Time          `json:"in_transit_at"`
+	ReceivedAt             *time.Time          `json:"received_at"`
+	Lines                  []TransferOrderLine `json:"lines" gorm:"foreignKey:TransferOrderId"`
+}
+
+func (TransferOrder) TableName() string {
+	return "transfer_orders"
+}
+
+// CanMoveTo reports whether status is the one that follows the current status of the transfer order
+func (t TransferOrder) CanMoveTo(status string) bool {
+	return transferOrderTransitions[t.Status] == status
+}
+
+// TransferOrderLine is a product batch moved by a transfer order, the quantity is the one of the batch when it was
+// dispatched
+type TransferOrderLine struct {
+	Id              int `json:"id" gorm:"primaryKey"`
+	TransferOrderId int `json:"transfer_order_id"`
+	ProductBatchId  int `json:"product_batch_id"`
+	Quantity        int `json:"quantity"`
+}
+
+func (TransferOrderLine) TableName() string {
+	return "transfer_order_lines"
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Types of the events notified to the webhook subscriptions
+const (
+	// WebhookEventPurchaseOrderCreated is sent when a purchase order is created
+	WebhookEventPurchaseOrderCreated = "purchase_order.created"
+	// WebhookEventInboundOrderReceived is sent when an inbound order is registered
+	WebhookEventInboundOrderReceived = "inbound_order.received"
+	// WebhookEventProductBatchExpiring is sent once when a product batch with stock gets close to its due date
+	WebhookEventProductBatchExpiring = "product_batch.expiring"
+	// WebhookEventSectionTemperatureExcursion is sent when a section is saved with its current temperature below its
+	// minimum temperature
+	WebhookEventSectionTemperatureExcursion = "section.temperature_excursion"
+)
+
+// WebhookEventTypes lists every event type a subscription can subscribe to
+var WebhookEventTypes = []string{
+	WebhookEventPurchaseOrderCreated,
+	WebhookEventInboundOrderReceived,
+	WebhookEventProductBatchExpiring,
+	WebhookEventSectionTemperatureExcursion,
+}
+
+// Statuses of a webhook delivery
+const (
+	// WebhookDeliveryPending is the status of a delivery waiting for its next attempt
+	WebhookDeliveryPending = "pending"
+	// WebhookDeliveryDelivered is the status of a delivery acknowledged by its receiver with a 2xx response
+	WebhookDeliveryDelivered = "delivered"
+	// WebhookDeliveryDead is the status of a delivery that failed every attempt, it stays in the dead-letter list
+	// until it is redelivered
+	WebhookDeliveryDead = "dead"
+)
+
+// WebhookSubscription is an endpoint notified of the events of the given types. The secret signs the deliveries
+// and is never returned by the API.
+type WebhookSubscription struct {
+	Id         int       `json:"id" gorm:"primaryKey"`
+	Url        string    `json:"url"`
+	Secret     string    `json:"-"`
+	EventTypes []string  `json:"event_types" gorm:"serializer:json"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Subscribed reports whether the subscription is active and notified of events of the given type
+func (s WebhookSubscription) Subscribed(eventType string) bool {
+	if !s.Active {
+		return false
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvent is the body of a webhook delivery
+type WebhookEvent struct {
+	// Id identifies the event, the same event is never delivered twice to a subscription
+	Id         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// WebhookDelivery is the delivery of an event to a subscription, along with the outcome of its attempts
+type WebhookDelivery struct {
+	Id             int             `json:"id" gorm:"primaryKey"`
+	SubscriptionId int             `json:"subscription_id"`
+	EventId        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"last_error"`
+	ResponseStatus int             `json:"response_status"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
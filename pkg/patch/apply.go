@@ -0,0 +1,40 @@
+package patch
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Apply sets the fields of the model pointed to by dst, by JSON name, converting every value to the type of its
+// field. Unlike a type assertion it never panics: unknown fields and values of the wrong type are reported in a
+// *ValidationError and dst is left untouched.
+func Apply(dst any, fields map[string]any) error {
+	target := reflect.ValueOf(dst).Elem()
+	schema := NewSchema(target.Interface())
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs ValidationError
+	values := make(map[string]reflect.Value, len(fields))
+	for _, name := range names {
+		if err := schema.check(name, fields[name]); err != nil {
+			errs.add(Pointer(name), err.Error())
+			continue
+		}
+		field := schema.fields[name]
+		value, _ := convert(field.Type, fields[name])
+		values[name] = value
+	}
+	if err := errs.orNil(); err != nil {
+		return err
+	}
+
+	for name, value := range values {
+		target.FieldByIndex(schema.fields[name].index).Set(value)
+	}
+	return nil
+}
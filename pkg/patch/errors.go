@@ -0,0 +1,74 @@
+package patch
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedMediaType is returned when a patch is neither a JSON Merge Patch nor a JSON Patch
+	ErrUnsupportedMediaType = errors.New("unsupported patch format, the Content-Type must be application/merge-patch+json or application/json-patch+json")
+	// ErrMalformedPatch is returned when the body of a patch is not valid JSON of the expected shape
+	ErrMalformedPatch = errors.New("malformed patch, check the request body")
+	// ErrTestFailed is returned when a test operation of a JSON Patch does not match the current entity
+	ErrTestFailed = errors.New("patch test operation failed")
+)
+
+// syntaxError is returned when the body of a patch is not valid JSON, it keeps the message of the JSON decoder and
+// matches ErrMalformedPatch
+type syntaxError struct {
+	err error
+}
+
+func (e *syntaxError) Error() string {
+	return e.err.Error()
+}
+
+func (e *syntaxError) Is(target error) bool {
+	return target == ErrMalformedPatch
+}
+
+// FieldError describes why a single field of a patch was rejected
+type FieldError struct {
+	// Pointer is the JSON pointer of the rejected member of the request body
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+// ValidationError holds every field error of a rejected patch
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Pointer + ": " + fieldErr.Message
+	}
+	return "invalid patch: " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) add(pointer string, message string) {
+	e.Errors = append(e.Errors, FieldError{Pointer: pointer, Message: message})
+}
+
+// orNil returns the error when it holds at least one field error, and nil otherwise
+func (e *ValidationError) orNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Pointer returns the JSON pointer (RFC 6901) of a top level member
+func Pointer(name string) string {
+	return "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
+
+// unescapePointer returns the member referenced by a top level JSON pointer
+func unescapePointer(pointer string) (string, bool) {
+	if !strings.HasPrefix(pointer, "/") || strings.Count(pointer, "/") != 1 {
+		return "", false
+	}
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(pointer[1:]), true
+}
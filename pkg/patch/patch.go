@@ -0,0 +1,196 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+)
+
+const (
+	// MergePatchMediaType is the media type of JSON Merge Patch documents (RFC 7396)
+	MergePatchMediaType = "application/merge-patch+json"
+	// JSONPatchMediaType is the media type of JSON Patch documents (RFC 6902)
+	JSONPatchMediaType = "application/json-patch+json"
+)
+
+// Loader returns the current state of the entity being patched. It is only called for JSON Patch test, move and
+// copy operations, which read the entity.
+type Loader func() (any, error)
+
+// operation is a single operation of a JSON Patch
+type operation struct {
+	Op    string           `json:"op"`
+	Path  string           `json:"path"`
+	From  string           `json:"from"`
+	Value *json.RawMessage `json:"value"`
+}
+
+// Decode reads the patch in the body of r and returns the fields it changes, validated against the schema. Merge
+// patches are accepted as application/merge-patch+json and, for compatibility, application/json; JSON Patches as
+// application/json-patch+json.
+//
+// It returns ErrUnsupportedMediaType or ErrMalformedPatch when the body cannot be read, a *ValidationError listing
+// every rejected field, ErrTestFailed when a test operation does not hold, or the error of the loader.
+func Decode(r *http.Request, schema *Schema, load Loader) (map[string]any, error) {
+	mediaType := MergePatchMediaType
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		var err error
+		if mediaType, _, err = mime.ParseMediaType(contentType); err != nil {
+			return nil, ErrUnsupportedMediaType
+		}
+	}
+
+	switch mediaType {
+	case MergePatchMediaType, "application/json":
+		var fields map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+			return nil, &syntaxError{err: err}
+		}
+		if fields == nil {
+			return nil, ErrMalformedPatch
+		}
+		if err := schema.Validate(fields); err != nil {
+			return nil, err
+		}
+		return fields, nil
+	case JSONPatchMediaType:
+		var operations []operation
+		if err := json.NewDecoder(r.Body).Decode(&operations); err != nil {
+			return nil, &syntaxError{err: err}
+		}
+		return schema.apply(operations, load)
+	default:
+		return nil, ErrUnsupportedMediaType
+	}
+}
+
+// apply runs the operations of a JSON Patch over a flat document and returns the fields they change
+func (s *Schema) apply(operations []operation, load Loader) (map[string]any, error) {
+	fields := make(map[string]any)
+	var document map[string]any
+
+	// current returns the value of a member, as left by the operations applied so far
+	current := func(name string) (any, error) {
+		if value, ok := fields[name]; ok {
+			return value, nil
+		}
+		if document == nil {
+			entity, err := load()
+			if err != nil {
+				return nil, err
+			}
+			if document, err = toDocument(entity); err != nil {
+				return nil, err
+			}
+		}
+		return document[name], nil
+	}
+
+	var errs ValidationError
+	for i, op := range operations {
+		at := func(member string) string {
+			return fmt.Sprintf("/%d/%s", i, member)
+		}
+
+		name, ok := unescapePointer(op.Path)
+		if !ok {
+			errs.add(at("path"), "must point to a top level field")
+			continue
+		}
+		if _, ok := s.fields[name]; !ok || s.readOnly[name] {
+			errs.add(Pointer(name), s.check(name, nil).Error())
+			continue
+		}
+
+		var value any
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				errs.add(at("value"), "is required")
+				continue
+			}
+			if err := json.Unmarshal(*op.Value, &value); err != nil {
+				errs.add(at("value"), "must be valid JSON")
+				continue
+			}
+		case "remove":
+		case "move", "copy":
+			from, ok := unescapePointer(op.From)
+			if _, known := s.fields[from]; !ok || !known {
+				errs.add(at("from"), "must point to a patchable field")
+				continue
+			}
+			var err error
+			if value, err = current(from); err != nil {
+				return nil, err
+			}
+			if op.Op == "move" && from != name {
+				if err := s.check(from, nil); err != nil {
+					errs.add(Pointer(from), "cannot be removed, "+err.Error())
+					continue
+				}
+				fields[from] = nil
+			}
+		default:
+			errs.add(at("op"), "must be one of add, remove, replace, move, copy or test")
+			continue
+		}
+
+		if op.Op == "test" {
+			actual, err := current(name)
+			if err != nil {
+				return nil, err
+			}
+			if !equal(actual, value) {
+				return nil, fmt.Errorf("%w: %s", ErrTestFailed, Pointer(name))
+			}
+			continue
+		}
+
+		if err := s.check(name, value); err != nil {
+			if op.Op == "remove" {
+				errs.add(Pointer(name), "cannot be removed, "+err.Error())
+			} else {
+				errs.add(Pointer(name), err.Error())
+			}
+			continue
+		}
+		fields[name] = value
+	}
+
+	if err := errs.orNil(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// toDocument returns the JSON representation of an entity as a map of members
+func toDocument(entity any) (map[string]any, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var document map[string]any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, errors.New("the entity is not a JSON object")
+	}
+	return document, nil
+}
+
+// equal compares two JSON values once both are normalized to the types produced by encoding/json
+func equal(a any, b any) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func normalize(value any) any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized any
+	_ = json.Unmarshal(data, &normalized)
+	return normalized
+}
@@ -0,0 +1,225 @@
+package patch
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testModel struct {
+	Id        int            `json:"id" gorm:"primaryKey"`
+	Name      string         `json:"name"`
+	Quantity  int            `json:"quantity"`
+	Ratio     float64        `json:"ratio"`
+	Active    bool           `json:"active"`
+	DueDate   time.Time      `json:"due_date"`
+	OwnerId   *int           `json:"owner_id,omitempty"`
+	Internal  string         `json:"-"`
+	Details   *[]string      `json:"details"`
+	Histogram map[string]int `json:"histogram"`
+}
+
+func TestDecode(t *testing.T) {
+	schema := NewSchema(testModel{})
+	ownerId := 4
+	current := testModel{Id: 1, Name: "north", Quantity: 10, OwnerId: &ownerId}
+
+	tests := []struct {
+		title          string
+		contentType    string
+		body           string
+		expectedFields map[string]any
+		expectedErrors []FieldError
+		expectedErr    error
+	}{
+		{
+			title:          "merge patch",
+			contentType:    MergePatchMediaType,
+			body:           `{"name": "south", "quantity": 3, "ratio": 0.5, "active": true, "due_date": "2025-12-31", "owner_id": null}`,
+			expectedFields: map[string]any{"name": "south", "quantity": float64(3), "ratio": 0.5, "active": true, "due_date": "2025-12-31", "owner_id": nil},
+		},
+		{
+			title:          "plain json is a merge patch",
+			contentType:    "application/json; charset=utf-8",
+			body:           `{"quantity": 3}`,
+			expectedFields: map[string]any{"quantity": float64(3)},
+		},
+		{
+			title:       "merge patch with invalid fields",
+			contentType: MergePatchMediaType,
+			body:        `{"id": 2, "nme": "south", "quantity": 1.5, "ratio": "high", "active": 1, "due_date": "tomorrow", "name": null, "details": []}`,
+			expectedErrors: []FieldError{
+				{Pointer: "/active", Message: "must be a boolean"},
+				{Pointer: "/details", Message: "field is read-only"},
+				{Pointer: "/due_date", Message: "must be a date in the RFC 3339 or YYYY-MM-DD format"},
+				{Pointer: "/id", Message: "field is read-only"},
+				{Pointer: "/name", Message: "must not be null"},
+				{Pointer: "/nme", Message: "unknown field"},
+				{Pointer: "/quantity", Message: "must be an integer"},
+				{Pointer: "/ratio", Message: "must be a number"},
+			},
+		},
+		{
+			title:       "merge patch that is not an object",
+			contentType: MergePatchMediaType,
+			body:        `[{"name": "south"}]`,
+			expectedErr: ErrMalformedPatch,
+		},
+		{
+			title:       "merge patch that is null",
+			contentType: MergePatchMediaType,
+			body:        `null`,
+			expectedErr: ErrMalformedPatch,
+		},
+		{
+			title:       "json patch",
+			contentType: JSONPatchMediaType,
+			body: `[
+				{"op": "test", "path": "/name", "value": "north"},
+				{"op": "replace", "path": "/name", "value": "south"},
+				{"op": "add", "path": "/quantity", "value": 12},
+				{"op": "remove", "path": "/owner_id"},
+				{"op": "copy", "from": "/name", "path": "/~0name"}
+			]`,
+			expectedErrors: []FieldError{
+				{Pointer: "/~0name", Message: "unknown field"},
+			},
+		},
+		{
+			title:       "json patch reading patched values",
+			contentType: JSONPatchMediaType,
+			body: `[
+				{"op": "replace", "path": "/quantity", "value": 12},
+				{"op": "test", "path": "/quantity", "value": 12.0},
+				{"op": "test", "path": "/owner_id", "value": 4},
+				{"op": "move", "from": "/owner_id", "path": "/quantity"}
+			]`,
+			expectedFields: map[string]any{"quantity": float64(4), "owner_id": nil},
+		},
+		{
+			title:       "json patch with invalid operations",
+			contentType: JSONPatchMediaType,
+			body: `[
+				{"op": "increment", "path": "/quantity", "value": 1},
+				{"op": "replace", "path": "/quantity"},
+				{"op": "remove", "path": "/name"},
+				{"op": "replace", "path": "/owner/id", "value": 1},
+				{"op": "move", "from": "/name", "path": "/ratio"}
+			]`,
+			expectedErrors: []FieldError{
+				{Pointer: "/0/op", Message: "must be one of add, remove, replace, move, copy or test"},
+				{Pointer: "/1/value", Message: "is required"},
+				{Pointer: "/name", Message: "cannot be removed, must not be null"},
+				{Pointer: "/3/path", Message: "must point to a top level field"},
+				{Pointer: "/name", Message: "cannot be removed, must not be null"},
+			},
+		},
+		{
+			title:       "json patch failing test",
+			contentType: JSONPatchMediaType,
+			body:        `[{"op": "test", "path": "/name", "value": "south"}, {"op": "replace", "path": "/name", "value": "east"}]`,
+			expectedErr: ErrTestFailed,
+		},
+		{
+			title:       "json patch that is not an array",
+			contentType: JSONPatchMediaType,
+			body:        `{"op": "replace", "path": "/name", "value": "east"}`,
+			expectedErr: ErrMalformedPatch,
+		},
+		{
+			title:       "unsupported media type",
+			contentType: "text/plain",
+			body:        `name=south`,
+			expectedErr: ErrUnsupportedMediaType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			request := httptest.NewRequest("PATCH", "/", strings.NewReader(test.body))
+			request.Header.Set("Content-Type", test.contentType)
+			load := func() (any, error) {
+				return current, nil
+			}
+
+			fields, err := Decode(request, schema, load)
+
+			switch {
+			case test.expectedErr != nil:
+				require.ErrorIs(t, err, test.expectedErr)
+				require.Nil(t, fields)
+			case test.expectedErrors != nil:
+				var validationErr *ValidationError
+				require.True(t, errors.As(err, &validationErr))
+				require.Equal(t, test.expectedErrors, validationErr.Errors)
+				require.Nil(t, fields)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, test.expectedFields, fields)
+			}
+		})
+	}
+}
+
+func TestDecode_LoaderIsOnlyCalledWhenNeeded(t *testing.T) {
+	request := httptest.NewRequest("PATCH", "/", strings.NewReader(`[{"op": "replace", "path": "/name", "value": "south"}]`))
+	request.Header.Set("Content-Type", JSONPatchMediaType)
+	load := func() (any, error) {
+		return nil, errors.New("entity not found")
+	}
+
+	fields, err := Decode(request, NewSchema(testModel{}), load)
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "south"}, fields)
+}
+
+func TestApply(t *testing.T) {
+	ownerId := 4
+	tests := []struct {
+		title       string
+		fields      map[string]any
+		expected    testModel
+		expectedErr string
+	}{
+		{
+			title: "values decoded from JSON",
+			fields: map[string]any{"name": "south", "quantity": float64(3), "ratio": 0.5, "active": true,
+				"due_date": "2025-12-31T10:00:00Z", "owner_id": float64(9)},
+			expected: func() testModel {
+				ownerId := 9
+				return testModel{Id: 1, Name: "south", Quantity: 3, Ratio: 0.5, Active: true,
+					DueDate: time.Date(2025, 12, 31, 10, 0, 0, 0, time.UTC), OwnerId: &ownerId}
+			}(),
+		},
+		{
+			title:    "values of the field type",
+			fields:   map[string]any{"quantity": 7, "owner_id": nil},
+			expected: testModel{Id: 1, Name: "north", Quantity: 7},
+		},
+		{
+			title:       "wrong types leave the model untouched",
+			fields:      map[string]any{"name": "south", "quantity": "seven", "warehouses_id": float64(1)},
+			expected:    testModel{Id: 1, Name: "north", Quantity: 10, OwnerId: &ownerId},
+			expectedErr: "invalid patch: /quantity: must be an integer; /warehouses_id: unknown field",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			model := testModel{Id: 1, Name: "north", Quantity: 10, OwnerId: &ownerId}
+
+			err := Apply(&model, test.fields)
+
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.expected, model)
+		})
+	}
+}
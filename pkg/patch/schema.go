@@ -0,0 +1,247 @@
+// Package patch validates partial updates against the shape of a model before they reach a repository.
+//
+// A Schema is built from the json tags of a model struct. Request bodies in the JSON Merge Patch (RFC 7396) or JSON
+// Patch (RFC 6902) formats are converted into the map of fields consumed by the PartialModify services, keyed by
+// JSON field name and holding the values encoding/json produces (float64 numbers, strings, booleans and nil), once
+// every field name and type has been checked.
+package patch
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// timeLayouts are the formats accepted for date fields
+var timeLayouts = []string{time.RFC3339, time.DateTime, time.DateOnly}
+
+// Field describes a field of the model that can be patched
+type Field struct {
+	// Name is the JSON name of the field
+	Name string
+	// Type is the Go type of the field, pointer types are nullable
+	Type reflect.Type
+	// index is the position of the field in the model struct
+	index []int
+}
+
+// Nullable reports whether the field accepts null, which merge patches and remove operations use to clear it
+func (f Field) Nullable() bool {
+	return f.Type.Kind() == reflect.Pointer
+}
+
+// Schema holds the fields of a model that can be patched
+type Schema struct {
+	fields   map[string]Field
+	readOnly map[string]bool
+}
+
+// NewSchema builds the schema of a model struct. Its primary key, the id field, and every field that is not a
+// scalar, such as nested collections, are read-only.
+func NewSchema(model any) *Schema {
+	s := &Schema{fields: make(map[string]Field), readOnly: make(map[string]bool)}
+	s.addFields(reflect.TypeOf(model), nil)
+	return s
+}
+
+func (s *Schema) addFields(t reflect.Type, index []int) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			s.addFields(field.Type, fieldIndex)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		primaryKey := name == "id" || strings.Contains(field.Tag.Get("gorm"), "primaryKey")
+		if primaryKey || !scalar(field.Type) {
+			s.readOnly[name] = true
+			continue
+		}
+		s.fields[name] = Field{Name: name, Type: field.Type, index: fieldIndex}
+	}
+}
+
+// Field returns the patchable field with the given JSON name
+func (s *Schema) Field(name string) (Field, bool) {
+	field, ok := s.fields[name]
+	return field, ok
+}
+
+// Fields returns the patchable fields sorted by name
+func (s *Schema) Fields() []Field {
+	fields := make([]Field, 0, len(s.fields))
+	for _, field := range s.fields {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return fields
+}
+
+// Validate checks that every entry of fields names a patchable field and holds a value of its type
+func (s *Schema) Validate(fields map[string]any) error {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs ValidationError
+	for _, name := range names {
+		if err := s.check(name, fields[name]); err != nil {
+			errs.add(Pointer(name), err.Error())
+		}
+	}
+	return errs.orNil()
+}
+
+// check validates the value of a single field, a nil value clears the field
+func (s *Schema) check(name string, value any) error {
+	field, ok := s.fields[name]
+	switch {
+	case s.readOnly[name]:
+		return fmt.Errorf("field is read-only")
+	case !ok:
+		return fmt.Errorf("unknown field")
+	case value == nil:
+		if !field.Nullable() {
+			return fmt.Errorf("must not be null")
+		}
+		return nil
+	}
+	_, err := convert(field.Type, value)
+	return err
+}
+
+// scalar reports whether values of type t can be patched as a whole
+func scalar(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// convert returns value as a value of type t. It accepts the values produced by encoding/json as well as values of
+// the target type itself, and fails instead of panicking when they do not match.
+func convert(t reflect.Type, value any) (reflect.Value, error) {
+	if t.Kind() == reflect.Pointer {
+		if value == nil {
+			return reflect.Zero(t), nil
+		}
+		elem, err := convert(t.Elem(), value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(elem)
+		return ptr, nil
+	}
+	if value == nil {
+		return reflect.Value{}, fmt.Errorf("must not be null")
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Type() == t {
+		return v, nil
+	}
+
+	if t == timeType {
+		text, ok := value.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("must be a date string")
+		}
+		for _, layout := range timeLayouts {
+			if parsed, err := time.Parse(layout, text); err == nil {
+				return reflect.ValueOf(parsed), nil
+			}
+		}
+		return reflect.Value{}, fmt.Errorf("must be a date in the RFC 3339 or YYYY-MM-DD format")
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if v.Kind() == reflect.String {
+			return v.Convert(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("must be a string")
+	case reflect.Bool:
+		if v.Kind() == reflect.Bool {
+			return v.Convert(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("must be a boolean")
+	case reflect.Float32, reflect.Float64:
+		number, ok := toFloat(v)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("must be a number")
+		}
+		return reflect.ValueOf(number).Convert(t), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := toFloat(v)
+		if !ok || number != math.Trunc(number) {
+			return reflect.Value{}, fmt.Errorf("must be an integer")
+		}
+		result := reflect.New(t).Elem()
+		if isUnsigned(t) {
+			if number < 0 || result.OverflowUint(uint64(number)) {
+				return reflect.Value{}, fmt.Errorf("is out of range")
+			}
+			result.SetUint(uint64(number))
+		} else {
+			if number < math.MinInt64 || number >= math.MaxInt64 || result.OverflowInt(int64(number)) {
+				return reflect.Value{}, fmt.Errorf("is out of range")
+			}
+			result.SetInt(int64(number))
+		}
+		return result, nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot be patched")
+}
+
+// toFloat returns the numeric value of v, if it is a number
+func toFloat(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		number := v.Float()
+		return number, !math.IsNaN(number) && !math.IsInf(number, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	}
+	return 0, false
+}
+
+func isUnsigned(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: fulfillment/v1/inbound_order.proto
+
+package fulfillmentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InboundOrder is the reception of a product batch in a warehouse.
+type InboundOrder struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderNumber    string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	OrderDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=order_date,json=orderDate,proto3" json:"order_date,omitempty"`
+	EmployeeId     int64                  `protobuf:"varint,4,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	ProductBatchId int64                  `protobuf:"varint,5,opt,name=product_batch_id,json=productBatchId,proto3" json:"product_batch_id,omitempty"`
+	WarehouseId    int64                  `protobuf:"varint,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InboundOrder) Reset() {
+	*x = InboundOrder{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboundOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboundOrder) ProtoMessage() {}
+
+func (x *InboundOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboundOrder.ProtoReflect.Descriptor instead.
+func (*InboundOrder) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InboundOrder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboundOrder) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *InboundOrder) GetOrderDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrderDate
+	}
+	return nil
+}
+
+func (x *InboundOrder) GetEmployeeId() int64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *InboundOrder) GetProductBatchId() int64 {
+	if x != nil {
+		return x.ProductBatchId
+	}
+	return 0
+}
+
+func (x *InboundOrder) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type ListInboundOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboundOrdersRequest) Reset() {
+	*x = ListInboundOrdersRequest{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboundOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboundOrdersRequest) ProtoMessage() {}
+
+func (x *ListInboundOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboundOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListInboundOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{1}
+}
+
+type ListInboundOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InboundOrders []*InboundOrder        `protobuf:"bytes,1,rep,name=inbound_orders,json=inboundOrders,proto3" json:"inbound_orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboundOrdersResponse) Reset() {
+	*x = ListInboundOrdersResponse{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboundOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboundOrdersResponse) ProtoMessage() {}
+
+func (x *ListInboundOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboundOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListInboundOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInboundOrdersResponse) GetInboundOrders() []*InboundOrder {
+	if x != nil {
+		return x.InboundOrders
+	}
+	return nil
+}
+
+type GetInboundOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInboundOrderRequest) Reset() {
+	*x = GetInboundOrderRequest{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInboundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboundOrderRequest) ProtoMessage() {}
+
+func (x *GetInboundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboundOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetInboundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetInboundOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateInboundOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id is assigned by the server.
+	InboundOrder  *InboundOrder `protobuf:"bytes,1,opt,name=inbound_order,json=inboundOrder,proto3" json:"inbound_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInboundOrderRequest) Reset() {
+	*x = CreateInboundOrderRequest{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInboundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInboundOrderRequest) ProtoMessage() {}
+
+func (x *CreateInboundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInboundOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateInboundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateInboundOrderRequest) GetInboundOrder() *InboundOrder {
+	if x != nil {
+		return x.InboundOrder
+	}
+	return nil
+}
+
+type UpdateInboundOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InboundOrder  *InboundOrder          `protobuf:"bytes,1,opt,name=inbound_order,json=inboundOrder,proto3" json:"inbound_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInboundOrderRequest) Reset() {
+	*x = UpdateInboundOrderRequest{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInboundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInboundOrderRequest) ProtoMessage() {}
+
+func (x *UpdateInboundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInboundOrderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInboundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateInboundOrderRequest) GetInboundOrder() *InboundOrder {
+	if x != nil {
+		return x.InboundOrder
+	}
+	return nil
+}
+
+type PatchInboundOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The fields to update, named as in the JSON representation of the REST API, such as "warehouse_id".
+	Fields        *structpb.Struct `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchInboundOrderRequest) Reset() {
+	*x = PatchInboundOrderRequest{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchInboundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchInboundOrderRequest) ProtoMessage() {}
+
+func (x *PatchInboundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchInboundOrderRequest.ProtoReflect.Descriptor instead.
+func (*PatchInboundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PatchInboundOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchInboundOrderRequest) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DeleteInboundOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInboundOrderRequest) Reset() {
+	*x = DeleteInboundOrderRequest{}
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInboundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInboundOrderRequest) ProtoMessage() {}
+
+func (x *DeleteInboundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inbound_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInboundOrderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInboundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inbound_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteInboundOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_fulfillment_v1_inbound_order_proto protoreflect.FileDescriptor
+
+const file_fulfillment_v1_inbound_order_proto_rawDesc = "" +
+	"\n" +
+	"\"fulfillment/v1/inbound_order.proto\x12\x0efulfillment.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xea\x01\n" +
+	"\fInboundOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\forder_number\x18\x02 \x01(\tR\vorderNumber\x129\n" +
+	"\n" +
+	"order_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\torderDate\x12\x1f\n" +
+	"\vemployee_id\x18\x04 \x01(\x03R\n" +
+	"employeeId\x12(\n" +
+	"\x10product_batch_id\x18\x05 \x01(\x03R\x0eproductBatchId\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\x03R\vwarehouseId\"\x1a\n" +
+	"\x18ListInboundOrdersRequest\"`\n" +
+	"\x19ListInboundOrdersResponse\x12C\n" +
+	"\x0einbound_orders\x18\x01 \x03(\v2\x1c.fulfillment.v1.InboundOrderR\rinboundOrders\"(\n" +
+	"\x16GetInboundOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"^\n" +
+	"\x19CreateInboundOrderRequest\x12A\n" +
+	"\rinbound_order\x18\x01 \x01(\v2\x1c.fulfillment.v1.InboundOrderR\finboundOrder\"^\n" +
+	"\x19UpdateInboundOrderRequest\x12A\n" +
+	"\rinbound_order\x18\x01 \x01(\v2\x1c.fulfillment.v1.InboundOrderR\finboundOrder\"[\n" +
+	"\x18PatchInboundOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12/\n" +
+	"\x06fields\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06fields\"+\n" +
+	"\x19DeleteInboundOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\xcc\x04\n" +
+	"\x13InboundOrderService\x12h\n" +
+	"\x11ListInboundOrders\x12(.fulfillment.v1.ListInboundOrdersRequest\x1a).fulfillment.v1.ListInboundOrdersResponse\x12W\n" +
+	"\x0fGetInboundOrder\x12&.fulfillment.v1.GetInboundOrderRequest\x1a\x1c.fulfillment.v1.InboundOrder\x12]\n" +
+	"\x12CreateInboundOrder\x12).fulfillment.v1.CreateInboundOrderRequest\x1a\x1c.fulfillment.v1.InboundOrder\x12]\n" +
+	"\x12UpdateInboundOrder\x12).fulfillment.v1.UpdateInboundOrderRequest\x1a\x1c.fulfillment.v1.InboundOrder\x12[\n" +
+	"\x11PatchInboundOrder\x12(.fulfillment.v1.PatchInboundOrderRequest\x1a\x1c.fulfillment.v1.InboundOrder\x12W\n" +
+	"\x12DeleteInboundOrder\x12).fulfillment.v1.DeleteInboundOrderRequest\x1a\x16.google.protobuf.EmptyB\xcd\x01\n" +
+	"\x12com.fulfillment.v1B\x11InboundOrderProtoP\x01ZKgithub.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1;fulfillmentv1\xa2\x02\x03FXX\xaa\x02\x0eFulfillment.V1\xca\x02\x0eFulfillment\\V1\xe2\x02\x1aFulfillment\\V1\\GPBMetadata\xea\x02\x0fFulfillment::V1b\x06proto3"
+
+var (
+	file_fulfillment_v1_inbound_order_proto_rawDescOnce sync.Once
+	file_fulfillment_v1_inbound_order_proto_rawDescData []byte
+)
+
+func file_fulfillment_v1_inbound_order_proto_rawDescGZIP() []byte {
+	file_fulfillment_v1_inbound_order_proto_rawDescOnce.Do(func() {
+		file_fulfillment_v1_inbound_order_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fulfillment_v1_inbound_order_proto_rawDesc), len(file_fulfillment_v1_inbound_order_proto_rawDesc)))
+	})
+	return file_fulfillment_v1_inbound_order_proto_rawDescData
+}
+
+var file_fulfillment_v1_inbound_order_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_fulfillment_v1_inbound_order_proto_goTypes = []any{
+	(*InboundOrder)(nil),              // 0: fulfillment.v1.InboundOrder
+	(*ListInboundOrdersRequest)(nil),  // 1: fulfillment.v1.ListInboundOrdersRequest
+	(*ListInboundOrdersResponse)(nil), // 2: fulfillment.v1.ListInboundOrdersResponse
+	(*GetInboundOrderRequest)(nil),    // 3: fulfillment.v1.GetInboundOrderRequest
+	(*CreateInboundOrderRequest)(nil), // 4: fulfillment.v1.CreateInboundOrderRequest
+	(*UpdateInboundOrderRequest)(nil), // 5: fulfillment.v1.UpdateInboundOrderRequest
+	(*PatchInboundOrderRequest)(nil),  // 6: fulfillment.v1.PatchInboundOrderRequest
+	(*DeleteInboundOrderRequest)(nil), // 7: fulfillment.v1.DeleteInboundOrderRequest
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),           // 9: google.protobuf.Struct
+	(*emptypb.Empty)(nil),             // 10: google.protobuf.Empty
+}
+var file_fulfillment_v1_inbound_order_proto_depIdxs = []int32{
+	8,  // 0: fulfillment.v1.InboundOrder.order_date:type_name -> google.protobuf.Timestamp
+	0,  // 1: fulfillment.v1.ListInboundOrdersResponse.inbound_orders:type_name -> fulfillment.v1.InboundOrder
+	0,  // 2: fulfillment.v1.CreateInboundOrderRequest.inbound_order:type_name -> fulfillment.v1.InboundOrder
+	0,  // 3: fulfillment.v1.UpdateInboundOrderRequest.inbound_order:type_name -> fulfillment.v1.InboundOrder
+	9,  // 4: fulfillment.v1.PatchInboundOrderRequest.fields:type_name -> google.protobuf.Struct
+	1,  // 5: fulfillment.v1.InboundOrderService.ListInboundOrders:input_type -> fulfillment.v1.ListInboundOrdersRequest
+	3,  // 6: fulfillment.v1.InboundOrderService.GetInboundOrder:input_type -> fulfillment.v1.GetInboundOrderRequest
+	4,  // 7: fulfillment.v1.InboundOrderService.CreateInboundOrder:input_type -> fulfillment.v1.CreateInboundOrderRequest
+	5,  // 8: fulfillment.v1.InboundOrderService.UpdateInboundOrder:input_type -> fulfillment.v1.UpdateInboundOrderRequest
+	6,  // 9: fulfillment.v1.InboundOrderService.PatchInboundOrder:input_type -> fulfillment.v1.PatchInboundOrderRequest
+	7,  // 10: fulfillment.v1.InboundOrderService.DeleteInboundOrder:input_type -> fulfillment.v1.DeleteInboundOrderRequest
+	2,  // 11: fulfillment.v1.InboundOrderService.ListInboundOrders:output_type -> fulfillment.v1.ListInboundOrdersResponse
+	0,  // 12: fulfillment.v1.InboundOrderService.GetInboundOrder:output_type -> fulfillment.v1.InboundOrder
+	0,  // 13: fulfillment.v1.InboundOrderService.CreateInboundOrder:output_type -> fulfillment.v1.InboundOrder
+	0,  // 14: fulfillment.v1.InboundOrderService.UpdateInboundOrder:output_type -> fulfillment.v1.InboundOrder
+	0,  // 15: fulfillment.v1.InboundOrderService.PatchInboundOrder:output_type -> fulfillment.v1.InboundOrder
+	10, // 16: fulfillment.v1.InboundOrderService.DeleteInboundOrder:output_type -> google.protobuf.Empty
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_fulfillment_v1_inbound_order_proto_init() }
+func file_fulfillment_v1_inbound_order_proto_init() {
+	if File_fulfillment_v1_inbound_order_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fulfillment_v1_inbound_order_proto_rawDesc), len(file_fulfillment_v1_inbound_order_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fulfillment_v1_inbound_order_proto_goTypes,
+		DependencyIndexes: file_fulfillment_v1_inbound_order_proto_depIdxs,
+		MessageInfos:      file_fulfillment_v1_inbound_order_proto_msgTypes,
+	}.Build()
+	File_fulfillment_v1_inbound_order_proto = out.File
+	file_fulfillment_v1_inbound_order_proto_goTypes = nil
+	file_fulfillment_v1_inbound_order_proto_depIdxs = nil
+}
@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: fulfillment/v1/inbound_order.proto
+
+package fulfillmentv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InboundOrderService_ListInboundOrders_FullMethodName  = "/fulfillment.v1.InboundOrderService/ListInboundOrders"
+	InboundOrderService_GetInboundOrder_FullMethodName    = "/fulfillment.v1.InboundOrderService/GetInboundOrder"
+	InboundOrderService_CreateInboundOrder_FullMethodName = "/fulfillment.v1.InboundOrderService/CreateInboundOrder"
+	InboundOrderService_UpdateInboundOrder_FullMethodName = "/fulfillment.v1.InboundOrderService/UpdateInboundOrder"
+	InboundOrderService_PatchInboundOrder_FullMethodName  = "/fulfillment.v1.InboundOrderService/PatchInboundOrder"
+	InboundOrderService_DeleteInboundOrder_FullMethodName = "/fulfillment.v1.InboundOrderService/DeleteInboundOrder"
+)
+
+// InboundOrderServiceClient is the client API for InboundOrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// InboundOrderService mirrors the inbound order service of the REST API.
+type InboundOrderServiceClient interface {
+	// ListInboundOrders returns every inbound order.
+	ListInboundOrders(ctx context.Context, in *ListInboundOrdersRequest, opts ...grpc.CallOption) (*ListInboundOrdersResponse, error)
+	// GetInboundOrder returns an inbound order.
+	GetInboundOrder(ctx context.Context, in *GetInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error)
+	// CreateInboundOrder creates an inbound order, dated now unless an order date is given.
+	CreateInboundOrder(ctx context.Context, in *CreateInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error)
+	// UpdateInboundOrder replaces an inbound order.
+	UpdateInboundOrder(ctx context.Context, in *UpdateInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error)
+	// PatchInboundOrder updates the given fields of an inbound order.
+	PatchInboundOrder(ctx context.Context, in *PatchInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error)
+	// DeleteInboundOrder deletes an inbound order.
+	DeleteInboundOrder(ctx context.Context, in *DeleteInboundOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type inboundOrderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInboundOrderServiceClient(cc grpc.ClientConnInterface) InboundOrderServiceClient {
+	return &inboundOrderServiceClient{cc}
+}
+
+func (c *inboundOrderServiceClient) ListInboundOrders(ctx context.Context, in *ListInboundOrdersRequest, opts ...grpc.CallOption) (*ListInboundOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInboundOrdersResponse)
+	err := c.cc.Invoke(ctx, InboundOrderService_ListInboundOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboundOrderServiceClient) GetInboundOrder(ctx context.Context, in *GetInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboundOrder)
+	err := c.cc.Invoke(ctx, InboundOrderService_GetInboundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboundOrderServiceClient) CreateInboundOrder(ctx context.Context, in *CreateInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboundOrder)
+	err := c.cc.Invoke(ctx, InboundOrderService_CreateInboundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboundOrderServiceClient) UpdateInboundOrder(ctx context.Context, in *UpdateInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboundOrder)
+	err := c.cc.Invoke(ctx, InboundOrderService_UpdateInboundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboundOrderServiceClient) PatchInboundOrder(ctx context.Context, in *PatchInboundOrderRequest, opts ...grpc.CallOption) (*InboundOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboundOrder)
+	err := c.cc.Invoke(ctx, InboundOrderService_PatchInboundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboundOrderServiceClient) DeleteInboundOrder(ctx context.Context, in *DeleteInboundOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InboundOrderService_DeleteInboundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InboundOrderServiceServer is the server API for InboundOrderService service.
+// All implementations must embed UnimplementedInboundOrderServiceServer
+// for forward compatibility.
+//
+// InboundOrderService mirrors the inbound order service of the REST API.
+type InboundOrderServiceServer interface {
+	// ListInboundOrders returns every inbound order.
+	ListInboundOrders(context.Context, *ListInboundOrdersRequest) (*ListInboundOrdersResponse, error)
+	// GetInboundOrder returns an inbound order.
+	GetInboundOrder(context.Context, *GetInboundOrderRequest) (*InboundOrder, error)
+	// CreateInboundOrder creates an inbound order, dated now unless an order date is given.
+	CreateInboundOrder(context.Context, *CreateInboundOrderRequest) (*InboundOrder, error)
+	// UpdateInboundOrder replaces an inbound order.
+	UpdateInboundOrder(context.Context, *UpdateInboundOrderRequest) (*InboundOrder, error)
+	// PatchInboundOrder updates the given fields of an inbound order.
+	PatchInboundOrder(context.Context, *PatchInboundOrderRequest) (*InboundOrder, error)
+	// DeleteInboundOrder deletes an inbound order.
+	DeleteInboundOrder(context.Context, *DeleteInboundOrderRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedInboundOrderServiceServer()
+}
+
+// UnimplementedInboundOrderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInboundOrderServiceServer struct{}
+
+func (UnimplementedInboundOrderServiceServer) ListInboundOrders(context.Context, *ListInboundOrdersRequest) (*ListInboundOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInboundOrders not implemented")
+}
+func (UnimplementedInboundOrderServiceServer) GetInboundOrder(context.Context, *GetInboundOrderRequest) (*InboundOrder, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInboundOrder not implemented")
+}
+func (UnimplementedInboundOrderServiceServer) CreateInboundOrder(context.Context, *CreateInboundOrderRequest) (*InboundOrder, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInboundOrder not implemented")
+}
+func (UnimplementedInboundOrderServiceServer) UpdateInboundOrder(context.Context, *UpdateInboundOrderRequest) (*InboundOrder, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInboundOrder not implemented")
+}
+func (UnimplementedInboundOrderServiceServer) PatchInboundOrder(context.Context, *PatchInboundOrderRequest) (*InboundOrder, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchInboundOrder not implemented")
+}
+func (UnimplementedInboundOrderServiceServer) DeleteInboundOrder(context.Context, *DeleteInboundOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteInboundOrder not implemented")
+}
+func (UnimplementedInboundOrderServiceServer) mustEmbedUnimplementedInboundOrderServiceServer() {}
+func (UnimplementedInboundOrderServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeInboundOrderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InboundOrderServiceServer will
+// result in compilation errors.
+type UnsafeInboundOrderServiceServer interface {
+	mustEmbedUnimplementedInboundOrderServiceServer()
+}
+
+func RegisterInboundOrderServiceServer(s grpc.ServiceRegistrar, srv InboundOrderServiceServer) {
+	// If the following call panics, it indicates UnimplementedInboundOrderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InboundOrderService_ServiceDesc, srv)
+}
+
+func _InboundOrderService_ListInboundOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInboundOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboundOrderServiceServer).ListInboundOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboundOrderService_ListInboundOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboundOrderServiceServer).ListInboundOrders(ctx, req.(*ListInboundOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboundOrderService_GetInboundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInboundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboundOrderServiceServer).GetInboundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboundOrderService_GetInboundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboundOrderServiceServer).GetInboundOrder(ctx, req.(*GetInboundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboundOrderService_CreateInboundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInboundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboundOrderServiceServer).CreateInboundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboundOrderService_CreateInboundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboundOrderServiceServer).CreateInboundOrder(ctx, req.(*CreateInboundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboundOrderService_UpdateInboundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInboundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboundOrderServiceServer).UpdateInboundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboundOrderService_UpdateInboundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboundOrderServiceServer).UpdateInboundOrder(ctx, req.(*UpdateInboundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboundOrderService_PatchInboundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchInboundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboundOrderServiceServer).PatchInboundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboundOrderService_PatchInboundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboundOrderServiceServer).PatchInboundOrder(ctx, req.(*PatchInboundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboundOrderService_DeleteInboundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInboundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboundOrderServiceServer).DeleteInboundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboundOrderService_DeleteInboundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboundOrderServiceServer).DeleteInboundOrder(ctx, req.(*DeleteInboundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InboundOrderService_ServiceDesc is the grpc.ServiceDesc for InboundOrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InboundOrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fulfillment.v1.InboundOrderService",
+	HandlerType: (*InboundOrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInboundOrders",
+			Handler:    _InboundOrderService_ListInboundOrders_Handler,
+		},
+		{
+			MethodName: "GetInboundOrder",
+			Handler:    _InboundOrderService_GetInboundOrder_Handler,
+		},
+		{
+			MethodName: "CreateInboundOrder",
+			Handler:    _InboundOrderService_CreateInboundOrder_Handler,
+		},
+		{
+			MethodName: "UpdateInboundOrder",
+			Handler:    _InboundOrderService_UpdateInboundOrder_Handler,
+		},
+		{
+			MethodName: "PatchInboundOrder",
+			Handler:    _InboundOrderService_PatchInboundOrder_Handler,
+		},
+		{
+			MethodName: "DeleteInboundOrder",
+			Handler:    _InboundOrderService_DeleteInboundOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fulfillment/v1/inbound_order.proto",
+}
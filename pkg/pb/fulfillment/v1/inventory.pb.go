@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: fulfillment/v1/inventory.proto
+
+package fulfillmentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Resource is a kind of entity whose changes are streamed.
+type Resource int32
+
+const (
+	Resource_RESOURCE_UNSPECIFIED   Resource = 0
+	Resource_RESOURCE_PRODUCT_BATCH Resource = 1
+	Resource_RESOURCE_SECTION       Resource = 2
+)
+
+// Enum value maps for Resource.
+var (
+	Resource_name = map[int32]string{
+		0: "RESOURCE_UNSPECIFIED",
+		1: "RESOURCE_PRODUCT_BATCH",
+		2: "RESOURCE_SECTION",
+	}
+	Resource_value = map[string]int32{
+		"RESOURCE_UNSPECIFIED":   0,
+		"RESOURCE_PRODUCT_BATCH": 1,
+		"RESOURCE_SECTION":       2,
+	}
+)
+
+func (x Resource) Enum() *Resource {
+	p := new(Resource)
+	*p = x
+	return p
+}
+
+func (x Resource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Resource) Descriptor() protoreflect.EnumDescriptor {
+	return file_fulfillment_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (Resource) Type() protoreflect.EnumType {
+	return &file_fulfillment_v1_inventory_proto_enumTypes[0]
+}
+
+func (x Resource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Resource.Descriptor instead.
+func (Resource) EnumDescriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// ChangeKind is what happened to an entity.
+type ChangeKind int32
+
+const (
+	ChangeKind_CHANGE_KIND_UNSPECIFIED ChangeKind = 0
+	ChangeKind_CHANGE_KIND_CREATED     ChangeKind = 1
+	ChangeKind_CHANGE_KIND_UPDATED     ChangeKind = 2
+	ChangeKind_CHANGE_KIND_DELETED     ChangeKind = 3
+)
+
+// Enum value maps for ChangeKind.
+var (
+	ChangeKind_name = map[int32]string{
+		0: "CHANGE_KIND_UNSPECIFIED",
+		1: "CHANGE_KIND_CREATED",
+		2: "CHANGE_KIND_UPDATED",
+		3: "CHANGE_KIND_DELETED",
+	}
+	ChangeKind_value = map[string]int32{
+		"CHANGE_KIND_UNSPECIFIED": 0,
+		"CHANGE_KIND_CREATED":     1,
+		"CHANGE_KIND_UPDATED":     2,
+		"CHANGE_KIND_DELETED":     3,
+	}
+)
+
+func (x ChangeKind) Enum() *ChangeKind {
+	p := new(ChangeKind)
+	*p = x
+	return p
+}
+
+func (x ChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_fulfillment_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (ChangeKind) Type() protoreflect.EnumType {
+	return &file_fulfillment_v1_inventory_proto_enumTypes[1]
+}
+
+func (x ChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeKind.Descriptor instead.
+func (ChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// Section is a section of a warehouse.
+type Section struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SectionNumber      string                 `protobuf:"bytes,2,opt,name=section_number,json=sectionNumber,proto3" json:"section_number,omitempty"`
+	CurrentTemperature float64                `protobuf:"fixed64,3,opt,name=current_temperature,json=currentTemperature,proto3" json:"current_temperature,omitempty"`
+	MinimumTemperature float64                `protobuf:"fixed64,4,opt,name=minimum_temperature,json=minimumTemperature,proto3" json:"minimum_temperature,omitempty"`
+	CurrentCapacity    int64                  `protobuf:"varint,5,opt,name=current_capacity,json=currentCapacity,proto3" json:"current_capacity,omitempty"`
+	MinimumCapacity    int64                  `protobuf:"varint,6,opt,name=minimum_capacity,json=minimumCapacity,proto3" json:"minimum_capacity,omitempty"`
+	MaximumCapacity    int64                  `protobuf:"varint,7,opt,name=maximum_capacity,json=maximumCapacity,proto3" json:"maximum_capacity,omitempty"`
+	WarehouseId        int64                  `protobuf:"varint,8,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductTypeId      int64                  `protobuf:"varint,9,opt,name=product_type_id,json=productTypeId,proto3" json:"product_type_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_fulfillment_v1_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Section) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Section) GetSectionNumber() string {
+	if x != nil {
+		return x.SectionNumber
+	}
+	return ""
+}
+
+func (x *Section) GetCurrentTemperature() float64 {
+	if x != nil {
+		return x.CurrentTemperature
+	}
+	return 0
+}
+
+func (x *Section) GetMinimumTemperature() float64 {
+	if x != nil {
+		return x.MinimumTemperature
+	}
+	return 0
+}
+
+func (x *Section) GetCurrentCapacity() int64 {
+	if x != nil {
+		return x.CurrentCapacity
+	}
+	return 0
+}
+
+func (x *Section) GetMinimumCapacity() int64 {
+	if x != nil {
+		return x.MinimumCapacity
+	}
+	return 0
+}
+
+func (x *Section) GetMaximumCapacity() int64 {
+	if x != nil {
+		return x.MaximumCapacity
+	}
+	return 0
+}
+
+func (x *Section) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *Section) GetProductTypeId() int64 {
+	if x != nil {
+		return x.ProductTypeId
+	}
+	return 0
+}
+
+type WatchChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resources to watch, every resource when empty.
+	Resources []Resource `protobuf:"varint,1,rep,packed,name=resources,proto3,enum=fulfillment.v1.Resource" json:"resources,omitempty"`
+	// Restricts the stream to a section and its product batches when set.
+	SectionId     int64 `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChangesRequest) Reset() {
+	*x = WatchChangesRequest{}
+	mi := &file_fulfillment_v1_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChangesRequest) ProtoMessage() {}
+
+func (x *WatchChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchChangesRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchChangesRequest) GetResources() []Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *WatchChangesRequest) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+type WatchChangesResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Resource   Resource               `protobuf:"varint,1,opt,name=resource,proto3,enum=fulfillment.v1.Resource" json:"resource,omitempty"`
+	Kind       ChangeKind             `protobuf:"varint,2,opt,name=kind,proto3,enum=fulfillment.v1.ChangeKind" json:"kind,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// The entity as it is after the change, or as it was before its deletion.
+	//
+	// Types that are valid to be assigned to Entity:
+	//
+	//	*WatchChangesResponse_ProductBatch
+	//	*WatchChangesResponse_Section
+	Entity        isWatchChangesResponse_Entity `protobuf_oneof:"entity"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChangesResponse) Reset() {
+	*x = WatchChangesResponse{}
+	mi := &file_fulfillment_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChangesResponse) ProtoMessage() {}
+
+func (x *WatchChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChangesResponse.ProtoReflect.Descriptor instead.
+func (*WatchChangesResponse) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchChangesResponse) GetResource() Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return Resource_RESOURCE_UNSPECIFIED
+}
+
+func (x *WatchChangesResponse) GetKind() ChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ChangeKind_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *WatchChangesResponse) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *WatchChangesResponse) GetEntity() isWatchChangesResponse_Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *WatchChangesResponse) GetProductBatch() *ProductBatch {
+	if x != nil {
+		if x, ok := x.Entity.(*WatchChangesResponse_ProductBatch); ok {
+			return x.ProductBatch
+		}
+	}
+	return nil
+}
+
+func (x *WatchChangesResponse) GetSection() *Section {
+	if x != nil {
+		if x, ok := x.Entity.(*WatchChangesResponse_Section); ok {
+			return x.Section
+		}
+	}
+	return nil
+}
+
+type isWatchChangesResponse_Entity interface {
+	isWatchChangesResponse_Entity()
+}
+
+type WatchChangesResponse_ProductBatch struct {
+	ProductBatch *ProductBatch `protobuf:"bytes,4,opt,name=product_batch,json=productBatch,proto3,oneof"`
+}
+
+type WatchChangesResponse_Section struct {
+	Section *Section `protobuf:"bytes,5,opt,name=section,proto3,oneof"`
+}
+
+func (*WatchChangesResponse_ProductBatch) isWatchChangesResponse_Entity() {}
+
+func (*WatchChangesResponse_Section) isWatchChangesResponse_Entity() {}
+
+var File_fulfillment_v1_inventory_proto protoreflect.FileDescriptor
+
+const file_fulfillment_v1_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x1efulfillment/v1/inventory.proto\x12\x0efulfillment.v1\x1a\"fulfillment/v1/product_batch.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\x02\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0esection_number\x18\x02 \x01(\tR\rsectionNumber\x12/\n" +
+	"\x13current_temperature\x18\x03 \x01(\x01R\x12currentTemperature\x12/\n" +
+	"\x13minimum_temperature\x18\x04 \x01(\x01R\x12minimumTemperature\x12)\n" +
+	"\x10current_capacity\x18\x05 \x01(\x03R\x0fcurrentCapacity\x12)\n" +
+	"\x10minimum_capacity\x18\x06 \x01(\x03R\x0fminimumCapacity\x12)\n" +
+	"\x10maximum_capacity\x18\a \x01(\x03R\x0fmaximumCapacity\x12!\n" +
+	"\fwarehouse_id\x18\b \x01(\x03R\vwarehouseId\x12&\n" +
+	"\x0fproduct_type_id\x18\t \x01(\x03R\rproductTypeId\"l\n" +
+	"\x13WatchChangesRequest\x126\n" +
+	"\tresources\x18\x01 \x03(\x0e2\x18.fulfillment.v1.ResourceR\tresources\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\x03R\tsectionId\"\xbd\x02\n" +
+	"\x14WatchChangesResponse\x124\n" +
+	"\bresource\x18\x01 \x01(\x0e2\x18.fulfillment.v1.ResourceR\bresource\x12.\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1a.fulfillment.v1.ChangeKindR\x04kind\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12C\n" +
+	"\rproduct_batch\x18\x04 \x01(\v2\x1c.fulfillment.v1.ProductBatchH\x00R\fproductBatch\x123\n" +
+	"\asection\x18\x05 \x01(\v2\x17.fulfillment.v1.SectionH\x00R\asectionB\b\n" +
+	"\x06entity*V\n" +
+	"\bResource\x12\x18\n" +
+	"\x14RESOURCE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RESOURCE_PRODUCT_BATCH\x10\x01\x12\x14\n" +
+	"\x10RESOURCE_SECTION\x10\x02*t\n" +
+	"\n" +
+	"ChangeKind\x12\x1b\n" +
+	"\x17CHANGE_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CHANGE_KIND_CREATED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_KIND_UPDATED\x10\x02\x12\x17\n" +
+	"\x13CHANGE_KIND_DELETED\x10\x032o\n" +
+	"\x10InventoryService\x12[\n" +
+	"\fWatchChanges\x12#.fulfillment.v1.WatchChangesRequest\x1a$.fulfillment.v1.WatchChangesResponse0\x01B\xca\x01\n" +
+	"\x12com.fulfillment.v1B\x0eInventoryProtoP\x01ZKgithub.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1;fulfillmentv1\xa2\x02\x03FXX\xaa\x02\x0eFulfillment.V1\xca\x02\x0eFulfillment\\V1\xe2\x02\x1aFulfillment\\V1\\GPBMetadata\xea\x02\x0fFulfillment::V1b\x06proto3"
+
+var (
+	file_fulfillment_v1_inventory_proto_rawDescOnce sync.Once
+	file_fulfillment_v1_inventory_proto_rawDescData []byte
+)
+
+func file_fulfillment_v1_inventory_proto_rawDescGZIP() []byte {
+	file_fulfillment_v1_inventory_proto_rawDescOnce.Do(func() {
+		file_fulfillment_v1_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fulfillment_v1_inventory_proto_rawDesc), len(file_fulfillment_v1_inventory_proto_rawDesc)))
+	})
+	return file_fulfillment_v1_inventory_proto_rawDescData
+}
+
+var file_fulfillment_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_fulfillment_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_fulfillment_v1_inventory_proto_goTypes = []any{
+	(Resource)(0),                 // 0: fulfillment.v1.Resource
+	(ChangeKind)(0),               // 1: fulfillment.v1.ChangeKind
+	(*Section)(nil),               // 2: fulfillment.v1.Section
+	(*WatchChangesRequest)(nil),   // 3: fulfillment.v1.WatchChangesRequest
+	(*WatchChangesResponse)(nil),  // 4: fulfillment.v1.WatchChangesResponse
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*ProductBatch)(nil),          // 6: fulfillment.v1.ProductBatch
+}
+var file_fulfillment_v1_inventory_proto_depIdxs = []int32{
+	0, // 0: fulfillment.v1.WatchChangesRequest.resources:type_name -> fulfillment.v1.Resource
+	0, // 1: fulfillment.v1.WatchChangesResponse.resource:type_name -> fulfillment.v1.Resource
+	1, // 2: fulfillment.v1.WatchChangesResponse.kind:type_name -> fulfillment.v1.ChangeKind
+	5, // 3: fulfillment.v1.WatchChangesResponse.occurred_at:type_name -> google.protobuf.Timestamp
+	6, // 4: fulfillment.v1.WatchChangesResponse.product_batch:type_name -> fulfillment.v1.ProductBatch
+	2, // 5: fulfillment.v1.WatchChangesResponse.section:type_name -> fulfillment.v1.Section
+	3, // 6: fulfillment.v1.InventoryService.WatchChanges:input_type -> fulfillment.v1.WatchChangesRequest
+	4, // 7: fulfillment.v1.InventoryService.WatchChanges:output_type -> fulfillment.v1.WatchChangesResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_fulfillment_v1_inventory_proto_init() }
+func file_fulfillment_v1_inventory_proto_init() {
+	if File_fulfillment_v1_inventory_proto != nil {
+		return
+	}
+	file_fulfillment_v1_product_batch_proto_init()
+	file_fulfillment_v1_inventory_proto_msgTypes[2].OneofWrappers = []any{
+		(*WatchChangesResponse_ProductBatch)(nil),
+		(*WatchChangesResponse_Section)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fulfillment_v1_inventory_proto_rawDesc), len(file_fulfillment_v1_inventory_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fulfillment_v1_inventory_proto_goTypes,
+		DependencyIndexes: file_fulfillment_v1_inventory_proto_depIdxs,
+		EnumInfos:         file_fulfillment_v1_inventory_proto_enumTypes,
+		MessageInfos:      file_fulfillment_v1_inventory_proto_msgTypes,
+	}.Build()
+	File_fulfillment_v1_inventory_proto = out.File
+	file_fulfillment_v1_inventory_proto_goTypes = nil
+	file_fulfillment_v1_inventory_proto_depIdxs = nil
+}
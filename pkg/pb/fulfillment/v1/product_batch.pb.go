@@ -0,0 +1,587 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: fulfillment/v1/product_batch.proto
+
+package fulfillmentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProductBatch is a batch of a product stored in a section.
+type ProductBatch struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BatchNumber        int64                  `protobuf:"varint,2,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	CurrentQuantity    int64                  `protobuf:"varint,3,opt,name=current_quantity,json=currentQuantity,proto3" json:"current_quantity,omitempty"`
+	CurrentTemperature float64                `protobuf:"fixed64,4,opt,name=current_temperature,json=currentTemperature,proto3" json:"current_temperature,omitempty"`
+	// The due date, in the YYYY-MM-DD format.
+	DueDate         string `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	InitialQuantity int64  `protobuf:"varint,6,opt,name=initial_quantity,json=initialQuantity,proto3" json:"initial_quantity,omitempty"`
+	// The manufacturing date, in the YYYY-MM-DD format.
+	ManufacturingDate  string  `protobuf:"bytes,7,opt,name=manufacturing_date,json=manufacturingDate,proto3" json:"manufacturing_date,omitempty"`
+	ManufacturingHour  int64   `protobuf:"varint,8,opt,name=manufacturing_hour,json=manufacturingHour,proto3" json:"manufacturing_hour,omitempty"`
+	MinimumTemperature float64 `protobuf:"fixed64,9,opt,name=minimum_temperature,json=minimumTemperature,proto3" json:"minimum_temperature,omitempty"`
+	SectionId          int64   `protobuf:"varint,10,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	ProductId          int64   `protobuf:"varint,11,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// The status of the batch, a new batch is always received, it is changed through the REST API.
+	Status        string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductBatch) Reset() {
+	*x = ProductBatch{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductBatch) ProtoMessage() {}
+
+func (x *ProductBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductBatch.ProtoReflect.Descriptor instead.
+func (*ProductBatch) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProductBatch) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetBatchNumber() int64 {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetCurrentQuantity() int64 {
+	if x != nil {
+		return x.CurrentQuantity
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetCurrentTemperature() float64 {
+	if x != nil {
+		return x.CurrentTemperature
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *ProductBatch) GetInitialQuantity() int64 {
+	if x != nil {
+		return x.InitialQuantity
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetManufacturingDate() string {
+	if x != nil {
+		return x.ManufacturingDate
+	}
+	return ""
+}
+
+func (x *ProductBatch) GetManufacturingHour() int64 {
+	if x != nil {
+		return x.ManufacturingHour
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetMinimumTemperature() float64 {
+	if x != nil {
+		return x.MinimumTemperature
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductBatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListProductBatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductBatchesRequest) Reset() {
+	*x = ListProductBatchesRequest{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductBatchesRequest) ProtoMessage() {}
+
+func (x *ListProductBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{1}
+}
+
+type ListProductBatchesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductBatches []*ProductBatch        `protobuf:"bytes,1,rep,name=product_batches,json=productBatches,proto3" json:"product_batches,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListProductBatchesResponse) Reset() {
+	*x = ListProductBatchesResponse{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductBatchesResponse) ProtoMessage() {}
+
+func (x *ListProductBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductBatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListProductBatchesResponse) GetProductBatches() []*ProductBatch {
+	if x != nil {
+		return x.ProductBatches
+	}
+	return nil
+}
+
+type GetProductBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBatchRequest) Reset() {
+	*x = GetProductBatchRequest{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBatchRequest) ProtoMessage() {}
+
+func (x *GetProductBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetProductBatchRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProductBatchRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateProductBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id is assigned by the server.
+	ProductBatch  *ProductBatch `protobuf:"bytes,1,opt,name=product_batch,json=productBatch,proto3" json:"product_batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductBatchRequest) Reset() {
+	*x = CreateProductBatchRequest{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductBatchRequest) ProtoMessage() {}
+
+func (x *CreateProductBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductBatchRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateProductBatchRequest) GetProductBatch() *ProductBatch {
+	if x != nil {
+		return x.ProductBatch
+	}
+	return nil
+}
+
+type UpdateProductBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductBatch  *ProductBatch          `protobuf:"bytes,1,opt,name=product_batch,json=productBatch,proto3" json:"product_batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductBatchRequest) Reset() {
+	*x = UpdateProductBatchRequest{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductBatchRequest) ProtoMessage() {}
+
+func (x *UpdateProductBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductBatchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductBatchRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateProductBatchRequest) GetProductBatch() *ProductBatch {
+	if x != nil {
+		return x.ProductBatch
+	}
+	return nil
+}
+
+type PatchProductBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The fields to update, named as in the JSON representation of the REST API, such as "current_quantity".
+	Fields        *structpb.Struct `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchProductBatchRequest) Reset() {
+	*x = PatchProductBatchRequest{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchProductBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchProductBatchRequest) ProtoMessage() {}
+
+func (x *PatchProductBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchProductBatchRequest.ProtoReflect.Descriptor instead.
+func (*PatchProductBatchRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PatchProductBatchRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchProductBatchRequest) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DeleteProductBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductBatchRequest) Reset() {
+	*x = DeleteProductBatchRequest{}
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductBatchRequest) ProtoMessage() {}
+
+func (x *DeleteProductBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_product_batch_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductBatchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductBatchRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_product_batch_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteProductBatchRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_fulfillment_v1_product_batch_proto protoreflect.FileDescriptor
+
+const file_fulfillment_v1_product_batch_proto_rawDesc = "" +
+	"\n" +
+	"\"fulfillment/v1/product_batch.proto\x12\x0efulfillment.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xc8\x03\n" +
+	"\fProductBatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fbatch_number\x18\x02 \x01(\x03R\vbatchNumber\x12)\n" +
+	"\x10current_quantity\x18\x03 \x01(\x03R\x0fcurrentQuantity\x12/\n" +
+	"\x13current_temperature\x18\x04 \x01(\x01R\x12currentTemperature\x12\x19\n" +
+	"\bdue_date\x18\x05 \x01(\tR\adueDate\x12)\n" +
+	"\x10initial_quantity\x18\x06 \x01(\x03R\x0finitialQuantity\x12-\n" +
+	"\x12manufacturing_date\x18\a \x01(\tR\x11manufacturingDate\x12-\n" +
+	"\x12manufacturing_hour\x18\b \x01(\x03R\x11manufacturingHour\x12/\n" +
+	"\x13minimum_temperature\x18\t \x01(\x01R\x12minimumTemperature\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\n" +
+	" \x01(\x03R\tsectionId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\v \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\"\x1b\n" +
+	"\x19ListProductBatchesRequest\"c\n" +
+	"\x1aListProductBatchesResponse\x12E\n" +
+	"\x0fproduct_batches\x18\x01 \x03(\v2\x1c.fulfillment.v1.ProductBatchR\x0eproductBatches\"(\n" +
+	"\x16GetProductBatchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"^\n" +
+	"\x19CreateProductBatchRequest\x12A\n" +
+	"\rproduct_batch\x18\x01 \x01(\v2\x1c.fulfillment.v1.ProductBatchR\fproductBatch\"^\n" +
+	"\x19UpdateProductBatchRequest\x12A\n" +
+	"\rproduct_batch\x18\x01 \x01(\v2\x1c.fulfillment.v1.ProductBatchR\fproductBatch\"[\n" +
+	"\x18PatchProductBatchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12/\n" +
+	"\x06fields\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06fields\"+\n" +
+	"\x19DeleteProductBatchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\xcf\x04\n" +
+	"\x13ProductBatchService\x12k\n" +
+	"\x12ListProductBatches\x12).fulfillment.v1.ListProductBatchesRequest\x1a*.fulfillment.v1.ListProductBatchesResponse\x12W\n" +
+	"\x0fGetProductBatch\x12&.fulfillment.v1.GetProductBatchRequest\x1a\x1c.fulfillment.v1.ProductBatch\x12]\n" +
+	"\x12CreateProductBatch\x12).fulfillment.v1.CreateProductBatchRequest\x1a\x1c.fulfillment.v1.ProductBatch\x12]\n" +
+	"\x12UpdateProductBatch\x12).fulfillment.v1.UpdateProductBatchRequest\x1a\x1c.fulfillment.v1.ProductBatch\x12[\n" +
+	"\x11PatchProductBatch\x12(.fulfillment.v1.PatchProductBatchRequest\x1a\x1c.fulfillment.v1.ProductBatch\x12W\n" +
+	"\x12DeleteProductBatch\x12).fulfillment.v1.DeleteProductBatchRequest\x1a\x16.google.protobuf.EmptyB\xcd\x01\n" +
+	"\x12com.fulfillment.v1B\x11ProductBatchProtoP\x01ZKgithub.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1;fulfillmentv1\xa2\x02\x03FXX\xaa\x02\x0eFulfillment.V1\xca\x02\x0eFulfillment\\V1\xe2\x02\x1aFulfillment\\V1\\GPBMetadata\xea\x02\x0fFulfillment::V1b\x06proto3"
+
+var (
+	file_fulfillment_v1_product_batch_proto_rawDescOnce sync.Once
+	file_fulfillment_v1_product_batch_proto_rawDescData []byte
+)
+
+func file_fulfillment_v1_product_batch_proto_rawDescGZIP() []byte {
+	file_fulfillment_v1_product_batch_proto_rawDescOnce.Do(func() {
+		file_fulfillment_v1_product_batch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fulfillment_v1_product_batch_proto_rawDesc), len(file_fulfillment_v1_product_batch_proto_rawDesc)))
+	})
+	return file_fulfillment_v1_product_batch_proto_rawDescData
+}
+
+var file_fulfillment_v1_product_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_fulfillment_v1_product_batch_proto_goTypes = []any{
+	(*ProductBatch)(nil),               // 0: fulfillment.v1.ProductBatch
+	(*ListProductBatchesRequest)(nil),  // 1: fulfillment.v1.ListProductBatchesRequest
+	(*ListProductBatchesResponse)(nil), // 2: fulfillment.v1.ListProductBatchesResponse
+	(*GetProductBatchRequest)(nil),     // 3: fulfillment.v1.GetProductBatchRequest
+	(*CreateProductBatchRequest)(nil),  // 4: fulfillment.v1.CreateProductBatchRequest
+	(*UpdateProductBatchRequest)(nil),  // 5: fulfillment.v1.UpdateProductBatchRequest
+	(*PatchProductBatchRequest)(nil),   // 6: fulfillment.v1.PatchProductBatchRequest
+	(*DeleteProductBatchRequest)(nil),  // 7: fulfillment.v1.DeleteProductBatchRequest
+	(*structpb.Struct)(nil),            // 8: google.protobuf.Struct
+	(*emptypb.Empty)(nil),              // 9: google.protobuf.Empty
+}
+var file_fulfillment_v1_product_batch_proto_depIdxs = []int32{
+	0,  // 0: fulfillment.v1.ListProductBatchesResponse.product_batches:type_name -> fulfillment.v1.ProductBatch
+	0,  // 1: fulfillment.v1.CreateProductBatchRequest.product_batch:type_name -> fulfillment.v1.ProductBatch
+	0,  // 2: fulfillment.v1.UpdateProductBatchRequest.product_batch:type_name -> fulfillment.v1.ProductBatch
+	8,  // 3: fulfillment.v1.PatchProductBatchRequest.fields:type_name -> google.protobuf.Struct
+	1,  // 4: fulfillment.v1.ProductBatchService.ListProductBatches:input_type -> fulfillment.v1.ListProductBatchesRequest
+	3,  // 5: fulfillment.v1.ProductBatchService.GetProductBatch:input_type -> fulfillment.v1.GetProductBatchRequest
+	4,  // 6: fulfillment.v1.ProductBatchService.CreateProductBatch:input_type -> fulfillment.v1.CreateProductBatchRequest
+	5,  // 7: fulfillment.v1.ProductBatchService.UpdateProductBatch:input_type -> fulfillment.v1.UpdateProductBatchRequest
+	6,  // 8: fulfillment.v1.ProductBatchService.PatchProductBatch:input_type -> fulfillment.v1.PatchProductBatchRequest
+	7,  // 9: fulfillment.v1.ProductBatchService.DeleteProductBatch:input_type -> fulfillment.v1.DeleteProductBatchRequest
+	2,  // 10: fulfillment.v1.ProductBatchService.ListProductBatches:output_type -> fulfillment.v1.ListProductBatchesResponse
+	0,  // 11: fulfillment.v1.ProductBatchService.GetProductBatch:output_type -> fulfillment.v1.ProductBatch
+	0,  // 12: fulfillment.v1.ProductBatchService.CreateProductBatch:output_type -> fulfillment.v1.ProductBatch
+	0,  // 13: fulfillment.v1.ProductBatchService.UpdateProductBatch:output_type -> fulfillment.v1.ProductBatch
+	0,  // 14: fulfillment.v1.ProductBatchService.PatchProductBatch:output_type -> fulfillment.v1.ProductBatch
+	9,  // 15: fulfillment.v1.ProductBatchService.DeleteProductBatch:output_type -> google.protobuf.Empty
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_fulfillment_v1_product_batch_proto_init() }
+func file_fulfillment_v1_product_batch_proto_init() {
+	if File_fulfillment_v1_product_batch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fulfillment_v1_product_batch_proto_rawDesc), len(file_fulfillment_v1_product_batch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fulfillment_v1_product_batch_proto_goTypes,
+		DependencyIndexes: file_fulfillment_v1_product_batch_proto_depIdxs,
+		MessageInfos:      file_fulfillment_v1_product_batch_proto_msgTypes,
+	}.Build()
+	File_fulfillment_v1_product_batch_proto = out.File
+	file_fulfillment_v1_product_batch_proto_goTypes = nil
+	file_fulfillment_v1_product_batch_proto_depIdxs = nil
+}
@@ -0,0 +1,332 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: fulfillment/v1/product_batch.proto
+
+package fulfillmentv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProductBatchService_ListProductBatches_FullMethodName = "/fulfillment.v1.ProductBatchService/ListProductBatches"
+	ProductBatchService_GetProductBatch_FullMethodName    = "/fulfillment.v1.ProductBatchService/GetProductBatch"
+	ProductBatchService_CreateProductBatch_FullMethodName = "/fulfillment.v1.ProductBatchService/CreateProductBatch"
+	ProductBatchService_UpdateProductBatch_FullMethodName = "/fulfillment.v1.ProductBatchService/UpdateProductBatch"
+	ProductBatchService_PatchProductBatch_FullMethodName  = "/fulfillment.v1.ProductBatchService/PatchProductBatch"
+	ProductBatchService_DeleteProductBatch_FullMethodName = "/fulfillment.v1.ProductBatchService/DeleteProductBatch"
+)
+
+// ProductBatchServiceClient is the client API for ProductBatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProductBatchService mirrors the product batch service of the REST API.
+type ProductBatchServiceClient interface {
+	// ListProductBatches returns every product batch.
+	ListProductBatches(ctx context.Context, in *ListProductBatchesRequest, opts ...grpc.CallOption) (*ListProductBatchesResponse, error)
+	// GetProductBatch returns a product batch.
+	GetProductBatch(ctx context.Context, in *GetProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error)
+	// CreateProductBatch creates a product batch.
+	CreateProductBatch(ctx context.Context, in *CreateProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error)
+	// UpdateProductBatch is not supported and fails with UNIMPLEMENTED, the stock of a batch changes through the stock
+	// ledger.
+	UpdateProductBatch(ctx context.Context, in *UpdateProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error)
+	// PatchProductBatch is not supported and fails with UNIMPLEMENTED, the stock of a batch changes through the stock
+	// ledger.
+	PatchProductBatch(ctx context.Context, in *PatchProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error)
+	// DeleteProductBatch is not supported and fails with UNIMPLEMENTED, a batch is disposed of instead.
+	DeleteProductBatch(ctx context.Context, in *DeleteProductBatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type productBatchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductBatchServiceClient(cc grpc.ClientConnInterface) ProductBatchServiceClient {
+	return &productBatchServiceClient{cc}
+}
+
+func (c *productBatchServiceClient) ListProductBatches(ctx context.Context, in *ListProductBatchesRequest, opts ...grpc.CallOption) (*ListProductBatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductBatchesResponse)
+	err := c.cc.Invoke(ctx, ProductBatchService_ListProductBatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productBatchServiceClient) GetProductBatch(ctx context.Context, in *GetProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductBatch)
+	err := c.cc.Invoke(ctx, ProductBatchService_GetProductBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productBatchServiceClient) CreateProductBatch(ctx context.Context, in *CreateProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductBatch)
+	err := c.cc.Invoke(ctx, ProductBatchService_CreateProductBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productBatchServiceClient) UpdateProductBatch(ctx context.Context, in *UpdateProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductBatch)
+	err := c.cc.Invoke(ctx, ProductBatchService_UpdateProductBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productBatchServiceClient) PatchProductBatch(ctx context.Context, in *PatchProductBatchRequest, opts ...grpc.CallOption) (*ProductBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductBatch)
+	err := c.cc.Invoke(ctx, ProductBatchService_PatchProductBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productBatchServiceClient) DeleteProductBatch(ctx context.Context, in *DeleteProductBatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProductBatchService_DeleteProductBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductBatchServiceServer is the server API for ProductBatchService service.
+// All implementations must embed UnimplementedProductBatchServiceServer
+// for forward compatibility.
+//
+// ProductBatchService mirrors the product batch service of the REST API.
+type ProductBatchServiceServer interface {
+	// ListProductBatches returns every product batch.
+	ListProductBatches(context.Context, *ListProductBatchesRequest) (*ListProductBatchesResponse, error)
+	// GetProductBatch returns a product batch.
+	GetProductBatch(context.Context, *GetProductBatchRequest) (*ProductBatch, error)
+	// CreateProductBatch creates a product batch.
+	CreateProductBatch(context.Context, *CreateProductBatchRequest) (*ProductBatch, error)
+	// UpdateProductBatch is not supported and fails with UNIMPLEMENTED, the stock of a batch changes through the stock
+	// ledger.
+	UpdateProductBatch(context.Context, *UpdateProductBatchRequest) (*ProductBatch, error)
+	// PatchProductBatch is not supported and fails with UNIMPLEMENTED, the stock of a batch changes through the stock
+	// ledger.
+	PatchProductBatch(context.Context, *PatchProductBatchRequest) (*ProductBatch, error)
+	// DeleteProductBatch is not supported and fails with UNIMPLEMENTED, a batch is disposed of instead.
+	DeleteProductBatch(context.Context, *DeleteProductBatchRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedProductBatchServiceServer()
+}
+
+// UnimplementedProductBatchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductBatchServiceServer struct{}
+
+func (UnimplementedProductBatchServiceServer) ListProductBatches(context.Context, *ListProductBatchesRequest) (*ListProductBatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductBatches not implemented")
+}
+func (UnimplementedProductBatchServiceServer) GetProductBatch(context.Context, *GetProductBatchRequest) (*ProductBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductBatch not implemented")
+}
+func (UnimplementedProductBatchServiceServer) CreateProductBatch(context.Context, *CreateProductBatchRequest) (*ProductBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProductBatch not implemented")
+}
+func (UnimplementedProductBatchServiceServer) UpdateProductBatch(context.Context, *UpdateProductBatchRequest) (*ProductBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProductBatch not implemented")
+}
+func (UnimplementedProductBatchServiceServer) PatchProductBatch(context.Context, *PatchProductBatchRequest) (*ProductBatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchProductBatch not implemented")
+}
+func (UnimplementedProductBatchServiceServer) DeleteProductBatch(context.Context, *DeleteProductBatchRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProductBatch not implemented")
+}
+func (UnimplementedProductBatchServiceServer) mustEmbedUnimplementedProductBatchServiceServer() {}
+func (UnimplementedProductBatchServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeProductBatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductBatchServiceServer will
+// result in compilation errors.
+type UnsafeProductBatchServiceServer interface {
+	mustEmbedUnimplementedProductBatchServiceServer()
+}
+
+func RegisterProductBatchServiceServer(s grpc.ServiceRegistrar, srv ProductBatchServiceServer) {
+	// If the following call panics, it indicates UnimplementedProductBatchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductBatchService_ServiceDesc, srv)
+}
+
+func _ProductBatchService_ListProductBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductBatchServiceServer).ListProductBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductBatchService_ListProductBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductBatchServiceServer).ListProductBatches(ctx, req.(*ListProductBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductBatchService_GetProductBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductBatchServiceServer).GetProductBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductBatchService_GetProductBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductBatchServiceServer).GetProductBatch(ctx, req.(*GetProductBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductBatchService_CreateProductBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductBatchServiceServer).CreateProductBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductBatchService_CreateProductBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductBatchServiceServer).CreateProductBatch(ctx, req.(*CreateProductBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductBatchService_UpdateProductBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductBatchServiceServer).UpdateProductBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductBatchService_UpdateProductBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductBatchServiceServer).UpdateProductBatch(ctx, req.(*UpdateProductBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductBatchService_PatchProductBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchProductBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductBatchServiceServer).PatchProductBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductBatchService_PatchProductBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductBatchServiceServer).PatchProductBatch(ctx, req.(*PatchProductBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductBatchService_DeleteProductBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductBatchServiceServer).DeleteProductBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductBatchService_DeleteProductBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductBatchServiceServer).DeleteProductBatch(ctx, req.(*DeleteProductBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductBatchService_ServiceDesc is the grpc.ServiceDesc for ProductBatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductBatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fulfillment.v1.ProductBatchService",
+	HandlerType: (*ProductBatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProductBatches",
+			Handler:    _ProductBatchService_ListProductBatches_Handler,
+		},
+		{
+			MethodName: "GetProductBatch",
+			Handler:    _ProductBatchService_GetProductBatch_Handler,
+		},
+		{
+			MethodName: "CreateProductBatch",
+			Handler:    _ProductBatchService_CreateProductBatch_Handler,
+		},
+		{
+			MethodName: "UpdateProductBatch",
+			Handler:    _ProductBatchService_UpdateProductBatch_Handler,
+		},
+		{
+			MethodName: "PatchProductBatch",
+			Handler:    _ProductBatchService_PatchProductBatch_Handler,
+		},
+		{
+			MethodName: "DeleteProductBatch",
+			Handler:    _ProductBatchService_DeleteProductBatch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fulfillment/v1/product_batch.proto",
+}
@@ -0,0 +1,751 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: fulfillment/v1/purchase_order.proto
+
+package fulfillmentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PurchaseOrder is an order placed by a buyer.
+type PurchaseOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderNumber   string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	OrderDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=order_date,json=orderDate,proto3" json:"order_date,omitempty"`
+	TracingCode   string                 `protobuf:"bytes,4,opt,name=tracing_code,json=tracingCode,proto3" json:"tracing_code,omitempty"`
+	BuyerId       int64                  `protobuf:"varint,5,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	CarrierId     int64                  `protobuf:"varint,7,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	OrderStatusId int64                  `protobuf:"varint,8,opt,name=order_status_id,json=orderStatusId,proto3" json:"order_status_id,omitempty"`
+	OrderDetails  []*OrderDetail         `protobuf:"bytes,9,rep,name=order_details,json=orderDetails,proto3" json:"order_details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrder) Reset() {
+	*x = PurchaseOrder{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrder) ProtoMessage() {}
+
+func (x *PurchaseOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrder.ProtoReflect.Descriptor instead.
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PurchaseOrder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetOrderDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrderDate
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetTracingCode() string {
+	if x != nil {
+		return x.TracingCode
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetBuyerId() int64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetCarrierId() int64 {
+	if x != nil {
+		return x.CarrierId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetOrderStatusId() int64 {
+	if x != nil {
+		return x.OrderStatusId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetOrderDetails() []*OrderDetail {
+	if x != nil {
+		return x.OrderDetails
+	}
+	return nil
+}
+
+// OrderDetail is a line of a purchase order.
+type OrderDetail struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity         int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CleanLinesStatus string                 `protobuf:"bytes,3,opt,name=clean_lines_status,json=cleanLinesStatus,proto3" json:"clean_lines_status,omitempty"`
+	Temperature      float64                `protobuf:"fixed64,4,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	ProductRecordId  int64                  `protobuf:"varint,5,opt,name=product_record_id,json=productRecordId,proto3" json:"product_record_id,omitempty"`
+	PurchaseOrderId  int64                  `protobuf:"varint,6,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrderDetail) Reset() {
+	*x = OrderDetail{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderDetail) ProtoMessage() {}
+
+func (x *OrderDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderDetail.ProtoReflect.Descriptor instead.
+func (*OrderDetail) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderDetail) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderDetail) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderDetail) GetCleanLinesStatus() string {
+	if x != nil {
+		return x.CleanLinesStatus
+	}
+	return ""
+}
+
+func (x *OrderDetail) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *OrderDetail) GetProductRecordId() int64 {
+	if x != nil {
+		return x.ProductRecordId
+	}
+	return 0
+}
+
+func (x *OrderDetail) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+type ListPurchaseOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersRequest) Reset() {
+	*x = ListPurchaseOrdersRequest{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersRequest) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{2}
+}
+
+type ListPurchaseOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrders []*PurchaseOrder       `protobuf:"bytes,1,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersResponse) Reset() {
+	*x = ListPurchaseOrdersResponse{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersResponse) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPurchaseOrdersResponse) GetPurchaseOrders() []*PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+type ListPurchaseOrdersByBuyerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuyerId       int64                  `protobuf:"varint,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersByBuyerRequest) Reset() {
+	*x = ListPurchaseOrdersByBuyerRequest{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersByBuyerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersByBuyerRequest) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersByBuyerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersByBuyerRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersByBuyerRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPurchaseOrdersByBuyerRequest) GetBuyerId() int64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+type ListPurchaseOrdersByBuyerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrders []*PurchaseOrder       `protobuf:"bytes,1,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersByBuyerResponse) Reset() {
+	*x = ListPurchaseOrdersByBuyerResponse{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersByBuyerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersByBuyerResponse) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersByBuyerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersByBuyerResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersByBuyerResponse) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPurchaseOrdersByBuyerResponse) GetPurchaseOrders() []*PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+type GetPurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseOrderRequest) Reset() {
+	*x = GetPurchaseOrderRequest{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseOrderRequest) ProtoMessage() {}
+
+func (x *GetPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPurchaseOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreatePurchaseOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id is assigned by the server.
+	PurchaseOrder *PurchaseOrder `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderRequest) Reset() {
+	*x = CreatePurchaseOrderRequest{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *CreatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePurchaseOrderRequest) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+type UpdatePurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePurchaseOrderRequest) Reset() {
+	*x = UpdatePurchaseOrderRequest{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *UpdatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePurchaseOrderRequest) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+type PatchPurchaseOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The fields to update, named as in the JSON representation of the REST API, such as "tracing_code".
+	Fields        *structpb.Struct `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchPurchaseOrderRequest) Reset() {
+	*x = PatchPurchaseOrderRequest{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchPurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchPurchaseOrderRequest) ProtoMessage() {}
+
+func (x *PatchPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*PatchPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PatchPurchaseOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchPurchaseOrderRequest) GetFields() *structpb.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DeletePurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePurchaseOrderRequest) Reset() {
+	*x = DeletePurchaseOrderRequest{}
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *DeletePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_purchase_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*DeletePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillment_v1_purchase_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeletePurchaseOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_fulfillment_v1_purchase_order_proto protoreflect.FileDescriptor
+
+const file_fulfillment_v1_purchase_order_proto_rawDesc = "" +
+	"\n" +
+	"#fulfillment/v1/purchase_order.proto\x12\x0efulfillment.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe7\x02\n" +
+	"\rPurchaseOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\forder_number\x18\x02 \x01(\tR\vorderNumber\x129\n" +
+	"\n" +
+	"order_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\torderDate\x12!\n" +
+	"\ftracing_code\x18\x04 \x01(\tR\vtracingCode\x12\x19\n" +
+	"\bbuyer_id\x18\x05 \x01(\x03R\abuyerId\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"carrier_id\x18\a \x01(\x03R\tcarrierId\x12&\n" +
+	"\x0forder_status_id\x18\b \x01(\x03R\rorderStatusId\x12@\n" +
+	"\rorder_details\x18\t \x03(\v2\x1b.fulfillment.v1.OrderDetailR\forderDetails\"\xe1\x01\n" +
+	"\vOrderDetail\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12,\n" +
+	"\x12clean_lines_status\x18\x03 \x01(\tR\x10cleanLinesStatus\x12 \n" +
+	"\vtemperature\x18\x04 \x01(\x01R\vtemperature\x12*\n" +
+	"\x11product_record_id\x18\x05 \x01(\x03R\x0fproductRecordId\x12*\n" +
+	"\x11purchase_order_id\x18\x06 \x01(\x03R\x0fpurchaseOrderId\"\x1b\n" +
+	"\x19ListPurchaseOrdersRequest\"d\n" +
+	"\x1aListPurchaseOrdersResponse\x12F\n" +
+	"\x0fpurchase_orders\x18\x01 \x03(\v2\x1d.fulfillment.v1.PurchaseOrderR\x0epurchaseOrders\"=\n" +
+	" ListPurchaseOrdersByBuyerRequest\x12\x19\n" +
+	"\bbuyer_id\x18\x01 \x01(\x03R\abuyerId\"k\n" +
+	"!ListPurchaseOrdersByBuyerResponse\x12F\n" +
+	"\x0fpurchase_orders\x18\x01 \x03(\v2\x1d.fulfillment.v1.PurchaseOrderR\x0epurchaseOrders\")\n" +
+	"\x17GetPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"b\n" +
+	"\x1aCreatePurchaseOrderRequest\x12D\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x1d.fulfillment.v1.PurchaseOrderR\rpurchaseOrder\"b\n" +
+	"\x1aUpdatePurchaseOrderRequest\x12D\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x1d.fulfillment.v1.PurchaseOrderR\rpurchaseOrder\"\\\n" +
+	"\x19PatchPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12/\n" +
+	"\x06fields\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06fields\",\n" +
+	"\x1aDeletePurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\xe1\x05\n" +
+	"\x14PurchaseOrderService\x12k\n" +
+	"\x12ListPurchaseOrders\x12).fulfillment.v1.ListPurchaseOrdersRequest\x1a*.fulfillment.v1.ListPurchaseOrdersResponse\x12\x80\x01\n" +
+	"\x19ListPurchaseOrdersByBuyer\x120.fulfillment.v1.ListPurchaseOrdersByBuyerRequest\x1a1.fulfillment.v1.ListPurchaseOrdersByBuyerResponse\x12Z\n" +
+	"\x10GetPurchaseOrder\x12'.fulfillment.v1.GetPurchaseOrderRequest\x1a\x1d.fulfillment.v1.PurchaseOrder\x12`\n" +
+	"\x13CreatePurchaseOrder\x12*.fulfillment.v1.CreatePurchaseOrderRequest\x1a\x1d.fulfillment.v1.PurchaseOrder\x12`\n" +
+	"\x13UpdatePurchaseOrder\x12*.fulfillment.v1.UpdatePurchaseOrderRequest\x1a\x1d.fulfillment.v1.PurchaseOrder\x12^\n" +
+	"\x12PatchPurchaseOrder\x12).fulfillment.v1.PatchPurchaseOrderRequest\x1a\x1d.fulfillment.v1.PurchaseOrder\x12Y\n" +
+	"\x13DeletePurchaseOrder\x12*.fulfillment.v1.DeletePurchaseOrderRequest\x1a\x16.google.protobuf.EmptyB\xce\x01\n" +
+	"\x12com.fulfillment.v1B\x12PurchaseOrderProtoP\x01ZKgithub.com/miloalej-dev/W17-G1-Bootcamp/pkg/pb/fulfillment/v1;fulfillmentv1\xa2\x02\x03FXX\xaa\x02\x0eFulfillment.V1\xca\x02\x0eFulfillment\\V1\xe2\x02\x1aFulfillment\\V1\\GPBMetadata\xea\x02\x0fFulfillment::V1b\x06proto3"
+
+var (
+	file_fulfillment_v1_purchase_order_proto_rawDescOnce sync.Once
+	file_fulfillment_v1_purchase_order_proto_rawDescData []byte
+)
+
+func file_fulfillment_v1_purchase_order_proto_rawDescGZIP() []byte {
+	file_fulfillment_v1_purchase_order_proto_rawDescOnce.Do(func() {
+		file_fulfillment_v1_purchase_order_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fulfillment_v1_purchase_order_proto_rawDesc), len(file_fulfillment_v1_purchase_order_proto_rawDesc)))
+	})
+	return file_fulfillment_v1_purchase_order_proto_rawDescData
+}
+
+var file_fulfillment_v1_purchase_order_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_fulfillment_v1_purchase_order_proto_goTypes = []any{
+	(*PurchaseOrder)(nil),                     // 0: fulfillment.v1.PurchaseOrder
+	(*OrderDetail)(nil),                       // 1: fulfillment.v1.OrderDetail
+	(*ListPurchaseOrdersRequest)(nil),         // 2: fulfillment.v1.ListPurchaseOrdersRequest
+	(*ListPurchaseOrdersResponse)(nil),        // 3: fulfillment.v1.ListPurchaseOrdersResponse
+	(*ListPurchaseOrdersByBuyerRequest)(nil),  // 4: fulfillment.v1.ListPurchaseOrdersByBuyerRequest
+	(*ListPurchaseOrdersByBuyerResponse)(nil), // 5: fulfillment.v1.ListPurchaseOrdersByBuyerResponse
+	(*GetPurchaseOrderRequest)(nil),           // 6: fulfillment.v1.GetPurchaseOrderRequest
+	(*CreatePurchaseOrderRequest)(nil),        // 7: fulfillment.v1.CreatePurchaseOrderRequest
+	(*UpdatePurchaseOrderRequest)(nil),        // 8: fulfillment.v1.UpdatePurchaseOrderRequest
+	(*PatchPurchaseOrderRequest)(nil),         // 9: fulfillment.v1.PatchPurchaseOrderRequest
+	(*DeletePurchaseOrderRequest)(nil),        // 10: fulfillment.v1.DeletePurchaseOrderRequest
+	(*timestamppb.Timestamp)(nil),             // 11: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                   // 12: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                     // 13: google.protobuf.Empty
+}
+var file_fulfillment_v1_purchase_order_proto_depIdxs = []int32{
+	11, // 0: fulfillment.v1.PurchaseOrder.order_date:type_name -> google.protobuf.Timestamp
+	1,  // 1: fulfillment.v1.PurchaseOrder.order_details:type_name -> fulfillment.v1.OrderDetail
+	0,  // 2: fulfillment.v1.ListPurchaseOrdersResponse.purchase_orders:type_name -> fulfillment.v1.PurchaseOrder
+	0,  // 3: fulfillment.v1.ListPurchaseOrdersByBuyerResponse.purchase_orders:type_name -> fulfillment.v1.PurchaseOrder
+	0,  // 4: fulfillment.v1.CreatePurchaseOrderRequest.purchase_order:type_name -> fulfillment.v1.PurchaseOrder
+	0,  // 5: fulfillment.v1.UpdatePurchaseOrderRequest.purchase_order:type_name -> fulfillment.v1.PurchaseOrder
+	12, // 6: fulfillment.v1.PatchPurchaseOrderRequest.fields:type_name -> google.protobuf.Struct
+	2,  // 7: fulfillment.v1.PurchaseOrderService.ListPurchaseOrders:input_type -> fulfillment.v1.ListPurchaseOrdersRequest
+	4,  // 8: fulfillment.v1.PurchaseOrderService.ListPurchaseOrdersByBuyer:input_type -> fulfillment.v1.ListPurchaseOrdersByBuyerRequest
+	6,  // 9: fulfillment.v1.PurchaseOrderService.GetPurchaseOrder:input_type -> fulfillment.v1.GetPurchaseOrderRequest
+	7,  // 10: fulfillment.v1.PurchaseOrderService.CreatePurchaseOrder:input_type -> fulfillment.v1.CreatePurchaseOrderRequest
+	8,  // 11: fulfillment.v1.PurchaseOrderService.UpdatePurchaseOrder:input_type -> fulfillment.v1.UpdatePurchaseOrderRequest
+	9,  // 12: fulfillment.v1.PurchaseOrderService.PatchPurchaseOrder:input_type -> fulfillment.v1.PatchPurchaseOrderRequest
+	10, // 13: fulfillment.v1.PurchaseOrderService.DeletePurchaseOrder:input_type -> fulfillment.v1.DeletePurchaseOrderRequest
+	3,  // 14: fulfillment.v1.PurchaseOrderService.ListPurchaseOrders:output_type -> fulfillment.v1.ListPurchaseOrdersResponse
+	5,  // 15: fulfillment.v1.PurchaseOrderService.ListPurchaseOrdersByBuyer:output_type -> fulfillment.v1.ListPurchaseOrdersByBuyerResponse
+	0,  // 16: fulfillment.v1.PurchaseOrderService.GetPurchaseOrder:output_type -> fulfillment.v1.PurchaseOrder
+	0,  // 17: fulfillment.v1.PurchaseOrderService.CreatePurchaseOrder:output_type -> fulfillment.v1.PurchaseOrder
+	0,  // 18: fulfillment.v1.PurchaseOrderService.UpdatePurchaseOrder:output_type -> fulfillment.v1.PurchaseOrder
+	0,  // 19: fulfillment.v1.PurchaseOrderService.PatchPurchaseOrder:output_type -> fulfillment.v1.PurchaseOrder
+	13, // 20: fulfillment.v1.PurchaseOrderService.DeletePurchaseOrder:output_type -> google.protobuf.Empty
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_fulfillment_v1_purchase_order_proto_init() }
+func file_fulfillment_v1_purchase_order_proto_init() {
+	if File_fulfillment_v1_purchase_order_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fulfillment_v1_purchase_order_proto_rawDesc), len(file_fulfillment_v1_purchase_order_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fulfillment_v1_purchase_order_proto_goTypes,
+		DependencyIndexes: file_fulfillment_v1_purchase_order_proto_depIdxs,
+		MessageInfos:      file_fulfillment_v1_purchase_order_proto_msgTypes,
+	}.Build()
+	File_fulfillment_v1_purchase_order_proto = out.File
+	file_fulfillment_v1_purchase_order_proto_goTypes = nil
+	file_fulfillment_v1_purchase_order_proto_depIdxs = nil
+}
@@ -33,7 +33,7 @@ func Test_BuyerBind(t *testing.T) {
 				FirstName:    &firstName,
 				LastName:     &lastName,
 			},
-			expectedError: "card number Id must be not null",
+			expectedError: "card_number_id must not be null",
 		},
 		{
 			title: "Error - Missing First Name",
@@ -42,7 +42,7 @@ func Test_BuyerBind(t *testing.T) {
 				FirstName:    nil,
 				LastName:     &lastName,
 			},
-			expectedError: "first name must not be null",
+			expectedError: "first_name must not be null",
 		},
 		{
 			title: "Error - Missing Last Name",
@@ -51,7 +51,7 @@ func Test_BuyerBind(t *testing.T) {
 				FirstName:    &firstName,
 				LastName:     nil,
 			},
-			expectedError: "last name must not be null",
+			expectedError: "last_name must not be null",
 		},
 	}
 
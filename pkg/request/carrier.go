@@ -1,36 +1,19 @@
 package request
 
 import (
-	"errors"
 	"net/http"
 )
 
 // WharehouseRequest is a struct that represents a wharehouse in JSON format
 type CarrierRequest struct {
 	ID				*int	`json:"id"`
-	CId				*string	`json:"cid" gorm:"column:cid"`
-	CompanyName		*string	`json:"company_name" gorm:"column:name"`
-	Address			*string	`json:"address"`
-	Telephone		*string	`json:"telephone"`
-	LocalityId		*int	`json:"locality_id"`
+	CId				*string	`json:"cid" gorm:"column:cid" minLength:"1" required:"true"`
+	CompanyName		*string	`json:"company_name" gorm:"column:name" required:"true"`
+	Address			*string	`json:"address" required:"true"`
+	Telephone		*string	`json:"telephone" required:"true"`
+	LocalityId		*int	`json:"locality_id" minimum:"1" required:"true"`
 }
 
 func (p *CarrierRequest) Bind(r *http.Request) error {
-	if p.CId == nil {
-		return errors.New("cid code must not be null")
-	}
-	if p.CompanyName == nil {
-		return errors.New("company name must not be null")
-	}
-	if p.Address == nil {
-		return errors.New("address must not be null")
-	}
-	if p.Telephone == nil {
-		return errors.New("telephone must not be null")
-	}
-	if p.LocalityId == nil {
-		return errors.New("locality id must not be null")
-	}
-
-	return nil
+	return checkRequired(p)
 }
@@ -39,7 +39,7 @@ func Test_CarrierBind(t *testing.T) {
 				Telephone:   &telephone,
 				LocalityId:  &locality_id,
 			},
-			expectedError: "cid code must not be null",
+			expectedError: "cid must not be null",
 		},
 		{
 			title: "Error - missing company name",
@@ -50,7 +50,7 @@ func Test_CarrierBind(t *testing.T) {
 				Telephone:   &telephone,
 				LocalityId:  &locality_id,
 			},
-			expectedError: "company name must not be null",
+			expectedError: "company_name must not be null",
 		},
 		{
 			title: "Error - missing address",
@@ -83,7 +83,7 @@ func Test_CarrierBind(t *testing.T) {
 				Telephone:   &telephone,
 				LocalityId:  nil,
 			},
-			expectedError: "locality id must not be null",
+			expectedError: "locality_id must not be null",
 		},
 	}
 
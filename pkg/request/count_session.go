@@ -0,0 +1,25 @@
+package request
+
+import (
+	"net/http"
+)
+
+// CountSessionRequest is the body of the requests that open a count session for a section
+type CountSessionRequest struct {
+	SectionId *int `json:"section_id" minimum:"1" required:"true"`
+}
+
+func (c *CountSessionRequest) Bind(r *http.Request) error {
+	return checkRequired(c)
+}
+
+// CountRequest is the body of the requests that record the quantity of a batch counted by an employee
+type CountRequest struct {
+	ProductBatchId  *int `json:"product_batch_id" minimum:"1" required:"true"`
+	CountedQuantity *int `json:"counted_quantity" minimum:"0" required:"true"`
+	EmployeeId      *int `json:"employee_id" minimum:"1" required:"true"`
+}
+
+func (c *CountRequest) Bind(r *http.Request) error {
+	return checkRequired(c)
+}
@@ -0,0 +1,62 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountSessionRequest_Bind(t *testing.T) {
+	sectionId := 1
+
+	require.NoError(t, (&CountSessionRequest{SectionId: &sectionId}).Bind(&http.Request{}))
+	require.EqualError(t, (&CountSessionRequest{}).Bind(&http.Request{}), "section_id must not be null")
+}
+
+func TestCountRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	productBatchId := 3
+	countedQuantity := 0
+	employeeId := 2
+
+	tests := []struct {
+		title         string
+		request       *CountRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - All fields valid",
+			request: &CountRequest{ProductBatchId: &productBatchId, CountedQuantity: &countedQuantity, EmployeeId: &employeeId},
+		},
+		{
+			title:         "Error - Missing ProductBatchId",
+			request:       &CountRequest{CountedQuantity: &countedQuantity, EmployeeId: &employeeId},
+			expectedError: "product_batch_id must not be null",
+		},
+		{
+			title:         "Error - Missing CountedQuantity",
+			request:       &CountRequest{ProductBatchId: &productBatchId, EmployeeId: &employeeId},
+			expectedError: "counted_quantity must not be null",
+		},
+		{
+			title:         "Error - Missing EmployeeId",
+			request:       &CountRequest{ProductBatchId: &productBatchId, CountedQuantity: &countedQuantity},
+			expectedError: "employee_id must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
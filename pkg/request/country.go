@@ -0,0 +1,14 @@
+package request
+
+import (
+	"net/http"
+)
+
+// CountryRequest is the body of the requests that create or replace a country
+type CountryRequest struct {
+	Country *string `json:"country" minLength:"1" required:"true"`
+}
+
+func (c *CountryRequest) Bind(r *http.Request) error {
+	return checkRequired(c)
+}
@@ -41,7 +41,7 @@ func TestEmployeeRequest_Bind(t *testing.T) {
 				LastName:     &lastName,
 				WarehouseId:  &warehouseId,
 			},
-			expectedError: "CardNumberId must not be null",
+			expectedError: "card_number_id must not be null",
 		},
 		{
 			title: "Error - Missing FirstName",
@@ -52,7 +52,7 @@ func TestEmployeeRequest_Bind(t *testing.T) {
 				LastName:     &lastName,
 				WarehouseId:  &warehouseId,
 			},
-			expectedError: "FirstName must not be null",
+			expectedError: "first_name must not be null",
 		},
 		{
 			title: "Error - Missing LastName",
@@ -63,7 +63,7 @@ func TestEmployeeRequest_Bind(t *testing.T) {
 				LastName:     nil,
 				WarehouseId:  &warehouseId,
 			},
-			expectedError: "LastName must not be null",
+			expectedError: "last_name must not be null",
 		},
 		{
 			title: "Error - Missing WarehouseId",
@@ -74,7 +74,7 @@ func TestEmployeeRequest_Bind(t *testing.T) {
 				LastName:     &lastName,
 				WarehouseId:  nil,
 			},
-			expectedError: "WarehouseId must not be null",
+			expectedError: "warehouse_id must not be null",
 		},
 	}
 
@@ -0,0 +1,16 @@
+package request
+
+import (
+	"net/http"
+)
+
+// GraphQLRequest is the body of a GraphQL query
+type GraphQLRequest struct {
+	Query         *string        `json:"query" minLength:"1" required:"true"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (g *GraphQLRequest) Bind(r *http.Request) error {
+	return checkRequired(g)
+}
@@ -0,0 +1,46 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLRequest_Bind(t *testing.T) {
+	query := "{ warehouses { id } }"
+
+	tests := []struct {
+		name          string
+		request       *GraphQLRequest
+		expectedError string
+	}{
+		{
+			name:          "Success - Query present",
+			request:       &GraphQLRequest{Query: &query},
+			expectedError: "",
+		},
+		{
+			name:          "Error - Query is nil",
+			request:       &GraphQLRequest{OperationName: "Warehouses"},
+			expectedError: "query must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			req, _ := http.NewRequest(http.MethodPost, "/graphql", nil)
+
+			// Act
+			err := tt.request.Bind(req)
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package request
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxImportLineSize is the longest JSON Lines row accepted
+const maxImportLineSize = 1 << 20
+
+// RowError is returned when a single row of an import cannot be decoded, the following rows can still be read
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// StreamError is returned when the import stream itself is unreadable and no further row can be decoded
+type StreamError struct {
+	Err error
+}
+
+func (e *StreamError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StreamError) Unwrap() error {
+	return e.Err
+}
+
+// RowDecoder decodes an import stream into request structs one row at a time
+type RowDecoder interface {
+	// Decode decodes the next row into dst, a pointer to a request struct, and returns its line number. It returns
+	// io.EOF once the stream ends, a *RowError when only the row is malformed and a *StreamError otherwise.
+	Decode(dst any) (int, error)
+}
+
+// CSVDecoder decodes CSV rows. The first record is the header and names each column after the json tag of the
+// request field it fills, empty cells are left unset.
+type CSVDecoder struct {
+	reader *csv.Reader
+	header []string
+}
+
+func NewCSVDecoder(r io.Reader) *CSVDecoder {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	return &CSVDecoder{reader: reader}
+}
+
+func (d *CSVDecoder) Decode(dst any) (int, error) {
+	target := reflect.TypeOf(dst).Elem()
+
+	if d.header == nil {
+		header, err := d.reader.Read()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, &StreamError{Err: err}
+		}
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+		for i, column := range header {
+			header[i] = strings.TrimSpace(column)
+			if _, ok := jsonField(target, header[i]); !ok {
+				return 1, &StreamError{Err: fmt.Errorf("unknown column %q", header[i])}
+			}
+		}
+		d.header = header
+	}
+
+	record, err := d.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return 0, io.EOF
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.StartLine, &RowError{Line: parseErr.StartLine, Err: parseErr.Err}
+	}
+	if err != nil {
+		return 0, &StreamError{Err: err}
+	}
+	line, _ := d.reader.FieldPos(0)
+
+	fields := make(map[string]json.RawMessage, len(record))
+	for i, cell := range record {
+		if cell == "" {
+			continue
+		}
+		field, _ := jsonField(target, d.header[i])
+		value, err := csvValue(field.Type, cell)
+		if err != nil {
+			return line, &RowError{Line: line, Err: fmt.Errorf("column %s: %w", d.header[i], err)}
+		}
+		fields[d.header[i]] = value
+	}
+
+	data, err := json.Marshal(fields)
+	if err == nil {
+		err = json.Unmarshal(data, dst)
+	}
+	if err != nil {
+		return line, &RowError{Line: line, Err: err}
+	}
+	return line, nil
+}
+
+// JSONLinesDecoder decodes JSON Lines rows, one JSON object per line, blank lines are skipped
+type JSONLinesDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func NewJSONLinesDecoder(r io.Reader) *JSONLinesDecoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+	return &JSONLinesDecoder{scanner: scanner}
+}
+
+func (d *JSONLinesDecoder) Decode(dst any) (int, error) {
+	for d.scanner.Scan() {
+		d.line++
+		data := bytes.TrimSpace(d.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(data, dst); err != nil {
+			return d.line, &RowError{Line: d.line, Err: err}
+		}
+		return d.line, nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return d.line + 1, &StreamError{Err: err}
+	}
+	return 0, io.EOF
+}
+
+// jsonField returns the struct field whose json tag is named name
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// csvValue encodes a CSV cell as the JSON value expected by a field of type t
+func csvValue(t reflect.Type, cell string) (json.RawMessage, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(cell, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", cell)
+		}
+		return json.Marshal(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", cell)
+		}
+		if data, err := json.Marshal(value); err == nil {
+			return data, nil
+		}
+		return nil, fmt.Errorf("invalid number %q", cell)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(cell)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q", cell)
+		}
+		return json.Marshal(value)
+	default:
+		return json.Marshal(cell)
+	}
+}
@@ -0,0 +1,116 @@
+package request
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCSVDecoder_Decode(t *testing.T) {
+	tests := []struct {
+		title         string
+		body          string
+		expectedLines []int
+		expectedNames []string
+		expectedErrs  []string
+		expectedFatal string
+	}{
+		{
+			title:         "decodes every row after the header",
+			body:          "name,address,telephone,locality_id\nAcme,Street 1,555,1\n\"Foo, Inc\",Street 2,556,2\n",
+			expectedLines: []int{2, 3},
+			expectedNames: []string{"Acme", "Foo, Inc"},
+			expectedErrs:  []string{"", ""},
+		},
+		{
+			title:         "leaves empty cells unset",
+			body:          "name,address,telephone,locality_id\n,Street 1,555,1\n",
+			expectedLines: []int{2},
+			expectedNames: []string{""},
+			expectedErrs:  []string{""},
+		},
+		{
+			title:         "reports an invalid cell and keeps reading",
+			body:          "name,address,telephone,locality_id\nAcme,Street 1,555,one\nFoo,Street 2,556,2\n",
+			expectedLines: []int{2, 3},
+			expectedNames: []string{"", "Foo"},
+			expectedErrs:  []string{`column locality_id: invalid integer "one"`, ""},
+		},
+		{
+			title:         "reports a row with a wrong number of fields",
+			body:          "name,address,telephone,locality_id\nAcme,Street 1\n",
+			expectedLines: []int{2},
+			expectedNames: []string{""},
+			expectedErrs:  []string{"wrong number of fields"},
+		},
+		{
+			title:         "rejects an unknown column",
+			body:          "name,color\nAcme,red\n",
+			expectedFatal: `unknown column "color"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			decoder := NewCSVDecoder(strings.NewReader(test.body))
+
+			var lines []int
+			var names []string
+			var errs []string
+			for {
+				data := &SellerRequest{}
+				line, err := decoder.Decode(data)
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				var streamErr *StreamError
+				if errors.As(err, &streamErr) {
+					require.EqualError(t, err, test.expectedFatal)
+					return
+				}
+
+				lines = append(lines, line)
+				errs = append(errs, "")
+				if err != nil {
+					errs[len(errs)-1] = err.Error()
+				}
+				names = append(names, "")
+				if data.Name != nil {
+					names[len(names)-1] = *data.Name
+				}
+			}
+
+			require.Empty(t, test.expectedFatal)
+			require.Equal(t, test.expectedLines, lines)
+			require.Equal(t, test.expectedNames, names)
+			require.Equal(t, test.expectedErrs, errs)
+		})
+	}
+}
+
+func TestJSONLinesDecoder_Decode(t *testing.T) {
+	body := "{\"name\":\"Acme\",\"locality_id\":1}\n\n{\"name\":\n{\"name\":\"Foo\",\"locality_id\":2}\n"
+	decoder := NewJSONLinesDecoder(strings.NewReader(body))
+
+	data := &SellerRequest{}
+	line, err := decoder.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, 1, line)
+	require.Equal(t, "Acme", *data.Name)
+
+	line, err = decoder.Decode(&SellerRequest{})
+	var rowErr *RowError
+	require.ErrorAs(t, err, &rowErr)
+	require.Equal(t, 3, line)
+
+	data = &SellerRequest{}
+	line, err = decoder.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, 4, line)
+	require.Equal(t, 2, *data.LocalityId)
+
+	_, err = decoder.Decode(&SellerRequest{})
+	require.ErrorIs(t, err, io.EOF)
+}
@@ -6,24 +6,15 @@ import (
 )
 
 type LocalityRequest struct {
-	Id       int     `json:"id"` // El ID debe ser proporcionado por el cliente
-	Locality *string `json:"locality_name"`
-	Province *string `json:"province_name,omitempty"`
-	Country  *string `json:"country_name,omitempty"`
+	Id       int     `json:"id" minimum:"1" required:"true"` // El ID debe ser proporcionado por el cliente
+	Locality *string `json:"locality_name" minLength:"1" required:"true"`
+	Province *string `json:"province_name,omitempty" required:"true"`
+	Country  *string `json:"country_name,omitempty" required:"true"`
 }
 
 func (l *LocalityRequest) Bind(r *http.Request) error {
 	if l.Id <= 0 {
 		return errors.New("locality_id must be greater than 0")
 	}
-	if l.Locality == nil {
-		return errors.New("locality_name is required")
-	}
-	if l.Province == nil {
-		return errors.New("province_name is required")
-	}
-	if l.Country == nil {
-		return errors.New("country_name is required")
-	}
-	return nil
+	return checkRequired(l)
 }
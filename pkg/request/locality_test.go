@@ -58,7 +58,7 @@ func TestLocalityRequest_Bind(t *testing.T) {
 				Province: &province,
 				Country:  &country,
 			},
-			expectedError: "locality_name is required",
+			expectedError: "locality_name must not be null",
 		},
 		{
 			title: "Error - Missing Province",
@@ -68,7 +68,7 @@ func TestLocalityRequest_Bind(t *testing.T) {
 				Province: nil,
 				Country:  &country,
 			},
-			expectedError: "province_name is required",
+			expectedError: "province_name must not be null",
 		},
 		{
 			title: "Error - Missing Country",
@@ -78,7 +78,7 @@ func TestLocalityRequest_Bind(t *testing.T) {
 				Province: &province,
 				Country:  nil,
 			},
-			expectedError: "country_name is required",
+			expectedError: "country_name must not be null",
 		},
 	}
 
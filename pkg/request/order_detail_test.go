@@ -51,7 +51,7 @@ func TestOrderDetailRequest_Bind(t *testing.T) {
 				ProductRecordID:  &productID,
 				PurchaseOrderID:  &orderID,
 			},
-			expectedError: "clean line status must not be null",
+			expectedError: "clean_lines_status must not be null",
 		},
 		{
 			name: "Error - Temperature is nil",
@@ -73,7 +73,7 @@ func TestOrderDetailRequest_Bind(t *testing.T) {
 				ProductRecordID:  nil,
 				PurchaseOrderID:  &orderID,
 			},
-			expectedError: "product record Id must not be null",
+			expectedError: "product_records_id must not be null",
 		},
 		{
 			name: "Error - PurchaseOrderID is nil",
@@ -84,7 +84,7 @@ func TestOrderDetailRequest_Bind(t *testing.T) {
 				ProductRecordID:  &productID,
 				PurchaseOrderID:  nil,
 			},
-			expectedError: "purchase order Id must not be null",
+			expectedError: "purchase_orders_id must not be null",
 		},
 	}
 
@@ -1,55 +1,36 @@
 package request
 
 import (
-	"errors"
 	"net/http"
 )
 
 type ProductBatchRequest struct {
 	Id                 *int     `json:"id"`
-	BatchNumber        *int     `json:"batch_number"`
-	CurrentQuantity    *int     `json:"current_quantity"`
-	CurrentTemperature *float64 `json:"current_temperature"`
-	DueDate            *string  `json:"due_date"`
-	InitialQuantity    *int     `json:"initial_quantity"`
-	ManufacturingDate  *string  `json:"manufacturing_date"`
-	ManufacturingHour  *int     `json:"manufacturing_hour"`
-	MinimumTemperature *float64 `json:"minimum_temperature"`
-	SectionId          *int     `json:"section_id"`
-	ProductId          *int     `json:"product_id"`
+	BatchNumber        *int     `json:"batch_number" minimum:"1" required:"true"`
+	CurrentQuantity    *int     `json:"current_quantity" minimum:"0" required:"true"`
+	CurrentTemperature *float64 `json:"current_temperature" required:"true"`
+	DueDate            *string  `json:"due_date" format:"date" required:"true"`
+	InitialQuantity    *int     `json:"initial_quantity" minimum:"0" required:"true"`
+	ManufacturingDate  *string  `json:"manufacturing_date" format:"date" required:"true"`
+	ManufacturingHour  *int     `json:"manufacturing_hour" minimum:"0" maximum:"23" required:"true"`
+	MinimumTemperature *float64 `json:"minimum_temperature" required:"true"`
+	SectionId          *int     `json:"section_id" minimum:"1" required:"true"`
+	ProductId          *int     `json:"product_id" minimum:"1" required:"true"`
 }
 
 func (p *ProductBatchRequest) Bind(r *http.Request) error {
-	if p.BatchNumber == nil {
-		return errors.New("BatchNumber must not be null")
-	}
-	if p.CurrentQuantity == nil {
-		return errors.New("CurrentQuantity must not be null")
-	}
-	if p.CurrentTemperature == nil {
-		return errors.New("CurrentTemperature must not be null")
-	}
-	if p.DueDate == nil {
-		return errors.New("DueDate must not be null")
-	}
-	if p.InitialQuantity == nil {
-		return errors.New("InitialQuantity must not be null")
-	}
-	if p.ManufacturingDate == nil {
-		return errors.New("ManufacturingDate must not be null")
-	}
-	if p.ManufacturingHour == nil {
-		return errors.New("ManufacturingHour must not be null")
-	}
-	if p.MinimumTemperature == nil {
-		return errors.New("MinimumTemperature must not be null")
-	}
-	if p.SectionId == nil {
-		return errors.New("SectionId must not be null")
-	}
-	if p.ProductId == nil {
-		return errors.New("ProductId must not be null")
-	}
+	return checkRequired(p)
+}
+
+// ProductBatchStatusRequest is the body of the requests that change the status of a product batch, the reason code
+// is required to dispose of it
+type ProductBatchStatusRequest struct {
+	Status     *string `json:"status" enum:"received,under_inspection,available,quarantined,expired,disposed" required:"true"`
+	Reason     *string `json:"reason" minLength:"1" required:"true"`
+	ReasonCode *string `json:"reason_code" enum:"expired,damaged,temperature_excursion"`
+	EmployeeId *int    `json:"employee_id" minimum:"1"`
+}
 
-	return nil
+func (p *ProductBatchStatusRequest) Bind(r *http.Request) error {
+	return checkRequired(p)
 }
@@ -75,7 +75,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "BatchNumber must not be null",
+			expectedError: "batch_number must not be null",
 		},
 		{
 			title: "Error - Missing CurrentQuantity",
@@ -92,7 +92,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "CurrentQuantity must not be null",
+			expectedError: "current_quantity must not be null",
 		},
 		{
 			title: "Error - Missing CurrentTemperature",
@@ -109,7 +109,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "CurrentTemperature must not be null",
+			expectedError: "current_temperature must not be null",
 		},
 		{
 			title: "Error - Missing DueDate",
@@ -126,7 +126,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "DueDate must not be null",
+			expectedError: "due_date must not be null",
 		},
 		{
 			title: "Error - Missing InitialQuantity",
@@ -143,7 +143,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "InitialQuantity must not be null",
+			expectedError: "initial_quantity must not be null",
 		},
 		{
 			title: "Error - Missing ManufacturingDate",
@@ -160,7 +160,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "ManufacturingDate must not be null",
+			expectedError: "manufacturing_date must not be null",
 		},
 		{
 			title: "Error - Missing ManufacturingHour",
@@ -177,7 +177,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "ManufacturingHour must not be null",
+			expectedError: "manufacturing_hour must not be null",
 		},
 		{
 			title: "Error - Missing MinimumTemperature",
@@ -194,7 +194,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          &productId,
 			},
-			expectedError: "MinimumTemperature must not be null",
+			expectedError: "minimum_temperature must not be null",
 		},
 		{
 			title: "Error - Missing SectionId",
@@ -211,7 +211,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          nil,
 				ProductId:          &productId,
 			},
-			expectedError: "SectionId must not be null",
+			expectedError: "section_id must not be null",
 		},
 		{
 			title: "Error - Missing ProductId",
@@ -228,7 +228,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          &sectionId,
 				ProductId:          nil,
 			},
-			expectedError: "ProductId must not be null",
+			expectedError: "product_id must not be null",
 		},
 		{
 			title: "Error - All required fields missing (first error should be BatchNumber)",
@@ -245,7 +245,7 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 				SectionId:          nil,
 				ProductId:          nil,
 			},
-			expectedError: "BatchNumber must not be null",
+			expectedError: "batch_number must not be null",
 		},
 	}
 
@@ -263,3 +263,44 @@ func TestProductBatchRequest_Bind(t *testing.T) {
 		})
 	}
 }
+
+func TestProductBatchStatusRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	status := "quarantined"
+	reason := "Damaged packaging"
+
+	tests := []struct {
+		title         string
+		request       *ProductBatchStatusRequest
+		expectedError string
+	}{
+		{
+			title:   "Success",
+			request: &ProductBatchStatusRequest{Status: &status, Reason: &reason},
+		},
+		{
+			title:         "Error - Missing Status",
+			request:       &ProductBatchStatusRequest{Reason: &reason},
+			expectedError: "status must not be null",
+		},
+		{
+			title:         "Error - Missing Reason",
+			request:       &ProductBatchStatusRequest{Status: &status},
+			expectedError: "reason must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -75,7 +75,7 @@ func TestProductRequest_Bind(t *testing.T) {
 				FreezingRate:                   &freezingRate,
 				ProductTypeId:                  &productTypeId,
 			},
-			expectedError: "productCode must not be null",
+			expectedError: "product_code must not be null",
 		},
 		{
 			title: "Error - Missing Description",
@@ -155,7 +155,7 @@ func TestProductRequest_Bind(t *testing.T) {
 				FreezingRate:                   &freezingRate,
 				ProductTypeId:                  &productTypeId,
 			},
-			expectedError: "netWeight must not be null",
+			expectedError: "net_weight must not be null",
 		},
 		{
 			title: "Error - Missing ExpirationRate",
@@ -171,7 +171,7 @@ func TestProductRequest_Bind(t *testing.T) {
 				FreezingRate:                   &freezingRate,
 				ProductTypeId:                  &productTypeId,
 			},
-			expectedError: "expirationRate must not be null",
+			expectedError: "expiration_rate must not be null",
 		},
 		{
 			title: "Error - Missing RecommendedFreezingTemperature",
@@ -187,7 +187,7 @@ func TestProductRequest_Bind(t *testing.T) {
 				FreezingRate:                   &freezingRate,
 				ProductTypeId:                  &productTypeId,
 			},
-			expectedError: "recommendedFreezingTemperature must not be null",
+			expectedError: "recommended_freezing_temperature must not be null",
 		},
 		{
 			title: "Error - Missing FreezingRate",
@@ -203,7 +203,7 @@ func TestProductRequest_Bind(t *testing.T) {
 				FreezingRate:                   nil,
 				ProductTypeId:                  &productTypeId,
 			},
-			expectedError: "freezingRate must not be null",
+			expectedError: "freezing_rate must not be null",
 		},
 		{
 			title: "Error - Missing ProductTypeId",
@@ -219,7 +219,7 @@ func TestProductRequest_Bind(t *testing.T) {
 				FreezingRate:                   &freezingRate,
 				ProductTypeId:                  nil,
 			},
-			expectedError: "productTypeId must not be null",
+			expectedError: "product_type_id must not be null",
 		},
 		{
 			title: "Error - All required fields missing (first error should be ProductCode)",
@@ -236,7 +236,7 @@ func TestProductRequest_Bind(t *testing.T) {
 				ProductTypeId:                  nil,
 				SellerId:                       nil,
 			},
-			expectedError: "productCode must not be null",
+			expectedError: "product_code must not be null",
 		},
 	}
 
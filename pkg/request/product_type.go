@@ -0,0 +1,15 @@
+package request
+
+import (
+	"net/http"
+)
+
+// ProductTypeRequest is the body of the requests that create or replace a product type
+type ProductTypeRequest struct {
+	Name        *string `json:"name" minLength:"1" required:"true"`
+	Description *string `json:"description" required:"true"`
+}
+
+func (p *ProductTypeRequest) Bind(r *http.Request) error {
+	return checkRequired(p)
+}
@@ -0,0 +1,49 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductTypeRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	name := "Fruits"
+	description := "Fresh fruits stored between 2 and 8 degrees"
+
+	tests := []struct {
+		title         string
+		request       *ProductTypeRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - All fields valid",
+			request: &ProductTypeRequest{Name: &name, Description: &description},
+		},
+		{
+			title:         "Error - Missing Name",
+			request:       &ProductTypeRequest{Description: &description},
+			expectedError: "name must not be null",
+		},
+		{
+			title:         "Error - Missing Description",
+			request:       &ProductTypeRequest{Name: &name},
+			expectedError: "description must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
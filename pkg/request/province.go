@@ -0,0 +1,15 @@
+package request
+
+import (
+	"net/http"
+)
+
+// ProvinceRequest is the body of the requests that create or replace a province
+type ProvinceRequest struct {
+	Province  *string `json:"province" minLength:"1" required:"true"`
+	CountryId *int    `json:"country_id" minimum:"1" required:"true"`
+}
+
+func (p *ProvinceRequest) Bind(r *http.Request) error {
+	return checkRequired(p)
+}
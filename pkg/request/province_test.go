@@ -0,0 +1,49 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvinceRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	province := "Córdoba"
+	countryId := 1
+
+	tests := []struct {
+		title         string
+		request       *ProvinceRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - All fields valid",
+			request: &ProvinceRequest{Province: &province, CountryId: &countryId},
+		},
+		{
+			title:         "Error - Missing Province",
+			request:       &ProvinceRequest{CountryId: &countryId},
+			expectedError: "province must not be null",
+		},
+		{
+			title:         "Error - Missing CountryId",
+			request:       &ProvinceRequest{Province: &province},
+			expectedError: "country_id must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package request
+
+import (
+	"net/http"
+)
+
+// PurchaseOrderLineRequest is the body of the requests that add or replace a line of a purchase order, the order
+// is taken from the URL
+type PurchaseOrderLineRequest struct {
+	Quantity         *int     `json:"quantity" minimum:"1" required:"true"`
+	CleanLinesStatus *string  `json:"clean_lines_status" required:"true"`
+	Temperature      *float64 `json:"temperature" required:"true"`
+	ProductRecordID  *int     `json:"product_record_id" minimum:"1" required:"true"`
+}
+
+func (o *PurchaseOrderLineRequest) Bind(r *http.Request) error {
+	return checkRequired(o)
+}
+
+// PurchaseOrderPickRequest is the body of the requests that pick stock of a product batch for a line of a purchase
+// order, the order and the line are taken from the URL
+type PurchaseOrderPickRequest struct {
+	ProductBatchId *int `json:"product_batch_id" minimum:"1" required:"true"`
+	Quantity       *int `json:"quantity" minimum:"1" required:"true"`
+	EmployeeId     *int `json:"employee_id" minimum:"1"`
+}
+
+func (o *PurchaseOrderPickRequest) Bind(r *http.Request) error {
+	return checkRequired(o)
+}
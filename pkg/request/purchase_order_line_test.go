@@ -0,0 +1,92 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurchaseOrderLineRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	quantity := 4
+	status := "OK"
+	temperature := 3.5
+	productRecordId := 2
+
+	tests := []struct {
+		title         string
+		request       *PurchaseOrderLineRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - All fields valid",
+			request: &PurchaseOrderLineRequest{Quantity: &quantity, CleanLinesStatus: &status, Temperature: &temperature, ProductRecordID: &productRecordId},
+		},
+		{
+			title:         "Error - Missing Quantity",
+			request:       &PurchaseOrderLineRequest{CleanLinesStatus: &status, Temperature: &temperature, ProductRecordID: &productRecordId},
+			expectedError: "quantity must not be null",
+		},
+		{
+			title:         "Error - Missing ProductRecordID",
+			request:       &PurchaseOrderLineRequest{Quantity: &quantity, CleanLinesStatus: &status, Temperature: &temperature},
+			expectedError: "product_record_id must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestPurchaseOrderPickRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	productBatchId := 3
+	quantity := 5
+
+	tests := []struct {
+		title         string
+		request       *PurchaseOrderPickRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - Without employee",
+			request: &PurchaseOrderPickRequest{ProductBatchId: &productBatchId, Quantity: &quantity},
+		},
+		{
+			title:         "Error - Missing ProductBatchId",
+			request:       &PurchaseOrderPickRequest{Quantity: &quantity},
+			expectedError: "product_batch_id must not be null",
+		},
+		{
+			title:         "Error - Missing Quantity",
+			request:       &PurchaseOrderPickRequest{ProductBatchId: &productBatchId},
+			expectedError: "quantity must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
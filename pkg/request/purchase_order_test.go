@@ -50,7 +50,7 @@ func TestPurchaseOrderRequest_Bind(t *testing.T) {
 				OrderStatusID: &statusID,
 				OrderDetails:  &details,
 			},
-			expectedError: "OrderNumber must not be null",
+			expectedError: "order_number must not be null",
 		},
 		{
 			name: "Error - OrderDate is nil",
@@ -64,7 +64,7 @@ func TestPurchaseOrderRequest_Bind(t *testing.T) {
 				OrderStatusID: &statusID,
 				OrderDetails:  &details,
 			},
-			expectedError: "OrderDate must not be null",
+			expectedError: "order_date must not be null",
 		},
 		{
 			name: "Error - TracingCode is nil",
@@ -78,7 +78,7 @@ func TestPurchaseOrderRequest_Bind(t *testing.T) {
 				OrderStatusID: &statusID,
 				OrderDetails:  &details,
 			},
-			expectedError: "TracingCode must not be null",
+			expectedError: "tracing_code must not be null",
 		},
 		{
 			name: "Error - BuyersID is nil",
@@ -92,7 +92,7 @@ func TestPurchaseOrderRequest_Bind(t *testing.T) {
 				OrderStatusID: &statusID,
 				OrderDetails:  &details,
 			},
-			expectedError: "BuyersID must not be null",
+			expectedError: "buyer_id must not be null",
 		},
 		{
 			name: "Error - WarehousesID is nil",
@@ -106,7 +106,7 @@ func TestPurchaseOrderRequest_Bind(t *testing.T) {
 				OrderStatusID: &statusID,
 				OrderDetails:  &details,
 			},
-			expectedError: "WarehousesID must not be null",
+			expectedError: "warehouse_id must not be null",
 		},
 		{
 			name: "Error - CarriersID is nil",
@@ -120,7 +120,7 @@ func TestPurchaseOrderRequest_Bind(t *testing.T) {
 				OrderStatusID: &statusID,
 				OrderDetails:  &details,
 			},
-			expectedError: "CarriersID must not be null",
+			expectedError: "carrier_id must not be null",
 		},
 		{
 			name: "Error - OrderStatusID is nil",
@@ -134,7 +134,7 @@ func TestPurchaseOrderRequest_Bind(t *testing.T) {
 				OrderStatusID: nil,
 				OrderDetails:  &details,
 			},
-			expectedError: "OrderStatusID must not be null",
+			expectedError: "order_status_id must not be null",
 		},
 	}
 
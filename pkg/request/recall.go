@@ -0,0 +1,36 @@
+package request
+
+import (
+	"net/http"
+)
+
+// RecallRequest is the body of the requests that open a recall, for a product or for a set of batch numbers
+type RecallRequest struct {
+	ProductId    *int      `json:"product_id" minimum:"1"`
+	BatchNumbers *[]string `json:"batch_numbers"`
+	Severity     *string   `json:"severity" enum:"low,medium,high" required:"true"`
+	Reason       *string   `json:"reason" minLength:"1" required:"true"`
+}
+
+func (rr *RecallRequest) Bind(r *http.Request) error {
+	return checkRequired(rr)
+}
+
+// RecallBatchRequest is the body of the requests that quarantine a batch of a recall
+type RecallBatchRequest struct {
+	ProductBatchId *int `json:"product_batch_id" minimum:"1" required:"true"`
+}
+
+func (rr *RecallBatchRequest) Bind(r *http.Request) error {
+	return checkRequired(rr)
+}
+
+// RecallDisposalRequest is the body of the requests that dispose of a quarantined batch of a recall
+type RecallDisposalRequest struct {
+	ProductBatchId *int `json:"product_batch_id" minimum:"1" required:"true"`
+	EmployeeId     *int `json:"employee_id" minimum:"1"`
+}
+
+func (rr *RecallDisposalRequest) Bind(r *http.Request) error {
+	return checkRequired(rr)
+}
@@ -0,0 +1,68 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecallRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	productId := 1
+	severity := "high"
+	reason := "Listeria found in the production line"
+
+	tests := []struct {
+		title         string
+		request       *RecallRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - Recall of a product",
+			request: &RecallRequest{ProductId: &productId, Severity: &severity, Reason: &reason},
+		},
+		{
+			title:   "Success - Recall of batches",
+			request: &RecallRequest{BatchNumbers: &[]string{"1", "2"}, Severity: &severity, Reason: &reason},
+		},
+		{
+			title:         "Error - Missing Severity",
+			request:       &RecallRequest{ProductId: &productId, Reason: &reason},
+			expectedError: "severity must not be null",
+		},
+		{
+			title:         "Error - Missing Reason",
+			request:       &RecallRequest{ProductId: &productId, Severity: &severity},
+			expectedError: "reason must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestRecallBatchRequest_Bind(t *testing.T) {
+	productBatchId := 3
+
+	require.NoError(t, (&RecallBatchRequest{ProductBatchId: &productBatchId}).Bind(&http.Request{}))
+	require.EqualError(t, (&RecallBatchRequest{}).Bind(&http.Request{}), "product_batch_id must not be null")
+}
+
+func TestRecallDisposalRequest_Bind(t *testing.T) {
+	productBatchId := 3
+
+	require.NoError(t, (&RecallDisposalRequest{ProductBatchId: &productBatchId}).Bind(&http.Request{}))
+	require.EqualError(t, (&RecallDisposalRequest{}).Bind(&http.Request{}), "product_batch_id must not be null")
+}
@@ -0,0 +1,23 @@
+package request
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// checkRequired returns an error for the first field of the request tagged as required that is missing, the OpenAPI
+// document reads the same tags. The required fields are pointers or slices so a missing value is told apart from its
+// zero value.
+func checkRequired(request any) error {
+	v := reflect.Indirect(reflect.ValueOf(request))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("required") != "true" || !v.Field(i).IsZero() {
+			continue
+		}
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		return fmt.Errorf("%s must not be null", name)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRequired(t *testing.T) {
+	type sample struct {
+		Name  *string  `json:"name,omitempty" required:"true"`
+		Tags  []string `json:"tags" required:"true"`
+		Notes *string  `json:"notes"`
+	}
+	name := "sample"
+
+	tests := []struct {
+		title         string
+		request       *sample
+		expectedError string
+	}{
+		{title: "Success - Optional field missing", request: &sample{Name: &name, Tags: []string{}}},
+		{title: "Error - Missing pointer", request: &sample{Tags: []string{"a"}}, expectedError: "name must not be null"},
+		{title: "Error - Missing slice", request: &sample{Name: &name}, expectedError: "tags must not be null"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := checkRequired(tt.request)
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -48,7 +48,7 @@ func TestSectionRequest_Bind(t *testing.T) {
 				WarehouseId:        &warehouseId,
 				ProductTypeId:      &productTypeId,
 			},
-			expectedError: "section_number is required",
+			expectedError: "section_number must not be null",
 		},
 		{
 			title: "Error - missing CurrentTemperature",
@@ -62,7 +62,7 @@ func TestSectionRequest_Bind(t *testing.T) {
 				WarehouseId:        &warehouseId,
 				ProductTypeId:      &productTypeId,
 			},
-			expectedError: "current_temperature is required",
+			expectedError: "current_temperature must not be null",
 		},
 		{
 			title: "Error - missing MinimumTemperature",
@@ -76,7 +76,7 @@ func TestSectionRequest_Bind(t *testing.T) {
 				WarehouseId:        &warehouseId,
 				ProductTypeId:      &productTypeId,
 			},
-			expectedError: "minimum_temperature is required",
+			expectedError: "minimum_temperature must not be null",
 		},
 		{
 			title: "Error - missing CurrentCapacity",
@@ -90,7 +90,7 @@ func TestSectionRequest_Bind(t *testing.T) {
 				WarehouseId:        &warehouseId,
 				ProductTypeId:      &productTypeId,
 			},
-			expectedError: "current_capacity is required",
+			expectedError: "current_capacity must not be null",
 		},
 		{
 			title: "Error - missing MinimumCapacity",
@@ -104,7 +104,7 @@ func TestSectionRequest_Bind(t *testing.T) {
 				WarehouseId:        &warehouseId,
 				ProductTypeId:      &productTypeId,
 			},
-			expectedError: "minimum_capacity is required",
+			expectedError: "minimum_capacity must not be null",
 		},
 		{
 			title: "Error - missing MaximumCapacity",
@@ -118,7 +118,7 @@ func TestSectionRequest_Bind(t *testing.T) {
 				WarehouseId:        &warehouseId,
 				ProductTypeId:      &productTypeId,
 			},
-			expectedError: "maximum_capacity is required",
+			expectedError: "maximum_capacity must not be null",
 		},
 	}
 
@@ -1,29 +1,16 @@
 package request
 
 import (
-	"errors"
 	"net/http"
 )
 
 type SellerRequest struct {
-	Name       *string `json:"name"`        // Name is the name of the seller company
-	Address    *string `json:"address"`     // Address is the address of the seller company
-	Telephone  *string `json:"telephone"`   // Telephone is the telephone of the seller company
-	LocalityId *int    `json:"locality_id"` // LocalityId is the locality id of the seller company
+	Name       *string `json:"name" minLength:"1" required:"true"`      // Name is the name of the seller company
+	Address    *string `json:"address" required:"true"`                 // Address is the address of the seller company
+	Telephone  *string `json:"telephone" required:"true"`               // Telephone is the telephone of the seller company
+	LocalityId *int    `json:"locality_id" minimum:"1" required:"true"` // LocalityId is the locality id of the seller company
 }
 
 func (p *SellerRequest) Bind(r *http.Request) error {
-	if p.Name == nil {
-		return errors.New("name must not be null")
-	}
-	if p.Address == nil {
-		return errors.New("address must not be null")
-	}
-	if p.Telephone == nil {
-		return errors.New("telephone must not be null")
-	}
-	if p.LocalityId == nil {
-		return errors.New("locality_id must not be null")
-	}
-	return nil
+	return checkRequired(p)
 }
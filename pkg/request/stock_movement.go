@@ -0,0 +1,32 @@
+package request
+
+import (
+	"net/http"
+)
+
+// StockMovementRequest is the body of the requests that post an adjustment or a disposal to the stock ledger by hand,
+// along with the reason code that explains it
+type StockMovementRequest struct {
+	ProductBatchId *int    `json:"product_batch_id" minimum:"1" required:"true"`
+	Quantity       *int    `json:"quantity" required:"true"`
+	Reason         *string `json:"reason" enum:"adjustment,disposal" required:"true"`
+	ReasonCode     *string `json:"reason_code" enum:"expired,damaged,temperature_excursion,theft,found"`
+	EmployeeId     *int    `json:"employee_id" minimum:"1"`
+}
+
+func (s *StockMovementRequest) Bind(r *http.Request) error {
+	return checkRequired(s)
+}
+
+// StockAdjustmentRequest is the body of the requests that adjust the stock of a batch, the quantity is negative for
+// the stock removed and positive for the stock found
+type StockAdjustmentRequest struct {
+	ProductBatchId *int    `json:"product_batch_id" minimum:"1" required:"true"`
+	Quantity       *int    `json:"quantity" required:"true"`
+	ReasonCode     *string `json:"reason_code" enum:"expired,damaged,temperature_excursion,theft,found" required:"true"`
+	EmployeeId     *int    `json:"employee_id" minimum:"1" required:"true"`
+}
+
+func (s *StockAdjustmentRequest) Bind(r *http.Request) error {
+	return checkRequired(s)
+}
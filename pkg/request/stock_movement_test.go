@@ -0,0 +1,113 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStockMovementRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	productBatchId := 3
+	quantity := -5
+	reason := "disposal"
+	employeeId := 2
+
+	tests := []struct {
+		title         string
+		request       *StockMovementRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - All fields valid",
+			request: &StockMovementRequest{ProductBatchId: &productBatchId, Quantity: &quantity, Reason: &reason, EmployeeId: &employeeId},
+		},
+		{
+			title:   "Success - Without employee",
+			request: &StockMovementRequest{ProductBatchId: &productBatchId, Quantity: &quantity, Reason: &reason},
+		},
+		{
+			title:         "Error - Missing ProductBatchId",
+			request:       &StockMovementRequest{Quantity: &quantity, Reason: &reason},
+			expectedError: "product_batch_id must not be null",
+		},
+		{
+			title:         "Error - Missing Quantity",
+			request:       &StockMovementRequest{ProductBatchId: &productBatchId, Reason: &reason},
+			expectedError: "quantity must not be null",
+		},
+		{
+			title:         "Error - Missing Reason",
+			request:       &StockMovementRequest{ProductBatchId: &productBatchId, Quantity: &quantity},
+			expectedError: "reason must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestStockAdjustmentRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	productBatchId := 3
+	quantity := -5
+	reasonCode := "expired"
+	employeeId := 2
+
+	tests := []struct {
+		title         string
+		request       *StockAdjustmentRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - All fields valid",
+			request: &StockAdjustmentRequest{ProductBatchId: &productBatchId, Quantity: &quantity, ReasonCode: &reasonCode, EmployeeId: &employeeId},
+		},
+		{
+			title:         "Error - Missing ProductBatchId",
+			request:       &StockAdjustmentRequest{Quantity: &quantity, ReasonCode: &reasonCode, EmployeeId: &employeeId},
+			expectedError: "product_batch_id must not be null",
+		},
+		{
+			title:         "Error - Missing Quantity",
+			request:       &StockAdjustmentRequest{ProductBatchId: &productBatchId, ReasonCode: &reasonCode, EmployeeId: &employeeId},
+			expectedError: "quantity must not be null",
+		},
+		{
+			title:         "Error - Missing ReasonCode",
+			request:       &StockAdjustmentRequest{ProductBatchId: &productBatchId, Quantity: &quantity, EmployeeId: &employeeId},
+			expectedError: "reason_code must not be null",
+		},
+		{
+			title:         "Error - Missing EmployeeId",
+			request:       &StockAdjustmentRequest{ProductBatchId: &productBatchId, Quantity: &quantity, ReasonCode: &reasonCode},
+			expectedError: "employee_id must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package request
+
+import (
+	"net/http"
+)
+
+// TaxRuleRequest is the body of the requests that create or replace a tax rule of a country, the rate is a
+// percentage of the subtotal of the purchase orders
+type TaxRuleRequest struct {
+	Name *string  `json:"name" minLength:"1" required:"true"`
+	Rate *float64 `json:"rate" minimum:"0" maximum:"100" required:"true"`
+}
+
+func (t *TaxRuleRequest) Bind(r *http.Request) error {
+	return checkRequired(t)
+}
@@ -0,0 +1,29 @@
+package request
+
+import (
+	"net/http"
+)
+
+// TransferOrderRequest is the body of the requests that draft a transfer order
+type TransferOrderRequest struct {
+	SourceWarehouseId      *int   `json:"source_warehouse_id" minimum:"1" required:"true"`
+	SourceSectionId        *int   `json:"source_section_id" minimum:"1" required:"true"`
+	DestinationWarehouseId *int   `json:"destination_warehouse_id" minimum:"1" required:"true"`
+	DestinationSectionId   *int   `json:"destination_section_id" minimum:"1" required:"true"`
+	CarrierId              *int   `json:"carrier_id" minimum:"1"`
+	ProductBatchIds        *[]int `json:"product_batch_ids" required:"true"`
+}
+
+func (t *TransferOrderRequest) Bind(r *http.Request) error {
+	return checkRequired(t)
+}
+
+// TransferOrderTransitRequest is the body of the requests that hand a transfer order to its carrier, the carrier
+// replaces the one assigned when the order was drafted
+type TransferOrderTransitRequest struct {
+	CarrierId *int `json:"carrier_id" minimum:"1"`
+}
+
+func (t *TransferOrderTransitRequest) Bind(r *http.Request) error {
+	return nil
+}
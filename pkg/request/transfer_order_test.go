@@ -0,0 +1,95 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferOrderRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	sourceWarehouseId := 1
+	sourceSectionId := 1
+	destinationWarehouseId := 2
+	destinationSectionId := 2
+	carrierId := 3
+	productBatchIds := []int{1, 4}
+
+	tests := []struct {
+		title         string
+		request       *TransferOrderRequest
+		expectedError string
+	}{
+		{
+			title: "Success - All fields valid",
+			request: &TransferOrderRequest{
+				SourceWarehouseId: &sourceWarehouseId, SourceSectionId: &sourceSectionId,
+				DestinationWarehouseId: &destinationWarehouseId, DestinationSectionId: &destinationSectionId,
+				CarrierId: &carrierId, ProductBatchIds: &productBatchIds,
+			},
+		},
+		{
+			title: "Success - Without carrier",
+			request: &TransferOrderRequest{
+				SourceWarehouseId: &sourceWarehouseId, SourceSectionId: &sourceSectionId,
+				DestinationWarehouseId: &destinationWarehouseId, DestinationSectionId: &destinationSectionId,
+				ProductBatchIds: &productBatchIds,
+			},
+		},
+		{
+			title: "Error - Missing SourceWarehouseId",
+			request: &TransferOrderRequest{
+				SourceSectionId: &sourceSectionId, DestinationWarehouseId: &destinationWarehouseId,
+				DestinationSectionId: &destinationSectionId, ProductBatchIds: &productBatchIds,
+			},
+			expectedError: "source_warehouse_id must not be null",
+		},
+		{
+			title: "Error - Missing SourceSectionId",
+			request: &TransferOrderRequest{
+				SourceWarehouseId: &sourceWarehouseId, DestinationWarehouseId: &destinationWarehouseId,
+				DestinationSectionId: &destinationSectionId, ProductBatchIds: &productBatchIds,
+			},
+			expectedError: "source_section_id must not be null",
+		},
+		{
+			title: "Error - Missing DestinationWarehouseId",
+			request: &TransferOrderRequest{
+				SourceWarehouseId: &sourceWarehouseId, SourceSectionId: &sourceSectionId,
+				DestinationSectionId: &destinationSectionId, ProductBatchIds: &productBatchIds,
+			},
+			expectedError: "destination_warehouse_id must not be null",
+		},
+		{
+			title: "Error - Missing DestinationSectionId",
+			request: &TransferOrderRequest{
+				SourceWarehouseId: &sourceWarehouseId, SourceSectionId: &sourceSectionId,
+				DestinationWarehouseId: &destinationWarehouseId, ProductBatchIds: &productBatchIds,
+			},
+			expectedError: "destination_section_id must not be null",
+		},
+		{
+			title: "Error - Missing ProductBatchIds",
+			request: &TransferOrderRequest{
+				SourceWarehouseId: &sourceWarehouseId, SourceSectionId: &sourceSectionId,
+				DestinationWarehouseId: &destinationWarehouseId, DestinationSectionId: &destinationSectionId,
+			},
+			expectedError: "product_batch_ids must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
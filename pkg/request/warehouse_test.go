@@ -44,7 +44,7 @@ func Test_WarehouseBind(t *testing.T) {
 				MinimumTemperature: &minimumTemperature,
 				LocalityId:         &locality_id,
 			},
-			expectedError: "warehouse code must not be null",
+			expectedError: "warehouse_code must not be null",
 		},
 		{
 			title: "Error - Missing address",
@@ -80,7 +80,7 @@ func Test_WarehouseBind(t *testing.T) {
 				MinimumTemperature: &minimumTemperature,
 				LocalityId:         &locality_id,
 			},
-			expectedError: "minimum Capacity must not be null",
+			expectedError: "minimum_capacity must not be null",
 		},
 		{
 			title: "Error - Missing minimum temperature",
@@ -92,7 +92,7 @@ func Test_WarehouseBind(t *testing.T) {
 				MinimumTemperature: nil,
 				LocalityId:         &locality_id,
 			},
-			expectedError: "minimum Temperature must not be null",
+			expectedError: "minimum_temperature must not be null",
 		},
 		{
 			title: "Error - Missing locality id",
@@ -104,7 +104,7 @@ func Test_WarehouseBind(t *testing.T) {
 				MinimumTemperature: &minimumTemperature,
 				LocalityId:         nil,
 			},
-			expectedError: "locality id must not be null",
+			expectedError: "locality_id must not be null",
 		},
 	}
 
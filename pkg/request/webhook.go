@@ -0,0 +1,18 @@
+package request
+
+import (
+	"net/http"
+)
+
+// WebhookSubscriptionRequest is the body of the requests that create or replace a webhook subscription. Active
+// defaults to true.
+type WebhookSubscriptionRequest struct {
+	Url        *string   `json:"url" minLength:"1" required:"true"`
+	Secret     *string   `json:"secret" minLength:"16" required:"true"`
+	EventTypes *[]string `json:"event_types" required:"true"`
+	Active     *bool     `json:"active"`
+}
+
+func (w *WebhookSubscriptionRequest) Bind(r *http.Request) error {
+	return checkRequired(w)
+}
@@ -0,0 +1,55 @@
+package request
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSubscriptionRequest_Bind(t *testing.T) {
+	// Common values for all tests
+	url := "https://example.com/hooks"
+	secret := "0123456789abcdef"
+	eventTypes := []string{"purchase_order.created"}
+
+	tests := []struct {
+		title         string
+		request       *WebhookSubscriptionRequest
+		expectedError string
+	}{
+		{
+			title:   "Success - Active defaults when missing",
+			request: &WebhookSubscriptionRequest{Url: &url, Secret: &secret, EventTypes: &eventTypes},
+		},
+		{
+			title:         "Error - Missing Url",
+			request:       &WebhookSubscriptionRequest{Secret: &secret, EventTypes: &eventTypes},
+			expectedError: "url must not be null",
+		},
+		{
+			title:         "Error - Missing Secret",
+			request:       &WebhookSubscriptionRequest{Url: &url, EventTypes: &eventTypes},
+			expectedError: "secret must not be null",
+		},
+		{
+			title:         "Error - Missing EventTypes",
+			request:       &WebhookSubscriptionRequest{Url: &url, Secret: &secret},
+			expectedError: "event_types must not be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			// Act
+			err := tt.request.Bind(&http.Request{})
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package response
+
+import (
+	"bytes"
+	"github.com/go-chi/render"
+	"html/template"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	// FormatHTML renders a document as a web page, ready to be printed
+	FormatHTML = "html"
+
+	// ContentTypeHTML is the media type of HTML documents
+	ContentTypeHTML = "text/html"
+)
+
+// DocumentFormat returns the format of a document requested with the format query parameter or, when it is missing,
+// with the Accept header. It returns an empty string when the document must be rendered as JSON.
+func DocumentFormat(r *http.Request) string {
+	if format := strings.ToLower(r.URL.Query().Get("format")); format != "" {
+		if format == FormatHTML {
+			return FormatHTML
+		}
+		return ""
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, _ := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if mediaType == ContentTypeHTML {
+			return FormatHTML
+		}
+	}
+	return ""
+}
+
+// RenderDocument renders a document in the format negotiated by the request. Documents rendered as HTML are
+// executed with the template and shown inline as name.html, the template is executed before anything is written so a
+// failure is still reported as a JSON error.
+func RenderDocument(w http.ResponseWriter, r *http.Request, name string, tmpl *template.Template, data any) {
+	if DocumentFormat(r) == "" {
+		_ = render.Render(w, r, NewResponse(data, http.StatusOK))
+		return
+	}
+
+	var page bytes.Buffer
+	if err := tmpl.Execute(&page, data); err != nil {
+		_ = render.Render(w, r, NewErrorResponse(err.Error(), http.StatusInternalServerError))
+		return
+	}
+	w.Header().Set("Content-Type", ContentTypeHTML+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{
+		"filename": name + "." + FormatHTML,
+	}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(page.Bytes())
+}
@@ -0,0 +1,45 @@
+package response
+
+import (
+	"html/template"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentFormat(t *testing.T) {
+	tests := []struct {
+		title    string
+		target   string
+		accept   string
+		expected string
+	}{
+		{title: "json by default", target: "/invoice", expected: ""},
+		{title: "html from the accept header", target: "/invoice", accept: "text/html,application/xhtml+xml;q=0.9", expected: FormatHTML},
+		{title: "html from the query", target: "/invoice?format=HTML", expected: FormatHTML},
+		{title: "query takes precedence", target: "/invoice?format=json", accept: ContentTypeHTML, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			request := httptest.NewRequest("GET", test.target, nil)
+			request.Header.Set("Accept", test.accept)
+
+			require.Equal(t, test.expected, DocumentFormat(request))
+		})
+	}
+}
+
+func TestRenderDocument_HTML(t *testing.T) {
+	tmpl := template.Must(template.New("doc").Parse(`<p>{{.Name}}</p>`))
+	request := httptest.NewRequest("GET", "/invoice?format=html", nil)
+	recorder := httptest.NewRecorder()
+
+	RenderDocument(recorder, request, "doc", tmpl, embeddedReport{Id: 1, Name: "<North>"})
+
+	require.Equal(t, 200, recorder.Code)
+	require.Equal(t, "text/html; charset=utf-8", recorder.Header().Get("Content-Type"))
+	require.Equal(t, "inline; filename=doc.html", recorder.Header().Get("Content-Disposition"))
+	require.Equal(t, "<p>&lt;North&gt;</p>", recorder.Body.String())
+}
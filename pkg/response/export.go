@@ -0,0 +1,230 @@
+package response
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/go-chi/render"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// FormatCSV exports a report as comma separated values
+	FormatCSV = "csv"
+	// FormatXLSX exports a report as an Excel workbook
+	FormatXLSX = "xlsx"
+
+	// ContentTypeCSV is the media type of CSV exports
+	ContentTypeCSV = "text/csv"
+	// ContentTypeXLSX is the media type of XLSX exports
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// flushEvery is the number of rows written between flushes of the response
+const flushEvery = 500
+
+// ExportFormat returns the export format requested with the format query parameter or, when it is missing, with the
+// Accept header. It returns an empty string when the report must be rendered as JSON.
+func ExportFormat(r *http.Request) string {
+	switch strings.ToLower(r.URL.Query().Get("format")) {
+	case FormatCSV:
+		return FormatCSV
+	case FormatXLSX:
+		return FormatXLSX
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, _ := mime.ParseMediaType(strings.TrimSpace(accepted))
+		switch mediaType {
+		case ContentTypeCSV:
+			return FormatCSV
+		case ContentTypeXLSX:
+			return FormatXLSX
+		}
+	}
+	return ""
+}
+
+// RenderReport renders a report, a struct or a slice of structs, in the format negotiated by the request. Reports
+// exported as CSV or XLSX are downloaded as name.csv or name.xlsx, with one column per JSON field of the struct.
+func RenderReport(w http.ResponseWriter, r *http.Request, name string, data any) {
+	format := ExportFormat(r)
+	if format == "" {
+		_ = render.Render(w, r, NewResponse(data, http.StatusOK))
+		return
+	}
+
+	table := NewTable(data)
+	switch format {
+	case FormatCSV:
+		w.Header().Set("Content-Type", ContentTypeCSV+"; charset=utf-8")
+	case FormatXLSX:
+		w.Header().Set("Content-Type", ContentTypeXLSX)
+	}
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": name + "." + format,
+	}))
+	w.WriteHeader(http.StatusOK)
+
+	if format == FormatCSV {
+		_ = table.WriteCSV(w)
+		return
+	}
+	_ = table.WriteXLSX(w, name)
+}
+
+// Table is a tabular view of a report, its columns are the JSON fields of the report struct, embedded structs
+// included, in declaration order
+type Table struct {
+	columns []column
+	rows    reflect.Value
+}
+
+// column is a single exported field of the report struct
+type column struct {
+	name  string
+	index []int
+}
+
+// NewTable builds the table of a struct, a pointer to a struct or a slice of them
+func NewTable(data any) *Table {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+
+	rows := value
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		rows = reflect.MakeSlice(reflect.SliceOf(value.Type()), 0, 1)
+		if value.IsValid() {
+			rows = reflect.Append(rows, value)
+		}
+	}
+
+	element := rows.Type().Elem()
+	for element.Kind() == reflect.Pointer {
+		element = element.Elem()
+	}
+
+	var columns []column
+	if element.Kind() == reflect.Struct {
+		columns = structColumns(element, nil)
+	}
+	return &Table{columns: columns, rows: rows}
+}
+
+// Header returns the name of every column
+func (t *Table) Header() []string {
+	header := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		header[i] = c.name
+	}
+	return header
+}
+
+// Len returns the number of rows
+func (t *Table) Len() int {
+	return t.rows.Len()
+}
+
+// Row returns the cells of the i-th row, a nil cell is an empty value
+func (t *Table) Row(i int) []any {
+	row := reflect.Indirect(t.rows.Index(i))
+	cells := make([]any, len(t.columns))
+	for j, c := range t.columns {
+		field, err := row.FieldByIndexErr(c.index)
+		if err != nil {
+			continue
+		}
+		for field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				break
+			}
+			field = field.Elem()
+		}
+		if field.Kind() == reflect.Pointer {
+			continue
+		}
+		cells[j] = field.Interface()
+	}
+	return cells
+}
+
+// WriteCSV streams the table as CSV, header first
+func (t *Table) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(t.Header()); err != nil {
+		return err
+	}
+
+	record := make([]string, len(t.columns))
+	for i := 0; i < t.Len(); i++ {
+		for j, cell := range t.Row(i) {
+			record[j] = formatCell(cell)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+		// flush periodically so long reports reach the client as they are written
+		if i%flushEvery == flushEvery-1 {
+			writer.Flush()
+			flush(w)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// flush sends the buffered response to the client, if the writer supports it
+func flush(w io.Writer) {
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// structColumns returns the columns of a struct type, flattening embedded structs
+func structColumns(t reflect.Type, index []int) []column {
+	var columns []column
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		// like encoding/json, the fields of embedded structs are promoted even when the struct is unexported
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			columns = append(columns, structColumns(field.Type, fieldIndex)...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, column{name: name, index: fieldIndex})
+	}
+	return columns
+}
+
+// formatCell returns the text of a cell
+func formatCell(cell any) string {
+	switch value := cell.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float32:
+		return strconv.FormatFloat(float64(value), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
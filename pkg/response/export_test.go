@@ -0,0 +1,124 @@
+package response
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type embeddedReport struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type testReport struct {
+	embeddedReport
+	Count    *int    `json:"count,omitempty"`
+	Ratio    float64 `json:"ratio"`
+	Internal string  `json:"-"`
+}
+
+func TestExportFormat(t *testing.T) {
+	tests := []struct {
+		title    string
+		target   string
+		accept   string
+		expected string
+	}{
+		{title: "json by default", target: "/report", expected: ""},
+		{title: "csv from the accept header", target: "/report", accept: "text/csv", expected: FormatCSV},
+		{title: "xlsx from the accept header", target: "/report", accept: "application/json;q=0.5, " + ContentTypeXLSX, expected: FormatXLSX},
+		{title: "xlsx from the query", target: "/report?format=xlsx", expected: FormatXLSX},
+		{title: "query takes precedence", target: "/report?format=csv", accept: ContentTypeXLSX, expected: FormatCSV},
+		{title: "unknown format", target: "/report?format=pdf", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			request := httptest.NewRequest("GET", test.target, nil)
+			request.Header.Set("Accept", test.accept)
+
+			require.Equal(t, test.expected, ExportFormat(request))
+		})
+	}
+}
+
+func TestTable_WriteCSV(t *testing.T) {
+	count := 7
+	tests := []struct {
+		title    string
+		data     any
+		expected string
+	}{
+		{
+			title: "slice of reports",
+			data: []testReport{
+				{embeddedReport: embeddedReport{Id: 1, Name: "North, 1"}, Count: &count, Ratio: 0.25, Internal: "x"},
+				{embeddedReport: embeddedReport{Id: 2, Name: "South"}, Ratio: 1},
+			},
+			expected: "id,name,count,ratio\n1,\"North, 1\",7,0.25\n2,South,,1\n",
+		},
+		{
+			title:    "single report",
+			data:     testReport{embeddedReport: embeddedReport{Id: 3, Name: "East"}},
+			expected: "id,name,count,ratio\n3,East,,0\n",
+		},
+		{
+			title:    "empty report",
+			data:     []testReport{},
+			expected: "id,name,count,ratio\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := NewTable(test.data).WriteCSV(&buf)
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestTable_WriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	data := []testReport{{embeddedReport: embeddedReport{Id: 1, Name: "<North>"}, Ratio: 0.5}}
+
+	err := NewTable(data).WriteXLSX(&buf, "sections/report")
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		parts[file.Name] = string(content)
+	}
+
+	require.Contains(t, parts, "[Content_Types].xml")
+	require.Contains(t, parts["xl/workbook.xml"], `name="sections_report"`)
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	require.True(t, strings.Contains(sheet, `<c r="A1" t="inlineStr"><is><t xml:space="preserve">id</t></is></c>`))
+	require.True(t, strings.Contains(sheet, `<c r="A2"><v>1</v></c>`))
+	require.True(t, strings.Contains(sheet, `<t xml:space="preserve">&lt;North&gt;</t>`))
+	require.True(t, strings.Contains(sheet, `<c r="D2"><v>0.5</v></c>`))
+	require.False(t, strings.Contains(sheet, `r="C2"`))
+}
+
+func TestColumnName(t *testing.T) {
+	require.Equal(t, "A", columnName(0))
+	require.Equal(t, "Z", columnName(25))
+	require.Equal(t, "AA", columnName(26))
+	require.Equal(t, "AZ", columnName(51))
+	require.Equal(t, "BA", columnName(52))
+}
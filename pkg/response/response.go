@@ -9,6 +9,7 @@ type Response struct {
 	//Data	render.Renderer	`json:"data"`
 	Data       any    `json:"data,omitempty"`
 	Message    string `json:"message,omitempty"`
+	Errors     any    `json:"errors,omitempty"`
 	StatusCode int    `json:"-"`
 }
 
@@ -36,3 +37,13 @@ func NewErrorResponse(msg string, statusCode int) *Response {
 	}
 	return resp
 }
+
+// NewValidationErrorResponse returns an error response that details the problem of every rejected field
+func NewValidationErrorResponse(msg string, errors any, statusCode int) *Response {
+	resp := &Response{
+		Message:    msg,
+		Errors:     errors,
+		StatusCode: statusCode,
+	}
+	return resp
+}
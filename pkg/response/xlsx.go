@@ -0,0 +1,144 @@
+package response
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// xlsxParts holds the static parts of a single sheet workbook, the sheet itself is streamed
+var xlsxParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// WriteXLSX streams the table as a workbook with a single sheet named after the report, header first
+func (t *Table) WriteXLSX(w io.Writer, sheet string) error {
+	archive := zip.NewWriter(w)
+
+	for _, part := range xlsxParts {
+		writer, err := archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(writer, part.content); err != nil {
+			return err
+		}
+	}
+
+	writer, err := archive.Create("xl/workbook.xml")
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(writer, xml.Header+
+		`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" `+
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`+
+		`<sheets><sheet name="`+escapeXML(sheetName(sheet))+`" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+	if err != nil {
+		return err
+	}
+
+	writer, err = archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(writer, xml.Header+
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return err
+	}
+
+	header := make([]any, len(t.columns))
+	for i, name := range t.Header() {
+		header[i] = name
+	}
+	if err := writeXLSXRow(writer, 1, header); err != nil {
+		return err
+	}
+	for i := 0; i < t.Len(); i++ {
+		if err := writeXLSXRow(writer, i+2, t.Row(i)); err != nil {
+			return err
+		}
+		if i%flushEvery == flushEvery-1 {
+			if err := archive.Flush(); err != nil {
+				return err
+			}
+			flush(w)
+		}
+	}
+
+	if _, err := io.WriteString(writer, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// writeXLSXRow writes a row of the sheet, numbers are stored as numeric cells and everything else as inline strings
+func writeXLSXRow(w io.Writer, number int, cells []any) error {
+	var row strings.Builder
+	row.WriteString(`<row r="` + strconv.Itoa(number) + `">`)
+	for i, cell := range cells {
+		if cell == nil {
+			continue
+		}
+		ref := columnName(i) + strconv.Itoa(number)
+		switch cell.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			row.WriteString(`<c r="` + ref + `"><v>` + formatCell(cell) + `</v></c>`)
+		default:
+			row.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">` +
+				escapeXML(formatCell(cell)) + `</t></is></c>`)
+		}
+	}
+	row.WriteString(`</row>`)
+
+	_, err := io.WriteString(w, row.String())
+	return err
+}
+
+// columnName returns the letters of the zero based i-th column: A, B, ..., Z, AA, AB...
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// sheetName returns a valid sheet name, at most 31 characters long and without the characters Excel forbids
+func sheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" {
+		return "report"
+	}
+	if runes := []rune(name); len(runes) > 31 {
+		return string(runes[:31])
+	}
+	return name
+}
+
+// escapeXML escapes a text node or attribute value
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	sentAt := time.Unix(1_750_000_000, 0)
+	body := []byte(`{"id":"evt-1"}`)
+	header := Sign("secret", sentAt, body)
+
+	tests := []struct {
+		name        string
+		secret      string
+		header      string
+		body        []byte
+		now         time.Time
+		expectedErr error
+	}{
+		{name: "Success - Valid signature", secret: "secret", header: header, body: body, now: sentAt.Add(time.Minute)},
+		{name: "Success - Any age without tolerance", secret: "secret", header: header, body: body, now: sentAt.Add(24 * time.Hour)},
+		{name: "Error - Wrong secret", secret: "other", header: header, body: body, now: sentAt, expectedErr: ErrInvalidSignature},
+		{name: "Error - Tampered body", secret: "secret", header: header, body: []byte(`{"id":"evt-2"}`), now: sentAt, expectedErr: ErrInvalidSignature},
+		{name: "Error - Malformed header", secret: "secret", header: "v1=abc", body: body, now: sentAt, expectedErr: ErrInvalidSignature},
+		{name: "Error - Expired", secret: "secret", header: header, body: body, now: sentAt.Add(10 * time.Minute), expectedErr: ErrExpiredSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tolerance := 5 * time.Minute
+			if tt.name == "Success - Any age without tolerance" {
+				tolerance = 0
+			}
+
+			// Act
+			err := Verify(tt.secret, tt.header, tt.body, tt.now, tolerance)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}